@@ -3,23 +3,43 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/websocket/v2"
 	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/circuit"
 	"github.com/yourorg/meeting-cost/backend/go/internal/config"
 	"github.com/yourorg/meeting-cost/backend/go/internal/container"
 	"github.com/yourorg/meeting-cost/backend/go/internal/handler"
+	"github.com/yourorg/meeting-cost/backend/go/internal/jobs"
 	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/metrics"
 	"github.com/yourorg/meeting-cost/backend/go/internal/middleware"
+	"github.com/yourorg/meeting-cost/backend/go/internal/openapi"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/seed"
+	"gorm.io/gorm"
 )
 
+// HealthResponse is the body of the health checks.
+type HealthResponse struct {
+	Status string `json:"status"`
+	// Cache is "degraded" while the cache circuit breaker is open.
+	Cache string `json:"cache"`
+}
+
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	cfg, err := config.Load()
 	if err != nil {
@@ -30,121 +50,239 @@ func main() {
 	}
 
 	// 1. Initialize Logger
-	l, err := logger.NewZapLogger(os.Getenv("ENV"))
+	level, err := logger.NewLevel(cfg.Log.Level)
+	if err != nil {
+		log.Fatalf("initialize logger: %v", err)
+	}
+	l, err := logger.NewZapLogger(os.Getenv("ENV"), level)
 	if err != nil {
 		log.Fatalf("initialize logger: %v", err)
 	}
+	l = logger.Redact(l, cfg.Log.Redaction)
 
 	// 2. Initialize Code Cache
-	cacheClient := cache.NewRedisCache(cfg.Cache.Addr, cfg.Cache.Password, cfg.Cache.DB)
-
 	// 3. Initialize Database
-	db, err := config.NewDB(&cfg.Database)
-	if err != nil {
-		log.Fatalf("initialize database: %v", err)
+	// Demo mode needs neither: the container serves everything from memory.
+	var (
+		cacheClient cache.Cache
+		db          *gorm.DB
+	)
+	if cfg.Demo() {
+		l.Warn("running in demo mode; data is kept in memory and lost on exit")
+		cacheClient = cache.NewMemoryCache(cfg.Cache.LocalSize)
+	} else {
+		cacheClient = cache.NewRedisCache(cfg.Cache.Addr, cfg.Cache.Password, cfg.Cache.DB)
+
+		db, err = config.NewDB(&cfg.Database)
+		if err != nil {
+			log.Fatalf("initialize database: %v", err)
+		}
 	}
 
 	// 4. Initialize Dependency Injection Container
-	ctn, err := container.NewContainer(ctx, cfg, db, cacheClient, l)
+	ctn, err := container.NewContainer(ctx, cfg, db, cacheClient, l, level)
 	if err != nil {
 		log.Fatalf("initialize container: %v", err)
 	}
 
 	// Run AutoMigrate in development
-	if cfg.Env == "development" {
+	if cfg.Env == "development" && !cfg.Demo() {
 		l.Info("running auto-migration")
 		if err := config.AutoMigrate(db); err != nil {
 			l.Error("auto-migration failed", "error", err)
 		}
 	}
 
+	// An empty in-memory store is no demo
+	if cfg.Demo() {
+		if _, err := seed.Run(ctx, ctn, time.Now()); err != nil {
+			log.Fatalf("seed demo data: %v", err)
+		}
+		// The demo credentials are public, so they go in the message where
+		// log redaction leaves them readable
+		l.Info(fmt.Sprintf("seeded demo data; sign in as %s with password %s", seed.AdminEmail, seed.Password))
+
+		// No separate worker process in demo mode
+		srv := queue.NewServer(ctn.QueueBroker, queue.ServerOptions{
+			Concurrency: cfg.Queue.Concurrency,
+			Lease:       cfg.Queue.Lease,
+		}, l, ctn.Metrics)
+		jobs.Register(srv, ctn)
+		go func() { _ = srv.Run(ctx) }()
+
+		sched := queue.NewScheduler(ctn.QueueBroker, l)
+		if err := jobs.Schedule(sched, cfg); err != nil {
+			log.Fatalf("schedule jobs: %v", err)
+		}
+		go sched.Run(ctx)
+		if cfg.Queue.CostTickInterval > 0 {
+			go jobs.RunCostTicker(ctx, ctn, cfg.Queue.CostTickInterval)
+		}
+	}
+
 	app := fiber.New(fiber.Config{
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 	})
 
-	// Add CORS middleware
+	// Add CORS middleware; the extension API sets its own
 	app.Use(cors.New(cors.Config{
+		Next: func(c *fiber.Ctx) bool {
+			return strings.HasPrefix(c.Path(), "/api/ext/")
+		},
 		AllowOrigins: "*",
 		AllowHeaders: "Origin, Content-Type, Accept, Authorization",
 		AllowMethods: "GET, POST, PUT, DELETE, PATCH, OPTIONS",
+		// Let browser clients see that /api/v1 is deprecated, and the link in
+		// a check-in QR code
+		ExposeHeaders: "Deprecation, Sunset, Link, X-Checkin-Url",
 	}))
 
 	// Add logging middleware
 	app.Use(logger.Middleware(l))
 
+	// Record full access trails of sensitive routes for customers who
+	// need them
+	if len(cfg.Audit.TrailGroups) > 0 {
+		trail, err := middleware.AuditTrail(ctn.AuditLogService, cfg.Audit.TrailGroups)
+		if err != nil {
+			log.Fatalf("AUDIT_TRAIL_GROUPS: %v", err)
+		}
+		app.Use(trail)
+	}
+
+	// Count each organization's requests by credential for its API usage
+	app.Use(middleware.APIUsage(ctn.APIUsageService))
+
 	// 5. Initialize Handlers
 	meetingHandler := handler.NewMeetingHandler(ctn.MeetingService)
 	authHandler := handler.NewAuthHandler(ctn.AuthService)
 	orgHandler := handler.NewOrganizationHandler(ctn.OrgService)
 	consentHandler := handler.NewConsentHandler(ctn.ConsentService)
 	wsHandler := handler.NewWebsocketHandler(ctn.PubSub, ctn.Logger)
+	adminHandler := handler.NewAdminHandler(ctn.MaintenanceService, ctn.LogLevelService, cfg.Purge.Retention)
+	webhookHandler := handler.NewWebhookHandler(ctn.WebhookService)
+	integrationHandler := handler.NewIntegrationHandler(ctn.IntegrationService)
+	wageSyncHandler := handler.NewWageSyncHandler(ctn.WageSyncService)
+	warehouseHandler := handler.NewWarehouseExportHandler(ctn.WarehouseService)
+	dpaHandler := handler.NewDPAHandler(ctn.DPAService)
+	archiveHandler := handler.NewArchiveHandler(ctn.ArchiveService)
+	notificationHandler := handler.NewNotificationHandler(ctn.NotifyService)
+	alertHandler := handler.NewCostAlertHandler(ctn.CostAlertService)
+	subscriptionHandler := handler.NewSubscriptionHandler(ctn.SubscriptionService, ctn.UsageService)
+	apiUsageHandler := handler.NewAPIUsageHandler(ctn.APIUsageService)
+	reportHandler := handler.NewReportHandler(ctn.ReportService, ctn.ReportExportService)
+	surveyHandler := handler.NewSurveyHandler(ctn.SurveyService)
+	accountHandler := handler.NewAccountHandler(ctn.DeletionService, ctn.PersonEmailService)
+	extensionHandler := handler.NewExtensionHandler(ctn.ExtensionService)
+	calculatorHandler := handler.NewCalculatorHandler(ctn.CalculatorService)
 
 	// 6. Routes
-	app.Get("/health", func(c *fiber.Ctx) error {
-		return c.JSON(fiber.Map{"status": "ok"})
-	})
+	// The API stays up without Redis, so a tripped cache circuit only
+	// degrades health.
+	health := func(c *fiber.Ctx) error {
+		cacheStatus := "ok"
+		if ctn.CacheBreaker.State() != circuit.Closed {
+			cacheStatus = "degraded"
+		}
+		return c.JSON(HealthResponse{Status: "ok", Cache: cacheStatus})
+	}
+	app.Get("/health", health)
+
+	// Only the API exports the meeting gauges, so workers don't repeat them
+	metrics.RegisterMeetings(ctn.Metrics, ctn.MeetingRepo)
+	app.Get("/metrics", metrics.Handler(ctn.Metrics))
 
 	// Websocket routes
 	app.Get("/ws/meetings/:id", websocket.New(wsHandler.HandleMeetingEvents))
 
-	apiV1 := app.Group("/api/v1")
-	{
-		apiV1.Get("/health", func(c *fiber.Ctx) error {
-			return c.JSON(fiber.Map{"status": "ok"})
-		})
-
-		// Public consent routes
-		apiV1.Get("/consent", consentHandler.GetConsent)
-		apiV1.Post("/consent", consentHandler.UpdateConsent)
-
-		auth := apiV1.Group("/auth")
-		{
-			auth.Post("/register", authHandler.Register)
-			auth.Post("/login", authHandler.Login)
-			auth.Post("/logout", authHandler.Logout)
-			auth.Post("/refresh", authHandler.RefreshToken)
-			auth.Get("/me", middleware.AuthRequired(ctn.AuthService), authHandler.Me)
-		}
-
-		// Private consent routes
-		apiV1.Get("/consent/history", middleware.AuthRequired(ctn.AuthService), consentHandler.GetHistory)
-		apiV1.Post("/consent/sync", middleware.AuthRequired(ctn.AuthService), consentHandler.SyncConsent)
-
-		organizations := apiV1.Group("/organizations", middleware.AuthRequired(ctn.AuthService))
-		{
-			organizations.Get("/", orgHandler.ListOrganizations)
-			organizations.Post("/", orgHandler.CreateOrganization)
-			organizations.Get("/:id", orgHandler.GetOrganization)
-			organizations.Put("/:id", orgHandler.UpdateOrganization)
-			organizations.Delete("/:id", orgHandler.DeleteOrganization)
-			organizations.Get("/:id/members", orgHandler.GetMembers)
-			organizations.Post("/:id/members", orgHandler.AddMember)
-			organizations.Delete("/:id/members/:memberId", orgHandler.RemoveMember)
-			organizations.Patch("/:id/members/:memberId/wage", orgHandler.UpdateMemberWage)
-		}
+	// Every /api/ route is registered through the documenting router. v1 is
+	// frozen: breaking changes ship in v2 and v1 announces its successor.
+	docs := openapi.New("Meeting Cost API", "2.0.0")
+	app.Get("/docs/openapi.json", docs.Handler())
+	app.Get("/docs", openapi.UIHandler("Meeting Cost API", "/docs/openapi.json"))
 
-		meetings := apiV1.Group("/meetings", middleware.AuthRequired(ctn.AuthService))
-		{
-			meetings.Get("/", meetingHandler.ListMeetings)
-			meetings.Post("/", meetingHandler.CreateMeeting)
-			meetings.Get("/:id", meetingHandler.GetMeeting)
-			meetings.Post("/:id/start", meetingHandler.StartMeeting)
-			meetings.Post("/:id/stop", meetingHandler.StopMeeting)
-			meetings.Patch("/:id/attendees", meetingHandler.UpdateAttendeeCount)
-			meetings.Get("/:id/cost", meetingHandler.GetMeetingCost)
-			meetings.Delete("/:id", meetingHandler.DeleteMeeting)
+	h := &handlers{
+		health:                health,
+		authRequired:          middleware.AuthRequired(ctn.AuthService),
+		requesterContext:      middleware.RequesterContext(ctn.Requesters),
+		tenantGuard:           middleware.TenantGuard(ctn.ProfileRepo, ctn.MeetingRepo),
+		adminRequired:         middleware.AdminRequired(cfg.Auth.AdminToken),
+		introspectionRequired: middleware.IntrospectionRequired(cfg.Auth.IntrospectionToken),
+		extensionRequired:     middleware.ExtensionTokenRequired(ctn.ExtensionService),
+		calculatorLimit:       middleware.RateLimit(cfg.Server.CalculatorRateLimit, time.Minute),
+		auth:                  authHandler,
+		consent:               consentHandler,
+		orgs:                  orgHandler,
+		meetings:              meetingHandler,
+		admin:                 adminHandler,
+		webhooks:              webhookHandler,
+		integrations:          integrationHandler,
+		wageSync:              wageSyncHandler,
+		warehouse:             warehouseHandler,
+		dpa:                   dpaHandler,
+		archive:               archiveHandler,
+		notify:                notificationHandler,
+		alerts:                alertHandler,
+		billing:               subscriptionHandler,
+		apiUsage:              apiUsageHandler,
+		reports:               reportHandler,
+		surveys:               surveyHandler,
+		account:               accountHandler,
+		extension:             extensionHandler,
+		calculator:            calculatorHandler,
+	}
+	if !cfg.Auth.TenantGuard {
+		h.tenantGuard = passThrough
+	}
+	h.rowLevelSecurity = passThrough
+	if cfg.Database.RowLevelSecurity {
+		sqlDB, err := db.DB()
+		if err != nil {
+			log.Fatalf("get underlying sql.DB: %v", err)
 		}
+		h.rowLevelSecurity = middleware.RowLevelSecurity(sqlDB, ctn.ProfileRepo)
 	}
 
+	apiV1 := openapi.NewRouter(app.Group("/api/v1", middleware.Deprecated("/api/v2", cfg.Server.V1Sunset)), docs, "/api/v1").
+		Deprecated()
+	registerAPI(apiV1, h, 1)
+
+	apiV2 := openapi.NewRouter(app.Group("/api/v2", middleware.EnvelopeErrors()), docs, "/api/v2").
+		ErrorBody(middleware.ErrorEnvelope{})
+	registerAPI(apiV2, h, 2)
+
+	// The browser extension's API is unversioned and takes calls from
+	// extension origins only
+	apiExt := openapi.NewRouter(app.Group("/api/ext", cors.New(cors.Config{
+		AllowOriginsFunc: middleware.ExtensionOrigins(cfg.Server.ExtensionOrigins),
+		AllowHeaders:     "Content-Type, Authorization",
+		AllowMethods:     "GET, POST, OPTIONS",
+	})), docs, "/api/ext")
+	registerExtensionAPI(apiExt, h)
+
 	port := cfg.Server.Port
 	if p := os.Getenv("PORT"); p != "" {
 		if i, err := strconv.Atoi(p); err == nil {
 			port = i
 		}
 	}
+	// Stop taking requests on a signal, then write what's still buffered
+	go func() {
+		<-ctx.Done()
+		_ = app.Shutdown()
+	}()
 	l.Info("listening", "port", port)
 	if err := app.Listen(":" + strconv.Itoa(port)); err != nil {
 		log.Fatalf("listen: %v", err)
 	}
+	if err := ctn.Close(); err != nil {
+		l.Error("closing container", "error", err)
+	}
+	l.Info("api stopped")
+}
+
+// passThrough stands in for middleware that is turned off.
+func passThrough(c *fiber.Ctx) error {
+	return c.Next()
 }
@@ -0,0 +1,1014 @@
+package main
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/yourorg/meeting-cost/backend/go/internal/handler"
+	"github.com/yourorg/meeting-cost/backend/go/internal/openapi"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+	"github.com/yourorg/meeting-cost/backend/go/internal/warehouse"
+)
+
+// handlers serves every version of the API.
+type handlers struct {
+	health                fiber.Handler
+	authRequired          fiber.Handler
+	requesterContext      fiber.Handler
+	tenantGuard           fiber.Handler
+	rowLevelSecurity      fiber.Handler
+	adminRequired         fiber.Handler
+	introspectionRequired fiber.Handler
+	extensionRequired     fiber.Handler
+	calculatorLimit       fiber.Handler
+
+	auth         *handler.AuthHandler
+	consent      *handler.ConsentHandler
+	orgs         *handler.OrganizationHandler
+	meetings     *handler.MeetingHandler
+	admin        *handler.AdminHandler
+	webhooks     *handler.WebhookHandler
+	integrations *handler.IntegrationHandler
+	wageSync     *handler.WageSyncHandler
+	warehouse    *handler.WarehouseExportHandler
+	dpa          *handler.DPAHandler
+	archive      *handler.ArchiveHandler
+	notify       *handler.NotificationHandler
+	alerts       *handler.CostAlertHandler
+	billing      *handler.SubscriptionHandler
+	apiUsage     *handler.APIUsageHandler
+	reports      *handler.ReportHandler
+	surveys      *handler.SurveyHandler
+	account      *handler.AccountHandler
+	extension    *handler.ExtensionHandler
+	calculator   *handler.CalculatorHandler
+}
+
+// registerAPI registers the routes of one API version. Versions share
+// handlers; v2 paginates list responses, and its error envelope is applied
+// by middleware on its group.
+func registerAPI(api *openapi.Router, h *handlers, version int) {
+	api.Tag("health").Get("/health", openapi.Route{
+		Summary:  "Report API health",
+		Response: HealthResponse{},
+	}, h.health)
+
+	// Public consent routes
+	consent := api.Tag("consent")
+	sessionQuery := openapi.Query{Name: "session_id", Description: "Anonymous browser session", Required: true}
+	consent.Get("/consent", openapi.Route{
+		Summary:  "Get cookie consent for a session",
+		Query:    []openapi.Query{sessionQuery},
+		Response: service.ConsentDTO{},
+		Errors:   []int{fiber.StatusNotFound},
+	}, h.consent.GetConsent)
+	consent.Post("/consent", openapi.Route{
+		Summary:  "Record cookie consent",
+		Request:  service.UpdateConsentRequest{},
+		Response: service.ConsentDTO{},
+		Errors:   []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+	}, h.consent.UpdateConsent)
+	consent.Post("/consent/do-not-sell", openapi.Route{
+		Summary:     "Opt out of, or back into, the sale or sharing of personal information",
+		Description: "Records the CCPA/CPRA choice as a new consent for the session, declining marketing while opted out.",
+		Request:     service.SetDoNotSellRequest{},
+		Response:    service.ConsentDTO{},
+		Errors:      []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+	}, h.consent.SetDoNotSell)
+	consent.Get("/consent/categories", openapi.Route{
+		Summary:  "List consent categories",
+		Response: []*service.ConsentCategoryDTO{},
+		Errors:   []int{fiber.StatusInternalServerError},
+	}, h.consent.ListCategories)
+	consent.Get("/consent/banner", openapi.Route{
+		Summary:  "Get the consent banner configuration",
+		Query:    []openapi.Query{{Name: "session_id", Description: "Anonymous browser session, to learn whether it must be asked for consent"}},
+		Response: service.ConsentBannerDTO{},
+		Errors:   []int{fiber.StatusInternalServerError},
+	}, h.consent.GetBanner)
+
+	api.Tag("compliance").Get("/subprocessors", openapi.Route{
+		Summary:  "List sub-processors",
+		Response: []service.SubProcessor{},
+	}, h.dpa.ListSubProcessors)
+
+	auth := api.Group("/auth").Tag("auth")
+	{
+		auth.Post("/register", openapi.Route{
+			Summary:  "Create an account",
+			Request:  service.RegisterRequest{},
+			Response: service.RegisterResponse{},
+			Status:   fiber.StatusCreated,
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+		}, h.auth.Register)
+		auth.Post("/login", openapi.Route{
+			Summary:  "Sign in with email and password",
+			Request:  service.LoginRequest{},
+			Response: service.LoginResponse{},
+			Errors:   []int{fiber.StatusUnauthorized},
+		}, h.auth.Login)
+		auth.Post("/logout", openapi.Route{
+			Summary:     "Sign out",
+			Description: "Revokes the session of the bearer token, if any.",
+		}, h.auth.Logout)
+		auth.Security(openapi.BearerAuth).Post("/logout-all", openapi.Route{
+			Summary:     "Sign out everywhere",
+			Description: "Revokes every session of the signed-in person, including the bearer token's, and records it in the audit log. Refresh tokens already issued stay valid until they expire.",
+			Errors:      []int{fiber.StatusInternalServerError},
+		}, h.authRequired, h.auth.LogoutAll)
+		auth.Security(openapi.BearerAuth).Get("/sessions", openapi.Route{
+			Summary:     "List the signed-in person's sessions",
+			Description: "Live sessions, most recently active first, with the browser and address each started from. The bearer token's own session is marked current.",
+			Response:    []*service.SessionDTO{},
+			Errors:      []int{fiber.StatusInternalServerError},
+		}, h.authRequired, h.auth.ListSessions)
+		auth.Security(openapi.BearerAuth).Delete("/sessions/:id", openapi.Route{
+			Summary:     "Revoke one of the signed-in person's sessions",
+			Description: "Signs the session out, such as a forgotten login on another device, and records it in the audit log. Refresh tokens already issued stay valid until they expire.",
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.authRequired, h.auth.RevokeSession)
+		auth.Post("/refresh", openapi.Route{
+			Summary:  "Exchange a refresh token for an access token",
+			Request:  handler.RefreshTokenRequest{},
+			Response: service.TokenResponse{},
+			Errors:   []int{fiber.StatusUnauthorized},
+		}, h.auth.RefreshToken)
+		auth.Security(openapi.BearerAuth).Get("/me", openapi.Route{
+			Summary:  "Identify the signed-in person",
+			Response: handler.MeResponse{},
+		}, h.authRequired, h.auth.Me)
+		auth.Security(openapi.IntrospectionToken).Post("/introspect", openapi.Route{
+			Summary:     "Describe an access token",
+			Description: "For internal services, with AUTH_INTROSPECTION_TOKEN as their bearer token. Takes the token form-encoded or as JSON and answers after RFC 7662: an access token is active while it is valid and its session is live, and the response names its person and their current organization memberships, with scope listing their grants as <organization ID>:<resource>:<activity>. Any other token is described by active: false alone. Introspecting doesn't count as activity on the session.",
+			Request:     handler.IntrospectRequest{},
+			Response:    service.IntrospectionResponse{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+		}, h.introspectionRequired, h.auth.Introspect)
+	}
+
+	account := api.Group("/persons/me", h.authRequired, h.requesterContext).
+		Tag("account").Security(openapi.BearerAuth)
+	{
+		account.Delete("/", openapi.Route{
+			Summary:     "Delete the signed-in person's account",
+			Description: "Starts erasing the account: a confirmation token is emailed to the person, valid for 24 hours, and replaces any earlier one. Once confirmed, the person is anonymized after ACCOUNT_DELETION_GRACE and can cancel until then.",
+			Response:    service.AccountDeletionDTO{},
+			Status:      fiber.StatusAccepted,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+		}, h.account.RequestDeletion)
+		account.Get("/deletion", openapi.Route{
+			Summary:  "Get the account's pending deletion",
+			Response: service.AccountDeletionDTO{},
+			Errors:   []int{fiber.StatusNotFound},
+		}, h.account.GetDeletion)
+		account.Post("/deletion/confirm", openapi.Route{
+			Summary:     "Confirm deleting the account",
+			Description: "Takes the emailed token and schedules the account to be anonymized: its sign-in methods and sessions deleted, its memberships, roles and wages removed, and its name and email replaced.",
+			Request:     service.ConfirmAccountDeletionRequest{},
+			Response:    service.AccountDeletionDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusNotFound},
+		}, h.account.ConfirmDeletion)
+		account.Delete("/deletion", openapi.Route{
+			Summary: "Cancel deleting the account",
+			Errors:  []int{fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.account.CancelDeletion)
+		account.Put("/active-organization", openapi.Route{
+			Summary:     "Switch the active organization",
+			Description: "Sets the organization the bearer token's session works in, and that the person's sessions start in from then on. Requests that take an organization_id query parameter, such as listing meetings, default to it. Access tokens issued afterwards carry it as active_org; a new session starts in the person's only organization when they have not switched.",
+			Request:     service.SetActiveOrganizationRequest{},
+			Response:    service.ActiveOrganizationDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.auth.SetActiveOrganization)
+		account.Get("/emails", openapi.Route{
+			Summary:     "List the signed-in person's secondary emails",
+			Description: "Addresses besides the sign-in email, such as work aliases, that integrations match meeting participants by once verified.",
+			Response:    []*service.PersonEmailDTO{},
+			Errors:      []int{fiber.StatusInternalServerError},
+		}, h.account.ListEmails)
+		account.Post("/emails", openapi.Route{
+			Summary:     "Add a secondary email",
+			Description: "Emails a verification link to the address, valid for 48 hours. Adding an address not yet verified sends a new link; one another account has yet to verify goes to whoever verifies it first. An account can have up to 10.",
+			Request:     service.AddPersonEmailRequest{},
+			Response:    service.PersonEmailDTO{},
+			Status:      fiber.StatusAccepted,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+		}, h.account.AddEmail)
+		account.Delete("/emails/:emailId", openapi.Route{
+			Summary: "Remove a secondary email",
+			Errors:  []int{fiber.StatusBadRequest, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.account.RemoveEmail)
+		account.Get("/extension-tokens", openapi.Route{
+			Summary:  "List the signed-in person's browser extension tokens",
+			Response: []*service.ExtensionTokenDTO{},
+			Errors:   []int{fiber.StatusInternalServerError},
+		}, h.extension.ListTokens)
+		account.Post("/extension-tokens", openapi.Route{
+			Summary:     "Create a browser extension token",
+			Description: "Returns a token for the browser extension to call the /api/ext routes with, acting for the person in the organization given. The token is shown only in this response. A person can have up to 10 unrevoked tokens.",
+			Request:     service.CreateExtensionTokenRequest{},
+			Response:    service.ExtensionTokenDTO{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.extension.CreateToken)
+		account.Delete("/extension-tokens/:tokenId", openapi.Route{
+			Summary: "Revoke a browser extension token",
+			Errors:  []int{fiber.StatusBadRequest, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.extension.RevokeToken)
+	}
+
+	// Verification links are tokens of their own, so they skip sign-in
+	verifyEmail := openapi.Route{
+		Summary:  "Verify a secondary email from its verification link",
+		Query:    []openapi.Query{{Name: "token", Description: "Token from the email's link", Required: true}},
+		Response: service.PersonEmailDTO{},
+		Errors:   []int{fiber.StatusBadRequest},
+	}
+	api.Tag("account").Get("/persons/emails/verify", verifyEmail, h.account.VerifyEmail)
+	api.Tag("account").Post("/persons/emails/verify", verifyEmail, h.account.VerifyEmail)
+
+	// Private consent routes
+	consent = consent.Security(openapi.BearerAuth)
+	historyRoute, getHistory := paged(version, openapi.Route{
+		Summary:  "List consent history for a session or the signed-in person",
+		Query:    []openapi.Query{{Name: "session_id", Description: "Anonymous browser session"}},
+		Response: []*service.ConsentDTO{},
+		Errors:   []int{fiber.StatusInternalServerError},
+	}, h.consent.GetHistory, h.consent.GetHistoryV2, handler.Page[*service.ConsentDTO]{})
+	consent.Get("/consent/history", historyRoute, h.authRequired, getHistory)
+	consent.Post("/consent/sync", openapi.Route{
+		Summary: "Attach a session's consent to the signed-in person",
+		Query:   []openapi.Query{sessionQuery},
+		Status:  fiber.StatusOK,
+		Errors:  []int{fiber.StatusInternalServerError},
+	}, h.authRequired, h.consent.SyncConsent)
+
+	organizations := api.Group("/organizations", h.authRequired, h.requesterContext, h.tenantGuard, h.rowLevelSecurity).
+		Tag("organizations").Security(openapi.BearerAuth)
+	{
+		listOrgsRoute, listOrgs := paged(version, openapi.Route{
+			Summary:  "List the signed-in person's organizations",
+			Response: []*service.OrganizationDTO{},
+			Errors:   []int{fiber.StatusInternalServerError},
+		}, h.orgs.ListOrganizations, h.orgs.ListOrganizationsV2, handler.Page[*service.OrganizationDTO]{})
+		organizations.Get("/", listOrgsRoute, listOrgs)
+		organizations.Post("/", openapi.Route{
+			Summary:  "Create an organization",
+			Request:  service.CreateOrganizationRequest{},
+			Response: service.OrganizationDTO{},
+			Status:   fiber.StatusCreated,
+			Errors:   []int{fiber.StatusInternalServerError},
+		}, h.orgs.CreateOrganization)
+		// Before /:id, which would take "discover" for an ID
+		organizations.Get("/discover", openapi.Route{
+			Summary:     "Find organizations to join",
+			Description: "Searches by name the organizations the signed-in person can join without an invitation: public ones, and those discoverable by the domain of their sign-in email or a verified secondary email. Invite-only organizations never appear. At most 50, by name.",
+			Query:       []openapi.Query{{Name: "q", Description: "Part of the organization's name"}},
+			Response:    []*service.DiscoverableOrganizationDTO{},
+			Errors:      []int{fiber.StatusInternalServerError},
+		}, h.orgs.DiscoverOrganizations)
+		organizations.Get("/:id", openapi.Route{
+			Summary:  "Get an organization",
+			Response: service.OrganizationDTO{},
+			Errors:   []int{fiber.StatusForbidden},
+		}, h.orgs.GetOrganization)
+		organizations.Put("/:id", openapi.Route{
+			Summary:  "Update an organization",
+			Request:  service.UpdateOrganizationRequest{},
+			Response: service.OrganizationDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.orgs.UpdateOrganization)
+		organizations.Delete("/:id", openapi.Route{
+			Summary: "Delete an organization",
+			Errors:  []int{fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.orgs.DeleteOrganization)
+		organizations.Post("/:id/join", openapi.Route{
+			Summary:     "Join an organization",
+			Description: "Makes the signed-in person a member with the Member role and the default wage, when they can discover the organization. Other organizations answer 404, as if they didn't exist.",
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusPaymentRequired, fiber.StatusNotFound, fiber.StatusConflict, fiber.StatusInternalServerError},
+		}, h.orgs.JoinOrganization)
+		membersRoute, getMembers := paged(version, openapi.Route{
+			Summary:  "List members",
+			Response: []*service.MemberDTO{},
+			Errors:   []int{fiber.StatusForbidden},
+		}, h.orgs.GetMembers, h.orgs.GetMembersV2, handler.Page[*service.MemberDTO]{})
+		organizations.Get("/:id/members", membersRoute, getMembers)
+		organizations.Post("/:id/members", openapi.Route{
+			Summary:     "Add a member by person ID or email",
+			Description: "The member's wage defaults to the organization's default wage. Aggregate-only organizations refuse a wage and store none.",
+			Request:     service.AddMemberRequest{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusPaymentRequired, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.orgs.AddMember)
+		organizations.Delete("/:id/members/:memberId", openapi.Route{
+			Summary: "Remove a member",
+			Errors:  []int{fiber.StatusForbidden},
+		}, h.orgs.RemoveMember)
+		organizations.Patch("/:id/members/:memberId/wage", openapi.Route{
+			Summary:     "Set a member's hourly wage",
+			Description: "Refused in aggregate-only organizations, which store no individual wages.",
+			Request:     handler.UpdateWageRequest{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.orgs.UpdateMemberWage)
+		organizations.Put("/:id/members/:memberId/approver", openapi.Route{
+			Summary:     "Designate a meeting approver",
+			Description: "Sets whether the member decides on meetings whose projected cost is over the organization's approval threshold.",
+			Request:     handler.MeetingApproverRequest{},
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.orgs.SetMeetingApprover)
+		organizations.Put("/:id/members/:memberId/wage-band", openapi.Route{
+			Summary:     "Assign a member a wage band",
+			Description: "Assigns the member a wage band in place of an exact wage, clearing their exact wage; a null wage_band_id unassigns it. Setting an exact wage clears the band in turn. Refused in aggregate-only organizations.",
+			Request:     handler.MemberWageBandRequest{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.orgs.SetMemberWageBand)
+
+		wageBands := organizations.Tag("wage bands")
+		wageBands.Get("/:id/wage-bands", openapi.Route{
+			Summary:  "List wage bands",
+			Response: []*service.WageBandDTO{},
+			Errors:   []int{fiber.StatusForbidden},
+		}, h.orgs.ListWageBands)
+		wageBands.Post("/:id/wage-bands", openapi.Route{
+			Summary:     "Create a wage band",
+			Description: "A range of hourly wages, such as a job level, that members can be assigned instead of an exact wage. Banded members count at the band's midpoint.",
+			Request:     service.WageBandRequest{},
+			Response:    service.WageBandDTO{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.orgs.CreateWageBand)
+		wageBands.Put("/:id/wage-bands/:bandId", openapi.Route{
+			Summary:  "Update a wage band",
+			Request:  service.WageBandRequest{},
+			Response: service.WageBandDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.orgs.UpdateWageBand)
+		wageBands.Delete("/:id/wage-bands/:bandId", openapi.Route{
+			Summary:     "Delete a wage band",
+			Description: "Refused while active members are assigned the band.",
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.orgs.DeleteWageBand)
+
+		holidays := organizations.Tag("holidays")
+		holidays.Get("/:id/holidays", openapi.Route{
+			Summary:  "List holidays",
+			Query:    []openapi.Query{{Name: "year", Description: "Only the holidays in this year"}},
+			Response: []*service.HolidayDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.orgs.ListHolidays)
+		holidays.Post("/:id/holidays", openapi.Route{
+			Summary:     "Add a holiday",
+			Description: "A day the organization doesn't work, dated in its timezone; at most one a day. Meetings that start on a holiday are reported apart from those in working hours, and scheduling one on a holiday warns.",
+			Request:     service.HolidayRequest{},
+			Response:    service.HolidayDTO{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.orgs.CreateHoliday)
+		holidays.Put("/:id/holidays/:holidayId", openapi.Route{
+			Summary:  "Update a holiday",
+			Request:  service.HolidayRequest{},
+			Response: service.HolidayDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.orgs.UpdateHoliday)
+		holidays.Delete("/:id/holidays/:holidayId", openapi.Route{
+			Summary: "Delete a holiday",
+			Errors:  []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.orgs.DeleteHoliday)
+
+		webhooks := organizations.Tag("webhooks")
+		webhooks.Get("/:id/webhooks", openapi.Route{
+			Summary:  "List webhook endpoints",
+			Response: []*service.WebhookEndpointDTO{},
+			Errors:   []int{fiber.StatusForbidden},
+		}, h.webhooks.ListEndpoints)
+		webhooks.Post("/:id/webhooks", openapi.Route{
+			Summary:     "Add a webhook endpoint",
+			Description: "The signing secret is only returned in this response.",
+			Request:     service.CreateWebhookEndpointRequest{},
+			Response:    service.WebhookEndpointDTO{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusPaymentRequired, fiber.StatusForbidden},
+		}, h.webhooks.CreateEndpoint)
+		webhooks.Delete("/:id/webhooks/:webhookId", openapi.Route{
+			Summary: "Remove a webhook endpoint",
+			Errors:  []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.webhooks.DeleteEndpoint)
+		webhooks.Post("/:id/webhooks/:webhookId/ping", openapi.Route{
+			Summary:  "Send a ping event to a webhook endpoint",
+			Response: service.WebhookDeliveryDTO{},
+			Status:   fiber.StatusAccepted,
+			Errors:   []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.webhooks.PingEndpoint)
+		deliveriesRoute, listDeliveries := paged(version, openapi.Route{
+			Summary:  "List a webhook endpoint's deliveries, newest first",
+			Response: []*service.WebhookDeliveryDTO{},
+			Errors:   []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.webhooks.ListDeliveries, h.webhooks.ListDeliveriesV2, handler.Page[*service.WebhookDeliveryDTO]{})
+		webhooks.Get("/:id/webhooks/:webhookId/deliveries", deliveriesRoute, listDeliveries)
+		webhooks.Post("/:id/webhooks/:webhookId/deliveries/:deliveryId/redeliver", openapi.Route{
+			Summary:     "Redeliver a past webhook delivery",
+			Description: "Sends the same event again, with the same event ID, as a new delivery.",
+			Response:    service.WebhookDeliveryDTO{},
+			Status:      fiber.StatusAccepted,
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.webhooks.Redeliver)
+
+		integrations := organizations.Tag("integrations")
+		integrations.Get("/:id/integrations", openapi.Route{
+			Summary:     "List integrations",
+			Description: "Every integration the organization can connect (zoom, google, slack, teams, and the HR systems bamboohr, workday and csv), with whether it is connected and its settings.",
+			Response:    []*service.IntegrationDTO{},
+			Errors:      []int{fiber.StatusForbidden},
+		}, h.integrations.ListIntegrations)
+		integrations.Get("/:id/integrations/:provider", openapi.Route{
+			Summary:  "Get an integration",
+			Response: service.IntegrationDTO{},
+			Errors:   []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.integrations.GetIntegration)
+		integrations.Post("/:id/integrations/:provider/connect", openapi.Route{
+			Summary:     "Connect an integration",
+			Description: "Stores the tokens the provider issued for the organization, replacing any from an earlier connection. Tokens are never returned. settings, when given, replace the integration's settings.",
+			Request:     service.ConnectIntegrationRequest{},
+			Response:    service.IntegrationDTO{},
+			Errors:      []int{fiber.StatusPaymentRequired, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.integrations.ConnectIntegration)
+		integrations.Post("/:id/integrations/:provider/disconnect", openapi.Route{
+			Summary:     "Disconnect an integration",
+			Description: "Forgets the integration's tokens. Its settings are kept for when it is connected again.",
+			Response:    service.IntegrationDTO{},
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.integrations.DisconnectIntegration)
+		integrations.Put("/:id/integrations/:provider/settings", openapi.Route{
+			Summary:     "Replace an integration's settings",
+			Description: "settings is a JSON object of options for the provider. It can be set before connecting.",
+			Request:     service.UpdateIntegrationSettingsRequest{},
+			Response:    service.IntegrationDTO{},
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.integrations.UpdateSettings)
+		integrations.Post("/:id/integrations/:provider/wage-sync/preview", openapi.Route{
+			Summary:     "Preview a wage sync",
+			Description: "Reads the connected HR system (bamboohr, workday or csv) and returns the wage and wage band changes a sync would make, the records it would skip and why, without changing anything. Wages hidden from the requester by the organization's wage visibility are left out.",
+			Response:    service.WageSyncDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusBadGateway},
+		}, h.wageSync.PreviewWageSync)
+		integrations.Post("/:id/integrations/:provider/wage-sync", openapi.Route{
+			Summary:     "Sync wages from an HR system",
+			Description: "Syncs now what the preview shows. Each member's change is recorded in the audit log.",
+			Response:    service.WageSyncDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusBadGateway},
+		}, h.wageSync.SyncWages)
+		integrations.Get("/:id/integrations/:provider/warehouse-export", openapi.Route{
+			Summary:     "Get the data warehouse export",
+			Description: "The export to a connected warehouse (bigquery, snowflake or s3): whether a run is under way, the watermarks up to which meeting and membership changes have been exported, the rows pushed to each table, and the last run's outcome.",
+			Response:    service.WarehouseExportDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.warehouse.GetWarehouseExport)
+		integrations.Post("/:id/integrations/:provider/warehouse-export/run", openapi.Route{
+			Summary:     "Run the data warehouse export",
+			Description: "Queues a run that exports the changes since the watermarks, whether or not scheduled exports are on. The warehouse's settings are checked first.",
+			Response:    service.WarehouseExportDTO{},
+			Status:      fiber.StatusAccepted,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.warehouse.RunWarehouseExport)
+		integrations.Post("/:id/integrations/:provider/warehouse-export/reset", openapi.Route{
+			Summary:     "Reset the data warehouse export",
+			Description: "Clears the watermarks and row counts, so the next run exports every meeting and membership again. Refused while a run is under way.",
+			Response:    service.WarehouseExportDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusConflict},
+		}, h.warehouse.ResetWarehouseExport)
+		integrations.Get("/:id/integrations/:provider/warehouse-export/schema", openapi.Route{
+			Summary:     "Describe the exported tables",
+			Description: "The tables the export writes, with each column's type and meaning. Types map to STRING, INT64, FLOAT64, BOOL and TIMESTAMP in BigQuery, VARCHAR, NUMBER, FLOAT, BOOLEAN and TIMESTAMP_TZ in Snowflake, and to Parquet's BYTE_ARRAY (UTF8), INT64, DOUBLE, BOOLEAN and INT64 (TIMESTAMP_MICROS).",
+			Response:    []warehouse.Table{},
+		}, h.warehouse.GetWarehouseSchema)
+
+		compliance := organizations.Tag("compliance")
+		compliance.Get("/:id/dpa", openapi.Route{
+			Summary:     "Get the data processing agreement",
+			Description: "The current agreement's version and link, whether the organization has accepted it, and its acceptances of every version.",
+			Response:    service.DPAStatusDTO{},
+			Errors:      []int{fiber.StatusForbidden},
+		}, h.dpa.GetDPA)
+		compliance.Post("/:id/dpa/accept", openapi.Route{
+			Summary:     "Accept the data processing agreement",
+			Description: "version must be the current version. Accepting a version again returns the first acceptance.",
+			Request:     service.AcceptDPARequest{},
+			Response:    service.DPAAcceptanceDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.dpa.AcceptDPA)
+
+		alerts := organizations.Tag("alerts")
+		alerts.Get("/:id/alerts", openapi.Route{
+			Summary:  "List your cost alerts",
+			Response: []*service.CostAlertDTO{},
+			Errors:   []int{fiber.StatusForbidden},
+		}, h.alerts.ListAlerts)
+		alerts.Post("/:id/alerts", openapi.Route{
+			Summary:     "Add a cost alert",
+			Description: "Notifies you once per meeting when a meeting's cost reaches the threshold. Without a meeting_id the alert watches every meeting of the organization.",
+			Request:     service.CreateCostAlertRequest{},
+			Response:    service.CostAlertDTO{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.alerts.CreateAlert)
+		alerts.Delete("/:id/alerts/:alertId", openapi.Route{
+			Summary: "Remove a cost alert",
+			Errors:  []int{fiber.StatusNotFound},
+		}, h.alerts.DeleteAlert)
+
+		orgMeetings := organizations.Tag("meetings")
+		orgMeetings.Post("/:id/meetings/bulk-delete", openapi.Route{
+			Summary:     "Delete many meetings",
+			Description: "Deletes the meetings listed in meeting_ids, or those a filter matches, together, with one audit entry. Listed meetings must all belong to the organization and be deletable by you, or none are deleted. A filter needs at least one criterion, matches stopped meetings only, and skips finalized ones and those you can't delete. At most 500 meetings at a time.",
+			Request:     service.BulkDeleteMeetingsRequest{},
+			Response:    service.BulkDeleteMeetingsDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.meetings.BulkDeleteMeetings)
+		trashRoute, listTrash := paged(version, openapi.Route{
+			Summary:     "List the organization's deleted meetings, most recently deleted first",
+			Description: "Meetings that were deleted and are yet to be purged, with when and, from the audit log, by whom. Only organization admins can see them.",
+			Response:    []*service.DeletedMeetingDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.meetings.ListDeletedMeetings, h.meetings.ListDeletedMeetingsV2, handler.Page[*service.DeletedMeetingDTO]{})
+		orgMeetings.Get("/:id/meetings/trash", trashRoute, listTrash)
+		archiveRoute, listArchive := paged(version, openapi.Route{
+			Summary:     "List the organization's archived meetings, most recently started first",
+			Description: "Meetings moved out of the live tables after MEETING_ARCHIVE_AFTER, with their totals. They still count in the daily report totals. Only organization admins can see them.",
+			Response:    []*service.ArchivedMeetingDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.archive.ListArchivedMeetings, h.archive.ListArchivedMeetingsV2, handler.Page[*service.ArchivedMeetingDTO]{})
+		orgMeetings.Get("/:id/meetings/archive", archiveRoute, listArchive)
+		orgMeetings.Post("/:id/meetings/archive/:meetingId/restore", openapi.Route{
+			Summary:     "Restore an archived meeting",
+			Description: "Moves the meeting, its increments, participants and ratings back into the live tables. It is archived again once it has been back as long as the archive age. Only organization admins can restore meetings.",
+			Response:    service.MeetingDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.archive.RestoreMeeting)
+
+		reports := organizations.Tag("reports")
+		reportRange := []openapi.Query{
+			{Name: "from", Description: "Start of the range, a date (YYYY-MM-DD) or RFC 3339 time (default 30 days before to)"},
+			{Name: "to", Description: "End of the range, exclusive; a date includes that day (default now)"},
+		}
+		reports.Get("/:id/dashboard", openapi.Route{
+			Summary:     "Get the home screen's numbers",
+			Description: "The meetings running now with their cost so far, today's cost, this week's against the same span of last week, and the month to date against the monthly budget. Periods are in UTC and count running meetings up to now.",
+			Response:    service.DashboardDTO{},
+			Errors:      []int{fiber.StatusForbidden},
+		}, h.reports.GetDashboard)
+		reports.Get("/:id/reports/summary", openapi.Route{
+			Summary:     "Summarize meeting costs",
+			Description: "Totals and averages over the meetings started in the range. The range starts no earlier than the plan's report retention.",
+			Query:       reportRange,
+			Response:    service.CostSummaryDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.reports.GetSummary)
+		reports.Get("/:id/reports/trends", openapi.Route{
+			Summary:     "Chart meeting costs over time",
+			Description: "Cost and hours of meeting time in the range by UTC day, ISO week or calendar month, with a bucket for every interval. With compare, also the same length of time just before the range.",
+			Query: append(reportRange,
+				openapi.Query{Name: "interval", Description: "day (default), week or month"},
+				openapi.Query{Name: "compare", Description: "true to add the previous period"},
+			),
+			Response: service.CostTrendDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.reports.GetTrend)
+		reports.Get("/:id/reports/top-meetings", openapi.Route{
+			Summary:     "List the most expensive meetings",
+			Description: "The most expensive meetings started in the range, with their organizers, and the most expensive recurring series: two or more meetings with the same purpose.",
+			Query: append(reportRange,
+				openapi.Query{Name: "limit", Description: "How many meetings and series, 1-50 (default 10)"},
+			),
+			Response: service.TopMeetingsDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.reports.GetTopMeetings)
+		reports.Get("/:id/reports/effectiveness", openapi.Route{
+			Summary:     "Weigh meeting cost against participants' ratings",
+			Description: "What the rated meetings started in the range cost, how they were rated, and how much went on poorly rated ones, with the most expensive rated meetings. Meetings are rated when the organization collects meeting surveys.",
+			Query: append(reportRange,
+				openapi.Query{Name: "limit", Description: "How many meetings, 1-50 (default 10)"},
+			),
+			Response: service.EffectivenessDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.reports.GetEffectiveness)
+		reports.Post("/:id/reports/exports", openapi.Route{
+			Summary:     "Export a report as a PDF or XLSX file",
+			Description: "Queues the report for the worker. Poll the export until it is ready; its download link works without signing in until the export expires.",
+			Request:     handler.CreateReportExportRequest{},
+			Response:    service.ReportExportDTO{},
+			Status:      fiber.StatusAccepted,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.reports.CreateExport)
+		reports.Get("/:id/reports/exports/:exportId", openapi.Route{
+			Summary:  "Get a report export and its download link",
+			Response: service.ReportExportDTO{},
+			Errors:   []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.reports.GetExport)
+
+		billing := organizations.Tag("billing")
+		billing.Get("/:id/subscription", openapi.Route{
+			Summary:     "Get the organization's subscription",
+			Description: "An organization that never subscribed is on the free plan, without an id or billing period.",
+			Response:    service.SubscriptionDTO{},
+			Errors:      []int{fiber.StatusForbidden},
+		}, h.billing.GetSubscription)
+		billing.Post("/:id/subscription", openapi.Route{
+			Summary:     "Change the organization's plan",
+			Description: "plan_type is one of free, basic, premium or enterprise. Changing plan undoes a pending cancellation.",
+			Request:     service.ChangePlanRequest{},
+			Response:    service.SubscriptionDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.billing.ChangePlan)
+		billing.Post("/:id/subscription/cancel", openapi.Route{
+			Summary:     "Cancel the organization's subscription",
+			Description: "The plan stays in effect until the end of the current period.",
+			Response:    service.SubscriptionDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.billing.CancelSubscription)
+		billing.Post("/:id/subscription/checkout", openapi.Route{
+			Summary:     "Start a Stripe Checkout for a paid plan",
+			Description: "Returns the Checkout page to send the browser to. The plan takes effect when Stripe reports the payment.",
+			Request:     service.CheckoutRequest{},
+			Response:    service.BillingSessionDTO{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusServiceUnavailable},
+		}, h.billing.CreateCheckoutSession)
+		billing.Post("/:id/subscription/portal", openapi.Route{
+			Summary:  "Open the Stripe Customer Portal",
+			Request:  service.PortalRequest{},
+			Response: service.BillingSessionDTO{},
+			Status:   fiber.StatusCreated,
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusServiceUnavailable},
+		}, h.billing.CreatePortalSession)
+		invoicesRoute, listInvoices := paged(version, openapi.Route{
+			Summary:     "List the organization's invoices, most recently issued first",
+			Description: "Invoices are synced from Stripe's invoice events.",
+			Response:    []*service.InvoiceDTO{},
+			Errors:      []int{fiber.StatusForbidden},
+		}, h.billing.ListInvoices, h.billing.ListInvoicesV2, handler.Page[*service.InvoiceDTO]{})
+		billing.Get("/:id/invoices", invoicesRoute, listInvoices)
+		paymentsRoute, listPayments := paged(version, openapi.Route{
+			Summary:     "List the organization's payments, newest first",
+			Description: "Includes failed payment attempts.",
+			Response:    []*service.PaymentDTO{},
+			Errors:      []int{fiber.StatusForbidden},
+		}, h.billing.ListPayments, h.billing.ListPaymentsV2, handler.Page[*service.PaymentDTO]{})
+		billing.Get("/:id/payments", paymentsRoute, listPayments)
+		billing.Get("/:id/usage", openapi.Route{
+			Summary:     "Get the organization's billable usage by month",
+			Description: "Meeting minutes and the most active members seen while meetings ran, newest month first.",
+			Query:       []openapi.Query{{Name: "months", Description: "How many calendar months, 1-24 (default 12)"}},
+			Response:    []service.UsageDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.billing.GetUsage)
+		billing.Get("/:id/api-usage", openapi.Route{
+			Summary:     "Get the organization's API usage",
+			Description: "Requests made for the organization, and those answered with an error, in total, by day (UTC, newest first) and by credential: members' sessions together, and each browser extension token, most requests first. Days over the plan's daily_limit are flagged. Counts are written every API_USAGE_FLUSH_INTERVAL, so the latest requests may not show yet. Needs the organization update permission.",
+			Query:       []openapi.Query{{Name: "days", Description: "How many days, today included, 1-90 (default 30)"}},
+			Response:    service.APIUsageDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden},
+		}, h.apiUsage.GetAPIUsage)
+	}
+
+	// Stripe signs its events, so they skip sign-in
+	api.Tag("billing").Post("/webhooks/stripe", openapi.Route{
+		Summary:     "Receive Stripe webhook events",
+		Description: "Verifies the Stripe-Signature header and syncs subscription status, billing period and payments.",
+		Response:    handler.StripeWebhookResponse{},
+		Errors:      []int{fiber.StatusBadRequest, fiber.StatusServiceUnavailable},
+	}, h.billing.StripeWebhook)
+
+	// Unsubscribe links are signed, so they skip the group's sign-in
+	unsubscribe := openapi.Route{
+		Summary:  "Turn off an email notification from an unsubscribe link",
+		Query:    []openapi.Query{{Name: "token", Description: "Token from the email's link", Required: true}},
+		Response: handler.UnsubscribeResponse{},
+	}
+	api.Tag("notifications").Get("/notifications/unsubscribe", unsubscribe, h.notify.Unsubscribe)
+	api.Tag("notifications").Post("/notifications/unsubscribe", unsubscribe, h.notify.Unsubscribe)
+
+	// Export download links are signed, so they skip sign-in
+	api.Tag("reports").Get("/reports/exports/download", openapi.Route{
+		Summary:     "Download a report export",
+		Description: "Sends the PDF or XLSX file of a ready export.",
+		Query:       []openapi.Query{{Name: "token", Description: "Token from the export's download link", Required: true}},
+		Status:      fiber.StatusOK,
+		Errors:      []int{fiber.StatusNotFound},
+	}, h.reports.DownloadExport)
+
+	// Survey links are signed, so they skip sign-in
+	surveys := api.Tag("surveys")
+	surveyToken := openapi.Query{Name: "token", Description: "Token from the survey notification's link", Required: true}
+	surveys.Get("/surveys", openapi.Route{
+		Summary:  "Get the survey of a survey link",
+		Query:    []openapi.Query{surveyToken},
+		Response: service.SurveyDTO{},
+		Errors:   []int{fiber.StatusNotFound},
+	}, h.surveys.GetSurvey)
+	surveys.Post("/surveys", openapi.Route{
+		Summary:     "Rate a meeting from a survey link",
+		Description: "Answering again changes the rating, until the survey closes a week after the meeting stopped.",
+		Query:       []openapi.Query{surveyToken},
+		Request:     handler.RateMeetingRequest{},
+		Response:    service.SurveyDTO{},
+		Errors:      []int{fiber.StatusBadRequest, fiber.StatusNotFound},
+	}, h.surveys.RespondByLink)
+
+	notifications := api.Group("/notifications", h.authRequired, h.requesterContext).
+		Tag("notifications").Security(openapi.BearerAuth)
+	{
+		notifications.Get("/preferences", openapi.Route{
+			Summary:     "Get the signed-in person's notification preferences",
+			Description: "Lists every event for each channel this deployment offers.",
+			Response:    service.NotificationPreferencesDTO{},
+			Errors:      []int{fiber.StatusInternalServerError},
+		}, h.notify.GetPreferences)
+		notifications.Put("/preferences", openapi.Route{
+			Summary:     "Turn notification channels on or off per event",
+			Description: "Preferences not listed are left unchanged.",
+			Request:     service.UpdateNotificationPreferencesRequest{},
+			Response:    service.NotificationPreferencesDTO{},
+			Errors:      []int{fiber.StatusInternalServerError},
+		}, h.notify.UpdatePreferences)
+		notifications.Get("/push/key", openapi.Route{
+			Summary:  "Get the Web Push application server key",
+			Response: handler.PushKeyResponse{},
+			Errors:   []int{fiber.StatusNotFound},
+		}, h.notify.GetPushKey)
+		notifications.Post("/push/subscriptions", openapi.Route{
+			Summary:  "Register a browser for Web Push notifications",
+			Request:  service.PushSubscriptionRequest{},
+			Response: service.PushSubscriptionDTO{},
+			Status:   fiber.StatusCreated,
+			Errors:   []int{fiber.StatusInternalServerError},
+		}, h.notify.AddPushSubscription)
+		notifications.Delete("/push/subscriptions/:id", openapi.Route{
+			Summary: "Unregister a browser from Web Push notifications",
+			Errors:  []int{fiber.StatusNotFound},
+		}, h.notify.RemovePushSubscription)
+	}
+
+	meetings := api.Group("/meetings", h.authRequired, h.requesterContext, h.tenantGuard, h.rowLevelSecurity).
+		Tag("meetings").Security(openapi.BearerAuth)
+	{
+		listMeetingsRoute, listMeetings := paged(version, openapi.Route{
+			Summary:  "List an organization's meetings",
+			Query:    []openapi.Query{{Name: "organization_id", Description: "Defaults to the session's active organization"}},
+			Response: []*service.MeetingDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.meetings.ListMeetings, h.meetings.ListMeetingsV2, handler.Page[*service.MeetingDTO]{})
+		meetings.Get("/", listMeetingsRoute, listMeetings)
+		meetings.Post("/", openapi.Route{
+			Summary:  "Create a meeting",
+			Request:  service.CreateMeetingRequest{},
+			Response: service.MeetingDTO{},
+			Status:   fiber.StatusCreated,
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.meetings.CreateMeeting)
+		meetings.Post("/completed", openapi.Route{
+			Summary:     "Record a meeting that has already ended",
+			Description: "For meetings nobody tracked live: creates a stopped meeting with one increment from started_at to stopped_at at the attendee count and average wage given, the wage defaulting to the organization's. The meeting must have ended and last at most 24 hours.",
+			Request:     service.CreateCompletedMeetingRequest{},
+			Response:    service.MeetingDTO{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.CreateCompletedMeeting)
+		meetings.Get("/:id", openapi.Route{
+			Summary:  "Get a meeting",
+			Query:    []openapi.Query{{Name: "expand", Description: "Comma-separated: increments, participants"}},
+			Response: service.MeetingDTO{},
+			Errors:   []int{fiber.StatusNotFound},
+		}, h.meetings.GetMeeting)
+		meetings.Patch("/:id", openapi.Route{
+			Summary:     "Update a meeting",
+			Description: "Changes the purpose, schedule or expected attendees. Until the meeting first starts, changing its schedule or attendees projects its cost again, which may need a new approval.",
+			Request:     service.UpdateMeetingRequest{},
+			Response:    service.MeetingDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.UpdateMeeting)
+		meetings.Post("/:id/start", openapi.Route{
+			Summary: "Start or resume the meeting clock",
+			Errors:  []int{fiber.StatusBadRequest, fiber.StatusPaymentRequired, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.meetings.StartMeeting)
+		meetings.Post("/:id/stop", openapi.Route{
+			Summary: "Stop the meeting clock",
+			Errors:  []int{fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.meetings.StopMeeting)
+		meetings.Post("/:id/finalize", openapi.Route{
+			Summary:     "Finalize a stopped meeting",
+			Description: "For organization admins. Locks the meeting's increments and totals so exported numbers stay put: a finalized meeting can't be restarted, edited or deleted, and only corrections to its increments, which are audited, change it.",
+			Response:    service.MeetingDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.FinalizeMeeting)
+		meetings.Post("/:id/approve", openapi.Route{
+			Summary:     "Approve a meeting",
+			Description: "For designated approvers and organization admins, on meetings they did not organize. Approves a scheduled meeting whose projected cost is over the organization's approval threshold so it can start; the organizer is notified with the optional note.",
+			Request:     service.ApprovalDecisionRequest{},
+			Response:    service.MeetingDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.ApproveMeeting)
+		meetings.Post("/:id/reject", openapi.Route{
+			Summary:     "Reject a meeting",
+			Description: "For designated approvers and organization admins, on meetings they did not organize. A rejected meeting can't start until its plans change and it is approved; the organizer is notified with the optional note.",
+			Request:     service.ApprovalDecisionRequest{},
+			Response:    service.MeetingDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.RejectMeeting)
+		meetings.Patch("/:id/attendees", openapi.Route{
+			Summary: "Change the attendee count",
+			Request: handler.UpdateAttendeesRequest{},
+			Errors:  []int{fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.meetings.UpdateAttendeeCount)
+		meetings.Patch("/:id/wage", openapi.Route{
+			Summary:     "Change the average wage",
+			Description: "Sets the blended hourly rate a running meeting costs each attendee at from now on, starting a new increment. A stopped meeting is left as it is.",
+			Request:     handler.UpdateAverageWageRequest{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.meetings.UpdateAverageWage)
+		meetings.Patch("/:id/purpose", openapi.Route{
+			Summary:     "Change the purpose",
+			Description: "Records a change of topic. A running meeting starts a new increment with the purpose, so reports can break its cost down by topic; a stopped meeting has its purpose changed. Finalized meetings refuse.",
+			Request:     handler.UpdatePurposeRequest{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.meetings.UpdatePurpose)
+		meetings.Patch("/:id/increment", openapi.Route{
+			Summary:     "Change attendees, wage and purpose together",
+			Description: "Applies any of attendee_count, average_wage and purpose at once, so a running meeting starts a single new increment and recalculates its totals once. A stopped meeting takes only the purpose.",
+			Request:     service.UpdateIncrementRequest{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.meetings.UpdateIncrement)
+		meetings.Post("/:id/undo", openapi.Route{
+			Summary:     "Undo the last change to a running meeting",
+			Description: "Reverts the last change of attendees, wage or purpose by removing the increment it opened and reopening the one before. Undoing again reverts the change before that, back to the meeting's start or resume.",
+			Response:    service.UndoDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.UndoLastChange)
+		meetings.Patch("/:id/increments/:incId", openapi.Route{
+			Summary:     "Correct a past increment",
+			Description: "For organization admins. Changes the fields given of a closed increment, which must not overlap the meeting's others, and recalculates its cost and the meeting's totals. The audit log records the increment before and after.",
+			Request:     service.CorrectIncrementRequest{},
+			Response:    service.IncrementDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.CorrectIncrement)
+		meetings.Get("/:id/increments/export", openapi.Route{
+			Summary:     "Export the increment history",
+			Description: "Sends every increment of the meeting, oldest first, as a CSV file with its start, stop, elapsed seconds, attendees, average wage, cost, running total and purpose. A running meeting's open increment has no stop time yet.",
+			Query:       []openapi.Query{{Name: "format", Description: "csv, the default"}},
+			Status:      fiber.StatusOK,
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.ExportIncrements)
+		meetings.Get("/:id/cost", openapi.Route{
+			Summary:  "Get the running cost",
+			Response: service.MeetingCostDTO{},
+			Errors:   []int{fiber.StatusInternalServerError},
+		}, h.meetings.GetMeetingCost)
+		meetings.Post("/:id/share", openapi.Route{
+			Summary:     "Create a share token for self check-in",
+			Description: "The token lets people outside the organization check in to the meeting until it expires, 12 hours after it was created.",
+			Response:    service.MeetingShareDTO{},
+			Status:      fiber.StatusCreated,
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.ShareMeeting)
+		meetings.Post("/:id/checkin", openapi.Route{
+			Summary:     "Check in to the meeting",
+			Description: "Records the caller as a participant and, while the meeting runs, adds them to its attendee count. Members of the organization check in without a token. Checking in again while present changes nothing.",
+			Query:       []openapi.Query{{Name: "token", Description: "Share token, for people outside the organization"}},
+			Response:    service.CheckInDTO{},
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.CheckIn)
+		meetings.Post("/:id/checkout", openapi.Route{
+			Summary:     "Check out of the meeting",
+			Description: "Records the caller as gone and, while the meeting runs, takes them off its attendee count. Checking out when not checked in changes nothing.",
+			Query:       []openapi.Query{{Name: "token", Description: "Share token, for people outside the organization"}},
+			Response:    service.CheckInDTO{},
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.CheckOut)
+		meetings.Get("/:id/checkin/qr.png", openapi.Route{
+			Summary:     "Get a QR code for checking in",
+			Description: "A PNG of the meeting's check-in link, with a share token that expires after 15 minutes; the link is also in the X-Checkin-Url header. Show it in the room and fetch a new one before it expires. The app scans it and posts to the link as the signed-in attendee.",
+			Errors:      []int{fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.meetings.GetCheckInCode)
+		meetings.Post("/:id/rating", openapi.Route{
+			Summary:     "Rate a stopped meeting",
+			Description: "Answers the meeting's survey, prompted by the meeting:survey websocket event, for organizations that collect ratings. Answering again changes the rating, until the survey closes a week after the meeting stopped.",
+			Request:     handler.RateMeetingRequest{},
+			Response:    service.SurveyDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound},
+		}, h.surveys.RateMeeting)
+		meetings.Delete("/:id", openapi.Route{
+			Summary: "Delete a meeting",
+			Errors:  []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.meetings.DeleteMeeting)
+	}
+
+	api.Tag("calculator").Post("/calculate", openapi.Route{
+		Summary:     "Price a hypothetical meeting",
+		Description: "For the marketing site's calculator: prices a meeting of the attendees given, at the average hourly wage, lasting duration_minutes, as a tracked meeting would be priced. Needs no sign-in and stores nothing. Each client IP can make CALCULATOR_RATE_LIMIT requests a minute.",
+		Request:     service.CalculateRequest{},
+		Response:    service.CalculationDTO{},
+		Errors:      []int{fiber.StatusBadRequest, fiber.StatusTooManyRequests},
+	}, h.calculatorLimit, h.calculator.Calculate)
+
+	sync := api.Group("/sync", h.authRequired, h.requesterContext, h.tenantGuard, h.rowLevelSecurity).
+		Tag("meetings").Security(openapi.BearerAuth)
+	{
+		sync.Post("/", openapi.Route{
+			Summary:     "Apply meeting operations recorded offline",
+			Description: "For mobile clients that keep timing meetings without a connection. Operations create and start a meeting, change a running meeting's attendees, wage or purpose, or stop it, at the time given, and apply in order. Each has a client-generated id, and a create the meeting's id, so a sync can be retried: an operation synced before returns its first outcome with replayed set. An operation the meeting's state on the server no longer allows, such as a change dated before someone else's, is a conflict and the rest go ahead. The response has each operation's outcome and the meetings as they now stand. At most 500 operations at a time.",
+			Request:     service.SyncRequest{},
+			Response:    service.SyncResultDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+		}, h.meetings.Sync)
+	}
+
+	admin := api.Group("/admin", h.adminRequired).
+		Tag("admin").Security(openapi.AdminToken)
+	{
+		admin.Post("/purge", openapi.Route{
+			Summary:     "Hard-delete soft-deleted rows",
+			Description: "Returns 202 with a task ID instead when async=true.",
+			Query: []openapi.Query{
+				{Name: "older_than", Description: "Go duration, e.g. 720h; defaults to PURGE_RETENTION"},
+				{Name: "async", Description: "Hand the purge to the worker"},
+			},
+			Response: service.PurgeResult{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+		}, h.admin.Purge)
+		admin.Post("/reencrypt", openapi.Route{
+			Summary:     "Re-encrypt secrets with the primary key",
+			Description: "Rewrites OAuth and integration tokens and webhook secrets that are stored in plaintext or encrypted with an older key in ENCRYPTION_KEYS. Run it after adding a new primary key, before removing the old one.",
+			Response:    service.ReencryptResult{},
+			Errors:      []int{fiber.StatusInternalServerError},
+		}, h.admin.Reencrypt)
+		admin.Get("/log-level", openapi.Route{
+			Summary:     "Get the log level",
+			Description: "The level of the instance serving the request.",
+			Response:    service.LogLevelDTO{},
+		}, h.admin.GetLogLevel)
+		admin.Put("/log-level", openapi.Route{
+			Summary:     "Change the log level",
+			Description: "Sets the level, debug, info, warn or error, of every API and worker process until they restart, when LOG_LEVEL applies again.",
+			Request:     service.SetLogLevelRequest{},
+			Response:    service.LogLevelDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+		}, h.admin.SetLogLevel)
+		admin.Put("/consent-categories/:key", openapi.Route{
+			Summary:     "Create or update a consent category",
+			Description: "Keys of built-in categories (necessary, functional, analytics, marketing) change their name, description and position.",
+			Request:     service.SaveConsentCategoryRequest{},
+			Response:    service.ConsentCategoryDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusInternalServerError},
+		}, h.consent.SaveCategory)
+		admin.Delete("/consent-categories/:key", openapi.Route{
+			Summary: "Delete a consent category",
+			Errors:  []int{fiber.StatusBadRequest, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.consent.DeleteCategory)
+	}
+}
+
+// registerExtensionAPI registers the browser extension's routes. They take
+// extension tokens rather than sessions, and answer in the compact
+// ExtensionMeetingDTO.
+func registerExtensionAPI(api *openapi.Router, h *handlers) {
+	ext := api.Group("/meetings", h.extensionRequired, h.requesterContext, h.rowLevelSecurity).
+		Tag("extension").Security(openapi.ExtensionToken)
+	{
+		ext.Post("/start", openapi.Route{
+			Summary:     "Track the call in a tab",
+			Description: "Returns the organization's running meeting for the call when someone is already tracking it, and otherwise creates and starts one with the tab's title as its purpose and the attendees given.",
+			Request:     service.ExtensionStartRequest{},
+			Response:    service.ExtensionMeetingDTO{},
+			Errors:      []int{fiber.StatusPaymentRequired, fiber.StatusForbidden, fiber.StatusInternalServerError},
+		}, h.extension.Start)
+		ext.Post("/:id/stop", openapi.Route{
+			Summary:  "Stop the meeting clock",
+			Response: service.ExtensionMeetingDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.extension.Stop)
+		ext.Post("/:id/tick", openapi.Route{
+			Summary:     "Report the attendees and get the cost so far",
+			Description: "Sent periodically while the tab is open. A changed attendee count starts a new increment; leave it out when the extension can't count attendees.",
+			Request:     service.ExtensionTickRequest{},
+			Response:    service.ExtensionMeetingDTO{},
+			Errors:      []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.extension.Tick)
+		ext.Get("/:id", openapi.Route{
+			Summary:  "Get the cost so far",
+			Response: service.ExtensionMeetingDTO{},
+			Errors:   []int{fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusInternalServerError},
+		}, h.extension.Cost)
+	}
+}
+
+// paged returns the route and handler of a list endpoint for version: a bare
+// array in v1, a Page with pagination metadata from v2.
+func paged(version int, route openapi.Route, v1, v2 fiber.Handler, page interface{}) (openapi.Route, fiber.Handler) {
+	if version < 2 {
+		return route, v1
+	}
+	route.Query = append(route.Query,
+		openapi.Query{Name: "page", Description: "1-based page number; default 1"},
+		openapi.Query{Name: "page_size", Description: "Items per page, at most 100; default 20"},
+	)
+	route.Response = page
+	route.Errors = append(route.Errors, fiber.StatusBadRequest)
+	return route, v2
+}
@@ -3,18 +3,77 @@ package main
 
 import (
 	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
 	"log"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 	_ "github.com/lib/pq"
 	"github.com/yourorg/meeting-cost/backend/go/internal/config"
 	"github.com/yourorg/meeting-cost/backend/go/migrations"
 )
 
+const usage = `usage: migrate [flags] <command> [arg]
+
+commands:
+  up            apply all pending migrations (default)
+  down          roll back all migrations
+  steps N       apply N migrations, or roll back N if negative
+  version       print the current version
+  status        list migrations and whether each is applied
+  force N       set the version to N and clear the dirty flag
+  create NAME   write empty timestamped up/down files to MIGRATIONS_DIR
+                (default ./migrations)
+
+flags:`
+
 func main() {
+	dryRun := flag.Bool("dry-run", false, "print the SQL that up, down or steps would run, without running it")
+	allowDestructive := flag.Bool("allow-destructive", false, "permit down migrations, force and data-dropping SQL when ENV=production")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	args = flag.Args()
+
+	cmd := "up"
+	if len(args) > 0 {
+		cmd = args[0]
+	}
+	switch cmd {
+	case "up", "down", "steps", "version", "status", "force", "create":
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// create only touches the filesystem, so it must not need a database
+	if cmd == "create" {
+		if len(args) != 2 {
+			log.Fatal(usage)
+		}
+		dir := os.Getenv("MIGRATIONS_DIR")
+		if dir == "" {
+			dir = "migrations"
+		}
+		if err := create(dir, args[1], time.Now()); err != nil {
+			log.Fatalf("create migration: %v", err)
+		}
+		return
+	}
+
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("load config: %v", err)
@@ -46,22 +105,181 @@ func main() {
 	}
 	defer m.Close()
 
-	cmd := "up"
-	if len(os.Args) > 1 {
-		cmd = os.Args[1]
+	// Production data is only ever dropped on purpose
+	guard := func(what string) {
+		if cfg.Env == "production" && !*allowDestructive {
+			log.Fatalf("refusing to run destructive %s in production without -allow-destructive", what)
+		}
 	}
+
 	switch cmd {
-	case "up":
-		if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-			log.Fatalf("migrate up: %v", err)
+	case "up", "down", "steps":
+		n := 0
+		if cmd == "steps" {
+			n = intArg()
 		}
-		log.Println("migrations applied")
-	case "down":
-		if err := m.Down(); err != nil && err != migrate.ErrNoChange {
-			log.Fatalf("migrate down: %v", err)
+
+		current, _, err := m.Version()
+		applied := err == nil
+		if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+			log.Fatalf("read version: %v", err)
 		}
-		log.Println("migrations rolled back")
-	default:
-		log.Fatalf("usage: migrate [up|down]")
+		if err := verifyChecksums(db, sourceDriver); err != nil {
+			log.Fatalf("pre-flight: %v", err)
+		}
+		steps, err := plan(sourceDriver, current, applied, cmd, n)
+		if err != nil {
+			log.Fatalf("plan migrations: %v", err)
+		}
+		for _, s := range steps {
+			if s.destructive() {
+				guard("migration " + s.String())
+			}
+		}
+
+		if *dryRun {
+			if len(steps) == 0 {
+				fmt.Println("-- no migrations to run")
+			}
+			for _, s := range steps {
+				fmt.Printf("-- %s\n%s\n", s, s.sql)
+			}
+			return
+		}
+
+		switch cmd {
+		case "up":
+			err = m.Up()
+		case "down":
+			err = m.Down()
+		case "steps":
+			err = m.Steps(n)
+		}
+		if err != nil && err != migrate.ErrNoChange {
+			log.Fatalf("migrate %s: %v", strings.Join(args, " "), err)
+		}
+
+		current, _, err = m.Version()
+		applied = err == nil
+		if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+			log.Fatalf("read version: %v", err)
+		}
+		if err := recordChecksums(db, sourceDriver, current, applied); err != nil {
+			log.Fatalf("record checksums: %v", err)
+		}
+		log.Printf("ran %d migrations", len(steps))
+	case "version":
+		version, dirty, err := m.Version()
+		if errors.Is(err, migrate.ErrNilVersion) {
+			fmt.Println("no migrations applied")
+			return
+		}
+		if err != nil {
+			log.Fatalf("read version: %v", err)
+		}
+		fmt.Printf("%d%s\n", version, dirtySuffix(dirty))
+	case "status":
+		if err := status(m, sourceDriver); err != nil {
+			log.Fatalf("migrate status: %v", err)
+		}
+		if err := verifyChecksums(db, sourceDriver); err != nil {
+			fmt.Printf("\nchecksum mismatch: %v\n", err)
+		}
+	case "force":
+		n := intArg()
+		guard("force")
+		if *dryRun {
+			fmt.Printf("-- would force version %d\n", n)
+			return
+		}
+		if err := m.Force(n); err != nil {
+			log.Fatalf("migrate force %d: %v", n, err)
+		}
+		log.Printf("forced version %d", n)
+	}
+}
+
+// args holds the positional arguments after flags.
+var args []string
+
+// intArg parses the command's integer argument or exits with usage.
+func intArg() int {
+	if len(args) != 2 {
+		log.Fatal(usage)
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil {
+		log.Fatalf("invalid number %q\n%s", args[1], usage)
+	}
+	return n
+}
+
+func dirtySuffix(dirty bool) string {
+	if dirty {
+		return " (dirty)"
+	}
+	return ""
+}
+
+// status prints every embedded migration alongside whether it is applied.
+func status(m *migrate.Migrate, src source.Driver) error {
+	current, dirty, err := m.Version()
+	applied := err == nil
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return fmt.Errorf("reading version: %w", err)
+	}
+
+	all, err := versions(src)
+	if err != nil {
+		return err
+	}
+	for _, version := range all {
+		state := "pending"
+		if applied && version <= current {
+			state = "applied"
+			if version == current {
+				state += dirtySuffix(dirty)
+			}
+		}
+		fmt.Printf("%-16d %-8s %s\n", version, state, migrationName(version))
+	}
+	return nil
+}
+
+// migrationName returns the description part of the embedded up file for
+// version.
+func migrationName(version uint) string {
+	matches, _ := fs.Glob(migrations.FS, fmt.Sprintf("%d_*.up.sql", version))
+	if len(matches) == 0 {
+		// Zero-padded versions such as 001 do not match %d
+		matches, _ = fs.Glob(migrations.FS, fmt.Sprintf("%03d_*.up.sql", version))
+	}
+	if len(matches) == 0 {
+		return ""
+	}
+	name := strings.TrimSuffix(matches[0], ".up.sql")
+	return name[strings.Index(name, "_")+1:]
+}
+
+var nonWord = regexp.MustCompile(`[^a-z0-9]+`)
+
+// create writes an empty up/down pair named <timestamp>_<name> into dir.
+func create(dir, name string, now time.Time) error {
+	name = strings.Trim(nonWord.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return fmt.Errorf("name must contain letters or digits")
+	}
+
+	base := filepath.Join(dir, now.UTC().Format("20060102150405")+"_"+name)
+	for _, path := range []string{base + ".up.sql", base + ".down.sql"} {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Println(path)
 	}
+	return nil
 }
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"regexp"
+	"sort"
+
+	"github.com/golang-migrate/migrate/v4/source"
+)
+
+// checksumTable records the SHA-256 of each applied up migration. The
+// golang-migrate schema table only stores the current version, so this is
+// what lets us notice an applied migration being edited after the fact.
+const checksumTable = "schema_migration_checksums"
+
+// destructiveSQL matches statements that lose data when they run.
+var destructiveSQL = regexp.MustCompile(`(?i)\b(DROP\s+(TABLE|COLUMN|SCHEMA|TYPE|VIEW|MATERIALIZED\s+VIEW)|TRUNCATE|DELETE\s+FROM|ALTER\s+TABLE\s+\S+\s+DROP)\b`)
+
+// step is one migration that a command would run.
+type step struct {
+	version uint
+	up      bool
+	sql     string
+}
+
+func (s step) destructive() bool {
+	return !s.up || destructiveSQL.MatchString(s.sql)
+}
+
+func (s step) String() string {
+	direction := "down"
+	if s.up {
+		direction = "up"
+	}
+	return fmt.Sprintf("%d %s (%s)", s.version, migrationName(s.version), direction)
+}
+
+// versions lists every embedded migration version in ascending order.
+func versions(src source.Driver) ([]uint, error) {
+	var all []uint
+	version, err := src.First()
+	for err == nil {
+		all = append(all, version)
+		version, err = src.Next(version)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("listing migrations: %w", err)
+	}
+	return all, nil
+}
+
+// plan returns the migrations cmd would run from the current version, in
+// execution order. n is the argument to steps.
+func plan(src source.Driver, current uint, applied bool, cmd string, n int) ([]step, error) {
+	all, err := versions(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending, done []uint
+	for _, v := range all {
+		if applied && v <= current {
+			done = append(done, v)
+		} else {
+			pending = append(pending, v)
+		}
+	}
+	sort.Slice(done, func(i, j int) bool { return done[i] > done[j] })
+
+	var picked []uint
+	up := true
+	switch {
+	case cmd == "up":
+		picked = pending
+	case cmd == "down":
+		picked, up = done, false
+	case cmd == "steps" && n >= 0:
+		if n > len(pending) {
+			return nil, fmt.Errorf("only %d migrations pending", len(pending))
+		}
+		picked = pending[:n]
+	case cmd == "steps":
+		if -n > len(done) {
+			return nil, fmt.Errorf("only %d migrations applied", len(done))
+		}
+		picked, up = done[:-n], false
+	}
+
+	steps := make([]step, 0, len(picked))
+	for _, v := range picked {
+		body, err := read(src, v, up)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step{version: v, up: up, sql: body})
+	}
+	return steps, nil
+}
+
+// read returns the up or down SQL for version. A missing down file reads as
+// empty, matching how golang-migrate treats it.
+func read(src source.Driver, version uint, up bool) (string, error) {
+	var (
+		r   io.ReadCloser
+		err error
+	)
+	if up {
+		r, _, err = src.ReadUp(version)
+	} else {
+		r, _, err = src.ReadDown(version)
+	}
+	if errors.Is(err, fs.ErrNotExist) && !up {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading migration %d: %w", version, err)
+	}
+	defer r.Close()
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("reading migration %d: %w", version, err)
+	}
+	return string(b), nil
+}
+
+func checksum(sql string) string {
+	sum := sha256.Sum256([]byte(sql))
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyChecksums fails if any applied migration's embedded up file no longer
+// matches the checksum recorded when it ran.
+func verifyChecksums(db *sql.DB, src source.Driver) error {
+	var exists bool
+	if err := db.QueryRow(`SELECT to_regclass($1) IS NOT NULL`, checksumTable).Scan(&exists); err != nil {
+		return fmt.Errorf("checking checksum table: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	rows, err := db.Query(`SELECT version, checksum FROM ` + checksumTable + ` ORDER BY version`)
+	if err != nil {
+		return fmt.Errorf("reading checksums: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			version  uint
+			recorded string
+		)
+		if err := rows.Scan(&version, &recorded); err != nil {
+			return fmt.Errorf("reading checksums: %w", err)
+		}
+		body, err := read(src, version, true)
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("applied migration %d is missing from this build", version)
+		}
+		if err != nil {
+			return err
+		}
+		if checksum(body) != recorded {
+			return fmt.Errorf("applied migration %d %s was modified after it ran", version, migrationName(version))
+		}
+	}
+	return rows.Err()
+}
+
+// recordChecksums brings the checksum table in line with the current
+// version: applied migrations without a checksum are recorded, and rows for
+// rolled-back migrations are dropped. Migrations applied before checksums
+// were tracked are trusted as they are now.
+func recordChecksums(db *sql.DB, src source.Driver, current uint, applied bool) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS ` + checksumTable + ` (
+		version    bigint PRIMARY KEY,
+		checksum   text NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`); err != nil {
+		return fmt.Errorf("creating checksum table: %w", err)
+	}
+
+	if !applied {
+		_, err := db.Exec(`DELETE FROM ` + checksumTable)
+		return err
+	}
+	if _, err := db.Exec(`DELETE FROM `+checksumTable+` WHERE version > $1`, current); err != nil {
+		return fmt.Errorf("dropping rolled-back checksums: %w", err)
+	}
+
+	all, err := versions(src)
+	if err != nil {
+		return err
+	}
+	for _, v := range all {
+		if v > current {
+			break
+		}
+		body, err := read(src, v, true)
+		if err != nil {
+			return err
+		}
+		if _, err := db.Exec(
+			`INSERT INTO `+checksumTable+` (version, checksum) VALUES ($1, $2) ON CONFLICT (version) DO NOTHING`,
+			v, checksum(body),
+		); err != nil {
+			return fmt.Errorf("recording checksum for %d: %w", v, err)
+		}
+	}
+	return nil
+}
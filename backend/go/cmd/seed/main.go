@@ -0,0 +1,68 @@
+// seed loads demo data (an organization, users with known credentials, roles
+// and historical meetings) into the configured database.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/config"
+	"github.com/yourorg/meeting-cost/backend/go/internal/container"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/seed"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("validate config: %v", err)
+	}
+	if cfg.Env == "production" {
+		log.Fatal("refusing to seed demo users with a known password in production")
+	}
+	if cfg.Demo() {
+		log.Fatal("the API seeds itself in demo mode; nothing to do")
+	}
+
+	level, err := logger.NewLevel(cfg.Log.Level)
+	if err != nil {
+		log.Fatalf("initialize logger: %v", err)
+	}
+	l, err := logger.NewZapLogger(os.Getenv("ENV"), level)
+	if err != nil {
+		log.Fatalf("initialize logger: %v", err)
+	}
+	l = logger.Redact(l, cfg.Log.Redaction)
+
+	cacheClient := cache.NewRedisCache(cfg.Cache.Addr, cfg.Cache.Password, cfg.Cache.DB)
+
+	db, err := config.NewDB(&cfg.Database)
+	if err != nil {
+		log.Fatalf("initialize database: %v", err)
+	}
+
+	ctn, err := container.NewContainer(ctx, cfg, db, cacheClient, l, level)
+	if err != nil {
+		log.Fatalf("initialize container: %v", err)
+	}
+	defer ctn.Close()
+
+	res, err := seed.Run(ctx, ctn, time.Now())
+	if err != nil {
+		log.Fatalf("seed: %v", err)
+	}
+	if res.Skipped {
+		log.Printf("%s already exists; skipping", seed.AdminEmail)
+		return
+	}
+	log.Printf("seeded %q (%s): %d people, %d meetings", seed.OrganizationName, res.OrganizationID, res.People, res.Meetings)
+	log.Printf("sign in as %s with password %q", seed.AdminEmail, seed.Password)
+}
@@ -0,0 +1,91 @@
+// worker processes background tasks enqueued by the API.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/config"
+	"github.com/yourorg/meeting-cost/backend/go/internal/container"
+	"github.com/yourorg/meeting-cost/backend/go/internal/jobs"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/metrics"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("validate config: %v", err)
+	}
+	if cfg.Demo() {
+		log.Fatal("the API runs its own worker in demo mode")
+	}
+
+	level, err := logger.NewLevel(cfg.Log.Level)
+	if err != nil {
+		log.Fatalf("initialize logger: %v", err)
+	}
+	l, err := logger.NewZapLogger(os.Getenv("ENV"), level)
+	if err != nil {
+		log.Fatalf("initialize logger: %v", err)
+	}
+	l = logger.Redact(l, cfg.Log.Redaction)
+
+	cacheClient := cache.NewRedisCache(cfg.Cache.Addr, cfg.Cache.Password, cfg.Cache.DB)
+
+	db, err := config.NewDB(&cfg.Database)
+	if err != nil {
+		log.Fatalf("initialize database: %v", err)
+	}
+
+	ctn, err := container.NewContainer(ctx, cfg, db, cacheClient, l, level)
+	if err != nil {
+		log.Fatalf("initialize container: %v", err)
+	}
+	defer ctn.Close()
+
+	srv := queue.NewServer(ctn.QueueBroker, queue.ServerOptions{
+		Concurrency: cfg.Queue.Concurrency,
+		Lease:       cfg.Queue.Lease,
+	}, l, ctn.Metrics)
+	jobs.Register(srv, ctn)
+
+	// Every replica runs the scheduler; the broker lets one enqueue per tick
+	sched := queue.NewScheduler(ctn.QueueBroker, l)
+	if err := jobs.Schedule(sched, cfg); err != nil {
+		log.Fatalf("schedule jobs: %v", err)
+	}
+	go sched.Run(ctx)
+	if cfg.Queue.CostTickInterval > 0 {
+		go jobs.RunCostTicker(ctx, ctn, cfg.Queue.CostTickInterval)
+	}
+
+	// The worker has no API, but its job metrics still need scraping
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get("/metrics", metrics.Handler(ctn.Metrics))
+	go func() {
+		if err := app.Listen(":" + strconv.Itoa(cfg.Queue.MetricsPort)); err != nil {
+			l.Error("metrics server stopped", "error", err)
+		}
+	}()
+
+	l.Info("worker started", "concurrency", cfg.Queue.Concurrency)
+	if err := srv.Run(ctx); err != nil {
+		log.Fatalf("run worker: %v", err)
+	}
+	_ = app.Shutdown()
+	l.Info("worker stopped")
+}
@@ -18,44 +18,70 @@ var (
 type Claims struct {
 	PersonID uuid.UUID `json:"person_id"`
 	Email    string    `json:"email"`
+	// Memberships are embedded when membership claims are enabled
+	Memberships []Membership `json:"orgs,omitempty"`
+	// ActiveOrganizationID is the organization the person was working in
+	// when the token was issued
+	ActiveOrganizationID *uuid.UUID `json:"active_org,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // TokenManager handles JWT generation and validation.
 type TokenManager struct {
-	secret         []byte
-	issuer         string
-	accessExpiry   time.Duration
-	refreshExpiry  time.Duration
+	secret        []byte
+	issuer        string
+	accessExpiry  time.Duration
+	refreshExpiry time.Duration
+	claimsExpiry  time.Duration
 }
 
-// NewTokenManager creates a new TokenManager.
-func NewTokenManager(secret string, issuer string, accessExpiry, refreshExpiry time.Duration) *TokenManager {
+// NewTokenManager creates a new TokenManager. claimsExpiry caps the
+// lifetime of access tokens carrying membership claims; 0 leaves them out
+// of tokens.
+func NewTokenManager(secret string, issuer string, accessExpiry, refreshExpiry, claimsExpiry time.Duration) *TokenManager {
 	return &TokenManager{
 		secret:        []byte(secret),
 		issuer:        issuer,
 		accessExpiry:  accessExpiry,
 		refreshExpiry: refreshExpiry,
+		claimsExpiry:  claimsExpiry,
 	}
 }
 
+// MembershipClaims reports whether access tokens carry membership claims.
+func (m *TokenManager) MembershipClaims() bool {
+	return m.claimsExpiry > 0
+}
+
 // TokenPair holds access and refresh tokens.
 type TokenPair struct {
-	AccessToken  string    `json:"access_token"`
-	RefreshToken string    `json:"refresh_token"`
-	ExpiresIn    int64     `json:"expires_in"` // Access token expiry in seconds
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"` // Access token expiry in seconds
 }
 
-// GenerateTokenPair creates a new access and refresh token pair.
-func (m *TokenManager) GenerateTokenPair(personID uuid.UUID, email string) (*TokenPair, error) {
+// GenerateTokenPair creates a new access and refresh token pair. The access
+// token carries memberships when membership claims are enabled, and then
+// expires within the claims expiry, and the person's active organization
+// when they have one.
+func (m *TokenManager) GenerateTokenPair(personID uuid.UUID, email string, memberships []Membership, activeOrgID *uuid.UUID) (*TokenPair, error) {
 	now := time.Now()
 
+	accessExpiry := m.accessExpiry
+	if !m.MembershipClaims() {
+		memberships = nil
+	} else if m.claimsExpiry < accessExpiry {
+		accessExpiry = m.claimsExpiry
+	}
+
 	// 1. Generate Access Token
 	accessClaims := &Claims{
-		PersonID: personID,
-		Email:    email,
+		PersonID:             personID,
+		Email:                email,
+		Memberships:          memberships,
+		ActiveOrganizationID: activeOrgID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(m.accessExpiry)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(accessExpiry)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    m.issuer,
@@ -85,7 +111,7 @@ func (m *TokenManager) GenerateTokenPair(personID uuid.UUID, email string) (*Tok
 	return &TokenPair{
 		AccessToken:  accessString,
 		RefreshToken: refreshString,
-		ExpiresIn:    int64(m.accessExpiry.Seconds()),
+		ExpiresIn:    int64(accessExpiry.Seconds()),
 	}, nil
 }
 
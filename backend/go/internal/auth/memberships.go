@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+)
+
+// Membership is an organization the person is an active member of, as
+// embedded in access token claims.
+type Membership struct {
+	OrganizationID uuid.UUID `json:"org"`
+	Roles          []string  `json:"roles"`
+	// Grants are the "resource:activity" permissions the person's roles
+	// grant on every resource in the organization
+	Grants []string `json:"grants,omitempty"`
+}
+
+// Grant formats a permission as it appears in Grants.
+func Grant(resourceName, activity string) string {
+	return resourceName + ":" + activity
+}
+
+// Allows reports whether the membership grants activity on every resource
+// named resourceName.
+func (m Membership) Allows(resourceName, activity string) bool {
+	return slices.Contains(m.Grants, Grant(resourceName, activity))
+}
+
+// MembershipClaims are the memberships the request's access token carries
+// for the person it was issued to.
+type MembershipClaims struct {
+	PersonID    uuid.UUID
+	Memberships []Membership
+}
+
+// For returns the membership in orgID the claims carry for personID.
+func (c *MembershipClaims) For(personID, orgID uuid.UUID) (Membership, bool) {
+	if c == nil || c.PersonID != personID {
+		return Membership{}, false
+	}
+	for _, m := range c.Memberships {
+		if m.OrganizationID == orgID {
+			return m, true
+		}
+	}
+	return Membership{}, false
+}
+
+type contextKey string
+
+// ContextKeyMembershipClaims is the context key the auth middleware stores
+// the request's *MembershipClaims under.
+const ContextKeyMembershipClaims contextKey = "membership_claims"
+
+// MembershipClaimsFromContext returns the membership claims of the request
+// ctx belongs to, or nil when its token carries none.
+func MembershipClaimsFromContext(ctx context.Context) *MembershipClaims {
+	claims, _ := ctx.Value(ContextKeyMembershipClaims).(*MembershipClaims)
+	return claims
+}
+
+// MembershipRevocations records when a person's memberships or roles last
+// changed, so the membership claims of tokens issued before then are no
+// longer trusted. Records are kept in the shared cache for as long as such
+// tokens live.
+type MembershipRevocations struct {
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewMembershipRevocations creates MembershipRevocations that outlive
+// tokens carrying membership claims, which expire after ttl.
+func NewMembershipRevocations(c cache.Cache, ttl time.Duration) *MembershipRevocations {
+	return &MembershipRevocations{cache: c, ttl: ttl}
+}
+
+// Revoke stops trusting the membership claims issued to the person so far.
+func (r *MembershipRevocations) Revoke(ctx context.Context, personID uuid.UUID) error {
+	return r.cache.Set(ctx, cache.KeyMembershipsRevoked(personID), time.Now().Unix(), r.ttl)
+}
+
+// Revoked reports whether membership claims issued to the person at
+// issuedAt are no longer trusted. Claims aren't trusted when the record
+// can't be read either.
+func (r *MembershipRevocations) Revoked(ctx context.Context, personID uuid.UUID, issuedAt time.Time) bool {
+	var revokedAt int64
+	if err := r.cache.Get(ctx, cache.KeyMembershipsRevoked(personID), &revokedAt); err != nil {
+		return !errors.Is(err, redis.Nil)
+	}
+	// Tokens carry whole seconds, so a token issued in the second of the
+	// revocation counts as before it
+	return issuedAt.Unix() <= revokedAt
+}
@@ -0,0 +1,213 @@
+package billing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Webhook event types the service handles.
+const (
+	EventCheckoutCompleted    = "checkout.session.completed"
+	EventSubscriptionCreated  = "customer.subscription.created"
+	EventSubscriptionUpdated  = "customer.subscription.updated"
+	EventSubscriptionDeleted  = "customer.subscription.deleted"
+	EventInvoiceFinalized     = "invoice.finalized"
+	EventInvoicePaid          = "invoice.paid"
+	EventInvoicePaymentFailed = "invoice.payment_failed"
+	EventInvoiceVoided        = "invoice.voided"
+	EventInvoiceUncollectible = "invoice.marked_uncollectible"
+)
+
+// signatureTolerance is how old a signed webhook request may be, to stop
+// replays.
+const signatureTolerance = 5 * time.Minute
+
+// ErrInvalidSignature means a webhook request was not signed with the
+// endpoint's secret, or was signed too long ago.
+var ErrInvalidSignature = errors.New("invalid stripe signature")
+
+// Event is a webhook event. Data.Object holds the object the event is
+// about; decode it with the type matching Type.
+type Event struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// Subscription is a Stripe subscription.
+type Subscription struct {
+	ID                string            `json:"id"`
+	Customer          string            `json:"customer"`
+	Status            string            `json:"status"`
+	CancelAtPeriodEnd bool              `json:"cancel_at_period_end"`
+	EndedAt           int64             `json:"ended_at"`
+	Metadata          map[string]string `json:"metadata"`
+
+	// Older API versions report the period here, newer ones on the items
+	CurrentPeriodStart int64 `json:"current_period_start"`
+	CurrentPeriodEnd   int64 `json:"current_period_end"`
+
+	Items struct {
+		Data []SubscriptionItem `json:"data"`
+	} `json:"items"`
+}
+
+// SubscriptionItem is one price a subscription bills for.
+type SubscriptionItem struct {
+	ID    string `json:"id"`
+	Price struct {
+		ID        string `json:"id"`
+		Recurring struct {
+			UsageType string `json:"usage_type"` // "licensed" or "metered"
+		} `json:"recurring"`
+	} `json:"price"`
+	Quantity           int   `json:"quantity"`
+	CurrentPeriodStart int64 `json:"current_period_start"`
+	CurrentPeriodEnd   int64 `json:"current_period_end"`
+}
+
+// PlanItem returns the item billing for the plan itself, the first one
+// that is not metered.
+func (s *Subscription) PlanItem() *SubscriptionItem {
+	for i := range s.Items.Data {
+		if s.Items.Data[i].Price.Recurring.UsageType != "metered" {
+			return &s.Items.Data[i]
+		}
+	}
+	return nil
+}
+
+// PriceID returns the price of the plan item.
+func (s *Subscription) PriceID() string {
+	if item := s.PlanItem(); item != nil {
+		return item.Price.ID
+	}
+	return ""
+}
+
+// Period returns the subscription's current billing period.
+func (s *Subscription) Period() (start, end time.Time) {
+	startUnix, endUnix := s.CurrentPeriodStart, s.CurrentPeriodEnd
+	if endUnix == 0 && len(s.Items.Data) > 0 {
+		startUnix, endUnix = s.Items.Data[0].CurrentPeriodStart, s.Items.Data[0].CurrentPeriodEnd
+	}
+	return time.Unix(startUnix, 0).UTC(), time.Unix(endUnix, 0).UTC()
+}
+
+// Invoice is a Stripe invoice. Amounts are in the currency's smallest unit.
+type Invoice struct {
+	ID               string `json:"id"`
+	Number           string `json:"number"`
+	Customer         string `json:"customer"`
+	Status           string `json:"status"`
+	AmountDue        int64  `json:"amount_due"`
+	AmountPaid       int64  `json:"amount_paid"`
+	Currency         string `json:"currency"`
+	Created          int64  `json:"created"`
+	PeriodStart      int64  `json:"period_start"`
+	PeriodEnd        int64  `json:"period_end"`
+	HostedInvoiceURL string `json:"hosted_invoice_url"`
+	InvoicePDF       string `json:"invoice_pdf"`
+
+	Lines struct {
+		Data []struct {
+			Period struct {
+				Start int64 `json:"start"`
+				End   int64 `json:"end"`
+			} `json:"period"`
+		} `json:"data"`
+	} `json:"lines"`
+
+	StatusTransitions struct {
+		PaidAt int64 `json:"paid_at"`
+	} `json:"status_transitions"`
+
+	// Older API versions link the subscription and payment intent
+	// directly; newer ones through parent
+	Subscription  string `json:"subscription"`
+	PaymentIntent string `json:"payment_intent"`
+	Parent        struct {
+		SubscriptionDetails struct {
+			Subscription string `json:"subscription"`
+		} `json:"subscription_details"`
+	} `json:"parent"`
+}
+
+// Period returns the service period the invoice bills for: its first
+// line's, as the invoice's own period is when usage accrued.
+func (i *Invoice) Period() (start, end time.Time) {
+	startUnix, endUnix := i.PeriodStart, i.PeriodEnd
+	if len(i.Lines.Data) > 0 {
+		startUnix, endUnix = i.Lines.Data[0].Period.Start, i.Lines.Data[0].Period.End
+	}
+	return time.Unix(startUnix, 0).UTC(), time.Unix(endUnix, 0).UTC()
+}
+
+// SubscriptionID returns the subscription the invoice bills, if any.
+func (i *Invoice) SubscriptionID() string {
+	if i.Subscription != "" {
+		return i.Subscription
+	}
+	return i.Parent.SubscriptionDetails.Subscription
+}
+
+// VerifyEvent checks the Stripe-Signature header of a webhook request and
+// decodes its payload.
+func (s *Stripe) VerifyEvent(payload []byte, header string, now time.Time) (*Event, error) {
+	var timestamp string
+	var signatures [][]byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			if sig, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, sig)
+			}
+		}
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return nil, ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > signatureTolerance || age < -signatureTolerance {
+		return nil, fmt.Errorf("%w: timestamp outside tolerance", ErrInvalidSignature)
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.webhookSecret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	valid := false
+	for _, sig := range signatures {
+		if hmac.Equal(sig, expected) {
+			valid = true
+		}
+	}
+	if !valid {
+		return nil, ErrInvalidSignature
+	}
+
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("decoding stripe event: %w", err)
+	}
+	return &event, nil
+}
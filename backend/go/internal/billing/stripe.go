@@ -0,0 +1,256 @@
+// Package billing talks to Stripe: Checkout and Customer Portal sessions,
+// subscription changes, usage for billing meters, and verifying the webhook
+// events Stripe sends back.
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const stripeAPI = "https://api.stripe.com/v1/"
+
+// Stripe calls the Stripe API with a secret key.
+type Stripe struct {
+	secretKey     string
+	webhookSecret string
+	client        *http.Client
+}
+
+// NewStripe creates a Stripe client. webhookSecret (whsec_...) verifies
+// webhook events.
+func NewStripe(secretKey, webhookSecret string, timeout time.Duration) *Stripe {
+	return &Stripe{
+		secretKey:     secretKey,
+		webhookSecret: webhookSecret,
+		client:        &http.Client{Timeout: timeout},
+	}
+}
+
+// CheckoutParams describes a Checkout session for a new subscription.
+type CheckoutParams struct {
+	PriceID string
+	// Quantity of PriceID, e.g. seats; zero means one
+	Quantity int
+	// MeteredPriceIDs are billed by usage alongside the plan
+	MeteredPriceIDs []string
+	// CustomerID reuses an existing customer; otherwise Checkout creates
+	// one for CustomerEmail.
+	CustomerID    string
+	CustomerEmail string
+	// ClientReferenceID and Metadata are echoed in the resulting events
+	ClientReferenceID string
+	Metadata          map[string]string
+	SuccessURL        string
+	CancelURL         string
+}
+
+// CheckoutSession is a hosted Checkout page. Customer and Subscription are
+// set once it completes.
+type CheckoutSession struct {
+	ID                string            `json:"id"`
+	URL               string            `json:"url"`
+	Customer          string            `json:"customer"`
+	Subscription      string            `json:"subscription"`
+	ClientReferenceID string            `json:"client_reference_id"`
+	Metadata          map[string]string `json:"metadata"`
+}
+
+// CreateCheckoutSession starts a Checkout session that subscribes the
+// customer to Quantity units of the price, plus any metered prices.
+// Metadata is copied onto the subscription.
+func (s *Stripe) CreateCheckoutSession(ctx context.Context, p CheckoutParams) (*CheckoutSession, error) {
+	form := url.Values{
+		"mode":                    {"subscription"},
+		"line_items[0][price]":    {p.PriceID},
+		"line_items[0][quantity]": {strconv.Itoa(max(p.Quantity, 1))},
+		"success_url":             {p.SuccessURL},
+		"cancel_url":              {p.CancelURL},
+	}
+	for i, price := range p.MeteredPriceIDs {
+		form.Set(fmt.Sprintf("line_items[%d][price]", i+1), price)
+	}
+	if p.CustomerID != "" {
+		form.Set("customer", p.CustomerID)
+	} else if p.CustomerEmail != "" {
+		form.Set("customer_email", p.CustomerEmail)
+	}
+	if p.ClientReferenceID != "" {
+		form.Set("client_reference_id", p.ClientReferenceID)
+	}
+	for k, v := range p.Metadata {
+		form.Set("metadata["+k+"]", v)
+		form.Set("subscription_data[metadata]["+k+"]", v)
+	}
+
+	var session CheckoutSession
+	if err := s.call(ctx, http.MethodPost, "checkout/sessions", form, &session); err != nil {
+		return nil, fmt.Errorf("creating checkout session: %w", err)
+	}
+	return &session, nil
+}
+
+// CreatePortalSession returns a Customer Portal link where the customer
+// manages payment methods, invoices and their subscription.
+func (s *Stripe) CreatePortalSession(ctx context.Context, customerID, returnURL string) (string, error) {
+	form := url.Values{"customer": {customerID}, "return_url": {returnURL}}
+	var session struct {
+		URL string `json:"url"`
+	}
+	if err := s.call(ctx, http.MethodPost, "billing_portal/sessions", form, &session); err != nil {
+		return "", fmt.Errorf("creating portal session: %w", err)
+	}
+	return session.URL, nil
+}
+
+// GetSubscription fetches a subscription.
+func (s *Stripe) GetSubscription(ctx context.Context, id string) (*Subscription, error) {
+	var sub Subscription
+	if err := s.call(ctx, http.MethodGet, "subscriptions/"+url.PathEscape(id), nil, &sub); err != nil {
+		return nil, fmt.Errorf("getting subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+// ChangePrice moves the subscription's plan item to another price, and to
+// quantity units of it unless quantity is zero, prorating the difference.
+// Metered items are left alone.
+func (s *Stripe) ChangePrice(ctx context.Context, id, priceID string, quantity int) (*Subscription, error) {
+	sub, err := s.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item := sub.PlanItem()
+	if item == nil {
+		return nil, fmt.Errorf("subscription %s has no plan item", id)
+	}
+
+	form := url.Values{
+		"items[0][id]":         {item.ID},
+		"items[0][price]":      {priceID},
+		"cancel_at_period_end": {"false"},
+		"proration_behavior":   {"create_prorations"},
+	}
+	if quantity > 0 {
+		form.Set("items[0][quantity]", strconv.Itoa(quantity))
+	}
+	var updated Subscription
+	if err := s.call(ctx, http.MethodPost, "subscriptions/"+url.PathEscape(id), form, &updated); err != nil {
+		return nil, fmt.Errorf("changing subscription price: %w", err)
+	}
+	return &updated, nil
+}
+
+// SetQuantity changes how many units of its plan item the subscription
+// bills for, such as seats, prorating the change for the rest of the
+// period.
+func (s *Stripe) SetQuantity(ctx context.Context, id string, quantity int) (*Subscription, error) {
+	sub, err := s.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	item := sub.PlanItem()
+	if item == nil {
+		return nil, fmt.Errorf("subscription %s has no plan item", id)
+	}
+
+	form := url.Values{
+		"items[0][id]":       {item.ID},
+		"items[0][quantity]": {strconv.Itoa(quantity)},
+		"proration_behavior": {"create_prorations"},
+	}
+	var updated Subscription
+	if err := s.call(ctx, http.MethodPost, "subscriptions/"+url.PathEscape(id), form, &updated); err != nil {
+		return nil, fmt.Errorf("changing subscription quantity: %w", err)
+	}
+	return &updated, nil
+}
+
+// CancelAtPeriodEnd schedules the subscription to end with its current
+// period.
+func (s *Stripe) CancelAtPeriodEnd(ctx context.Context, id string) (*Subscription, error) {
+	form := url.Values{"cancel_at_period_end": {"true"}}
+	var sub Subscription
+	if err := s.call(ctx, http.MethodPost, "subscriptions/"+url.PathEscape(id), form, &sub); err != nil {
+		return nil, fmt.Errorf("canceling subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+// MeterEvent is usage reported to a billing meter.
+type MeterEvent struct {
+	// EventName is the meter's event name
+	EventName  string
+	CustomerID string
+	Value      int64
+	// Identifier makes the report idempotent: Stripe drops a second event
+	// with the same identifier
+	Identifier string
+	Timestamp  time.Time
+}
+
+// ReportMeterEvent sends usage to a billing meter.
+func (s *Stripe) ReportMeterEvent(ctx context.Context, e MeterEvent) error {
+	form := url.Values{
+		"event_name":                  {e.EventName},
+		"payload[stripe_customer_id]": {e.CustomerID},
+		"payload[value]":              {strconv.FormatInt(e.Value, 10)},
+		"identifier":                  {e.Identifier},
+		"timestamp":                   {strconv.FormatInt(e.Timestamp.Unix(), 10)},
+	}
+	var out struct {
+		Identifier string `json:"identifier"`
+	}
+	if err := s.call(ctx, http.MethodPost, "billing/meter_events", form, &out); err != nil {
+		return fmt.Errorf("reporting %s usage: %w", e.EventName, err)
+	}
+	return nil
+}
+
+// call sends a form-encoded request, decoding the JSON reply into out or
+// Stripe's error into an error.
+func (s *Stripe) call(ctx context.Context, method, path string, form url.Values, out interface{}) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, stripeAPI+path, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(s.secretKey, "")
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		var e struct {
+			Error struct {
+				Type    string `json:"type"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(raw, &e) == nil && e.Error.Message != "" {
+			return fmt.Errorf("stripe: %s: %s", e.Error.Type, e.Error.Message)
+		}
+		return fmt.Errorf("stripe: status %d", resp.StatusCode)
+	}
+	return json.Unmarshal(raw, out)
+}
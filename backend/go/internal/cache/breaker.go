@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/yourorg/meeting-cost/backend/go/internal/circuit"
+)
+
+// breakerCache short-circuits calls to a failing cache backend. While the
+// circuit is open every call fails fast with circuit.ErrOpen, which the
+// repositories treat as a miss and fall through to the database.
+//
+// Invalidations that fail, or are refused while the circuit is open, are
+// kept and replayed before the next call that reaches the backend, so
+// entries they would have removed are never served once it recovers.
+type breakerCache struct {
+	next    Cache
+	breaker *circuit.Breaker
+
+	// mu guards pending, the keys, and prefixes when true, still to be
+	// deleted; hasPending spares calls the lock while there are none
+	mu         sync.Mutex
+	pending    map[string]bool
+	hasPending atomic.Bool
+}
+
+// NewBreakerCache guards next with breaker.
+func NewBreakerCache(next Cache, breaker *circuit.Breaker) Cache {
+	return &breakerCache{next: next, breaker: breaker, pending: make(map[string]bool)}
+}
+
+func (c *breakerCache) Get(ctx context.Context, key string, dest interface{}) error {
+	if !c.breaker.Allow() {
+		return circuit.ErrOpen
+	}
+	err := c.flush(ctx)
+	if err == nil {
+		err = c.next.Get(ctx, key, dest)
+	}
+	c.record(err)
+	return err
+}
+
+func (c *breakerCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if !c.breaker.Allow() {
+		return circuit.ErrOpen
+	}
+	err := c.flush(ctx)
+	if err == nil {
+		err = c.next.Set(ctx, key, value, ttl)
+	}
+	c.record(err)
+	return err
+}
+
+func (c *breakerCache) Delete(ctx context.Context, key string) error {
+	if !c.breaker.Allow() {
+		c.keep(key, false)
+		return circuit.ErrOpen
+	}
+	err := c.flush(ctx)
+	if err == nil {
+		err = c.next.Delete(ctx, key)
+	}
+	if err != nil {
+		c.keep(key, false)
+	}
+	c.record(err)
+	return err
+}
+
+func (c *breakerCache) DeletePrefix(ctx context.Context, prefix string) error {
+	if !c.breaker.Allow() {
+		c.keep(prefix, true)
+		return circuit.ErrOpen
+	}
+	err := c.flush(ctx)
+	if err == nil {
+		err = c.next.DeletePrefix(ctx, prefix)
+	}
+	if err != nil {
+		c.keep(prefix, true)
+	}
+	c.record(err)
+	return err
+}
+
+func (c *breakerCache) Exists(ctx context.Context, key string) (bool, error) {
+	if !c.breaker.Allow() {
+		return false, circuit.ErrOpen
+	}
+	err := c.flush(ctx)
+	var ok bool
+	if err == nil {
+		ok, err = c.next.Exists(ctx, key)
+	}
+	c.record(err)
+	return ok, err
+}
+
+// Ping goes through the breaker like any other call, so it can't change
+// the breaker's state while another call is probing the backend. Health
+// checks see circuit.ErrOpen while the circuit is open.
+func (c *breakerCache) Ping(ctx context.Context) error {
+	if !c.breaker.Allow() {
+		return circuit.ErrOpen
+	}
+	err := c.next.Ping(ctx)
+	c.record(err)
+	return err
+}
+
+func (c *breakerCache) GetClient() *redis.Client {
+	return c.next.GetClient()
+}
+
+// keep keeps an invalidation the backend didn't get for the next flush.
+func (c *breakerCache) keep(key string, prefix bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending[key] = c.pending[key] || prefix
+	c.hasPending.Store(true)
+}
+
+// flush replays the invalidations kept while the backend was failing. Calls
+// wait for it, so none reads from the backend before they are all done, and
+// it stops at the first failure, keeping what is left.
+func (c *breakerCache) flush(ctx context.Context) error {
+	if !c.hasPending.Load() {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, prefix := range c.pending {
+		var err error
+		if prefix {
+			err = c.next.DeletePrefix(ctx, key)
+		} else {
+			err = c.next.Delete(ctx, key)
+		}
+		if err != nil {
+			return err
+		}
+		delete(c.pending, key)
+	}
+	c.hasPending.Store(false)
+	return nil
+}
+
+// record reports the outcome of a backend call. Misses and decoding errors
+// mean the backend answered, so they count as successes.
+func (c *breakerCache) record(err error) {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var unsupportedErr *json.UnsupportedTypeError
+	switch {
+	case err == nil,
+		errors.Is(err, redis.Nil),
+		errors.As(err, &syntaxErr),
+		errors.As(err, &typeErr),
+		errors.As(err, &unsupportedErr):
+		c.breaker.Success()
+	default:
+		c.breaker.Failure()
+	}
+}
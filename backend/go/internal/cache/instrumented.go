@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"github.com/yourorg/meeting-cost/backend/go/internal/metrics"
+)
+
+// instrumentedCache is a Cache decorator that counts hits, misses and errors
+// per key prefix.
+type instrumentedCache struct {
+	next   Cache
+	gets   *prometheus.CounterVec
+	errors *prometheus.CounterVec
+}
+
+// NewInstrumentedCache wraps next and registers its counters on reg.
+func NewInstrumentedCache(next Cache, reg prometheus.Registerer) Cache {
+	c := &instrumentedCache{
+		next: next,
+		gets: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "cache",
+			Name:      "gets_total",
+			Help:      "Cache lookups by key prefix and result (hit, miss, error).",
+		}, []string{"prefix", "result"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "cache",
+			Name:      "errors_total",
+			Help:      "Failed cache writes and deletes by key prefix and operation.",
+		}, []string{"prefix", "op"}),
+	}
+	reg.MustRegister(c.gets, c.errors)
+	return c
+}
+
+func (c *instrumentedCache) Get(ctx context.Context, key string, dest interface{}) error {
+	err := c.next.Get(ctx, key, dest)
+	result := "hit"
+	switch {
+	case errors.Is(err, redis.Nil):
+		result = "miss"
+	case err != nil:
+		result = "error"
+	}
+	c.gets.WithLabelValues(keyPrefix(key), result).Inc()
+	return err
+}
+
+func (c *instrumentedCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	err := c.next.Set(ctx, key, value, ttl)
+	if err != nil {
+		c.errors.WithLabelValues(keyPrefix(key), "set").Inc()
+	}
+	return err
+}
+
+func (c *instrumentedCache) Delete(ctx context.Context, key string) error {
+	err := c.next.Delete(ctx, key)
+	if err != nil {
+		c.errors.WithLabelValues(keyPrefix(key), "delete").Inc()
+	}
+	return err
+}
+
+func (c *instrumentedCache) DeletePrefix(ctx context.Context, prefix string) error {
+	err := c.next.DeletePrefix(ctx, prefix)
+	if err != nil {
+		c.errors.WithLabelValues(keyPrefix(prefix), "delete_prefix").Inc()
+	}
+	return err
+}
+
+func (c *instrumentedCache) Exists(ctx context.Context, key string) (bool, error) {
+	return c.next.Exists(ctx, key)
+}
+
+func (c *instrumentedCache) Ping(ctx context.Context) error {
+	return c.next.Ping(ctx)
+}
+
+func (c *instrumentedCache) GetClient() *redis.Client {
+	return c.next.GetClient()
+}
+
+// keyPrefix returns the entity segment of key (e.g. "person", "has_perm"),
+// keeping label cardinality bounded.
+func keyPrefix(key string) string {
+	if i := strings.IndexByte(key, ':'); i > 0 {
+		return key[:i]
+	}
+	return "other"
+}
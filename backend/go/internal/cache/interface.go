@@ -20,6 +20,10 @@ type Cache interface {
 	// Delete removes the value at key (no-op if it does not exist).
 	Delete(ctx context.Context, key string) error
 
+	// DeletePrefix removes every key starting with prefix. It is used to
+	// invalidate composite keys that cannot be enumerated by the writer.
+	DeletePrefix(ctx context.Context, prefix string) error
+
 	// Exists returns true if the key exists.
 	Exists(ctx context.Context, key string) (bool, error)
 
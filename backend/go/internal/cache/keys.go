@@ -17,6 +17,10 @@ const (
 	KeyPrefixPermission = "permission:"
 	KeyPrefixRole       = "role:"
 	KeyPrefixConsent    = "consent:"
+
+	KeyPrefixHasPermission = "has_perm:"
+
+	KeyPrefixMembershipsRevoked = "memberships_revoked:"
 )
 
 func KeyPerson(id uuid.UUID) string {
@@ -52,6 +56,17 @@ func KeySession(tokenHash string) string {
 	return KeyPrefixSession + tokenHash
 }
 
+// KeySessionByID holds the token hash of a session kept in Redis alone.
+func KeySessionByID(id uuid.UUID) string {
+	return KeyPrefixSession + "id:" + id.String()
+}
+
+// KeyPersonSessions is the set of token hashes of a person's sessions kept
+// in Redis alone.
+func KeyPersonSessions(personID uuid.UUID) string {
+	return KeyPrefixSession + "person:" + personID.String()
+}
+
 func KeyProfile(id uuid.UUID) string {
 	return KeyPrefixProfile + id.String()
 }
@@ -89,7 +104,26 @@ func KeyHasPermission(personID, orgID uuid.UUID, resourceName string, resourceID
 	if resourceID != nil {
 		resIDStr = resourceID.String()
 	}
-	return fmt.Sprintf("has_perm:%s:%s:%s:%s:%s", personID.String(), orgID.String(), resourceName, resIDStr, activity)
+	return fmt.Sprintf("%s%s:%s:%s:%s:%s", KeyPrefixHasPermission, personID.String(), orgID.String(), resourceName, resIDStr, activity)
+}
+
+// KeyPermissionGrants holds everything a person is granted in an
+// organization. It falls under KeyPrefixHasPermissionForPerson, so it is
+// invalidated with the person's cached checks.
+func KeyPermissionGrants(personID, orgID uuid.UUID) string {
+	return KeyPrefixHasPermission + personID.String() + ":" + orgID.String()
+}
+
+// KeyPrefixHasPermissionForPerson covers every cached permission check for a
+// person, across organizations and resources.
+func KeyPrefixHasPermissionForPerson(personID uuid.UUID) string {
+	return KeyPrefixHasPermission + personID.String() + ":"
+}
+
+// KeyMembershipsRevoked records when a person's membership claims were
+// last revoked.
+func KeyMembershipsRevoked(personID uuid.UUID) string {
+	return KeyPrefixMembershipsRevoked + personID.String()
 }
 
 func KeyConsentBySession(sessionID string) string {
@@ -100,6 +134,14 @@ func KeyConsentByPerson(personID uuid.UUID) string {
 	return KeyPrefixConsent + "person:" + personID.String()
 }
 
+// ChannelCacheInvalidation carries keys deleted by one instance so that others
+// can evict them from their in-process cache tier.
+const ChannelCacheInvalidation = "cache:invalidate"
+
+// ChannelLogLevel carries log level changes made through one instance to
+// every API and worker process.
+const ChannelLogLevel = "log:level"
+
 func ChannelMeetingEvents(meetingID uuid.UUID) string {
 	return fmt.Sprintf("events:meeting:%s", meetingID.String())
 }
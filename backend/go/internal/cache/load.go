@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/rls"
+	"golang.org/x/sync/singleflight"
+)
+
+// loadGroup deduplicates concurrent cache misses across all repositories.
+// Cache keys are unique per entity, so a single group is sufficient.
+var loadGroup singleflight.Group
+
+// LoadThrough implements the cache-aside read used by the repositories with
+// stampede protection: when key is missing from the cache, only one caller runs
+// load and every concurrent caller for the same key shares its result. The
+// result is cached for ttl on success.
+//
+// load runs with a context detached from the caller's cancellation, since its
+// result is shared with other requests. Callers scoped by row-level security
+// load on their own: their reads run on their tenant's connection, which
+// must not answer for anyone else, and behind the nop cache there is nothing
+// to share anyway.
+func LoadThrough[T any](ctx context.Context, c Cache, key string, ttl time.Duration, load func(ctx context.Context) (T, error)) (T, error) {
+	var value T
+	if err := c.Get(ctx, key, &value); err == nil {
+		return value, nil
+	}
+
+	if _, ok := c.(nopCache); ok || ctx.Value(rls.ContextKeyConn) != nil {
+		loaded, err := load(ctx)
+		if err != nil {
+			return value, err
+		}
+		_ = c.Set(ctx, key, loaded, ttl)
+		return loaded, nil
+	}
+
+	res, err, _ := loadGroup.Do(key, func() (interface{}, error) {
+		loadCtx := context.WithoutCancel(ctx)
+		loaded, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		_ = c.Set(loadCtx, key, loaded, ttl)
+		return loaded, nil
+	})
+	if err != nil {
+		return value, err
+	}
+
+	return res.(T), nil
+}
@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// memoryCache is a process-local Cache used when no Redis is available, such
+// as in demo mode. Misses return redis.Nil so callers and decorators treat it
+// exactly like the Redis implementation.
+type memoryCache struct {
+	store *lruCache
+}
+
+// NewMemoryCache creates a Cache held entirely in process memory, bounded to
+// size entries. A non-positive size leaves it unbounded.
+func NewMemoryCache(size int) Cache {
+	if size <= 0 {
+		size = math.MaxInt
+	}
+	return &memoryCache{store: newLRUCache(size)}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string, dest interface{}) error {
+	data, ok := c.store.get(key)
+	if !ok {
+		return redis.Nil
+	}
+	return json.Unmarshal(data, dest)
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	// Redis treats a zero TTL as "never expire"
+	if ttl <= 0 {
+		ttl = 100 * 365 * 24 * time.Hour
+	}
+	c.store.set(key, data, ttl)
+	return nil
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.store.delete(key)
+	return nil
+}
+
+func (c *memoryCache) DeletePrefix(ctx context.Context, prefix string) error {
+	c.store.deletePrefix(prefix)
+	return nil
+}
+
+func (c *memoryCache) Exists(ctx context.Context, key string) (bool, error) {
+	_, ok := c.store.get(key)
+	return ok, nil
+}
+
+func (c *memoryCache) Ping(ctx context.Context) error {
+	return nil
+}
+
+// GetClient returns nil; there is no Redis behind a memory cache.
+func (c *memoryCache) GetClient() *redis.Client {
+	return nil
+}
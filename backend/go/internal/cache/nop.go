@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// nopCache caches nothing: every Get misses, so repositories always read
+// the database.
+type nopCache struct{}
+
+// NewNopCache creates a Cache that stores nothing, for repositories whose
+// reads must always go to the database, such as under row-level security.
+func NewNopCache() Cache {
+	return nopCache{}
+}
+
+func (nopCache) Get(ctx context.Context, key string, dest interface{}) error {
+	return redis.Nil
+}
+
+func (nopCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return nil
+}
+
+func (nopCache) Delete(ctx context.Context, key string) error {
+	return nil
+}
+
+func (nopCache) DeletePrefix(ctx context.Context, prefix string) error {
+	return nil
+}
+
+func (nopCache) Exists(ctx context.Context, key string) (bool, error) {
+	return false, nil
+}
+
+func (nopCache) Ping(ctx context.Context) error {
+	return nil
+}
+
+// GetClient returns nil; there is no Redis behind a nop cache.
+func (nopCache) GetClient() *redis.Client {
+	return nil
+}
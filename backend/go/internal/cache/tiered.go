@@ -0,0 +1,160 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/yourorg/meeting-cost/backend/go/internal/pubsub"
+)
+
+// DefaultLocalPrefixes are the key prefixes held in the in-process tier: hot
+// point reads that are hit on every cost tick during active meetings.
+var DefaultLocalPrefixes = []string{
+	KeyPrefixHasPermission,
+	KeyPrefixPerson,
+	KeyPrefixOrg,
+	KeyPrefixMeeting,
+	KeyPrefixProfile,
+	KeyPrefixRole,
+	KeyPrefixPermission,
+}
+
+// TieredOptions configures the in-process tier of a tiered cache.
+type TieredOptions struct {
+	// Size is the maximum number of entries kept in process.
+	Size int
+	// TTL caps how long an entry is served from process memory; entries
+	// expire at the lesser of TTL and the TTL given to Set.
+	TTL time.Duration
+	// Prefixes restricts the in-process tier to keys with these prefixes.
+	Prefixes []string
+}
+
+// invalidationMessage is published on ChannelCacheInvalidation whenever keys
+// are deleted, so that other instances drop their in-process copies.
+type invalidationMessage struct {
+	Origin   string   `json:"origin"`
+	Keys     []string `json:"keys,omitempty"`
+	Prefixes []string `json:"prefixes,omitempty"`
+}
+
+// tieredCache is a Cache that serves hot keys from a small in-process LRU in
+// front of a remote (Valkey/Redis) cache.
+type tieredCache struct {
+	local    *lruCache
+	remote   Cache
+	ps       pubsub.PubSub
+	ttl      time.Duration
+	prefixes []string
+	origin   string
+}
+
+// NewTieredCache wraps remote with an in-process LRU tier. Deletes are
+// broadcast over ps so every instance evicts its local copy; broadcasts from
+// other instances are applied until ctx is cancelled.
+func NewTieredCache(ctx context.Context, remote Cache, ps pubsub.PubSub, opts TieredOptions) Cache {
+	c := &tieredCache{
+		local:    newLRUCache(opts.Size),
+		remote:   remote,
+		ps:       ps,
+		ttl:      opts.TTL,
+		prefixes: opts.Prefixes,
+		origin:   uuid.NewString(),
+	}
+	c.listen(ctx)
+	return c
+}
+
+func (c *tieredCache) listen(ctx context.Context) {
+	messages := c.ps.Subscribe(ctx, ChannelCacheInvalidation)
+	go func() {
+		for payload := range messages {
+			var msg invalidationMessage
+			if err := json.Unmarshal([]byte(payload), &msg); err != nil || msg.Origin == c.origin {
+				continue
+			}
+			for _, key := range msg.Keys {
+				c.local.delete(key)
+			}
+			for _, prefix := range msg.Prefixes {
+				c.local.deletePrefix(prefix)
+			}
+		}
+	}()
+}
+
+func (c *tieredCache) Get(ctx context.Context, key string, dest interface{}) error {
+	if !c.isLocal(key) {
+		return c.remote.Get(ctx, key, dest)
+	}
+
+	if data, ok := c.local.get(key); ok {
+		return json.Unmarshal(data, dest)
+	}
+
+	if err := c.remote.Get(ctx, key, dest); err != nil {
+		return err
+	}
+	if data, err := json.Marshal(dest); err == nil {
+		c.local.set(key, data, c.ttl)
+	}
+	return nil
+}
+
+func (c *tieredCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if err := c.remote.Set(ctx, key, value, ttl); err != nil {
+		return err
+	}
+	if c.isLocal(key) {
+		if data, err := json.Marshal(value); err == nil {
+			c.local.set(key, data, min(ttl, c.ttl))
+		}
+	}
+	return nil
+}
+
+func (c *tieredCache) Delete(ctx context.Context, key string) error {
+	c.local.delete(key)
+	err := c.remote.Delete(ctx, key)
+	if c.isLocal(key) {
+		_ = c.ps.Publish(ctx, ChannelCacheInvalidation, invalidationMessage{Origin: c.origin, Keys: []string{key}})
+	}
+	return err
+}
+
+func (c *tieredCache) DeletePrefix(ctx context.Context, prefix string) error {
+	c.local.deletePrefix(prefix)
+	err := c.remote.DeletePrefix(ctx, prefix)
+	_ = c.ps.Publish(ctx, ChannelCacheInvalidation, invalidationMessage{Origin: c.origin, Prefixes: []string{prefix}})
+	return err
+}
+
+func (c *tieredCache) Exists(ctx context.Context, key string) (bool, error) {
+	if c.isLocal(key) {
+		if _, ok := c.local.get(key); ok {
+			return true, nil
+		}
+	}
+	return c.remote.Exists(ctx, key)
+}
+
+func (c *tieredCache) Ping(ctx context.Context) error {
+	return c.remote.Ping(ctx)
+}
+
+func (c *tieredCache) GetClient() *redis.Client {
+	return c.remote.GetClient()
+}
+
+func (c *tieredCache) isLocal(key string) bool {
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package cache
+
+import "time"
+
+// TTLs holds the per-entity expirations used by the repositories when
+// populating the cache.
+type TTLs struct {
+	Person        time.Duration
+	Organization  time.Duration
+	Profile       time.Duration
+	Meeting       time.Duration
+	Increment     time.Duration
+	IncrementList time.Duration
+	AuthMethod    time.Duration
+	Role          time.Duration
+	Permission    time.Duration
+	HasPermission time.Duration
+	Consent       time.Duration
+}
+
+// DefaultTTLs returns the default expirations: long for rarely changing
+// entities, short for meetings and permission checks.
+func DefaultTTLs() TTLs {
+	return TTLs{
+		Person:        1 * time.Hour,
+		Organization:  1 * time.Hour,
+		Profile:       1 * time.Hour,
+		Meeting:       15 * time.Minute,
+		Increment:     1 * time.Hour,
+		IncrementList: 15 * time.Minute,
+		AuthMethod:    1 * time.Hour,
+		Role:          1 * time.Hour,
+		Permission:    1 * time.Hour,
+		HasPermission: 1 * time.Minute,
+		Consent:       1 * time.Hour,
+	}
+}
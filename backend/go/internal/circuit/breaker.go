@@ -0,0 +1,132 @@
+// Package circuit provides a minimal circuit breaker used to stop calling a
+// backend (Valkey/Redis) that is failing, so requests fall back quickly
+// instead of waiting on timeouts.
+package circuit
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned by guarded calls while the circuit is open.
+var ErrOpen = errors.New("circuit open")
+
+// State is the state of a Breaker.
+type State int
+
+const (
+	// Closed lets all calls through.
+	Closed State = iota
+	// Open rejects calls until the cooldown has elapsed.
+	Open
+	// HalfOpen lets a single probe call through to test recovery.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// Breaker opens after a number of consecutive failures and probes the backend
+// again once a cooldown has passed. It is safe for concurrent use.
+type Breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	state     State
+	openedAt  time.Time
+	probing   bool
+	onChange  []func(from, to State)
+}
+
+// New creates a Breaker that opens after threshold consecutive failures and
+// stays open for cooldown before probing.
+func New(threshold int, cooldown time.Duration) *Breaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &Breaker{threshold: threshold, cooldown: cooldown}
+}
+
+// OnStateChange registers fn to be called after every state transition.
+// Callbacks run synchronously and must not call back into the Breaker.
+func (b *Breaker) OnStateChange(fn func(from, to State)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.onChange = append(b.onChange, fn)
+}
+
+// State returns the current state.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow reports whether a call may proceed. Callers that are allowed must
+// report the outcome with Success or Failure.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Closed:
+		return true
+	case Open:
+		if time.Since(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.transition(HalfOpen)
+		b.probing = true
+		return true
+	default:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+}
+
+// Success records a successful call, closing the circuit if it was probing.
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+	if b.state != Closed {
+		b.transition(Closed)
+	}
+}
+
+// Failure records a failed call, opening the circuit once the threshold is
+// reached or immediately if a probe failed.
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == HalfOpen || (b.state == Closed && b.failures >= b.threshold) {
+		b.openedAt = time.Now()
+		b.transition(Open)
+	}
+}
+
+func (b *Breaker) transition(to State) {
+	from := b.state
+	b.state = to
+	for _, fn := range b.onChange {
+		fn(from, to)
+	}
+}
@@ -4,16 +4,36 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/encryption"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 )
 
 // Config holds application configuration loaded from environment.
 type Config struct {
-	Env      string
-	Database DatabaseConfig
-	Server   ServerConfig
-	Cache    CacheConfig
-	Auth     AuthConfig
+	Env        string
+	Database   DatabaseConfig
+	Server     ServerConfig
+	Cache      CacheConfig
+	Auth       AuthConfig
+	Purge      PurgeConfig
+	Partitions PartitionConfig
+	Archive    ArchiveConfig
+	Queue      QueueConfig
+	Webhook    WebhookConfig
+	Email      EmailConfig
+	Notify     NotifyConfig
+	Billing    BillingConfig
+	Reports    ReportsConfig
+	Encryption EncryptionConfig
+	Log        LogConfig
+	Audit      AuditConfig
+	APIUsage   APIUsageConfig
+	Consent    ConsentConfig
+	Compliance ComplianceConfig
 }
 
 // DatabaseConfig holds PostgreSQL connection settings.
@@ -27,6 +47,17 @@ type DatabaseConfig struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+
+	// RepositoryDriver selects the repository implementation: "gorm"
+	// (default), "pgx" (sqlc-generated queries on the hot increment path) or
+	// "memory" (demo mode with no Postgres or Redis; data is lost on exit).
+	RepositoryDriver string
+
+	// RowLevelSecurity scopes the database connections of organization and
+	// meeting requests to the person's organizations, which the row-level
+	// security policies on meetings and their rows then enforce.
+	RowLevelSecurity bool
 }
 
 // ServerConfig holds HTTP server settings.
@@ -34,6 +65,23 @@ type ServerConfig struct {
 	Port         int
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+
+	// PublicURL is where clients reach the API, for links in emails;
+	// defaults to localhost on Port.
+	PublicURL string
+
+	// V1Sunset is announced in the Sunset header of /api/v1 responses; zero
+	// omits it.
+	V1Sunset time.Time
+
+	// ExtensionOrigins are the browser extension origins, such as
+	// chrome-extension://<id>, allowed to call the extension API from the
+	// browser; empty allows any extension.
+	ExtensionOrigins []string
+
+	// CalculatorRateLimit is how many cost calculations each client IP can
+	// request a minute
+	CalculatorRateLimit int
 }
 
 // CacheConfig holds Valkey/Redis cache settings.
@@ -42,6 +90,21 @@ type CacheConfig struct {
 	Password string
 	DB       int
 	TTL      time.Duration
+
+	// LocalSize is the number of entries held in the in-process tier in
+	// front of Valkey/Redis; 0 disables it.
+	LocalSize int
+	// LocalTTL bounds how stale an in-process entry can be if an
+	// invalidation broadcast is missed.
+	LocalTTL time.Duration
+
+	// BreakerThreshold is the number of consecutive cache failures after
+	// which calls short-circuit to the database for BreakerCooldown.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+
+	// TTLs are the per-entity expirations used by the repositories.
+	TTLs cache.TTLs
 }
 
 // AuthConfig holds JWT and authentication settings.
@@ -50,6 +113,292 @@ type AuthConfig struct {
 	JWTIssuer     string
 	AccessExpiry  time.Duration
 	RefreshExpiry time.Duration
+
+	// MembershipClaimsExpiry embeds the person's organization memberships
+	// and role names in access tokens, which then expire within it, so
+	// permission checks their roles grant skip the database and cache; 0
+	// leaves them out.
+	MembershipClaimsExpiry time.Duration
+
+	// SessionStore is where sessions live: "database", or "redis" to keep
+	// them in Redis alone until they expire.
+	SessionStore string
+	// SessionDatabaseFallback has a Redis session store look up sessions
+	// it lacks in the database, and write sessions there while Redis is
+	// unavailable.
+	SessionDatabaseFallback bool
+
+	// AdminToken authorizes the operator endpoints under /api/v1/admin;
+	// empty disables them.
+	AdminToken string
+	// IntrospectionToken authorizes internal services calling the token
+	// introspection endpoint; empty disables it.
+	IntrospectionToken string
+
+	// AccountDeletionGrace is how long after a person confirms deleting
+	// their account it is anonymized, during which they can cancel.
+	AccountDeletionGrace time.Duration
+
+	// TenantGuard rejects requests naming an organization the signed-in
+	// person isn't a member of before they reach the services.
+	TenantGuard bool
+}
+
+// Session stores for AuthConfig.SessionStore.
+const (
+	SessionStoreDatabase = "database"
+	SessionStoreRedis    = "redis"
+)
+
+// PurgeConfig controls hard deletion of soft-deleted rows.
+type PurgeConfig struct {
+	// Retention is how long soft-deleted rows are kept before purging.
+	Retention time.Duration
+	// Interval between scheduled purges; 0 disables the schedule.
+	Interval time.Duration
+}
+
+// PartitionConfig controls the monthly partitions of the increments table.
+type PartitionConfig struct {
+	// Ahead is how many months ahead partitions are created.
+	Ahead int
+	// Retention is how long increments are kept before their month's
+	// partition is dropped; 0 keeps them forever.
+	Retention time.Duration
+	// Schedule is a cron spec for creating and dropping partitions; empty
+	// or "off" disables it.
+	Schedule string
+}
+
+// ArchiveConfig controls archiving old meetings.
+type ArchiveConfig struct {
+	// After is how long after stopping meetings are archived; 0 disables
+	// archiving.
+	After time.Duration
+	// Schedule is a cron spec for archiving; empty or "off" disables it.
+	Schedule string
+}
+
+// QueueConfig holds background job worker settings. The queue lives in the
+// same Valkey/Redis as the cache.
+type QueueConfig struct {
+	Concurrency int
+	// Lease is how long a task may run before another worker may pick it
+	// up again.
+	Lease time.Duration
+	// MetricsPort serves the worker's /metrics.
+	MetricsPort int
+	// SessionCleanupSchedule is a cron spec for deleting expired sessions;
+	// empty or "off" disables it.
+	SessionCleanupSchedule string
+	// DigestSchedule is a cron spec for the weekly digest emails; empty or
+	// "off" disables them.
+	DigestSchedule string
+	// CostAlertSchedule is a cron spec for checking active meetings against
+	// cost alerts; empty or "off" disables it.
+	CostAlertSchedule string
+	// OverrunSchedule is a cron spec for checking active meetings against
+	// their scheduled ends; empty or "off" disables it.
+	OverrunSchedule string
+	// UsageReportSchedule is a cron spec for reporting usage to Stripe's
+	// billing meters; empty or "off" disables it.
+	UsageReportSchedule string
+	// TrialSchedule is a cron spec for reminding organizations of ending
+	// trials and downgrading lapsed ones; empty or "off" disables it.
+	TrialSchedule string
+	// ReportExportPurgeSchedule is a cron spec for deleting expired report
+	// exports; empty or "off" disables it.
+	ReportExportPurgeSchedule string
+	// APIUsagePurgeSchedule is a cron spec for deleting API request
+	// counts older than their retention; empty or "off" disables it.
+	APIUsagePurgeSchedule string
+	// ReportRollupSchedule is a cron spec for refreshing the daily
+	// aggregates reports read from; empty or "off" disables it, leaving
+	// reports to read days since the last refresh from the meetings.
+	ReportRollupSchedule string
+	// AccountDeletionSchedule is a cron spec for anonymizing the people
+	// whose account deletions are due; empty or "off" disables it.
+	AccountDeletionSchedule string
+	// CompactionSchedule is a cron spec for merging identical increments
+	// of stopped meetings that weren't merged when they stopped; empty or
+	// "off" disables it.
+	CompactionSchedule string
+	// WageSyncSchedule is a cron spec for syncing member wages from the HR
+	// systems of organizations that turned scheduled syncs on; empty or
+	// "off" disables it.
+	WageSyncSchedule string
+	// WageSyncTimeout bounds each read of an HR system.
+	WageSyncTimeout time.Duration
+	// WarehouseExportSchedule is a cron spec for exporting to the data
+	// warehouses of organizations that turned scheduled exports on; empty
+	// or "off" disables it.
+	WarehouseExportSchedule string
+	// WarehouseExportTimeout bounds each export run; a run that doesn't
+	// catch up in time queues another to continue.
+	WarehouseExportTimeout time.Duration
+	// CostTickInterval is how often the live cost of each active meeting
+	// is published to its event channel; zero disables it.
+	CostTickInterval time.Duration
+}
+
+// WebhookConfig controls outbound webhook delivery.
+type WebhookConfig struct {
+	// MaxAttempts is how many times a delivery is tried before it is
+	// dead-lettered.
+	MaxAttempts int
+	// Timeout bounds each delivery request.
+	Timeout time.Duration
+	// AllowPrivateNetworks lets endpoints resolve to loopback and private
+	// addresses. Only for development.
+	AllowPrivateNetworks bool
+}
+
+// EmailConfig selects and configures the email provider.
+type EmailConfig struct {
+	// Driver is log, smtp, ses or sendgrid; log only writes messages to the
+	// application log.
+	Driver string
+	// From is the sender, e.g. "Meeting Cost <no-reply@example.com>".
+	From string
+	// Timeout bounds each request to an HTTP provider.
+	Timeout time.Duration
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+
+	SESRegion          string
+	SESAccessKeyID     string
+	SESSecretAccessKey string
+	SESSessionToken    string
+
+	SendGridAPIKey string
+}
+
+// NotifyConfig enables the notification channels beyond email. A channel
+// is offered to people only when it is configured.
+type NotifyConfig struct {
+	// SlackBotToken is a Slack app's bot token for direct messages.
+	SlackBotToken string
+	// SlackMilestoneThrottle is the least time between an organization's
+	// cost milestone announcements in Slack.
+	SlackMilestoneThrottle time.Duration
+	// VAPID key pair (base64url P-256) and contact for Web Push.
+	VAPIDPublicKey  string
+	VAPIDPrivateKey string
+	VAPIDSubject    string
+	// Timeout bounds each request to Slack or a push service.
+	Timeout time.Duration
+}
+
+// BillingConfig connects subscriptions to Stripe. Billing is off when
+// StripeSecretKey is empty.
+type BillingConfig struct {
+	StripeSecretKey string
+	// StripeWebhookSecret (whsec_...) verifies events sent to the Stripe
+	// webhook endpoint.
+	StripeWebhookSecret string
+	// Prices maps each paid plan type to its Stripe price ID.
+	Prices map[string]string
+	// MeteredPrices are added to every Checkout subscription so that
+	// usage reported to the meters below is billed.
+	MeteredPrices []string
+	// Billing meter event names for meeting minutes and active members;
+	// empty leaves that usage unreported.
+	MeterMeetingMinutes string
+	MeterActiveMembers  string
+	// SeatPlans are the paid plans billed per active member; their
+	// subscriptions hold a number of seats.
+	SeatPlans []string
+	// Timeout bounds each request to Stripe.
+	Timeout time.Duration
+
+	// TrialDays is how long new organizations trial TrialPlan; zero starts
+	// them on the free plan.
+	TrialDays int
+	TrialPlan string
+	// TrialReminderDays is how many days before a trial ends its admins
+	// are reminded.
+	TrialReminderDays int
+}
+
+// ReportsConfig controls report exports.
+type ReportsConfig struct {
+	// ExportTTL is how long an export's download link works.
+	ExportTTL time.Duration
+}
+
+// EncryptionConfig holds the keys sensitive columns, such as OAuth and
+// integration tokens and webhook secrets, are encrypted at rest with.
+type EncryptionConfig struct {
+	// Keys is a comma-separated list of <id>:<base64 32-byte key>, the
+	// primary key first; older keys stay listed until values are
+	// re-encrypted. Empty stores the columns in plaintext.
+	Keys string
+	// Wages encrypts members' hourly wages with the keys too: deployment
+	// uses the keys as they are, organization a key derived from them for
+	// each organization. Empty stores wages in plaintext.
+	Wages string
+}
+
+// LogConfig controls what reaches the logs.
+type LogConfig struct {
+	// Redaction is how much of the logs emails, tokens, IP addresses and
+	// wages are masked in: "full" masks them at every level, "verbose"
+	// leaves debug logs unmasked for development. Defaults to full in
+	// production and verbose elsewhere.
+	Redaction string
+	// Level is the least severe level logged: debug, info, warn or error.
+	// Defaults to info in production and debug elsewhere. Operators can
+	// change it at runtime through the admin API.
+	Level string
+}
+
+// AuditConfig controls what the audit log records beyond the changes
+// services log themselves.
+type AuditConfig struct {
+	// TrailGroups are the route groups, of auth, members and wages, whose
+	// every request is recorded with its sanitized bodies; empty records
+	// none.
+	TrailGroups []string
+	// BufferSize is how many entries may wait to be written in batches
+	// off the request path; 0 writes each as it is logged.
+	BufferSize int
+	// FlushInterval is the longest a buffered entry waits to be written.
+	FlushInterval time.Duration
+}
+
+// APIUsageConfig controls the API usage analytics.
+type APIUsageConfig struct {
+	// FlushInterval is how often each API instance writes the request
+	// counts it holds.
+	FlushInterval time.Duration
+	// Retention is how long hourly counts are kept.
+	Retention time.Duration
+}
+
+// ConsentConfig holds the cookie consent policy version and the consent
+// banner's copy.
+type ConsentConfig struct {
+	// PolicyVersion is recorded on consents; raising it asks everyone for
+	// consent again
+	PolicyVersion    string
+	BannerTitle      string
+	BannerText       string
+	PrivacyPolicyURL string
+	CookiePolicyURL  string
+}
+
+// ComplianceConfig holds what enterprise customers review in procurement.
+type ComplianceConfig struct {
+	// DPAVersion is the data processing agreement organizations accept;
+	// raising it asks them to accept again
+	DPAVersion string
+	DPAURL     string
+	// SubProcessorsFile is a JSON array of sub-processors, such as the
+	// hosting provider, listed before those the configuration implies
+	SubProcessorsFile string
 }
 
 // Load reads configuration from environment variables.
@@ -66,28 +415,218 @@ func Load() (*Config, error) {
 			MaxOpenConns:    getEnvInt("DB_MAX_OPEN_CONNS", 25),
 			MaxIdleConns:    getEnvInt("DB_MAX_IDLE_CONNS", 5),
 			ConnMaxLifetime: getEnvDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute),
+			ConnMaxIdleTime: getEnvDuration("DB_CONN_MAX_IDLE_TIME", time.Minute),
+
+			RepositoryDriver: getEnv("DB_REPOSITORY_DRIVER", "gorm"),
+			RowLevelSecurity: getEnvBool("DB_ROW_LEVEL_SECURITY", false),
 		},
 		Server: ServerConfig{
 			Port:         getEnvInt("PORT", 8080),
 			ReadTimeout:  getEnvDuration("SERVER_READ_TIMEOUT", 10*time.Second),
 			WriteTimeout: getEnvDuration("SERVER_WRITE_TIMEOUT", 10*time.Second),
+			PublicURL:    getEnv("PUBLIC_URL", ""),
+
+			ExtensionOrigins: getEnvList("EXTENSION_ORIGINS"),
+
+			CalculatorRateLimit: getEnvInt("CALCULATOR_RATE_LIMIT", 30),
 		},
 		Cache: CacheConfig{
 			Addr:     getEnv("CACHE_ADDR", "localhost:6379"),
 			Password: getEnv("CACHE_PASSWORD", ""),
 			DB:       getEnvInt("CACHE_DB", 0),
 			TTL:      getEnvDuration("CACHE_TTL", 5*time.Minute),
+
+			LocalSize: getEnvInt("CACHE_LOCAL_SIZE", 10000),
+			LocalTTL:  getEnvDuration("CACHE_LOCAL_TTL", 5*time.Second),
+
+			BreakerThreshold: getEnvInt("CACHE_BREAKER_THRESHOLD", 5),
+			BreakerCooldown:  getEnvDuration("CACHE_BREAKER_COOLDOWN", 10*time.Second),
+
+			TTLs: loadCacheTTLs(),
 		},
 		Auth: AuthConfig{
 			JWTSecret:     getEnv("JWT_SECRET", "change-me-in-production"),
 			JWTIssuer:     getEnv("JWT_ISSUER", "meeting-cost"),
 			AccessExpiry:  getEnvDuration("JWT_ACCESS_EXPIRY", 15*time.Minute),
 			RefreshExpiry: getEnvDuration("JWT_REFRESH_EXPIRY", 7*24*time.Hour),
+			AdminToken:    getEnv("ADMIN_API_TOKEN", ""),
+
+			IntrospectionToken: getEnv("AUTH_INTROSPECTION_TOKEN", ""),
+
+			AccountDeletionGrace: getEnvDuration("ACCOUNT_DELETION_GRACE", 30*24*time.Hour),
+
+			TenantGuard: getEnvBool("TENANT_GUARD", true),
+
+			MembershipClaimsExpiry: getEnvDuration("JWT_MEMBERSHIP_CLAIMS_EXPIRY", 0),
+
+			SessionStore:            getEnv("SESSION_STORE", SessionStoreDatabase),
+			SessionDatabaseFallback: getEnvBool("SESSION_DATABASE_FALLBACK", false),
+		},
+		Purge: PurgeConfig{
+			Retention: getEnvDuration("PURGE_RETENTION", 30*24*time.Hour),
+			Interval:  getEnvDuration("PURGE_INTERVAL", 24*time.Hour),
+		},
+		Partitions: PartitionConfig{
+			Ahead:     getEnvInt("INCREMENT_PARTITIONS_AHEAD", 3),
+			Retention: getEnvDuration("INCREMENT_RETENTION", 0),
+			Schedule:  getEnv("PARTITION_SCHEDULE", "@every 24h"),
+		},
+		Archive: ArchiveConfig{
+			After:    getEnvDuration("MEETING_ARCHIVE_AFTER", 0),
+			Schedule: getEnv("ARCHIVE_SCHEDULE", "@every 24h"),
+		},
+		Queue: QueueConfig{
+			Concurrency: getEnvInt("QUEUE_CONCURRENCY", 10),
+			Lease:       getEnvDuration("QUEUE_LEASE", 30*time.Minute),
+			MetricsPort: getEnvInt("WORKER_METRICS_PORT", 9091),
+
+			SessionCleanupSchedule: getEnv("SESSION_CLEANUP_SCHEDULE", "@every 1h"),
+			DigestSchedule:         getEnv("DIGEST_SCHEDULE", "0 8 * * 1"),
+			CostAlertSchedule:      getEnv("COST_ALERT_SCHEDULE", "@every 1m"),
+			OverrunSchedule:        getEnv("OVERRUN_CHECK_SCHEDULE", "@every 1m"),
+			UsageReportSchedule:    getEnv("USAGE_REPORT_SCHEDULE", "@every 1h"),
+			TrialSchedule:          getEnv("TRIAL_CHECK_SCHEDULE", "@every 1h"),
+
+			ReportExportPurgeSchedule: getEnv("REPORT_EXPORT_PURGE_SCHEDULE", "@every 1h"),
+			APIUsagePurgeSchedule:     getEnv("API_USAGE_PURGE_SCHEDULE", "@every 24h"),
+			ReportRollupSchedule:      getEnv("REPORT_ROLLUP_SCHEDULE", "@every 5m"),
+			AccountDeletionSchedule:   getEnv("ACCOUNT_DELETION_SCHEDULE", "@every 1h"),
+			CompactionSchedule:        getEnv("INCREMENT_COMPACTION_SCHEDULE", "@every 1h"),
+
+			WageSyncSchedule: getEnv("WAGE_SYNC_SCHEDULE", "@every 24h"),
+			WageSyncTimeout:  getEnvDuration("WAGE_SYNC_TIMEOUT", time.Minute),
+
+			WarehouseExportSchedule: getEnv("WAREHOUSE_EXPORT_SCHEDULE", "@every 1h"),
+			WarehouseExportTimeout:  getEnvDuration("WAREHOUSE_EXPORT_TIMEOUT", 10*time.Minute),
+
+			CostTickInterval: getEnvDuration("COST_TICK_INTERVAL", 5*time.Second),
 		},
+		Webhook: WebhookConfig{
+			MaxAttempts: getEnvInt("WEBHOOK_MAX_ATTEMPTS", 10),
+			Timeout:     getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+
+			AllowPrivateNetworks: getEnvBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS", false),
+		},
+		Email: EmailConfig{
+			Driver:  getEnv("EMAIL_DRIVER", "log"),
+			From:    getEnv("EMAIL_FROM", "Meeting Cost <no-reply@meetingcost.local>"),
+			Timeout: getEnvDuration("EMAIL_TIMEOUT", 10*time.Second),
+
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnvInt("SMTP_PORT", 587),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+
+			SESRegion:          getEnv("AWS_REGION", ""),
+			SESAccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
+			SESSecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
+			SESSessionToken:    getEnv("AWS_SESSION_TOKEN", ""),
+
+			SendGridAPIKey: getEnv("SENDGRID_API_KEY", ""),
+		},
+		Notify: NotifyConfig{
+			SlackBotToken:          getEnv("SLACK_BOT_TOKEN", ""),
+			SlackMilestoneThrottle: getEnvDuration("SLACK_MILESTONE_THROTTLE", 10*time.Minute),
+			VAPIDPublicKey:         getEnv("VAPID_PUBLIC_KEY", ""),
+			VAPIDPrivateKey:        getEnv("VAPID_PRIVATE_KEY", ""),
+			VAPIDSubject:           getEnv("VAPID_SUBJECT", "mailto:admin@meetingcost.local"),
+			Timeout:                getEnvDuration("NOTIFY_TIMEOUT", 10*time.Second),
+		},
+		Billing: BillingConfig{
+			StripeSecretKey:     getEnv("STRIPE_SECRET_KEY", ""),
+			StripeWebhookSecret: getEnv("STRIPE_WEBHOOK_SECRET", ""),
+			Prices: map[string]string{
+				models.PlanBasic:      getEnv("STRIPE_PRICE_BASIC", ""),
+				models.PlanPremium:    getEnv("STRIPE_PRICE_PREMIUM", ""),
+				models.PlanEnterprise: getEnv("STRIPE_PRICE_ENTERPRISE", ""),
+			},
+			MeteredPrices:       getEnvList("STRIPE_METERED_PRICES"),
+			MeterMeetingMinutes: getEnv("STRIPE_METER_MEETING_MINUTES", ""),
+			MeterActiveMembers:  getEnv("STRIPE_METER_ACTIVE_MEMBERS", ""),
+			SeatPlans:           getEnvList("BILLING_SEAT_PLANS"),
+			Timeout:             getEnvDuration("STRIPE_TIMEOUT", 10*time.Second),
+
+			TrialDays:         getEnvInt("BILLING_TRIAL_DAYS", 14),
+			TrialPlan:         getEnv("BILLING_TRIAL_PLAN", models.PlanPremium),
+			TrialReminderDays: getEnvInt("BILLING_TRIAL_REMINDER_DAYS", 3),
+		},
+		Reports: ReportsConfig{
+			ExportTTL: getEnvDuration("REPORT_EXPORT_TTL", 7*24*time.Hour),
+		},
+		Consent: ConsentConfig{
+			PolicyVersion:    getEnv("CONSENT_POLICY_VERSION", "1.0.0"),
+			BannerTitle:      getEnv("CONSENT_BANNER_TITLE", "We use cookies"),
+			BannerText:       getEnv("CONSENT_BANNER_TEXT", "We use necessary cookies to run the site and, with your consent, others to remember your preferences, understand usage and measure marketing."),
+			PrivacyPolicyURL: getEnv("CONSENT_PRIVACY_POLICY_URL", ""),
+			CookiePolicyURL:  getEnv("CONSENT_COOKIE_POLICY_URL", ""),
+		},
+		Compliance: ComplianceConfig{
+			DPAVersion:        getEnv("DPA_VERSION", "1.0"),
+			DPAURL:            getEnv("DPA_URL", ""),
+			SubProcessorsFile: getEnv("SUBPROCESSORS_FILE", ""),
+		},
+		Encryption: EncryptionConfig{
+			Keys:  getEnv("ENCRYPTION_KEYS", ""),
+			Wages: getEnv("ENCRYPTION_WAGES", ""),
+		},
+	}
+	defaultRedaction := "verbose"
+	if cfg.Env == "production" {
+		defaultRedaction = "full"
+	}
+	cfg.Log.Redaction = getEnv("LOG_REDACTION", defaultRedaction)
+	defaultLevel := "debug"
+	if cfg.Env == "production" {
+		defaultLevel = "info"
+	}
+	cfg.Log.Level = getEnv("LOG_LEVEL", defaultLevel)
+	cfg.Audit.TrailGroups = getEnvList("AUDIT_TRAIL_GROUPS")
+	cfg.Audit.BufferSize = getEnvInt("AUDIT_BUFFER_SIZE", 1000)
+	cfg.Audit.FlushInterval = getEnvDuration("AUDIT_FLUSH_INTERVAL", time.Second)
+	cfg.APIUsage.FlushInterval = getEnvDuration("API_USAGE_FLUSH_INTERVAL", time.Minute)
+	cfg.APIUsage.Retention = getEnvDuration("API_USAGE_RETENTION", 90*24*time.Hour)
+	// Secrets managers mount the keys as a file rather than expose them in
+	// the environment
+	if path := os.Getenv("ENCRYPTION_KEYS_FILE"); path != "" && cfg.Encryption.Keys == "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading ENCRYPTION_KEYS_FILE: %w", err)
+		}
+		cfg.Encryption.Keys = strings.TrimSpace(string(b))
+	}
+
+	if v := os.Getenv("API_V1_SUNSET"); v != "" {
+		sunset, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return nil, fmt.Errorf("API_V1_SUNSET must be a date (YYYY-MM-DD): %w", err)
+		}
+		cfg.Server.V1Sunset = sunset
+	}
+	if cfg.Server.PublicURL == "" {
+		cfg.Server.PublicURL = fmt.Sprintf("http://localhost:%d", cfg.Server.Port)
 	}
 	return cfg, nil
 }
 
+// loadCacheTTLs applies CACHE_TTL_<ENTITY> overrides to the default
+// per-entity cache expirations.
+func loadCacheTTLs() cache.TTLs {
+	d := cache.DefaultTTLs()
+	return cache.TTLs{
+		Person:        getEnvDuration("CACHE_TTL_PERSON", d.Person),
+		Organization:  getEnvDuration("CACHE_TTL_ORGANIZATION", d.Organization),
+		Profile:       getEnvDuration("CACHE_TTL_PROFILE", d.Profile),
+		Meeting:       getEnvDuration("CACHE_TTL_MEETING", d.Meeting),
+		Increment:     getEnvDuration("CACHE_TTL_INCREMENT", d.Increment),
+		IncrementList: getEnvDuration("CACHE_TTL_INCREMENT_LIST", d.IncrementList),
+		AuthMethod:    getEnvDuration("CACHE_TTL_AUTH_METHOD", d.AuthMethod),
+		Role:          getEnvDuration("CACHE_TTL_ROLE", d.Role),
+		Permission:    getEnvDuration("CACHE_TTL_PERMISSION", d.Permission),
+		HasPermission: getEnvDuration("CACHE_TTL_HAS_PERMISSION", d.HasPermission),
+		Consent:       getEnvDuration("CACHE_TTL_CONSENT", d.Consent),
+	}
+}
+
 // Validate checks required configuration. Returns an error if invalid.
 func (c *Config) Validate() error {
 	if c.Database.Host == "" {
@@ -96,13 +635,142 @@ func (c *Config) Validate() error {
 	if c.Database.DBName == "" {
 		return fmt.Errorf("DB_NAME is required")
 	}
+	if c.Database.MaxOpenConns < 1 {
+		return fmt.Errorf("DB_MAX_OPEN_CONNS must be at least 1")
+	}
+	if c.Database.MaxIdleConns < 0 || c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		return fmt.Errorf("DB_MAX_IDLE_CONNS must be between 0 and DB_MAX_OPEN_CONNS")
+	}
+	if c.Purge.Retention <= 0 {
+		return fmt.Errorf("PURGE_RETENTION must be positive")
+	}
+	if c.Partitions.Ahead < 1 {
+		return fmt.Errorf("INCREMENT_PARTITIONS_AHEAD must be at least 1")
+	}
+	if c.Partitions.Retention != 0 && c.Partitions.Retention < 31*24*time.Hour {
+		return fmt.Errorf("INCREMENT_RETENTION must be 0 or at least 31 days")
+	}
+	if c.Archive.After < 0 {
+		return fmt.Errorf("MEETING_ARCHIVE_AFTER must not be negative")
+	}
+	if c.Audit.BufferSize < 0 {
+		return fmt.Errorf("AUDIT_BUFFER_SIZE must not be negative")
+	}
+	if c.Audit.BufferSize > 0 && c.Audit.FlushInterval <= 0 {
+		return fmt.Errorf("AUDIT_FLUSH_INTERVAL must be positive")
+	}
+	if c.APIUsage.FlushInterval <= 0 {
+		return fmt.Errorf("API_USAGE_FLUSH_INTERVAL must be positive")
+	}
+	if c.APIUsage.Retention < 24*time.Hour {
+		return fmt.Errorf("API_USAGE_RETENTION must be at least 24h")
+	}
+	if c.Queue.CostTickInterval != 0 && c.Queue.CostTickInterval < time.Second {
+		return fmt.Errorf("COST_TICK_INTERVAL must be at least 1s, or 0 to disable it")
+	}
+	if c.Queue.WageSyncTimeout <= 0 {
+		return fmt.Errorf("WAGE_SYNC_TIMEOUT must be positive")
+	}
+	if c.Queue.WarehouseExportTimeout <= 0 {
+		return fmt.Errorf("WAREHOUSE_EXPORT_TIMEOUT must be positive")
+	}
+	for _, origin := range c.Server.ExtensionOrigins {
+		if !strings.Contains(origin, "://") {
+			return fmt.Errorf("EXTENSION_ORIGINS: %q is not an origin, such as chrome-extension://<id>", origin)
+		}
+	}
+	if c.Server.CalculatorRateLimit < 1 {
+		return fmt.Errorf("CALCULATOR_RATE_LIMIT must be at least 1")
+	}
+	if c.Notify.SlackMilestoneThrottle < 0 {
+		return fmt.Errorf("SLACK_MILESTONE_THROTTLE must not be negative")
+	}
+	if c.Webhook.MaxAttempts < 1 {
+		return fmt.Errorf("WEBHOOK_MAX_ATTEMPTS must be at least 1")
+	}
+	switch c.Email.Driver {
+	case "log", "smtp":
+	case "ses":
+		if c.Email.SESRegion == "" || c.Email.SESAccessKeyID == "" || c.Email.SESSecretAccessKey == "" {
+			return fmt.Errorf("EMAIL_DRIVER=ses requires AWS_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+		}
+	case "sendgrid":
+		if c.Email.SendGridAPIKey == "" {
+			return fmt.Errorf("EMAIL_DRIVER=sendgrid requires SENDGRID_API_KEY")
+		}
+	default:
+		return fmt.Errorf("EMAIL_DRIVER must be log, smtp, ses or sendgrid, got %q", c.Email.Driver)
+	}
+	if c.Billing.StripeSecretKey != "" && c.Billing.StripeWebhookSecret == "" {
+		return fmt.Errorf("STRIPE_SECRET_KEY requires STRIPE_WEBHOOK_SECRET")
+	}
+	if c.Billing.TrialDays < 0 || c.Billing.TrialReminderDays < 0 {
+		return fmt.Errorf("BILLING_TRIAL_DAYS and BILLING_TRIAL_REMINDER_DAYS must not be negative")
+	}
+	for _, plan := range c.Billing.SeatPlans {
+		switch plan {
+		case models.PlanBasic, models.PlanPremium, models.PlanEnterprise:
+		default:
+			return fmt.Errorf("BILLING_SEAT_PLANS may list basic, premium and enterprise, got %q", plan)
+		}
+	}
+	switch c.Billing.TrialPlan {
+	case models.PlanBasic, models.PlanPremium, models.PlanEnterprise:
+	default:
+		return fmt.Errorf("BILLING_TRIAL_PLAN must be basic, premium or enterprise, got %q", c.Billing.TrialPlan)
+	}
+	switch c.Database.RepositoryDriver {
+	case "gorm", "pgx", "memory":
+	default:
+		return fmt.Errorf("DB_REPOSITORY_DRIVER must be gorm, pgx or memory, got %q", c.Database.RepositoryDriver)
+	}
+	// Only GORM repositories run on scoped connections
+	if c.Database.RowLevelSecurity && c.Database.RepositoryDriver != "gorm" {
+		return fmt.Errorf("DB_ROW_LEVEL_SECURITY needs DB_REPOSITORY_DRIVER=gorm")
+	}
+	switch c.Auth.SessionStore {
+	case SessionStoreDatabase, SessionStoreRedis:
+	default:
+		return fmt.Errorf("SESSION_STORE must be database or redis, got %q", c.Auth.SessionStore)
+	}
+	if c.Encryption.Keys != "" {
+		if _, err := encryption.ParseKeys(c.Encryption.Keys); err != nil {
+			return fmt.Errorf("ENCRYPTION_KEYS: %w", err)
+		}
+	}
+	switch c.Log.Redaction {
+	case "full", "verbose":
+	default:
+		return fmt.Errorf("LOG_REDACTION must be full or verbose, got %q", c.Log.Redaction)
+	}
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		return fmt.Errorf("LOG_LEVEL must be debug, info, warn or error, got %q", c.Log.Level)
+	}
+	switch c.Encryption.Wages {
+	case encryption.WageScopeNone:
+	case encryption.WageScopeDeployment, encryption.WageScopeOrganization:
+		if c.Encryption.Keys == "" {
+			return fmt.Errorf("ENCRYPTION_WAGES needs ENCRYPTION_KEYS")
+		}
+	default:
+		return fmt.Errorf("ENCRYPTION_WAGES must be deployment or organization, got %q", c.Encryption.Wages)
+	}
 	return nil
 }
 
-// DSN returns the PostgreSQL connection string.
+// Demo reports whether the API runs on in-memory repositories, cache and
+// pubsub instead of Postgres and Redis.
+func (c *Config) Demo() bool {
+	return c.Database.RepositoryDriver == "memory"
+}
+
+// DSN returns the PostgreSQL connection string. Sessions run in UTC, so
+// times come back in UTC whatever the server's zone.
 func (d *DatabaseConfig) DSN() string {
 	return fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s TimeZone=UTC",
 		d.Host, d.Port, d.User, d.Password, d.DBName, d.SSLMode,
 	)
 }
@@ -123,6 +791,26 @@ func getEnvInt(key string, defaultVal int) int {
 	return defaultVal
 }
 
+// getEnvList splits a comma-separated variable, dropping empty entries.
+func getEnvList(key string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func getEnvBool(key string, defaultVal bool) bool {
+	if v := os.Getenv(key); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return defaultVal
+}
+
 func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
 	if v := os.Getenv(key); v != "" {
 		if d, err := time.ParseDuration(v); err == nil {
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -14,6 +17,8 @@ func NewDB(cfg *DatabaseConfig) (*gorm.DB, error) {
 	dsn := cfg.DSN()
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
+		// Stamp created and updated times in UTC, as they are stored
+		NowFunc: func() time.Time { return time.Now().UTC() },
 	})
 	if err != nil {
 		return nil, fmt.Errorf("connecting to database: %w", err)
@@ -26,16 +31,42 @@ func NewDB(cfg *DatabaseConfig) (*gorm.DB, error) {
 	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
 	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
 	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
 
 	return db, nil
 }
 
+// NewPgxPool creates a pgx connection pool for the pgx repositories, sized
+// like the GORM pool.
+func NewPgxPool(ctx context.Context, cfg *DatabaseConfig) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(cfg.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("parsing pgx pool config: %w", err)
+	}
+	poolCfg.MaxConns = int32(cfg.MaxOpenConns)
+	poolCfg.MinConns = int32(cfg.MaxIdleConns)
+	poolCfg.MaxConnLifetime = cfg.ConnMaxLifetime
+	poolCfg.MaxConnIdleTime = cfg.ConnMaxIdleTime
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, fmt.Errorf("connecting pgx pool: %w", err)
+	}
+	return pool, nil
+}
+
 // AutoMigrate runs GORM AutoMigrate for all models (development only).
 // Production should use versioned SQL migrations.
 func AutoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&models.Person{},
 		&models.Organization{},
+		&models.WageBand{},
+		&models.Holiday{},
+		&models.AccountDeletion{},
+		&models.PersonEmail{},
+		&models.ExtensionToken{},
+		&models.SyncOperation{},
 		&models.PersonOrganizationProfile{},
 		&models.Role{},
 		&models.RoleAssignment{},
@@ -47,7 +78,27 @@ func AutoMigrate(db *gorm.DB) error {
 		&models.Meeting{},
 		&models.Increment{},
 		&models.MeetingParticipant{},
+		&models.MeetingRating{},
 		&models.AuditLog{},
 		&models.CookieConsent{},
+		&models.WebhookEndpoint{},
+		&models.WebhookDelivery{},
+		&models.Integration{},
+		&models.WarehouseExport{},
+		&models.ConsentCategory{},
+		&models.DPAAcceptance{},
+		&models.MeetingArchive{},
+		&models.MeetingArchiveDay{},
+		&models.EmailDelivery{},
+		&models.NotificationPreference{},
+		&models.PushSubscription{},
+		&models.CostAlert{},
+		&models.CostAlertTrigger{},
+		&models.CostMilestone{},
+		&models.UsageRecord{},
+		&models.APIUsage{},
+		&models.Invoice{},
+		&models.ReportExport{},
+		&models.ReportDailyCost{},
 	)
 }
@@ -2,52 +2,163 @@ package container
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/yourorg/meeting-cost/backend/go/internal/auth"
+	"github.com/yourorg/meeting-cost/backend/go/internal/billing"
 	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/circuit"
 	"github.com/yourorg/meeting-cost/backend/go/internal/config"
+	"github.com/yourorg/meeting-cost/backend/go/internal/email"
+	"github.com/yourorg/meeting-cost/backend/go/internal/encryption"
 	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/metrics"
+	"github.com/yourorg/meeting-cost/backend/go/internal/notify"
 	"github.com/yourorg/meeting-cost/backend/go/internal/pubsub"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
 	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository/claims"
 	"github.com/yourorg/meeting-cost/backend/go/internal/repository/gorm"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository/memory"
+	pgxrepo "github.com/yourorg/meeting-cost/backend/go/internal/repository/pgx"
+	redisrepo "github.com/yourorg/meeting-cost/backend/go/internal/repository/redis"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository/requester"
+	"github.com/yourorg/meeting-cost/backend/go/internal/rls"
 	"github.com/yourorg/meeting-cost/backend/go/internal/service"
 	"github.com/yourorg/meeting-cost/backend/go/internal/service/impl"
+	"github.com/yourorg/meeting-cost/backend/go/internal/webhook"
 	gormio "gorm.io/gorm"
 )
 
 // Container manages application dependencies.
 type Container struct {
 	DB     *gormio.DB
+	Pool   *pgxpool.Pool
+	Store  *memory.Store
 	Cache  cache.Cache
 	PubSub pubsub.PubSub
 	Logger logger.Logger
 
+	// QueueBroker holds background tasks; Queue enqueues onto it
+	QueueBroker queue.Broker
+	Queue       *queue.Client
+
+	// Metrics collects Prometheus metrics served on /metrics
+	Metrics *prometheus.Registry
+
+	// Requesters creates the per-request Requester the requester middleware
+	// puts in the context
+	Requesters *requester.Loader
+
+	// CacheBreaker trips when Redis is unavailable; its state is the cache
+	// health signal.
+	CacheBreaker *circuit.Breaker
+
 	// Repositories
-	PersonRepo     repository.PersonRepository
-	OrgRepo        repository.OrganizationRepository
-	ProfileRepo    repository.PersonOrganizationProfileRepository
-	MeetingRepo    repository.MeetingRepository
-	IncrementRepo  repository.IncrementRepository
-	AuthRepo       repository.AuthRepository
-	PermissionRepo repository.PermissionRepository
-	ConsentRepo    repository.ConsentRepository
-	AuditLogRepo   repository.AuditLogRepository
+	PersonRepo          repository.PersonRepository
+	OrgRepo             repository.OrganizationRepository
+	ProfileRepo         repository.PersonOrganizationProfileRepository
+	MeetingRepo         repository.MeetingRepository
+	IncrementRepo       repository.IncrementRepository
+	AuthRepo            repository.AuthRepository
+	PermissionRepo      repository.PermissionRepository
+	ConsentRepo         repository.ConsentRepository
+	ConsentCategoryRepo repository.ConsentCategoryRepository
+	AuditLogRepo        repository.AuditLogRepository
+	PurgeRepo           repository.PurgeRepository
+	PartitionRepo       repository.PartitionRepository
+	ArchiveRepo         repository.ArchiveRepository
+	EncryptionRepo      repository.EncryptionRepository
+	WebhookRepo         repository.WebhookRepository
+	IntegrationRepo     repository.IntegrationRepository
+	WarehouseExportRepo repository.WarehouseExportRepository
+	DPARepo             repository.DPARepository
+	EmailRepo           repository.EmailDeliveryRepository
+	NotifyRepo          repository.NotificationRepository
+	CostAlertRepo       repository.CostAlertRepository
+	SubscriptionRepo    repository.SubscriptionRepository
+	UsageRepo           repository.UsageRepository
+	APIUsageRepo        repository.APIUsageRepository
+	ReportRepo          repository.ReportRepository
+	ReportExportRepo    repository.ReportExportRepository
+	RatingRepo          repository.MeetingRatingRepository
+	WageBandRepo        repository.WageBandRepository
+	HolidayRepo         repository.HolidayRepository
+	DeletionRepo        repository.AccountDeletionRepository
+	PersonEmailRepo     repository.PersonEmailRepository
+	ExtensionTokenRepo  repository.ExtensionTokenRepository
+	SyncOperationRepo   repository.SyncOperationRepository
 
 	// Services
-	AuthService     service.AuthService
-	PersonService   service.PersonService
-	OrgService      service.OrganizationService
-	MeetingService  service.MeetingService
-	ConsentService  service.ConsentService
-	AuditLogService service.AuditLogService
+	AuthService         service.AuthService
+	PersonService       service.PersonService
+	OrgService          service.OrganizationService
+	MeetingService      service.MeetingService
+	ConsentService      service.ConsentService
+	AuditLogService     service.AuditLogService
+	WebhookService      service.WebhookService
+	IntegrationService  service.IntegrationService
+	DPAService          service.DPAService
+	EmailService        service.EmailService
+	NotifyService       service.NotificationService
+	DigestService       service.DigestService
+	CostAlertService    service.CostAlertService
+	SubscriptionService service.SubscriptionService
+	EntitlementService  service.EntitlementService
+	UsageService        service.UsageService
+	APIUsageService     service.APIUsageService
+	ReportService       service.ReportService
+	ReportExportService service.ReportExportService
+	SurveyService       service.SurveyService
+	DeletionService     service.AccountDeletionService
+	PersonEmailService  service.PersonEmailService
+	ArchiveService      service.ArchiveService
+	ExtensionService    service.ExtensionService
+	CalculatorService   service.CalculatorService
+	WageSyncService     service.WageSyncService
+	WarehouseService    service.WarehouseExportService
+
+	MaintenanceService service.MaintenanceService
+	LogLevelService    service.LogLevelService
 }
 
+// auditFlushTimeout bounds how long Close waits for buffered audit log
+// entries, and then for the API request counts held, to be written.
+const auditFlushTimeout = 15 * time.Second
+
 // NewContainer initializes all dependencies.
-func NewContainer(ctx context.Context, cfg *config.Config, db *gormio.DB, cacheClient cache.Cache, log logger.Logger) (*Container, error) {
+func NewContainer(ctx context.Context, cfg *config.Config, db *gormio.DB, cacheClient cache.Cache, log logger.Logger, level *logger.Level) (*Container, error) {
 	c := &Container{
-		DB:     db,
-		Cache:  cacheClient,
-		Logger: log,
+		DB:      db,
+		Cache:   cacheClient,
+		Logger:  log,
+		Metrics: metrics.NewRegistry(),
+	}
+
+	// Export connection pool stats so exhaustion is visible
+	if db != nil {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, fmt.Errorf("getting underlying sql.DB: %w", err)
+		}
+		metrics.RegisterSQLDB(c.Metrics, cfg.Database.DBName, sqlDB)
+	}
+
+	// Encrypt tokens and secrets, and optionally wages, at rest when keys
+	// are configured
+	if cfg.Encryption.Keys != "" {
+		keyring, err := encryption.ParseKeys(cfg.Encryption.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("loading encryption keys: %w", err)
+		}
+		encryption.Use(keyring)
+		encryption.UseWageScope(cfg.Encryption.Wages)
 	}
 
 	// Initialize Auth components
@@ -56,33 +167,325 @@ func NewContainer(ctx context.Context, cfg *config.Config, db *gormio.DB, cacheC
 		cfg.Auth.JWTIssuer,
 		cfg.Auth.AccessExpiry,
 		cfg.Auth.RefreshExpiry,
+		cfg.Auth.MembershipClaimsExpiry,
 	)
 
+	// Fail fast to the database and drop events while Redis is unavailable
+	c.CacheBreaker = circuit.New(cfg.Cache.BreakerThreshold, cfg.Cache.BreakerCooldown)
+	c.CacheBreaker.OnStateChange(func(from, to circuit.State) {
+		log.Warn("cache circuit state changed", "from", from.String(), "to", to.String())
+	})
+	c.Metrics.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "cache",
+		Name:      "circuit_state",
+		Help:      "Cache circuit breaker state (0 closed, 1 open, 2 half-open).",
+	}, func() float64 {
+		return float64(c.CacheBreaker.State())
+	}))
+	cacheClient = cache.NewBreakerCache(cacheClient, c.CacheBreaker)
+
+	// Initialize PubSub
+	if cfg.Demo() {
+		c.PubSub = pubsub.NewMemoryPubSub()
+		c.QueueBroker = queue.NewMemoryBroker()
+	} else {
+		c.PubSub = pubsub.NewBreakerPubSub(pubsub.NewRedisPubSub(cacheClient.GetClient()), c.CacheBreaker, c.Metrics)
+		c.QueueBroker = queue.NewRedisBroker(cacheClient.GetClient(), "default")
+	}
+	c.Queue = queue.NewClient(c.QueueBroker)
+
+	// Put an in-process tier in front of Redis for hot reads
+	if cfg.Cache.LocalSize > 0 && !cfg.Demo() {
+		cacheClient = cache.NewTieredCache(ctx, cacheClient, c.PubSub, cache.TieredOptions{
+			Size:     cfg.Cache.LocalSize,
+			TTL:      cfg.Cache.LocalTTL,
+			Prefixes: cache.DefaultLocalPrefixes,
+		})
+	}
+
+	// Count hits and misses across both tiers
+	cacheClient = cache.NewInstrumentedCache(cacheClient, c.Metrics)
+	c.Cache = cacheClient
+
+	// Run requests' statements on connections scoped for the row-level
+	// security policies when configured. Cached meetings and increments
+	// would slip past the policies, so they are always read from the
+	// database.
+	meetingCache := cacheClient
+	if cfg.Database.RowLevelSecurity {
+		if err := rls.Install(db); err != nil {
+			return nil, fmt.Errorf("installing row-level security: %w", err)
+		}
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, fmt.Errorf("getting underlying sql.DB: %w", err)
+		}
+		if err := rls.Verify(ctx, sqlDB); err != nil {
+			return nil, err
+		}
+		meetingCache = cache.NewNopCache()
+	}
+
 	// Initialize repositories
-	c.PersonRepo = gorm.NewPersonRepository(db, cacheClient)
-	c.OrgRepo = gorm.NewOrganizationRepository(db, cacheClient)
-	c.ProfileRepo = gorm.NewPersonOrganizationProfileRepository(db, cacheClient)
-	c.MeetingRepo = gorm.NewMeetingRepository(db, cacheClient)
-	c.IncrementRepo = gorm.NewIncrementRepository(db, cacheClient)
-	c.AuthRepo = gorm.NewAuthRepository(db, cacheClient)
-	c.PermissionRepo = gorm.NewPermissionRepository(db, cacheClient)
-	c.ConsentRepo = gorm.NewConsentRepository(db, cacheClient)
+	c.PersonRepo = gorm.NewPersonRepository(db, cacheClient, cfg.Cache.TTLs)
+	c.OrgRepo = gorm.NewOrganizationRepository(db, cacheClient, cfg.Cache.TTLs)
+	c.ProfileRepo = gorm.NewPersonOrganizationProfileRepository(db, cacheClient, cfg.Cache.TTLs)
+	c.MeetingRepo = gorm.NewMeetingRepository(db, meetingCache, cfg.Cache.TTLs)
+	c.IncrementRepo = gorm.NewIncrementRepository(db, meetingCache, cfg.Cache.TTLs)
+	c.AuthRepo = gorm.NewAuthRepository(db, cacheClient, cfg.Cache.TTLs)
+	c.PermissionRepo = gorm.NewPermissionRepository(db, cacheClient, cfg.Cache.TTLs)
+	c.ConsentRepo = gorm.NewConsentRepository(db, cacheClient, cfg.Cache.TTLs)
+	c.ConsentCategoryRepo = gorm.NewConsentCategoryRepository(db)
 	c.AuditLogRepo = gorm.NewAuditLogRepository(db)
+	c.PurgeRepo = gorm.NewPurgeRepository(db)
+	c.PartitionRepo = gorm.NewPartitionRepository(db)
+	c.ArchiveRepo = gorm.NewArchiveRepository(db, meetingCache)
+	c.EncryptionRepo = gorm.NewEncryptionRepository(db)
+	c.WebhookRepo = gorm.NewWebhookRepository(db)
+	c.IntegrationRepo = gorm.NewIntegrationRepository(db)
+	c.WarehouseExportRepo = gorm.NewWarehouseExportRepository(db)
+	c.DPARepo = gorm.NewDPARepository(db)
+	c.EmailRepo = gorm.NewEmailDeliveryRepository(db)
+	c.NotifyRepo = gorm.NewNotificationRepository(db)
+	c.CostAlertRepo = gorm.NewCostAlertRepository(db)
+	c.SubscriptionRepo = gorm.NewSubscriptionRepository(db)
+	c.UsageRepo = gorm.NewUsageRepository(db)
+	c.APIUsageRepo = gorm.NewAPIUsageRepository(db)
+	c.ReportRepo = gorm.NewReportRepository(db)
+	c.ReportExportRepo = gorm.NewReportExportRepository(db)
+	c.RatingRepo = gorm.NewMeetingRatingRepository(db)
+	c.WageBandRepo = gorm.NewWageBandRepository(db)
+	c.HolidayRepo = gorm.NewHolidayRepository(db)
+	c.DeletionRepo = gorm.NewAccountDeletionRepository(db)
+	c.PersonEmailRepo = gorm.NewPersonEmailRepository(db)
+	c.ExtensionTokenRepo = gorm.NewExtensionTokenRepository(db)
+	c.SyncOperationRepo = gorm.NewSyncOperationRepository(db)
 
-	// Initialize PubSub
-	c.PubSub = pubsub.NewRedisPubSub(cacheClient.GetClient())
+	// Serve increments from sqlc queries when configured
+	switch cfg.Database.RepositoryDriver {
+	case "pgx":
+		pool, err := config.NewPgxPool(ctx, &cfg.Database)
+		if err != nil {
+			return nil, err
+		}
+		c.Pool = pool
+		metrics.RegisterPgxPool(c.Metrics, cfg.Database.DBName, pool)
+		c.IncrementRepo = pgxrepo.NewIncrementRepository(pool, cacheClient, cfg.Cache.TTLs)
+	case "memory":
+		c.useMemoryRepositories(memory.NewStore())
+	}
+
+	// Answer the signed-in person's own membership lookups from the
+	// Requester in the request context
+	c.Requesters = requester.NewLoader(c.ProfileRepo, c.PermissionRepo)
+	c.ProfileRepo = requester.NewProfileRepository(c.ProfileRepo)
+
+	// Keep sessions out of the database when configured
+	if cfg.Auth.SessionStore == config.SessionStoreRedis && !cfg.Demo() {
+		c.AuthRepo = redisrepo.NewAuthRepository(c.AuthRepo, cacheClient, cfg.Auth.SessionDatabaseFallback)
+	}
 
 	// Initialize services
-	c.AuditLogService = impl.NewAuditLogService(c.AuditLogRepo)
-	c.AuthService = impl.NewAuthService(c.PersonRepo, c.AuthRepo, tokenManager, c.AuditLogService, c.Logger)
-	c.ConsentService = impl.NewConsentService(c.ConsentRepo, c.AuditLogService)
+	c.AuditLogService = impl.NewAuditLogService(c.AuditLogRepo, service.AuditBuffer{
+		Size:          cfg.Audit.BufferSize,
+		FlushInterval: cfg.Audit.FlushInterval,
+	}, c.Logger)
+	// Answer permission checks from token claims when they carry them, and
+	// revoke the claims when memberships and roles change
+	revocations := auth.NewMembershipRevocations(cacheClient, cfg.Auth.MembershipClaimsExpiry)
+	if tokenManager.MembershipClaims() {
+		c.PermissionRepo = claims.NewPermissionRepository(c.PermissionRepo, revocations)
+		c.ProfileRepo = claims.NewProfileRepository(c.ProfileRepo, revocations)
+	}
+	c.AuthService = impl.NewAuthService(c.PersonRepo, c.AuthRepo, c.ProfileRepo, c.PermissionRepo, tokenManager, revocations, c.AuditLogService, c.Logger)
+	c.ConsentService = impl.NewConsentService(c.ConsentRepo, c.ConsentCategoryRepo, c.AuditLogService, service.ConsentPolicy{
+		Version:          cfg.Consent.PolicyVersion,
+		BannerTitle:      cfg.Consent.BannerTitle,
+		BannerText:       cfg.Consent.BannerText,
+		PrivacyPolicyURL: cfg.Consent.PrivacyPolicyURL,
+		CookiePolicyURL:  cfg.Consent.CookiePolicyURL,
+	})
+	c.EmailService = impl.NewEmailService(c.EmailRepo, newMailer(&cfg.Email, c.Logger), cfg.Email.From, c.Queue, c.Logger)
+
+	// Offer Slack and Web Push only when configured
+	var slack *notify.Slack
+	if cfg.Notify.SlackBotToken != "" {
+		slack = notify.NewSlack(cfg.Notify.SlackBotToken, cfg.Notify.Timeout)
+	}
+	var push *notify.WebPush
+	if cfg.Notify.VAPIDPrivateKey != "" {
+		var err error
+		push, err = notify.NewWebPush(cfg.Notify.VAPIDPublicKey, cfg.Notify.VAPIDPrivateKey, cfg.Notify.VAPIDSubject, cfg.Notify.Timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+	c.NotifyService = impl.NewNotificationService(
+		c.NotifyRepo,
+		c.PersonRepo,
+		c.EmailService,
+		slack,
+		push,
+		c.Queue,
+		cfg.Server.PublicURL,
+		cfg.Auth.JWTSecret,
+		c.Logger,
+	)
+	c.DigestService = impl.NewDigestService(
+		c.OrgRepo,
+		c.MeetingRepo,
+		c.ProfileRepo,
+		c.PersonRepo,
+		c.EmailService,
+		c.NotifyService,
+		c.Queue,
+		c.Logger,
+	)
+
+	// Plans change without payment unless Stripe is configured
+	var stripe *billing.Stripe
+	if cfg.Billing.StripeSecretKey != "" {
+		stripe = billing.NewStripe(cfg.Billing.StripeSecretKey, cfg.Billing.StripeWebhookSecret, cfg.Billing.Timeout)
+	}
+
+	c.EntitlementService = impl.NewEntitlementService(c.SubscriptionRepo, c.ProfileRepo, c.MeetingRepo)
+	c.UsageService = impl.NewUsageService(
+		c.UsageRepo,
+		c.SubscriptionRepo,
+		c.ProfileRepo,
+		stripe,
+		cfg.Billing.MeterMeetingMinutes,
+		cfg.Billing.MeterActiveMembers,
+		c.Logger,
+	)
+	c.APIUsageService = impl.NewAPIUsageService(
+		c.APIUsageRepo,
+		c.ExtensionTokenRepo,
+		c.PermissionRepo,
+		c.EntitlementService,
+		cfg.APIUsage.FlushInterval,
+		cfg.APIUsage.Retention,
+		c.Logger,
+	)
+
+	c.SubscriptionService = impl.NewSubscriptionService(
+		c.SubscriptionRepo,
+		c.OrgRepo,
+		c.ProfileRepo,
+		c.PermissionRepo,
+		c.PersonRepo,
+		c.AuditLogService,
+		c.NotifyService,
+		stripe,
+		cfg.Billing.Prices,
+		cfg.Billing.MeteredPrices,
+		cfg.Billing.SeatPlans,
+		service.TrialPolicy{
+			PlanType:     cfg.Billing.TrialPlan,
+			Length:       time.Duration(cfg.Billing.TrialDays) * 24 * time.Hour,
+			RemindBefore: time.Duration(cfg.Billing.TrialReminderDays) * 24 * time.Hour,
+		},
+		cfg.Server.PublicURL,
+		c.Logger,
+	)
+
+	c.ReportService = impl.NewReportService(c.ReportRepo, c.MeetingRepo, c.OrgRepo, c.PersonRepo, c.ProfileRepo, c.PermissionRepo, c.WageBandRepo, c.HolidayRepo, c.EntitlementService)
+	c.ReportExportService = impl.NewReportExportService(
+		c.ReportExportRepo,
+		c.OrgRepo,
+		c.ProfileRepo,
+		c.ReportService,
+		c.Queue,
+		cfg.Reports.ExportTTL,
+		cfg.Server.PublicURL,
+		cfg.Auth.JWTSecret,
+		c.Logger,
+	)
 
 	c.OrgService = impl.NewOrganizationService(
 		c.OrgRepo,
 		c.ProfileRepo,
 		c.PermissionRepo,
 		c.PersonRepo,
+		c.PersonEmailRepo,
+		c.WageBandRepo,
+		c.HolidayRepo,
 		c.AuditLogService,
+		c.EntitlementService,
+		c.SubscriptionService,
+		c.Logger,
+	)
+
+	c.WebhookService = impl.NewWebhookService(
+		c.WebhookRepo,
+		c.PermissionRepo,
+		c.AuditLogService,
+		c.EntitlementService,
+		c.Queue,
+		webhook.NewSender(cfg.Webhook.Timeout, cfg.Webhook.AllowPrivateNetworks),
+		cfg.Webhook.MaxAttempts,
+		c.Logger,
+	)
+	c.IntegrationService = impl.NewIntegrationService(c.IntegrationRepo, c.PermissionRepo, c.AuditLogService, c.EntitlementService)
+	c.WageSyncService = impl.NewWageSyncService(
+		c.IntegrationRepo,
+		c.OrgRepo,
+		c.ProfileRepo,
+		c.PersonRepo,
+		c.PersonEmailRepo,
+		c.WageBandRepo,
+		c.PermissionRepo,
+		c.AuditLogService,
+		c.Queue,
+		cfg.Queue.WageSyncTimeout,
+		c.Logger,
+	)
+	c.WarehouseService = impl.NewWarehouseExportService(
+		c.IntegrationRepo,
+		c.OrgRepo,
+		c.WarehouseExportRepo,
+		c.PermissionRepo,
+		c.AuditLogService,
+		c.Queue,
+		cfg.Queue.WarehouseExportTimeout,
+		c.Logger,
+	)
+
+	processors, err := subProcessors(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.DPAService = impl.NewDPAService(c.DPARepo, c.PermissionRepo, c.AuditLogService, service.DPAPolicy{
+		Version: cfg.Compliance.DPAVersion,
+		URL:     cfg.Compliance.DPAURL,
+	}, processors)
+
+	c.CostAlertService = impl.NewCostAlertService(
+		c.CostAlertRepo,
+		c.MeetingRepo,
+		c.ProfileRepo,
+		c.PermissionRepo,
+		c.IntegrationRepo,
+		c.NotifyService,
+		c.PubSub,
+		service.MilestonePolicy{
+			Throttle: cfg.Notify.SlackMilestoneThrottle,
+			Timeout:  cfg.Notify.Timeout,
+		},
+		c.Logger,
+	)
+
+	c.SurveyService = impl.NewSurveyService(
+		c.RatingRepo,
+		c.MeetingRepo,
+		c.OrgRepo,
+		c.ProfileRepo,
+		c.NotifyService,
+		c.Queue,
+		c.PubSub,
+		cfg.Server.PublicURL,
+		cfg.Auth.JWTSecret,
 		c.Logger,
 	)
 
@@ -92,17 +495,159 @@ func NewContainer(ctx context.Context, cfg *config.Config, db *gormio.DB, cacheC
 		c.OrgRepo,
 		c.ProfileRepo,
 		c.PermissionRepo,
+		c.SyncOperationRepo,
+		c.HolidayRepo,
 		c.AuditLogService,
+		c.WebhookService,
+		c.CostAlertService,
+		c.SurveyService,
+		c.NotifyService,
+		c.EntitlementService,
+		c.UsageService,
 		c.Cache,
 		c.PubSub,
+		cfg.Server.PublicURL,
+		cfg.Auth.JWTSecret,
+		c.Metrics,
 		c.Logger,
 	)
 
+	c.DeletionService = impl.NewAccountDeletionService(
+		c.DeletionRepo,
+		c.PersonRepo,
+		c.PersonEmailRepo,
+		c.ExtensionTokenRepo,
+		c.AuthRepo,
+		c.ProfileRepo,
+		c.PermissionRepo,
+		c.EmailService,
+		c.AuditLogService,
+		cfg.Auth.AccountDeletionGrace,
+		c.Logger,
+	)
+	c.PersonEmailService = impl.NewPersonEmailService(
+		c.PersonEmailRepo,
+		c.PersonRepo,
+		c.EmailService,
+		c.AuditLogService,
+		cfg.Server.PublicURL,
+		c.Logger,
+	)
+	c.ExtensionService = impl.NewExtensionService(
+		c.ExtensionTokenRepo,
+		c.ProfileRepo,
+		c.MeetingRepo,
+		c.MeetingService,
+		c.AuditLogService,
+		c.Logger,
+	)
+	c.CalculatorService = impl.NewCalculatorService()
+	c.ArchiveService = impl.NewArchiveService(c.ArchiveRepo, c.PermissionRepo, c.MeetingService, c.AuditLogService, c.Logger)
+	c.MaintenanceService = impl.NewMaintenanceService(c.PurgeRepo, c.PartitionRepo, c.MeetingRepo, c.EncryptionRepo, c.AuthRepo, c.AuditLogService, c.Queue, c.Logger)
+	c.LogLevelService = impl.NewLogLevelService(ctx, level, c.PubSub, c.AuditLogService, c.Logger)
+
 	return c, nil
 }
 
+// useMemoryRepositories replaces every repository with one backed by store.
+func (c *Container) useMemoryRepositories(store *memory.Store) {
+	c.Store = store
+	c.PersonRepo = memory.NewPersonRepository(store)
+	c.OrgRepo = memory.NewOrganizationRepository(store)
+	c.ProfileRepo = memory.NewPersonOrganizationProfileRepository(store)
+	c.MeetingRepo = memory.NewMeetingRepository(store)
+	c.IncrementRepo = memory.NewIncrementRepository(store)
+	c.AuthRepo = memory.NewAuthRepository(store)
+	c.PermissionRepo = memory.NewPermissionRepository(store)
+	c.ConsentRepo = memory.NewConsentRepository(store)
+	c.ConsentCategoryRepo = memory.NewConsentCategoryRepository(store)
+	c.AuditLogRepo = memory.NewAuditLogRepository(store)
+	c.PurgeRepo = memory.NewPurgeRepository(store)
+	c.PartitionRepo = memory.NewPartitionRepository(store)
+	c.ArchiveRepo = memory.NewArchiveRepository(store)
+	c.EncryptionRepo = memory.NewEncryptionRepository(store)
+	c.WebhookRepo = memory.NewWebhookRepository(store)
+	c.IntegrationRepo = memory.NewIntegrationRepository(store)
+	c.WarehouseExportRepo = memory.NewWarehouseExportRepository(store)
+	c.DPARepo = memory.NewDPARepository(store)
+	c.EmailRepo = memory.NewEmailDeliveryRepository(store)
+	c.NotifyRepo = memory.NewNotificationRepository(store)
+	c.CostAlertRepo = memory.NewCostAlertRepository(store)
+	c.SubscriptionRepo = memory.NewSubscriptionRepository(store)
+	c.UsageRepo = memory.NewUsageRepository(store)
+	c.APIUsageRepo = memory.NewAPIUsageRepository(store)
+	c.ReportRepo = memory.NewReportRepository(store)
+	c.ReportExportRepo = memory.NewReportExportRepository(store)
+	c.RatingRepo = memory.NewMeetingRatingRepository(store)
+	c.WageBandRepo = memory.NewWageBandRepository(store)
+	c.HolidayRepo = memory.NewHolidayRepository(store)
+	c.DeletionRepo = memory.NewAccountDeletionRepository(store)
+	c.PersonEmailRepo = memory.NewPersonEmailRepository(store)
+	c.ExtensionTokenRepo = memory.NewExtensionTokenRepository(store)
+	c.SyncOperationRepo = memory.NewSyncOperationRepository(store)
+}
+
+// newMailer builds the email driver cfg selects.
+func newMailer(cfg *config.EmailConfig, log logger.Logger) email.Mailer {
+	switch cfg.Driver {
+	case "smtp":
+		return email.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword)
+	case "ses":
+		return email.NewSESMailer(cfg.SESRegion, cfg.SESAccessKeyID, cfg.SESSecretAccessKey, cfg.SESSessionToken, cfg.Timeout)
+	case "sendgrid":
+		return email.NewSendGridMailer(cfg.SendGridAPIKey, cfg.Timeout)
+	}
+	return email.NewLogMailer(log)
+}
+
+// subProcessors lists the deployment's sub-processors: those in
+// SUBPROCESSORS_FILE, then the email and billing providers it is configured
+// with, then the integrations organizations can connect.
+func subProcessors(cfg *config.Config) ([]service.SubProcessor, error) {
+	processors := []service.SubProcessor{}
+	if cfg.Compliance.SubProcessorsFile != "" {
+		b, err := os.ReadFile(cfg.Compliance.SubProcessorsFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading SUBPROCESSORS_FILE: %w", err)
+		}
+		if err := json.Unmarshal(b, &processors); err != nil {
+			return nil, fmt.Errorf("parsing SUBPROCESSORS_FILE: %w", err)
+		}
+	}
+
+	switch cfg.Email.Driver {
+	case "ses":
+		processors = append(processors, service.SubProcessor{Name: "Amazon Web Services (SES)", Purpose: "Sending email", Location: cfg.Email.SESRegion, URL: "https://aws.amazon.com/compliance/gdpr-center/"})
+	case "sendgrid":
+		processors = append(processors, service.SubProcessor{Name: "Twilio SendGrid", Purpose: "Sending email", Location: "United States", URL: "https://www.twilio.com/en-us/legal/privacy"})
+	}
+	if cfg.Billing.StripeSecretKey != "" {
+		processors = append(processors, service.SubProcessor{Name: "Stripe", Purpose: "Billing and payments", Location: "United States", URL: "https://stripe.com/legal/dpa"})
+	}
+	for _, p := range service.Integrations {
+		if p.Purpose != "" {
+			processors = append(processors, service.SubProcessor{Name: p.Name, Purpose: p.Purpose, Condition: "Only for organizations that connect it"})
+		}
+	}
+	return processors, nil
+}
+
 // Close performs cleanup of dependencies.
 func (c *Container) Close() error {
 	// Add cleanup logic if needed (e.g. closing db, cache connections)
-	return nil
+	var err error
+	if c.AuditLogService != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), auditFlushTimeout)
+		err = c.AuditLogService.Close(ctx)
+		cancel()
+	}
+	if c.APIUsageService != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), auditFlushTimeout)
+		err = errors.Join(err, c.APIUsageService.Close(ctx))
+		cancel()
+	}
+	if c.Pool != nil {
+		c.Pool.Close()
+	}
+	return err
 }
@@ -0,0 +1,44 @@
+// Package email renders transactional emails from templates and sends them
+// through a configured provider.
+//
+// Drivers:
+//
+//	log       writes messages to the application log instead of sending
+//	smtp      any SMTP relay, upgrading to TLS with STARTTLS when offered
+//	ses       the Amazon SES v2 API
+//	sendgrid  the SendGrid v3 mail API
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+)
+
+// Message is one email to a single recipient. Text is required; HTML is
+// sent as an alternative when set.
+type Message struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+	HTML    string `json:"html,omitempty"`
+}
+
+// Mailer sends messages through a provider.
+type Mailer interface {
+	// Send delivers msg and returns the provider's message ID, if any.
+	Send(ctx context.Context, msg *Message) (string, error)
+	// Name identifies the driver in delivery logs.
+	Name() string
+}
+
+// address returns the bare address of an RFC 5322 address such as
+// "Meeting Cost <no-reply@example.com>".
+func address(s string) (string, error) {
+	a, err := mail.ParseAddress(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %q: %w", s, err)
+	}
+	return a.Address, nil
+}
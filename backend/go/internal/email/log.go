@@ -0,0 +1,27 @@
+package email
+
+import (
+	"context"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+)
+
+// LogMailer logs messages instead of sending them, for development and demo
+// mode.
+type LogMailer struct {
+	log logger.Logger
+}
+
+func NewLogMailer(log logger.Logger) *LogMailer {
+	return &LogMailer{log: log}
+}
+
+func (m *LogMailer) Name() string { return "log" }
+
+func (m *LogMailer) Send(ctx context.Context, msg *Message) (string, error) {
+	m.log.Info("email not sent (log driver)", "to", msg.To, "subject", msg.Subject)
+	// The text carries sign-in and verification links, so it is left to
+	// debug logging
+	m.log.Debug("email text (log driver)", "to", msg.To, "text", msg.Text)
+	return "", nil
+}
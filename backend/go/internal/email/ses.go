@@ -0,0 +1,142 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SESMailer sends raw MIME messages through the Amazon SES v2 API, signing
+// requests with AWS Signature Version 4.
+type SESMailer struct {
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	client          *http.Client
+}
+
+func NewSESMailer(region, accessKeyID, secretAccessKey, sessionToken string, timeout time.Duration) *SESMailer {
+	return &SESMailer{
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		sessionToken:    sessionToken,
+		client:          &http.Client{Timeout: timeout},
+	}
+}
+
+func (m *SESMailer) Name() string { return "ses" }
+
+func (m *SESMailer) Send(ctx context.Context, msg *Message) (string, error) {
+	from, err := address(msg.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := address(msg.To)
+	if err != nil {
+		return "", err
+	}
+	raw, _, err := buildMIME(msg)
+	if err != nil {
+		return "", err
+	}
+
+	// []byte fields are encoded as base64, as the API expects
+	body, err := json.Marshal(map[string]interface{}{
+		"FromEmailAddress": from,
+		"Destination":      map[string][]string{"ToAddresses": {to}},
+		"Content":          map[string]interface{}{"Raw": map[string][]byte{"Data": raw}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("encoding ses request: %w", err)
+	}
+
+	host := "email." + m.region + ".amazonaws.com"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+host+"/v2/email/outbound-emails", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	m.sign(req, host, body, time.Now().UTC())
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("sending via ses: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+	if resp.StatusCode/100 != 2 {
+		return "", fmt.Errorf("ses responded %d: %s", resp.StatusCode, respBody)
+	}
+
+	var out struct{ MessageId string }
+	_ = json.Unmarshal(respBody, &out)
+	return out.MessageId, nil
+}
+
+// sign adds SigV4 authentication headers to req.
+func (m *SESMailer) sign(req *http.Request, host string, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	if m.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", m.sessionToken)
+	}
+
+	headers := []string{"content-type", "host", "x-amz-date"}
+	values := map[string]string{
+		"content-type": req.Header.Get("Content-Type"),
+		"host":         host,
+		"x-amz-date":   amzDate,
+	}
+	if m.sessionToken != "" {
+		headers = append(headers, "x-amz-security-token")
+		values["x-amz-security-token"] = m.sessionToken
+	}
+	var canonicalHeaders strings.Builder
+	for _, h := range headers {
+		canonicalHeaders.WriteString(h + ":" + values[h] + "\n")
+	}
+	signedHeaders := strings.Join(headers, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hexSHA256(body),
+	}, "\n")
+
+	scope := date + "/" + m.region + "/ses/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hexSHA256([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+m.secretAccessKey), date)
+	for _, s := range []string{m.region, "ses", "aws4_request"} {
+		key = hmacSHA256(key, s)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+m.accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hexSHA256(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,45 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+)
+
+// SMTPMailer sends through an SMTP relay. It authenticates with PLAIN when a
+// username is set, which net/smtp only allows over TLS or to localhost.
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(host string, port int, username, password string) *SMTPMailer {
+	m := &SMTPMailer{addr: net.JoinHostPort(host, strconv.Itoa(port))}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m
+}
+
+func (m *SMTPMailer) Name() string { return "smtp" }
+
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) (string, error) {
+	from, err := address(msg.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := address(msg.To)
+	if err != nil {
+		return "", err
+	}
+	body, id, err := buildMIME(msg)
+	if err != nil {
+		return "", err
+	}
+	if err := smtp.SendMail(m.addr, m.auth, from, []string{to}, body); err != nil {
+		return "", fmt.Errorf("sending via smtp: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,170 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// Templates. Each file in templates/ defines "subject", "text" and "html";
+// "subject" and "text" are rendered as plain text and "html" with HTML
+// escaping, wrapped by the "header" and "footer" of layout.tmpl.
+const (
+	TemplateInvite        = "invite"
+	TemplateVerification  = "verification"
+	TemplatePasswordReset = "password_reset"
+	TemplateDigest        = "digest"
+	TemplateNotification  = "notification"
+
+	TemplateAccountDeletion = "account_deletion"
+)
+
+// InviteData renders TemplateInvite.
+type InviteData struct {
+	Name             string
+	OrganizationName string
+	InviterName      string
+	URL              string
+}
+
+// VerificationData renders TemplateVerification.
+type VerificationData struct {
+	Name string
+	URL  string
+}
+
+// PasswordResetData renders TemplatePasswordReset.
+type PasswordResetData struct {
+	Name      string
+	URL       string
+	ExpiresIn time.Duration
+}
+
+// AccountDeletionData renders TemplateAccountDeletion, which asks a person
+// to confirm deleting their account.
+type AccountDeletionData struct {
+	Name      string
+	Token     string
+	ExpiresIn time.Duration
+	// GraceDays is how long after confirming the account is anonymized
+	GraceDays int
+}
+
+// NotificationData renders TemplateNotification, the email channel of
+// notifications.
+type NotificationData struct {
+	Name  string
+	Title string
+	Body  string
+	URL   string
+}
+
+// DigestData renders TemplateDigest, a summary of meeting costs over a
+// period.
+type DigestData struct {
+	Name             string
+	OrganizationName string
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	TotalCost        float64
+	MeetingHours     float64
+	MeetingCount     int
+	TopMeetings      []DigestMeeting
+	// UnsubscribeURL turns the digest off for the recipient
+	UnsubscribeURL string
+}
+
+// DigestMeeting is one row of a digest's most expensive meetings.
+type DigestMeeting struct {
+	Title    string
+	Cost     float64
+	Duration time.Duration
+}
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var funcs = map[string]interface{}{
+	"money":    money,
+	"duration": duration,
+	"date":     func(t time.Time) string { return t.Format("Jan 2, 2006") },
+	"hours":    func(h float64) string { return strconv.FormatFloat(h, 'f', 1, 64) },
+	"link":     func(url, label string) map[string]string { return map[string]string{"URL": url, "Label": label} },
+}
+
+// template is one email's subject, text and HTML bodies, each parsed with
+// the shared layout.
+type template struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var templates = map[string]template{}
+
+func init() {
+	for _, name := range []string{TemplateInvite, TemplateVerification, TemplatePasswordReset, TemplateDigest, TemplateNotification, TemplateAccountDeletion} {
+		files := []string{"templates/layout.tmpl", "templates/" + name + ".tmpl"}
+		templates[name] = template{
+			text: texttemplate.Must(texttemplate.New(name).Funcs(funcs).ParseFS(templateFS, files...)),
+			html: htmltemplate.Must(htmltemplate.New(name).Funcs(funcs).ParseFS(templateFS, files...)),
+		}
+	}
+}
+
+// Render renders the named template with data into a message with no
+// sender or recipient.
+func Render(name string, data interface{}) (*Message, error) {
+	t, ok := templates[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown email template %q", name)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("rendering %s subject: %w", name, err)
+	}
+	if err := t.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return nil, fmt.Errorf("rendering %s text: %w", name, err)
+	}
+	if err := t.html.ExecuteTemplate(&html, "html", data); err != nil {
+		return nil, fmt.Errorf("rendering %s html: %w", name, err)
+	}
+	return &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
+
+// money formats an amount in dollars with thousands separators.
+func money(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	whole, cents := s[:len(s)-3], s[len(s)-3:]
+	neg := strings.HasPrefix(whole, "-")
+	whole = strings.TrimPrefix(whole, "-")
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+	if neg {
+		return "-$" + whole + cents
+	}
+	return "$" + whole + cents
+}
+
+// duration formats d as hours and minutes, e.g. "1h 30m".
+func duration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	}
+	return fmt.Sprintf("%dh %dm", h, m)
+}
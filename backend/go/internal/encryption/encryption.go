@@ -0,0 +1,229 @@
+// Package encryption encrypts sensitive columns at rest with AES-256-GCM.
+//
+// Encrypted values are stored as
+//
+//	enc:v1:<key id>:<base64 of nonce and ciphertext>
+//
+// or, for values encrypted with a key derived for a scope such as one
+// organization, with <key id>/<scope> in place of the key ID.
+//
+// A Keyring encrypts with its primary key and decrypts with any of its
+// keys, so a key is rotated by making a new key primary, keeping the old
+// one until every value has been re-encrypted, then dropping it. Values
+// without the prefix are read as plaintext, so rows written before
+// encryption was enabled keep working until they are re-encrypted.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// prefix marks an encrypted value and its format version.
+const prefix = "enc:v1:"
+
+// KeySize is the length of a key in bytes, for AES-256.
+const KeySize = 32
+
+// Keyring holds the keys values are encrypted with.
+type Keyring struct {
+	primary string
+	keys    map[string][]byte
+	aeads   map[string]cipher.AEAD
+}
+
+// ParseKeys builds a Keyring from a comma-separated list of
+// <id>:<base64 key> pairs, primary first, e.g. "2024b:...,2024a:...".
+func ParseKeys(spec string) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string][]byte), aeads: make(map[string]cipher.AEAD)}
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(pair, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("encryption key %q must be <id>:<base64 key>", pair)
+		}
+		if strings.Contains(id, "/") {
+			return nil, fmt.Errorf("encryption key id %q must not contain /", id)
+		}
+		if _, dup := k.aeads[id]; dup {
+			return nil, fmt.Errorf("encryption key id %q is listed twice", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %q is not base64: %w", id, err)
+		}
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("encryption key %q must be %d bytes, got %d", id, KeySize, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		k.keys[id] = key
+		k.aeads[id] = aead
+		if k.primary == "" {
+			k.primary = id
+		}
+	}
+	if k.primary == "" {
+		return nil, fmt.Errorf("no encryption keys given")
+	}
+	return k, nil
+}
+
+// Primary is the ID of the key new values are encrypted with.
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+// Encrypt encrypts plaintext with the primary key. The empty string stays
+// empty, so unset columns are left unset.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	return k.EncryptScoped(plaintext, "")
+}
+
+// EncryptScoped encrypts plaintext with a key derived from the primary key
+// for scope, such as one organization, so values of different scopes can't
+// be decrypted with each other's keys. The scope is stored with the value
+// and bound to it, and an empty scope is the same as Encrypt.
+func (k *Keyring) EncryptScoped(plaintext, scope string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	if strings.ContainsAny(scope, ":/") {
+		return "", fmt.Errorf("invalid encryption scope %q", scope)
+	}
+	label := k.primary
+	if scope != "" {
+		label += "/" + scope
+	}
+	aead, err := k.aead(label)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(label))
+	return prefix + label + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the plaintext of value, which is returned as it is if it
+// was never encrypted.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	label, sealed, ok, err := parse(value)
+	if err != nil || !ok {
+		return value, err
+	}
+	aead, err := k.aead(label)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("encrypted value is truncated")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(label))
+	if err != nil {
+		return "", fmt.Errorf("decrypting with key %q: %w", label, err)
+	}
+	return string(plaintext), nil
+}
+
+// aead returns the cipher for a stored key label: a key ID, or a key ID
+// and scope as <id>/<scope>, whose key is derived from the key's with
+// HMAC-SHA256.
+func (k *Keyring) aead(label string) (cipher.AEAD, error) {
+	id, scope, _ := strings.Cut(label, "/")
+	if scope == "" {
+		aead, found := k.aeads[id]
+		if !found {
+			return nil, fmt.Errorf("value is encrypted with unknown key %q", id)
+		}
+		return aead, nil
+	}
+	key, found := k.keys[id]
+	if !found {
+		return nil, fmt.Errorf("value is encrypted with unknown key %q", id)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("scope:" + scope))
+	return newAEAD(mac.Sum(nil))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Current reports whether value needs no re-encryption: it is empty or
+// encrypted with the primary key, or a key derived from it.
+func (k *Keyring) Current(value string) bool {
+	if value == "" {
+		return true
+	}
+	label, _, ok, err := parse(value)
+	id, _, _ := strings.Cut(label, "/")
+	return err == nil && ok && id == k.primary
+}
+
+// IsEncrypted reports whether value is in the encrypted format.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// parse splits an encrypted value into its key label and sealed bytes, and
+// reports false for plaintext.
+func parse(value string) (label string, sealed []byte, ok bool, err error) {
+	if !IsEncrypted(value) {
+		return "", nil, false, nil
+	}
+	label, encoded, found := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	if !found {
+		return "", nil, false, fmt.Errorf("malformed encrypted value")
+	}
+	sealed, err = base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, false, fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	return label, sealed, true, nil
+}
+
+// Rotate returns value re-encrypted with the primary key, keeping its
+// scope, and false if it already was. Plaintext values are encrypted.
+func (k *Keyring) Rotate(value string) (string, bool, error) {
+	return k.RotateScoped(value, "")
+}
+
+// RotateScoped is Rotate with the scope plaintext values are encrypted
+// for; encrypted values keep the scope they were stored with.
+func (k *Keyring) RotateScoped(value, scope string) (string, bool, error) {
+	if k.Current(value) {
+		return value, false, nil
+	}
+	if label, _, ok, _ := parse(value); ok {
+		_, scope, _ = strings.Cut(label, "/")
+	}
+	plaintext, err := k.Decrypt(value)
+	if err != nil {
+		return "", false, err
+	}
+	rotated, err := k.EncryptScoped(plaintext, scope)
+	if err != nil {
+		return "", false, err
+	}
+	return rotated, true, nil
+}
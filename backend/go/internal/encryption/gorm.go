@@ -0,0 +1,71 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is the GORM serializer that encrypts a string column with
+// the keyring set by Use: `gorm:"serializer:encrypted"`.
+const SerializerName = "encrypted"
+
+var active atomic.Pointer[Keyring]
+
+func init() {
+	schema.RegisterSerializer(SerializerName, serializer{})
+}
+
+// Use sets the keyring encrypted columns are written and read with. Until
+// it is called, or after Use(nil), they are written in plaintext.
+func Use(k *Keyring) {
+	active.Store(k)
+}
+
+// Active returns the keyring set by Use, or nil.
+func Active() *Keyring {
+	return active.Load()
+}
+
+type serializer struct{}
+
+func (serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var value string
+	switch v := dbValue.(type) {
+	case nil:
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("encrypted column %s holds %T, not text", field.DBName, dbValue)
+	}
+
+	if IsEncrypted(value) {
+		k := active.Load()
+		if k == nil {
+			return fmt.Errorf("column %s is encrypted but no encryption keys are configured", field.DBName)
+		}
+		plaintext, err := k.Decrypt(value)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", field.DBName, err)
+		}
+		value = plaintext
+	}
+	return field.Set(ctx, dst, value)
+}
+
+func (serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	value, ok := fieldValue.(string)
+	if !ok {
+		return nil, fmt.Errorf("encrypted column %s must be a string, not %T", field.DBName, fieldValue)
+	}
+	k := active.Load()
+	if k == nil {
+		return value, nil
+	}
+	return k.Encrypt(value)
+}
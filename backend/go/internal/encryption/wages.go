@@ -0,0 +1,165 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/schema"
+)
+
+// Wage encryption scopes, the keys members' hourly wages are encrypted
+// with.
+const (
+	WageScopeNone         = ""             // Wages are stored in plaintext
+	WageScopeDeployment   = "deployment"   // The keyring's primary key
+	WageScopeOrganization = "organization" // A key derived from it for each organization
+)
+
+// WageScopes lists the valid wage encryption scopes.
+var WageScopes = []string{WageScopeNone, WageScopeDeployment, WageScopeOrganization}
+
+// WageSerializerName is the GORM serializer that stores a *float64 wage as
+// text, encrypted as UseWageScope says: `gorm:"serializer:encrypted_wage"`.
+// The model must have an OrganizationID field for the organization scope.
+const WageSerializerName = "encrypted_wage"
+
+var wageScope atomic.Value
+
+func init() {
+	wageScope.Store(WageScopeNone)
+	schema.RegisterSerializer(WageSerializerName, wageSerializer{})
+}
+
+// UseWageScope sets the scope wages are encrypted with. Wages are read
+// whatever scope they were written with, so it can be changed at any time;
+// re-encrypting moves existing wages to it.
+func UseWageScope(scope string) {
+	wageScope.Store(scope)
+}
+
+// WageScope returns the scope set by UseWageScope.
+func WageScope() string {
+	return wageScope.Load().(string)
+}
+
+// WagesEncrypted reports whether new wages are written encrypted.
+func WagesEncrypted() bool {
+	return WageScope() != WageScopeNone && Active() != nil
+}
+
+// wageKeyScope is the keyring scope an organization's wages are encrypted
+// for.
+func wageKeyScope(orgID uuid.UUID) string {
+	if WageScope() == WageScopeOrganization {
+		return "org-" + orgID.String()
+	}
+	return ""
+}
+
+// EncryptWage returns the text an organization member's wage is stored as:
+// encrypted when wage encryption is on, else the plain number, and nil for
+// no wage.
+func EncryptWage(orgID uuid.UUID, wage *float64) (interface{}, error) {
+	if wage == nil {
+		return nil, nil
+	}
+	value := strconv.FormatFloat(*wage, 'f', -1, 64)
+	if !WagesEncrypted() {
+		return value, nil
+	}
+	return Active().EncryptScoped(value, wageKeyScope(orgID))
+}
+
+// DecryptWage parses a stored wage, decrypting it if it is encrypted.
+func DecryptWage(value string) (float64, error) {
+	if IsEncrypted(value) {
+		k := Active()
+		if k == nil {
+			return 0, fmt.Errorf("wage is encrypted but no encryption keys are configured")
+		}
+		plaintext, err := k.Decrypt(value)
+		if err != nil {
+			return 0, err
+		}
+		value = plaintext
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// RotateWage re-encrypts a stored wage with k's primary key for the
+// current wage scope, returning false if it needs no change. With wage
+// encryption off, encrypted wages are decrypted instead.
+func RotateWage(k *Keyring, orgID uuid.UUID, value string) (string, bool, error) {
+	if WageScope() == WageScopeNone {
+		if !IsEncrypted(value) {
+			return value, false, nil
+		}
+		plaintext, err := k.Decrypt(value)
+		return plaintext, err == nil, err
+	}
+	want := k.Primary()
+	if scope := wageKeyScope(orgID); scope != "" {
+		want += "/" + scope
+	}
+	if label, _, ok, err := parse(value); value == "" || (err == nil && ok && label == want) {
+		return value, false, nil
+	}
+	plaintext, err := k.Decrypt(value)
+	if err != nil {
+		return "", false, err
+	}
+	rotated, err := k.EncryptScoped(plaintext, wageKeyScope(orgID))
+	if err != nil {
+		return "", false, err
+	}
+	return rotated, true, nil
+}
+
+type wageSerializer struct{}
+
+func (wageSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	target := field.ReflectValueOf(ctx, dst)
+	var value string
+	switch v := dbValue.(type) {
+	case nil:
+		target.Set(reflect.Zero(field.FieldType))
+		return nil
+	case float64:
+		target.Set(reflect.ValueOf(&v))
+		return nil
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("wage column %s holds %T, not text", field.DBName, dbValue)
+	}
+
+	wage, err := DecryptWage(value)
+	if err != nil {
+		return fmt.Errorf("column %s: %w", field.DBName, err)
+	}
+	target.Set(reflect.ValueOf(&wage))
+	return nil
+}
+
+func (wageSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	wage, ok := fieldValue.(*float64)
+	if !ok {
+		return nil, fmt.Errorf("wage column %s must be a *float64, not %T", field.DBName, fieldValue)
+	}
+	var orgID uuid.UUID
+	if v := reflect.Indirect(dst); v.Kind() == reflect.Struct {
+		if f := v.FieldByName("OrganizationID"); f.IsValid() {
+			orgID, _ = f.Interface().(uuid.UUID)
+		}
+	}
+	if wage != nil && orgID == uuid.Nil && WageScope() == WageScopeOrganization {
+		return nil, fmt.Errorf("wage column %s needs the row's organization to be encrypted", field.DBName)
+	}
+	return EncryptWage(orgID, wage)
+}
@@ -18,5 +18,6 @@ const (
 	// Domain-specific codes
 	CodeMeetingActive   = "MEETING_ACTIVE"
 	CodeMeetingNotFound = "MEETING_NOT_FOUND"
+	CodeLimitExceeded   = "LIMIT_EXCEEDED"
+	CodeSeatsExhausted  = "SEATS_EXHAUSTED"
 )
-
@@ -69,3 +69,26 @@ func ErrMeetingNotFound(id uuid.UUID) *DomainError {
 	}
 }
 
+// ErrLimitExceeded reports that an organization's plan does not allow
+// more of limit. A max of zero means the plan does not include it at all.
+func ErrLimitExceeded(limit string, max int, planType string) *DomainError {
+	message := fmt.Sprintf("%s limit of %d reached on the %s plan; upgrade to add more", limit, max, planType)
+	if max == 0 {
+		message = fmt.Sprintf("the %s plan does not include %s; upgrade to use them", planType, limit)
+	}
+	return &DomainError{
+		Code:    CodeLimitExceeded,
+		Message: message,
+		Details: map[string]interface{}{"limit": limit, "max": max, "plan_type": planType},
+	}
+}
+
+// ErrSeatsExhausted reports that every seat an organization pays for is
+// taken and it does not buy seats automatically.
+func ErrSeatsExhausted(seats int) *DomainError {
+	return &DomainError{
+		Code:    CodeSeatsExhausted,
+		Message: fmt.Sprintf("all %d seats on the subscription are taken; add seats to add more members", seats),
+		Details: map[string]interface{}{"seats": seats},
+	}
+}
@@ -17,8 +17,9 @@ func StatusCodeFor(code string) int {
 		return http.StatusConflict
 	case CodeRateLimit:
 		return http.StatusTooManyRequests
+	case CodeLimitExceeded, CodeSeatsExhausted:
+		return http.StatusPaymentRequired
 	default:
 		return http.StatusInternalServerError
 	}
 }
-
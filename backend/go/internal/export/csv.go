@@ -0,0 +1,37 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"time"
+)
+
+// CSV writes t as comma-separated values under a header row; its title is
+// left out. Times are written in RFC 3339, to the second, so spreadsheets
+// can tell them apart within a minute.
+func CSV(t Table) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(t.Columns); err != nil {
+		return nil, err
+	}
+	record := make([]string, len(t.Columns))
+	for _, row := range t.Rows {
+		for i := range record {
+			record[i] = ""
+			if i >= len(row) {
+				continue
+			}
+			if v, ok := row[i].(time.Time); ok && !v.IsZero() {
+				record[i] = v.Format(time.RFC3339)
+				continue
+			}
+			record[i] = text(row[i])
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,94 @@
+// Package export renders reports as files to share outside the app.
+//
+// Formats:
+//
+//	pdf   a printable document, tables laid out on US Letter pages
+//	xlsx  an Excel workbook with one sheet per table and numeric cells
+//	csv   comma-separated values, for a single table
+//
+// All are written with the standard library only: the PDF uses the
+// standard Helvetica fonts, so text outside Latin-1 is replaced.
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// File formats.
+const (
+	FormatPDF  = "pdf"
+	FormatXLSX = "xlsx"
+	FormatCSV  = "csv"
+)
+
+// Formats lists every format a Document renders in. CSV holds a single
+// Table, so it is not one of them.
+var Formats = []string{FormatPDF, FormatXLSX}
+
+// ContentTypes maps each format to its MIME type.
+var ContentTypes = map[string]string{
+	FormatPDF:  "application/pdf",
+	FormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	FormatCSV:  "text/csv; charset=utf-8",
+}
+
+// Document is a report laid out as a title over titled tables.
+type Document struct {
+	Title string
+	// Subtitle is printed under the title, e.g. the period covered
+	Subtitle string
+	Tables   []Table
+}
+
+// Table is a grid of values under a header row. Values are strings,
+// integers, float64 (two decimals) or time.Time (to the minute, in its own
+// zone).
+type Table struct {
+	Title   string
+	Columns []string
+	Rows    [][]any
+}
+
+// Render writes doc in format.
+func Render(doc *Document, format string) ([]byte, error) {
+	switch format {
+	case FormatPDF:
+		return PDF(doc)
+	case FormatXLSX:
+		return XLSX(doc)
+	}
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+// text formats a table value for display.
+func text(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Format("2006-01-02 15:04")
+	}
+	return fmt.Sprint(v)
+}
+
+// numeric reports whether v is a number, which both formats align right.
+func numeric(v any) bool {
+	switch v.(type) {
+	case float64, int, int64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,263 @@
+package export
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+)
+
+// Page layout in points: US Letter, portrait.
+const (
+	pageWidth  = 612.0
+	pageHeight = 792.0
+	margin     = 40.0
+
+	titleSize = 16.0
+	headSize  = 12.0
+	cellSize  = 8.0
+	rowHeight = 13.0
+	// cellPad is the horizontal space around a cell's text
+	cellPad = 8.0
+)
+
+// The two fonts, by resource name.
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+)
+
+// helvetica holds the widths of ASCII 32-126 in Helvetica, in thousandths
+// of the font size, from the font's standard metrics.
+var helvetica = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// PDF writes doc as a PDF: the title, then each table with its header
+// repeated on every page it spans. Columns too wide for the page are
+// narrowed and their text cut short.
+func PDF(doc *Document) ([]byte, error) {
+	w := &pdfWriter{}
+	w.newPage()
+	w.text(margin, w.y-titleSize, fontBold, titleSize, doc.Title)
+	w.y -= titleSize + 6
+	if doc.Subtitle != "" {
+		w.text(margin, w.y-10, fontRegular, 10, doc.Subtitle)
+		w.y -= 10 + 6
+	}
+	for _, t := range doc.Tables {
+		w.y -= 14
+		w.table(t)
+	}
+	return w.bytes(doc.Title)
+}
+
+type pdfWriter struct {
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	// y is the top of the free space on the page
+	y float64
+}
+
+func (w *pdfWriter) newPage() {
+	w.page = &bytes.Buffer{}
+	w.pages = append(w.pages, w.page)
+	w.y = pageHeight - margin
+}
+
+// ensure starts a new page unless h points fit above the bottom margin,
+// and reports whether it did.
+func (w *pdfWriter) ensure(h float64) bool {
+	if w.y-h >= margin+rowHeight {
+		return false
+	}
+	w.newPage()
+	return true
+}
+
+func (w *pdfWriter) table(t Table) {
+	widths := columnWidths(t)
+
+	// Keep the title with the header and first row
+	w.ensure(headSize + 6 + 2*rowHeight)
+	w.text(margin, w.y-headSize, fontBold, headSize, t.Title)
+	w.y -= headSize + 6
+	w.header(t.Columns, widths)
+
+	if len(t.Rows) == 0 {
+		w.text(margin+cellPad/2, w.y-rowHeight+4, fontRegular, cellSize, "No data")
+		w.y -= rowHeight
+		return
+	}
+	for _, row := range t.Rows {
+		if w.ensure(rowHeight) {
+			w.header(t.Columns, widths)
+		}
+		x := margin
+		for i, v := range row {
+			if i >= len(widths) {
+				break
+			}
+			s := fit(text(v), widths[i]-cellPad, cellSize)
+			tx := x + cellPad/2
+			if numeric(v) {
+				tx = x + widths[i] - cellPad/2 - textWidth(s, cellSize)
+			}
+			w.text(tx, w.y-rowHeight+4, fontRegular, cellSize, s)
+			x += widths[i]
+		}
+		w.y -= rowHeight
+	}
+}
+
+func (w *pdfWriter) header(columns []string, widths []float64) {
+	x := margin
+	for i, c := range columns {
+		// Bold runs about a tenth wider than the regular metrics
+		w.text(x+cellPad/2, w.y-rowHeight+4, fontBold, cellSize, fit(c, (widths[i]-cellPad)/1.1, cellSize))
+		x += widths[i]
+	}
+	w.y -= rowHeight
+	fmt.Fprintf(w.page, "0.5 w %.2f %.2f m %.2f %.2f l S\n", margin, w.y+2, x, w.y+2)
+}
+
+func (w *pdfWriter) text(x, y float64, font string, size float64, s string) {
+	fmt.Fprintf(w.page, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, pdfString(s))
+}
+
+// bytes assembles the pages into a PDF file, numbering each page in its
+// footer.
+func (w *pdfWriter) bytes(title string) ([]byte, error) {
+	// Objects 1-5 are fixed; each page then takes two: the page and its
+	// content stream.
+	const firstPage = 6
+	var objects []string
+	kids := make([]string, len(w.pages))
+	for i := range w.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(w.pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Title (%s) /Producer (Meeting Cost) >>", pdfString(title)),
+	)
+
+	for i, page := range w.pages {
+		footer := fmt.Sprintf("Page %d of %d", i+1, len(w.pages))
+		w.page = page
+		w.text(pageWidth-margin-textWidth(footer, cellSize), margin/2, fontRegular, cellSize, footer)
+
+		var content bytes.Buffer
+		zw := zlib.NewWriter(&content)
+		if _, err := zw.Write(page.Bytes()); err != nil {
+			return nil, fmt.Errorf("compressing page: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return nil, fmt.Errorf("compressing page: %w", err)
+		}
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+				pageWidth, pageHeight, fontRegular, fontBold, firstPage+2*i+1),
+			fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", content.Len(), content.Bytes()),
+		)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes(), nil
+}
+
+// columnWidths sizes each column to its widest text, scaled down to fit
+// the page when the table is too wide.
+func columnWidths(t Table) []float64 {
+	widths := make([]float64, len(t.Columns))
+	for i, c := range t.Columns {
+		widths[i] = textWidth(c, cellSize)*1.1 + cellPad
+	}
+	for _, row := range t.Rows {
+		for i, v := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], textWidth(text(v), cellSize)+cellPad)
+			}
+		}
+	}
+
+	var total float64
+	for _, w := range widths {
+		total += w
+	}
+	if usable := pageWidth - 2*margin; total > usable {
+		for i := range widths {
+			widths[i] *= usable / total
+		}
+	}
+	return widths
+}
+
+// fit cuts s short with an ellipsis so it is at most width points wide.
+func fit(s string, width, size float64) string {
+	if textWidth(s, size) <= width {
+		return s
+	}
+	r := []rune(s)
+	for len(r) > 0 && textWidth(string(r)+"...", size) > width {
+		r = r[:len(r)-1]
+	}
+	if len(r) == 0 {
+		return ""
+	}
+	return string(r) + "..."
+}
+
+// textWidth returns the width of s in Helvetica at size points.
+func textWidth(s string, size float64) float64 {
+	var units int
+	for _, r := range s {
+		if r >= 32 && r <= 126 {
+			units += helvetica[r-32]
+		} else {
+			units += 556
+		}
+	}
+	return float64(units) * size / 1000
+}
+
+// pdfString encodes s as the body of a PDF literal string in WinAnsi,
+// replacing what the encoding lacks.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 32:
+			b.WriteByte(' ')
+		case r < 127:
+			b.WriteRune(r)
+		case r >= 160 && r <= 255:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
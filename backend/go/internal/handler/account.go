@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// AccountHandler serves the signed-in person's own account.
+type AccountHandler struct {
+	deletionService service.AccountDeletionService
+	emailService    service.PersonEmailService
+}
+
+func NewAccountHandler(deletionService service.AccountDeletionService, emailService service.PersonEmailService) *AccountHandler {
+	return &AccountHandler{
+		deletionService: deletionService,
+		emailService:    emailService,
+	}
+}
+
+// RequestDeletion starts deleting the signed-in person's account by
+// emailing them a confirmation token.
+func (h *AccountHandler) RequestDeletion(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	res, err := h.deletionService.RequestDeletion(c.Context(), personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return accountDeletionError(c, err)
+	}
+	return c.Status(fiber.StatusAccepted).JSON(res)
+}
+
+func (h *AccountHandler) ConfirmDeletion(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	var req service.ConfirmAccountDeletionRequest
+	if err := c.BodyParser(&req); err != nil || req.Token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "missing token"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.deletionService.ConfirmDeletion(c.Context(), personID, req)
+	if err != nil {
+		return accountDeletionError(c, err)
+	}
+	return c.JSON(res)
+}
+
+func (h *AccountHandler) GetDeletion(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	res, err := h.deletionService.GetDeletion(c.Context(), personID)
+	if err != nil {
+		return accountDeletionError(c, err)
+	}
+	return c.JSON(res)
+}
+
+func (h *AccountHandler) CancelDeletion(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	if err := h.deletionService.CancelDeletion(c.Context(), personID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return accountDeletionError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *AccountHandler) ListEmails(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	res, err := h.emailService.ListEmails(c.Context(), personID)
+	if err != nil {
+		return personEmailError(c, err)
+	}
+	return c.JSON(res)
+}
+
+// AddEmail adds a secondary email and sends it a verification link.
+func (h *AccountHandler) AddEmail(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	var req service.AddPersonEmailRequest
+	if err := c.BodyParser(&req); err != nil || req.Email == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "missing email"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.emailService.AddEmail(c.Context(), personID, req)
+	if err != nil {
+		return personEmailError(c, err)
+	}
+	return c.Status(fiber.StatusAccepted).JSON(res)
+}
+
+// VerifyEmail verifies a secondary email from the link sent to it.
+func (h *AccountHandler) VerifyEmail(c *fiber.Ctx) error {
+	res, err := h.emailService.VerifyEmail(c.Context(), c.Query("token"))
+	if err != nil {
+		return personEmailError(c, err)
+	}
+	return c.JSON(res)
+}
+
+func (h *AccountHandler) RemoveEmail(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	emailID, err := uuid.Parse(c.Params("emailId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid email ID"})
+	}
+
+	if err := h.emailService.RemoveEmail(c.Context(), personID, emailID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return personEmailError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// personEmailError maps a PersonEmailService error to a response.
+func personEmailError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "email not found"})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
+
+// accountDeletionError maps an AccountDeletionService error to a response.
+func accountDeletionError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "no account deletion requested"})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// TaskResponse identifies a task handed to the worker.
+type TaskResponse struct {
+	TaskID string `json:"task_id"`
+}
+
+// AdminHandler serves operator endpoints.
+type AdminHandler struct {
+	maintenanceService service.MaintenanceService
+	logLevelService    service.LogLevelService
+	purgeRetention     time.Duration
+}
+
+// NewAdminHandler creates a new AdminHandler. purgeRetention is the default
+// age for Purge when the request does not specify one.
+func NewAdminHandler(maintenanceService service.MaintenanceService, logLevelService service.LogLevelService, purgeRetention time.Duration) *AdminHandler {
+	return &AdminHandler{
+		maintenanceService: maintenanceService,
+		logLevelService:    logLevelService,
+		purgeRetention:     purgeRetention,
+	}
+}
+
+// Purge permanently removes soft-deleted rows older than the older_than
+// query parameter (a Go duration, e.g. 720h). With async=true the purge is
+// handed to the worker and the task ID is returned with 202 Accepted.
+func (h *AdminHandler) Purge(c *fiber.Ctx) error {
+	retention := h.purgeRetention
+	if v := c.Query("older_than"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "older_than must be a positive duration"})
+		}
+		retention = d
+	}
+
+	if c.QueryBool("async") {
+		taskID, err := h.maintenanceService.EnqueuePurgeDeleted(c.Context(), retention)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusAccepted).JSON(TaskResponse{TaskID: taskID})
+	}
+
+	result, err := h.maintenanceService.PurgeDeleted(c.Context(), retention)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(result)
+}
+
+// Reencrypt rewrites values encrypted at rest with the primary key.
+func (h *AdminHandler) Reencrypt(c *fiber.Ctx) error {
+	result, err := h.maintenanceService.Reencrypt(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(result)
+}
+
+// GetLogLevel returns the log level of the instance serving the request.
+func (h *AdminHandler) GetLogLevel(c *fiber.Ctx) error {
+	return c.JSON(h.logLevelService.GetLevel())
+}
+
+// SetLogLevel changes the log level of every API and worker process.
+func (h *AdminHandler) SetLogLevel(c *fiber.Ctx) error {
+	var req service.SetLogLevelRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	result, err := h.logLevelService.SetLevel(c.Context(), req)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid") {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(result)
+}
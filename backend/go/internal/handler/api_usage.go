@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// Bounds of the API usage endpoint's days parameter.
+const (
+	defaultAPIUsageDays = 30
+	maxAPIUsageDays     = 90
+)
+
+type APIUsageHandler struct {
+	apiUsageService service.APIUsageService
+}
+
+func NewAPIUsageHandler(apiUsageService service.APIUsageService) *APIUsageHandler {
+	return &APIUsageHandler{
+		apiUsageService: apiUsageService,
+	}
+}
+
+// GetAPIUsage returns the organization's API request counts by day and by
+// credential.
+func (h *APIUsageHandler) GetAPIUsage(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	days := c.QueryInt("days", defaultAPIUsageDays)
+	if days < 1 || days > maxAPIUsageDays {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid days: must be between 1 and 90"})
+	}
+
+	res, err := h.apiUsageService.GetAPIUsage(c.Context(), orgID, personID, days)
+	if err != nil {
+		return apiUsageError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func apiUsageError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
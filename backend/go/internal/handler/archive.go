@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type ArchiveHandler struct {
+	archiveService service.ArchiveService
+}
+
+func NewArchiveHandler(archiveService service.ArchiveService) *ArchiveHandler {
+	return &ArchiveHandler{
+		archiveService: archiveService,
+	}
+}
+
+// ListArchivedMeetings returns the organization's 100 most recently started
+// archived meetings.
+func (h *ArchiveHandler) ListArchivedMeetings(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination := service.Pagination{Page: 1, PageSize: 100}
+
+	res, _, err := h.archiveService.ListArchivedMeetings(c.Context(), orgID, personID, pagination)
+	if err != nil {
+		return archiveError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ListArchivedMeetingsV2 is ListArchivedMeetings with page and page_size
+// parameters and pagination metadata.
+func (h *ArchiveHandler) ListArchivedMeetingsV2(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	res, total, err := h.archiveService.ListArchivedMeetings(c.Context(), orgID, personID, pagination)
+	if err != nil {
+		return archiveError(c, err)
+	}
+
+	return c.JSON(newPage(res, pagination, total))
+}
+
+// RestoreMeeting moves an archived meeting back into the live tables.
+func (h *ArchiveHandler) RestoreMeeting(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	meetingID, err := uuid.Parse(c.Params("meetingId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	res, err := h.archiveService.RestoreMeeting(c.Context(), orgID, meetingID, personID)
+	if err != nil {
+		return archiveError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func archiveError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
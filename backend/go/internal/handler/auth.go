@@ -4,9 +4,30 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/service"
 )
 
+// RefreshTokenRequest is the body of RefreshToken.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// IntrospectRequest is the body of Introspect, form-encoded after RFC 7662
+// or JSON.
+type IntrospectRequest struct {
+	Token string `json:"token" form:"token"`
+	// TokenTypeHint is accepted and ignored: only access tokens are
+	// described
+	TokenTypeHint string `json:"token_type_hint" form:"token_type_hint"`
+}
+
+// MeResponse identifies the authenticated person.
+type MeResponse struct {
+	PersonID uuid.UUID `json:"person_id"`
+	Email    string    `json:"email"`
+}
+
 type AuthHandler struct {
 	authService service.AuthService
 }
@@ -28,6 +49,9 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 
 	res, err := h.authService.Register(c.Context(), req)
 	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid") {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
@@ -65,15 +89,25 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
-func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
-	var req struct {
-		RefreshToken string `json:"refresh_token"`
+func (h *AuthHandler) LogoutAll(c *fiber.Ctx) error {
+	personID, ok := c.Locals("person_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	if err := h.authService.RevokeAllSessions(c.Context(), personID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
+	var req RefreshTokenRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "missing refresh token"})
 	}
 
-	res, err := h.authService.RefreshToken(c.Context(), req.RefreshToken)
+	res, err := h.authService.RefreshToken(c.Context(), req.RefreshToken, c.IP(), string(c.Request().Header.UserAgent()))
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid refresh token"})
 	}
@@ -81,15 +115,83 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 	return c.JSON(res)
 }
 
+func (h *AuthHandler) ListSessions(c *fiber.Ctx) error {
+	personID, ok := c.Locals("person_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	sessionID, _ := c.Locals("session_id").(uuid.UUID)
+
+	sessions, err := h.authService.GetSessions(c.Context(), personID, sessionID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(sessions)
+}
+
+func (h *AuthHandler) RevokeSession(c *fiber.Ctx) error {
+	personID, ok := c.Locals("person_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid session ID"})
+	}
+
+	if err := h.authService.RevokeSession(c.Context(), personID, sessionID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// SetActiveOrganization switches the organization the session works in.
+func (h *AuthHandler) SetActiveOrganization(c *fiber.Ctx) error {
+	personID, ok := c.Locals("person_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	sessionID, _ := c.Locals("session_id").(uuid.UUID)
+
+	var req service.SetActiveOrganizationRequest
+	if err := c.BodyParser(&req); err != nil || req.OrganizationID == uuid.Nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization_id is required"})
+	}
+
+	res, err := h.authService.SetActiveOrganization(c.Context(), personID, sessionID, req.OrganizationID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "forbidden") {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(res)
+}
+
+func (h *AuthHandler) Introspect(c *fiber.Ctx) error {
+	var req IntrospectRequest
+	if err := c.BodyParser(&req); err != nil || req.Token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "missing token"})
+	}
+
+	res, err := h.authService.IntrospectToken(c.Context(), req.Token)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(res)
+}
+
 func (h *AuthHandler) Me(c *fiber.Ctx) error {
-	personID := c.Locals("person_id")
-	if personID == nil {
+	personID, ok := c.Locals("person_id").(uuid.UUID)
+	if !ok {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
 
 	// For now just return IDs. Normally we'd call PersonService.
-	return c.JSON(fiber.Map{
-		"person_id": personID,
-		"email":     c.Locals("email"),
-	})
+	email, _ := c.Locals("email").(string)
+	return c.JSON(MeResponse{PersonID: personID, Email: email})
 }
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type CalculatorHandler struct {
+	calculatorService service.CalculatorService
+}
+
+func NewCalculatorHandler(calculatorService service.CalculatorService) *CalculatorHandler {
+	return &CalculatorHandler{
+		calculatorService: calculatorService,
+	}
+}
+
+// Calculate prices a hypothetical meeting for anyone who asks.
+func (h *CalculatorHandler) Calculate(c *fiber.Ctx) error {
+	var req service.CalculateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := h.calculatorService.Calculate(req)
+	if err != nil {
+		if strings.HasPrefix(strings.ToLower(err.Error()), "invalid") {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(res)
+}
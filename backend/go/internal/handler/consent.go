@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/service"
@@ -40,6 +42,12 @@ func (h *ConsentHandler) UpdateConsent(c *fiber.Ctx) error {
 	req.IPAddress = c.IP()
 	req.UserAgent = string(c.Request().Header.UserAgent())
 
+	// A Global Privacy Control signal is a do-not-sell request under CCPA
+	if c.Get("Sec-GPC") == "1" {
+		optOut := true
+		req.DoNotSell = &optOut
+	}
+
 	// If there's a person in locals (from auth middleware), set it
 	if personIDStr, ok := c.Locals("personID").(string); ok {
 		if id, err := uuid.Parse(personIDStr); err == nil {
@@ -49,13 +57,38 @@ func (h *ConsentHandler) UpdateConsent(c *fiber.Ctx) error {
 
 	consent, err := h.service.UpdateConsent(c.Context(), req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return consentError(c, err)
 	}
 
 	return c.JSON(consent)
 }
 
 func (h *ConsentHandler) GetHistory(c *fiber.Ctx) error {
+	history, ok, err := h.history(c)
+	if !ok {
+		return err
+	}
+	return c.JSON(history)
+}
+
+// GetHistoryV2 is GetHistory with page and page_size parameters and
+// pagination metadata.
+func (h *ConsentHandler) GetHistoryV2(c *fiber.Ctx) error {
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	history, ok, err := h.history(c)
+	if !ok {
+		return err
+	}
+	return c.JSON(paginate(history, pagination))
+}
+
+// history loads the consent history for GetHistory and GetHistoryV2. When ok
+// is false the error response has already been written.
+func (h *ConsentHandler) history(c *fiber.Ctx) ([]*service.ConsentDTO, bool, error) {
 	sessionID := c.Query("session_id")
 
 	var personID *uuid.UUID
@@ -66,15 +99,15 @@ func (h *ConsentHandler) GetHistory(c *fiber.Ctx) error {
 	}
 
 	if sessionID == "" && personID == nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "sessionID or authenticated user required"})
+		return nil, false, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "sessionID or authenticated user required"})
 	}
 
 	history, err := h.service.GetConsentHistory(c.Context(), sessionID, personID)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return nil, false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	return c.JSON(history)
+	return history, true, nil
 }
 func (h *ConsentHandler) SyncConsent(c *fiber.Ctx) error {
 	sessionID := c.Query("session_id")
@@ -98,3 +131,78 @@ func (h *ConsentHandler) SyncConsent(c *fiber.Ctx) error {
 
 	return c.SendStatus(fiber.StatusOK)
 }
+
+// SetDoNotSell records a session's CCPA/CPRA do-not-sell-or-share choice.
+func (h *ConsentHandler) SetDoNotSell(c *fiber.Ctx) error {
+	var req service.SetDoNotSellRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+	if personIDStr, ok := c.Locals("personID").(string); ok {
+		if id, err := uuid.Parse(personIDStr); err == nil {
+			req.PersonID = &id
+		}
+	}
+
+	consent, err := h.service.SetDoNotSell(c.Context(), req)
+	if err != nil {
+		return consentError(c, err)
+	}
+	return c.JSON(consent)
+}
+
+// GetBanner returns the consent banner's policy version, copy, links and
+// categories, and with session_id whether that session must be asked.
+func (h *ConsentHandler) GetBanner(c *fiber.Ctx) error {
+	banner, err := h.service.GetBanner(c.Context(), c.Query("session_id"))
+	if err != nil {
+		return consentError(c, err)
+	}
+	return c.JSON(banner)
+}
+
+// ListCategories returns the consent categories people can grant.
+func (h *ConsentHandler) ListCategories(c *fiber.Ctx) error {
+	categories, err := h.service.ListCategories(c.Context())
+	if err != nil {
+		return consentError(c, err)
+	}
+	return c.JSON(categories)
+}
+
+// SaveCategory creates or updates the consent category with the key in the
+// path.
+func (h *ConsentHandler) SaveCategory(c *fiber.Ctx) error {
+	var req service.SaveConsentCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	category, err := h.service.SaveCategory(c.Context(), c.Params("key"), req)
+	if err != nil {
+		return consentError(c, err)
+	}
+	return c.JSON(category)
+}
+
+// DeleteCategory removes a configured consent category.
+func (h *ConsentHandler) DeleteCategory(c *fiber.Ctx) error {
+	if err := h.service.DeleteCategory(c.Context(), c.Params("key")); err != nil {
+		return consentError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func consentError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type CostAlertHandler struct {
+	alertService service.CostAlertService
+}
+
+func NewCostAlertHandler(alertService service.CostAlertService) *CostAlertHandler {
+	return &CostAlertHandler{
+		alertService: alertService,
+	}
+}
+
+// ListAlerts returns the current user's cost alerts in the organization.
+func (h *CostAlertHandler) ListAlerts(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.alertService.ListAlerts(c.Context(), orgID, personID)
+	if err != nil {
+		return costAlertError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *CostAlertHandler) CreateAlert(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.CreateCostAlertRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := h.alertService.CreateAlert(c.Context(), orgID, personID, req)
+	if err != nil {
+		return costAlertError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+func (h *CostAlertHandler) DeleteAlert(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	alertID, err := uuid.Parse(c.Params("alertId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid alert id"})
+	}
+
+	if err := h.alertService.DeleteAlert(c.Context(), orgID, alertID, personID); err != nil {
+		return costAlertError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func costAlertError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
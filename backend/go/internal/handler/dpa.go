@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type DPAHandler struct {
+	dpaService service.DPAService
+}
+
+func NewDPAHandler(dpaService service.DPAService) *DPAHandler {
+	return &DPAHandler{
+		dpaService: dpaService,
+	}
+}
+
+// GetDPA returns the current data processing agreement and the
+// organization's acceptances.
+func (h *DPAHandler) GetDPA(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.dpaService.GetDPA(c.Context(), orgID, personID)
+	if err != nil {
+		return dpaError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// AcceptDPA accepts the current data processing agreement for the
+// organization.
+func (h *DPAHandler) AcceptDPA(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.AcceptDPARequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.dpaService.AcceptDPA(c.Context(), orgID, personID, req)
+	if err != nil {
+		return dpaError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ListSubProcessors returns the platform's sub-processors.
+func (h *DPAHandler) ListSubProcessors(c *fiber.Ctx) error {
+	return c.JSON(h.dpaService.ListSubProcessors(c.Context()))
+}
+
+func dpaError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	apperrors "github.com/yourorg/meeting-cost/backend/go/internal/errors"
+)
+
+// asDomainError returns the DomainError err wraps, if any.
+func asDomainError(err error) (*apperrors.DomainError, bool) {
+	var de *apperrors.DomainError
+	ok := errors.As(err, &de)
+	return de, ok
+}
+
+// domainError sends de with the status its code maps to. The body keeps
+// the {"error": message} shape, adding the code and any details.
+func domainError(c *fiber.Ctx, de *apperrors.DomainError) error {
+	body := fiber.Map{"error": de.Message, "code": de.Code}
+	if de.Details != nil {
+		body["details"] = de.Details
+	}
+	return c.Status(apperrors.StatusCodeFor(de.Code)).JSON(body)
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// ExtensionHandler serves the browser extension's API, and the account
+// routes people manage its tokens with.
+type ExtensionHandler struct {
+	extensionService service.ExtensionService
+}
+
+func NewExtensionHandler(extensionService service.ExtensionService) *ExtensionHandler {
+	return &ExtensionHandler{
+		extensionService: extensionService,
+	}
+}
+
+func (h *ExtensionHandler) CreateToken(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	var req service.CreateExtensionTokenRequest
+	if err := c.BodyParser(&req); err != nil || req.OrganizationID == uuid.Nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "missing organization_id"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.extensionService.CreateToken(c.Context(), personID, req)
+	if err != nil {
+		return extensionError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+func (h *ExtensionHandler) ListTokens(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	res, err := h.extensionService.ListTokens(c.Context(), personID)
+	if err != nil {
+		return extensionError(c, err)
+	}
+	return c.JSON(res)
+}
+
+func (h *ExtensionHandler) RevokeToken(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	tokenID, err := uuid.Parse(c.Params("tokenId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid token ID"})
+	}
+
+	if err := h.extensionService.RevokeToken(c.Context(), personID, tokenID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return extensionError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Start tracks the call in the extension's tab.
+func (h *ExtensionHandler) Start(c *fiber.Ctx) error {
+	id := c.Locals("extension").(service.ExtensionIdentity)
+
+	var req service.ExtensionStartRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := h.extensionService.Start(c.Context(), id, req)
+	if err != nil {
+		return extensionError(c, err)
+	}
+	return c.JSON(res)
+}
+
+func (h *ExtensionHandler) Stop(c *fiber.Ctx) error {
+	id := c.Locals("extension").(service.ExtensionIdentity)
+	meetingID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	res, err := h.extensionService.Stop(c.Context(), id, meetingID)
+	if err != nil {
+		return extensionError(c, err)
+	}
+	return c.JSON(res)
+}
+
+// Tick takes the attendee count the extension sees, if any, and returns
+// the cost so far.
+func (h *ExtensionHandler) Tick(c *fiber.Ctx) error {
+	id := c.Locals("extension").(service.ExtensionIdentity)
+	meetingID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	var req service.ExtensionTickRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+	}
+
+	res, err := h.extensionService.Tick(c.Context(), id, meetingID, req)
+	if err != nil {
+		return extensionError(c, err)
+	}
+	return c.JSON(res)
+}
+
+func (h *ExtensionHandler) Cost(c *fiber.Ctx) error {
+	id := c.Locals("extension").(service.ExtensionIdentity)
+	meetingID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	res, err := h.extensionService.Cost(c.Context(), id, meetingID)
+	if err != nil {
+		return extensionError(c, err)
+	}
+	return c.JSON(res)
+}
+
+// extensionError maps an ExtensionService error to a response.
+func extensionError(c *fiber.Ctx, err error) error {
+	if de, ok := asDomainError(err); ok {
+		return domainError(c, de)
+	}
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"), strings.Contains(msg, "already active"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (h *OrganizationHandler) ListHolidays(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	year := 0
+	if v := c.Query("year"); v != "" {
+		if year, err = strconv.Atoi(v); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid year"})
+		}
+	}
+
+	res, err := h.orgService.ListHolidays(c.Context(), orgID, personID, year)
+	if err != nil {
+		return holidayError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *OrganizationHandler) CreateHoliday(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.HolidayRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.orgService.CreateHoliday(c.Context(), orgID, personID, req)
+	if err != nil {
+		return holidayError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+func (h *OrganizationHandler) UpdateHoliday(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	holidayID, err := uuid.Parse(c.Params("holidayId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid holiday id"})
+	}
+
+	var req service.HolidayRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.orgService.UpdateHoliday(c.Context(), orgID, holidayID, personID, req)
+	if err != nil {
+		return holidayError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *OrganizationHandler) DeleteHoliday(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	holidayID, err := uuid.Parse(c.Params("holidayId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid holiday id"})
+	}
+
+	if err := h.orgService.DeleteHoliday(c.Context(), orgID, holidayID, personID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return holidayError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func holidayError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type IntegrationHandler struct {
+	integrationService service.IntegrationService
+}
+
+func NewIntegrationHandler(integrationService service.IntegrationService) *IntegrationHandler {
+	return &IntegrationHandler{
+		integrationService: integrationService,
+	}
+}
+
+// ListIntegrations returns every provider with the organization's
+// connection status.
+func (h *IntegrationHandler) ListIntegrations(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.integrationService.ListIntegrations(c.Context(), orgID, personID)
+	if err != nil {
+		return integrationError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *IntegrationHandler) GetIntegration(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.integrationService.GetIntegration(c.Context(), orgID, c.Params("provider"), personID)
+	if err != nil {
+		return integrationError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *IntegrationHandler) ConnectIntegration(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.ConnectIntegrationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.integrationService.ConnectIntegration(c.Context(), orgID, c.Params("provider"), personID, req)
+	if err != nil {
+		return integrationError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *IntegrationHandler) DisconnectIntegration(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.integrationService.DisconnectIntegration(c.Context(), orgID, c.Params("provider"), personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return integrationError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *IntegrationHandler) UpdateSettings(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.UpdateIntegrationSettingsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.integrationService.UpdateIntegrationSettings(c.Context(), orgID, c.Params("provider"), personID, req)
+	if err != nil {
+		return integrationError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func integrationError(c *fiber.Ctx, err error) error {
+	if de, ok := asDomainError(err); ok {
+		return domainError(c, de)
+	}
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
@@ -8,6 +9,21 @@ import (
 	"github.com/yourorg/meeting-cost/backend/go/internal/service"
 )
 
+// UpdateAttendeesRequest is the body of UpdateAttendeeCount.
+type UpdateAttendeesRequest struct {
+	Count int `json:"count"`
+}
+
+// UpdateAverageWageRequest is the body of UpdateAverageWage.
+type UpdateAverageWageRequest struct {
+	AverageWage *float64 `json:"average_wage"`
+}
+
+// UpdatePurposeRequest is the body of UpdatePurpose.
+type UpdatePurposeRequest struct {
+	Purpose string `json:"purpose"`
+}
+
 type MeetingHandler struct {
 	meetingService service.MeetingService
 }
@@ -31,10 +47,26 @@ func (h *MeetingHandler) CreateMeeting(c *fiber.Ctx) error {
 
 	meeting, err := h.meetingService.CreateMeeting(c.Context(), req.OrganizationID, personID, req)
 	if err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "forbidden") {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return meetingError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(meeting)
+}
+
+// CreateCompletedMeeting records a meeting that has already ended.
+func (h *MeetingHandler) CreateCompletedMeeting(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	var req service.CreateCompletedMeetingRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	meeting, err := h.meetingService.CreateCompletedMeeting(c.Context(), req.OrganizationID, personID, req)
+	if err != nil {
+		return meetingError(c, err)
 	}
 
 	return c.Status(fiber.StatusCreated).JSON(meeting)
@@ -47,7 +79,21 @@ func (h *MeetingHandler) GetMeeting(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
 	}
 
-	meeting, err := h.meetingService.GetMeeting(c.Context(), id, personID)
+	var expand service.MeetingExpand
+	if raw := c.Query("expand"); raw != "" {
+		for _, field := range strings.Split(raw, ",") {
+			switch strings.TrimSpace(field) {
+			case "increments":
+				expand.Increments = true
+			case "participants":
+				expand.Participants = true
+			default:
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid expand value: " + field})
+			}
+		}
+	}
+
+	meeting, err := h.meetingService.GetMeeting(c.Context(), id, personID, expand)
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
 	}
@@ -63,10 +109,10 @@ func (h *MeetingHandler) StartMeeting(c *fiber.Ctx) error {
 	}
 
 	if err := h.meetingService.StartMeeting(c.Context(), id, personID); err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "forbidden") {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		if de, ok := asDomainError(err); ok {
+			return domainError(c, de)
 		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return meetingError(c, err)
 	}
 
 	return c.SendStatus(fiber.StatusNoContent)
@@ -96,9 +142,7 @@ func (h *MeetingHandler) UpdateAttendeeCount(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
 	}
 
-	var req struct {
-		Count int `json:"count"`
-	}
+	var req UpdateAttendeesRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
 	}
@@ -113,6 +157,187 @@ func (h *MeetingHandler) UpdateAttendeeCount(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+// UpdateAverageWage changes the blended hourly rate of a running meeting.
+func (h *MeetingHandler) UpdateAverageWage(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	var req UpdateAverageWageRequest
+	if err := c.BodyParser(&req); err != nil || req.AverageWage == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "average_wage is required"})
+	}
+
+	if err := h.meetingService.UpdateAverageWage(c.Context(), id, *req.AverageWage, personID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// UpdatePurpose records a change of the meeting's topic.
+func (h *MeetingHandler) UpdatePurpose(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	var req UpdatePurposeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	if err := h.meetingService.UpdatePurpose(c.Context(), id, req.Purpose, personID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// UpdateIncrement changes a running meeting's attendee count, average wage
+// and purpose together.
+func (h *MeetingHandler) UpdateIncrement(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	var req service.UpdateIncrementRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	if err := h.meetingService.UpdateIncrement(c.Context(), id, personID, req); err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// CorrectIncrement fixes a closed increment of the meeting.
+func (h *MeetingHandler) CorrectIncrement(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+	incID, err := uuid.Parse(c.Params("incId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid increment id"})
+	}
+
+	var req service.CorrectIncrementRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.meetingService.CorrectIncrement(c.Context(), id, incID, personID, req)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// FinalizeMeeting locks the stopped meeting's increments and totals.
+func (h *MeetingHandler) FinalizeMeeting(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	res, err := h.meetingService.FinalizeMeeting(c.Context(), id, personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// UpdateMeeting changes a meeting's purpose and plans.
+func (h *MeetingHandler) UpdateMeeting(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	var req service.UpdateMeetingRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := h.meetingService.UpdateMeeting(c.Context(), id, personID, req)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ApproveMeeting approves a meeting awaiting approval.
+func (h *MeetingHandler) ApproveMeeting(c *fiber.Ctx) error {
+	return h.decideApproval(c, true)
+}
+
+// RejectMeeting rejects a meeting awaiting approval.
+func (h *MeetingHandler) RejectMeeting(c *fiber.Ctx) error {
+	return h.decideApproval(c, false)
+}
+
+func (h *MeetingHandler) decideApproval(c *fiber.Ctx, approve bool) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	var req service.ApprovalDecisionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	decide := h.meetingService.RejectMeeting
+	if approve {
+		decide = h.meetingService.ApproveMeeting
+	}
+	res, err := decide(c.Context(), id, personID, req)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// UndoLastChange reverts the meeting's last change while it runs.
+func (h *MeetingHandler) UndoLastChange(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	res, err := h.meetingService.UndoLastChange(c.Context(), id, personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
 func (h *MeetingHandler) GetMeetingCost(c *fiber.Ctx) error {
 	personID := c.Locals("person_id").(uuid.UUID)
 	id, err := uuid.Parse(c.Params("id"))
@@ -131,14 +356,9 @@ func (h *MeetingHandler) GetMeetingCost(c *fiber.Ctx) error {
 func (h *MeetingHandler) ListMeetings(c *fiber.Ctx) error {
 	personID := c.Locals("person_id").(uuid.UUID)
 
-	orgIDStr := c.Query("organization_id")
-	if orgIDStr == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization_id is required"})
-	}
-
-	orgID, err := uuid.Parse(orgIDStr)
+	orgID, err := queryOrganization(c)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization_id"})
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
 	filters := service.MeetingFilters{}
@@ -151,19 +371,231 @@ func (h *MeetingHandler) ListMeetings(c *fiber.Ctx) error {
 
 	return c.JSON(res)
 }
-func (h *MeetingHandler) DeleteMeeting(c *fiber.Ctx) error {
+
+// ListMeetingsV2 is ListMeetings with page and page_size parameters and
+// pagination metadata.
+func (h *MeetingHandler) ListMeetingsV2(c *fiber.Ctx) error {
 	personID := c.Locals("person_id").(uuid.UUID)
-	id, err := uuid.Parse(c.Params("id"))
+
+	orgID, err := queryOrganization(c)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	if err := h.meetingService.DeleteMeeting(c.Context(), id, personID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	res, total, err := h.meetingService.ListMeetings(c.Context(), orgID, personID, service.MeetingFilters{}, pagination)
+	if err != nil {
 		if strings.Contains(strings.ToLower(err.Error()), "forbidden") {
 			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	return c.JSON(newPage(res, pagination, total))
+}
+
+func (h *MeetingHandler) DeleteMeeting(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	if err := h.meetingService.DeleteMeeting(c.Context(), id, personID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return meetingError(c, err)
+	}
+
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+// BulkDeleteMeetings deletes the organization's meetings listed or matched
+// by a filter.
+func (h *MeetingHandler) BulkDeleteMeetings(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.BulkDeleteMeetingsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.meetingService.BulkDeleteMeetings(c.Context(), orgID, personID, req)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// Sync applies the meeting operations a mobile client recorded offline.
+func (h *MeetingHandler) Sync(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	var req service.SyncRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.meetingService.Sync(c.Context(), personID, req)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ExportIncrements sends the meeting's increment history as a file to
+// download.
+func (h *MeetingHandler) ExportIncrements(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	file, err := h.meetingService.ExportIncrements(c.Context(), id, personID, c.Query("format"))
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Attachment(file.Name)
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	return c.Send(file.Data)
+}
+
+// ListDeletedMeetings returns the organization's 100 most recently deleted
+// meetings that are yet to be purged.
+func (h *MeetingHandler) ListDeletedMeetings(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination := service.Pagination{Page: 1, PageSize: 100}
+
+	res, _, err := h.meetingService.ListDeletedMeetings(c.Context(), orgID, personID, pagination)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ListDeletedMeetingsV2 is ListDeletedMeetings with page and page_size
+// parameters and pagination metadata.
+func (h *MeetingHandler) ListDeletedMeetingsV2(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	res, total, err := h.meetingService.ListDeletedMeetings(c.Context(), orgID, personID, pagination)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(newPage(res, pagination, total))
+}
+
+// ShareMeeting returns a token that lets people outside the organization
+// check in to the meeting.
+func (h *MeetingHandler) ShareMeeting(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	res, err := h.meetingService.ShareMeeting(c.Context(), id, personID)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+// CheckIn records the caller as present in the meeting. The token
+// parameter is a share token, for people outside the organization.
+func (h *MeetingHandler) CheckIn(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	res, err := h.meetingService.CheckIn(c.Context(), id, personID, c.Query("token"))
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// CheckOut records the caller as gone from the meeting.
+func (h *MeetingHandler) CheckOut(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	res, err := h.meetingService.CheckOut(c.Context(), id, personID, c.Query("token"))
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// GetCheckInCode returns a QR code, as a PNG, of a short-lived check-in
+// link for the meeting.
+func (h *MeetingHandler) GetCheckInCode(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	code, err := h.meetingService.CheckInCode(c.Context(), id, personID)
+	if err != nil {
+		return meetingError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "image/png")
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	c.Set(fiber.HeaderExpires, code.ExpiresAt.UTC().Format(http.TimeFormat))
+	c.Set("X-Checkin-Url", code.URL)
+	return c.Send(code.PNG)
+}
+
+func meetingError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// PushKeyResponse is the body of GetPushKey.
+type PushKeyResponse struct {
+	PublicKey string `json:"public_key"`
+}
+
+// UnsubscribeResponse is the body of Unsubscribe.
+type UnsubscribeResponse struct {
+	Event   string `json:"event"`
+	Message string `json:"message"`
+}
+
+type NotificationHandler struct {
+	notificationService service.NotificationService
+}
+
+func NewNotificationHandler(notificationService service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+func (h *NotificationHandler) GetPreferences(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	res, err := h.notificationService.GetPreferences(c.Context(), personID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(res)
+}
+
+func (h *NotificationHandler) UpdatePreferences(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	var req service.UpdateNotificationPreferencesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := h.notificationService.UpdatePreferences(c.Context(), personID, req)
+	if err != nil {
+		return notificationError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// GetPushKey returns the VAPID public key browsers pass to
+// pushManager.subscribe as applicationServerKey.
+func (h *NotificationHandler) GetPushKey(c *fiber.Ctx) error {
+	key := h.notificationService.PushPublicKey()
+	if key == "" {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "web push is not configured"})
+	}
+
+	return c.JSON(PushKeyResponse{PublicKey: key})
+}
+
+func (h *NotificationHandler) AddPushSubscription(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	var req service.PushSubscriptionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.notificationService.AddPushSubscription(c.Context(), personID, req)
+	if err != nil {
+		return notificationError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+func (h *NotificationHandler) RemovePushSubscription(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid subscription id"})
+	}
+
+	if err := h.notificationService.RemovePushSubscription(c.Context(), personID, id); err != nil {
+		return notificationError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Unsubscribe turns off the email notification named by the token of an
+// unsubscribe link. It needs no sign-in, and answers POST for one-click
+// unsubscribe as well as GET.
+func (h *NotificationHandler) Unsubscribe(c *fiber.Ctx) error {
+	event, err := h.notificationService.Unsubscribe(c.Context(), c.Query("token"))
+	if err != nil {
+		return notificationError(c, err)
+	}
+
+	return c.JSON(UnsubscribeResponse{
+		Event:   event,
+		Message: "You will no longer receive these emails.",
+	})
+}
+
+func notificationError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
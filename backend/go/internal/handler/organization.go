@@ -8,6 +8,16 @@ import (
 	"github.com/yourorg/meeting-cost/backend/go/internal/service"
 )
 
+// UpdateWageRequest is the body of UpdateMemberWage.
+type UpdateWageRequest struct {
+	Wage float64 `json:"wage"`
+}
+
+// MeetingApproverRequest is the body of SetMeetingApprover.
+type MeetingApproverRequest struct {
+	Approver bool `json:"approver"`
+}
+
 type OrganizationHandler struct {
 	orgService service.OrganizationService
 }
@@ -63,6 +73,62 @@ func (h *OrganizationHandler) ListOrganizations(c *fiber.Ctx) error {
 	return c.JSON(res)
 }
 
+// ListOrganizationsV2 is ListOrganizations with page and page_size
+// parameters and pagination metadata.
+func (h *OrganizationHandler) ListOrganizationsV2(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	res, err := h.orgService.ListOrganizations(c.Context(), personID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(paginate(res, pagination))
+}
+
+// DiscoverOrganizations searches the organizations the signed-in person
+// can join without an invitation.
+func (h *OrganizationHandler) DiscoverOrganizations(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+
+	res, err := h.orgService.DiscoverOrganizations(c.Context(), personID, c.Query("q"))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(res)
+}
+
+func (h *OrganizationHandler) JoinOrganization(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	err = h.orgService.JoinOrganization(c.Context(), orgID, personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		if de, ok := asDomainError(err); ok {
+			return domainError(c, de)
+		}
+		msg := strings.ToLower(err.Error())
+		switch {
+		case strings.Contains(msg, "not found"):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case strings.Contains(msg, "already a member"):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.SendStatus(fiber.StatusCreated)
+}
+
 func (h *OrganizationHandler) UpdateOrganization(c *fiber.Ctx) error {
 	personID := c.Locals("person_id").(uuid.UUID)
 	orgID, err := uuid.Parse(c.Params("id"))
@@ -80,6 +146,9 @@ func (h *OrganizationHandler) UpdateOrganization(c *fiber.Ctx) error {
 
 	res, err := h.orgService.UpdateOrganization(c.Context(), orgID, personID, req)
 	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid") {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
 	}
 
@@ -101,6 +170,28 @@ func (h *OrganizationHandler) GetMembers(c *fiber.Ctx) error {
 	return c.JSON(res)
 }
 
+// GetMembersV2 is GetMembers with page and page_size parameters and
+// pagination metadata.
+func (h *OrganizationHandler) GetMembersV2(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	res, err := h.orgService.GetMembers(c.Context(), orgID, personID)
+	if err != nil {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(paginate(res, pagination))
+}
+
 func (h *OrganizationHandler) AddMember(c *fiber.Ctx) error {
 	personID := c.Locals("person_id").(uuid.UUID)
 	orgID, err := uuid.Parse(c.Params("id"))
@@ -118,8 +209,15 @@ func (h *OrganizationHandler) AddMember(c *fiber.Ctx) error {
 
 	err = h.orgService.AddMember(c.Context(), orgID, personID, req)
 	if err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "forbidden") {
+		if de, ok := asDomainError(err); ok {
+			return domainError(c, de)
+		}
+		msg := strings.ToLower(err.Error())
+		switch {
+		case strings.Contains(msg, "forbidden"):
 			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case strings.HasPrefix(msg, "invalid"):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
@@ -157,17 +255,50 @@ func (h *OrganizationHandler) UpdateMemberWage(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid member id"})
 	}
 
-	var req struct {
-		Wage float64 `json:"wage"`
-	}
+	var req UpdateWageRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
 	}
 
 	err = h.orgService.UpdateMemberWage(c.Context(), orgID, memberID, req.Wage, personID, c.IP(), string(c.Request().Header.UserAgent()))
 	if err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "forbidden") {
+		msg := strings.ToLower(err.Error())
+		switch {
+		case strings.Contains(msg, "forbidden"):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case strings.HasPrefix(msg, "invalid"):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *OrganizationHandler) SetMeetingApprover(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	memberID, err := uuid.Parse(c.Params("memberId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid member id"})
+	}
+
+	var req MeetingApproverRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	err = h.orgService.SetMeetingApprover(c.Context(), orgID, memberID, req.Approver, personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		msg := strings.ToLower(err.Error())
+		switch {
+		case strings.Contains(msg, "forbidden"):
 			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case strings.Contains(msg, "not found"):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
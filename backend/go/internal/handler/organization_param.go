@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// queryOrganization reads the organization_id query parameter, falling back
+// to the session's active organization when the request names none.
+func queryOrganization(c *fiber.Ctx) (uuid.UUID, error) {
+	if s := c.Query("organization_id"); s != "" {
+		orgID, err := uuid.Parse(s)
+		if err != nil {
+			return uuid.Nil, errors.New("invalid organization_id")
+		}
+		return orgID, nil
+	}
+	if orgID, ok := c.Locals("active_organization_id").(uuid.UUID); ok {
+		return orgID, nil
+	}
+	return uuid.Nil, errors.New("organization_id is required without an active organization")
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// Page bounds for the paginated /api/v2 list endpoints.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// Page is a page of a /api/v2 list response.
+type Page[T any] struct {
+	Data []T      `json:"data"`
+	Meta PageMeta `json:"meta"`
+}
+
+// PageMeta locates a Page in the full result.
+type PageMeta struct {
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	Total      int64 `json:"total"`
+	TotalPages int64 `json:"total_pages"`
+}
+
+// parsePagination reads the page and page_size query parameters.
+func parsePagination(c *fiber.Ctx) (service.Pagination, bool) {
+	p := service.Pagination{
+		Page:     c.QueryInt("page", 1),
+		PageSize: c.QueryInt("page_size", defaultPageSize),
+	}
+	if p.Page < 1 || p.PageSize < 1 || p.PageSize > maxPageSize {
+		return p, false
+	}
+	return p, true
+}
+
+func newPage[T any](data []T, p service.Pagination, total int64) Page[T] {
+	if data == nil {
+		data = []T{}
+	}
+	return Page[T]{
+		Data: data,
+		Meta: PageMeta{
+			Page:       p.Page,
+			PageSize:   p.PageSize,
+			Total:      total,
+			TotalPages: (total + int64(p.PageSize) - 1) / int64(p.PageSize),
+		},
+	}
+}
+
+// paginate pages a result the service returns in full.
+func paginate[T any](all []T, p service.Pagination) Page[T] {
+	start := (p.Page - 1) * p.PageSize
+	if start > len(all) {
+		start = len(all)
+	}
+	end := start + p.PageSize
+	if end > len(all) {
+		end = len(all)
+	}
+	return newPage(all[start:end], p, int64(len(all)))
+}
+
+func invalidPagination(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "page must be at least 1 and page_size between 1 and 100"})
+}
@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// Bounds of the limit parameter of the top meetings and effectiveness
+// reports.
+const (
+	defaultTopMeetings = 10
+	maxTopMeetings     = 50
+)
+
+type ReportHandler struct {
+	reportService service.ReportService
+	exportService service.ReportExportService
+}
+
+func NewReportHandler(reportService service.ReportService, exportService service.ReportExportService) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+		exportService: exportService,
+	}
+}
+
+// CreateReportExportRequest asks for a report as a file. The parameters
+// are those of the report's endpoint.
+type CreateReportExportRequest struct {
+	Report string `json:"report"` // summary, trends, top-meetings or effectiveness
+	Format string `json:"format"` // pdf or xlsx
+	// From and To are dates (YYYY-MM-DD) or RFC 3339 times
+	From     string `json:"from,omitempty"`
+	To       string `json:"to,omitempty"`
+	Interval string `json:"interval,omitempty"`
+	Compare  bool   `json:"compare,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+}
+
+// GetSummary returns the organization's cost summary for the from and to
+// parameters.
+func (h *ReportHandler) GetSummary(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	r, err := parseReportRange(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.reportService.GetSummary(c.Context(), orgID, personID, r)
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// GetDashboard returns the organization's home screen numbers.
+func (h *ReportHandler) GetDashboard(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.reportService.GetDashboard(c.Context(), orgID, personID)
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// GetTrend returns the organization's cost by day, week or month for the
+// from, to, interval and compare parameters.
+func (h *ReportHandler) GetTrend(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	r, err := parseReportRange(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.reportService.GetTrend(c.Context(), orgID, personID, service.TrendRequest{
+		ReportRange: r,
+		Interval:    c.Query("interval"),
+		Compare:     c.QueryBool("compare"),
+	})
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// GetTopMeetings returns the organization's most expensive meetings and
+// recurring series for the from, to and limit parameters.
+func (h *ReportHandler) GetTopMeetings(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	r, err := parseReportRange(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	limit, err := topMeetingsLimit(c.QueryInt("limit", defaultTopMeetings))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.reportService.GetTopMeetings(c.Context(), orgID, personID, service.TopMeetingsRequest{
+		ReportRange: r,
+		Limit:       limit,
+	})
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// GetEffectiveness weighs meeting cost against participants' ratings.
+func (h *ReportHandler) GetEffectiveness(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	r, err := parseReportRange(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	limit, err := topMeetingsLimit(c.QueryInt("limit", defaultTopMeetings))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.reportService.GetEffectiveness(c.Context(), orgID, personID, service.TopMeetingsRequest{
+		ReportRange: r,
+		Limit:       limit,
+	})
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// CreateExport queues a report to be rendered as a PDF or XLSX file.
+func (h *ReportHandler) CreateExport(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req CreateReportExportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	var r service.ReportRange
+	if r.From, r.FromDate, err = parseReportTime("from", req.From, false); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if r.To, r.ToDate, err = parseReportTime("to", req.To, true); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultTopMeetings
+	}
+	limit, err := topMeetingsLimit(req.Limit)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.exportService.RequestExport(c.Context(), orgID, personID, service.ReportExportRequest{
+		Report:      req.Report,
+		Format:      req.Format,
+		ReportRange: r,
+		Interval:    req.Interval,
+		Compare:     req.Compare,
+		Limit:       limit,
+	})
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(res)
+}
+
+// GetExport returns an export's status and, once ready, its download link.
+func (h *ReportHandler) GetExport(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	exportID, err := uuid.Parse(c.Params("exportId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid export id"})
+	}
+
+	res, err := h.exportService.GetExport(c.Context(), orgID, personID, exportID)
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// DownloadExport sends the file of a ready export to anyone holding its
+// signed link.
+func (h *ReportHandler) DownloadExport(c *fiber.Ctx) error {
+	file, err := h.exportService.Download(c.Context(), c.Query("token"))
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Attachment(file.Name)
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	return c.Send(file.Data)
+}
+
+// topMeetingsLimit checks the limit parameter of the top meetings and
+// effectiveness reports.
+func topMeetingsLimit(limit int) (int, error) {
+	if limit < 1 || limit > maxTopMeetings {
+		return 0, fmt.Errorf("invalid limit: must be between 1 and 50")
+	}
+	return limit, nil
+}
+
+// parseReportRange reads the from and to parameters, each an RFC 3339
+// time with an offset or a date. Dates are days in the report's timezone,
+// and a date for to includes that whole day.
+func parseReportRange(c *fiber.Ctx) (service.ReportRange, error) {
+	var r service.ReportRange
+	var err error
+	if r.From, r.FromDate, err = parseReportTime("from", c.Query("from"), false); err != nil {
+		return r, err
+	}
+	if r.To, r.ToDate, err = parseReportTime("to", c.Query("to"), true); err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+// parseReportTime parses v and reports whether it was a date, which the
+// report service places in the report's timezone.
+func parseReportTime(name, v string, end bool) (time.Time, bool, error) {
+	if v == "" {
+		return time.Time{}, false, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, false, nil
+	}
+	day, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s: must be a date (YYYY-MM-DD) or RFC 3339 time", name)
+	}
+	if end {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day, true, nil
+}
+
+func reportError(c *fiber.Ctx, err error) error {
+	if de, ok := asDomainError(err); ok {
+		return domainError(c, de)
+	}
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// Bounds of the usage endpoint's months parameter.
+const (
+	defaultUsageMonths = 12
+	maxUsageMonths     = 24
+)
+
+type SubscriptionHandler struct {
+	subscriptionService service.SubscriptionService
+	usageService        service.UsageService
+}
+
+func NewSubscriptionHandler(subscriptionService service.SubscriptionService, usageService service.UsageService) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		subscriptionService: subscriptionService,
+		usageService:        usageService,
+	}
+}
+
+func (h *SubscriptionHandler) GetSubscription(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.subscriptionService.GetSubscription(c.Context(), orgID, personID)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *SubscriptionHandler) ChangePlan(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.ChangePlanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.subscriptionService.ChangePlan(c.Context(), orgID, personID, req)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *SubscriptionHandler) CancelSubscription(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.subscriptionService.CancelSubscription(c.Context(), orgID, personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// GetUsage returns the organization's billable usage by month.
+func (h *SubscriptionHandler) GetUsage(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	months := c.QueryInt("months", defaultUsageMonths)
+	if months < 1 || months > maxUsageMonths {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid months: must be between 1 and 24"})
+	}
+
+	res, err := h.usageService.GetUsage(c.Context(), orgID, personID, months)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ListInvoices returns the organization's 100 most recent invoices.
+func (h *SubscriptionHandler) ListInvoices(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination := service.Pagination{Page: 1, PageSize: 100}
+
+	res, _, err := h.subscriptionService.ListInvoices(c.Context(), orgID, personID, pagination)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ListInvoicesV2 is ListInvoices with page and page_size parameters and
+// pagination metadata.
+func (h *SubscriptionHandler) ListInvoicesV2(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	res, total, err := h.subscriptionService.ListInvoices(c.Context(), orgID, personID, pagination)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(newPage(res, pagination, total))
+}
+
+// ListPayments returns the organization's 100 most recent payments.
+func (h *SubscriptionHandler) ListPayments(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination := service.Pagination{Page: 1, PageSize: 100}
+
+	res, _, err := h.subscriptionService.ListPayments(c.Context(), orgID, personID, pagination)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ListPaymentsV2 is ListPayments with page and page_size parameters and
+// pagination metadata.
+func (h *SubscriptionHandler) ListPaymentsV2(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	res, total, err := h.subscriptionService.ListPayments(c.Context(), orgID, personID, pagination)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(newPage(res, pagination, total))
+}
+
+// CreateCheckoutSession returns a Stripe Checkout link for a paid plan.
+func (h *SubscriptionHandler) CreateCheckoutSession(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.CheckoutRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := h.subscriptionService.CreateCheckoutSession(c.Context(), orgID, personID, req)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+// CreatePortalSession returns a Stripe Customer Portal link.
+func (h *SubscriptionHandler) CreatePortalSession(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.PortalRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+	}
+
+	res, err := h.subscriptionService.CreatePortalSession(c.Context(), orgID, personID, req)
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+// StripeWebhook receives Stripe's signed events. Any error response makes
+// Stripe retry the event later.
+func (h *SubscriptionHandler) StripeWebhook(c *fiber.Ctx) error {
+	err := h.subscriptionService.HandleStripeEvent(c.Context(), c.Body(), c.Get("Stripe-Signature"))
+	if err != nil {
+		return subscriptionError(c, err)
+	}
+
+	return c.JSON(StripeWebhookResponse{Received: true})
+}
+
+// StripeWebhookResponse acknowledges a Stripe event.
+type StripeWebhookResponse struct {
+	Received bool `json:"received"`
+}
+
+func subscriptionError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not configured"):
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
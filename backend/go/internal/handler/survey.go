@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type SurveyHandler struct {
+	surveyService service.SurveyService
+}
+
+func NewSurveyHandler(surveyService service.SurveyService) *SurveyHandler {
+	return &SurveyHandler{
+		surveyService: surveyService,
+	}
+}
+
+// RateMeetingRequest answers a meeting survey.
+type RateMeetingRequest struct {
+	Rating int `json:"rating"` // 1 (not worth the time) to 5 (time well spent)
+}
+
+// GetSurvey returns the survey of a link's token, with the answer already
+// given through it.
+func (h *SurveyHandler) GetSurvey(c *fiber.Ctx) error {
+	res, err := h.surveyService.GetSurvey(c.Context(), c.Query("token"))
+	if err != nil {
+		return surveyError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// RespondByLink answers the survey of a link's token.
+func (h *SurveyHandler) RespondByLink(c *fiber.Ctx) error {
+	var req RateMeetingRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := h.surveyService.RespondByLink(c.Context(), c.Query("token"), req.Rating)
+	if err != nil {
+		return surveyError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// RateMeeting answers a meeting's survey as the current user.
+func (h *SurveyHandler) RateMeeting(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	var req RateMeetingRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	res, err := h.surveyService.Respond(c.Context(), id, personID, req.Rating)
+	if err != nil {
+		return surveyError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func surveyError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
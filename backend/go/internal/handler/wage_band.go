@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// MemberWageBandRequest is the body of SetMemberWageBand. A null
+// wage_band_id unassigns the member's band.
+type MemberWageBandRequest struct {
+	WageBandID *uuid.UUID `json:"wage_band_id"`
+}
+
+func (h *OrganizationHandler) ListWageBands(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.orgService.ListWageBands(c.Context(), orgID, personID)
+	if err != nil {
+		return wageBandError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *OrganizationHandler) CreateWageBand(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.WageBandRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.orgService.CreateWageBand(c.Context(), orgID, personID, req)
+	if err != nil {
+		return wageBandError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+func (h *OrganizationHandler) UpdateWageBand(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	bandID, err := uuid.Parse(c.Params("bandId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid wage band id"})
+	}
+
+	var req service.WageBandRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.orgService.UpdateWageBand(c.Context(), orgID, bandID, personID, req)
+	if err != nil {
+		return wageBandError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *OrganizationHandler) DeleteWageBand(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	bandID, err := uuid.Parse(c.Params("bandId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid wage band id"})
+	}
+
+	if err := h.orgService.DeleteWageBand(c.Context(), orgID, bandID, personID, c.IP(), string(c.Request().Header.UserAgent())); err != nil {
+		return wageBandError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *OrganizationHandler) SetMemberWageBand(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+	memberID, err := uuid.Parse(c.Params("memberId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid member id"})
+	}
+
+	var req MemberWageBandRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	err = h.orgService.SetMemberWageBand(c.Context(), orgID, memberID, req.WageBandID, personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return wageBandError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func wageBandError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type WageSyncHandler struct {
+	wageSyncService service.WageSyncService
+}
+
+func NewWageSyncHandler(wageSyncService service.WageSyncService) *WageSyncHandler {
+	return &WageSyncHandler{
+		wageSyncService: wageSyncService,
+	}
+}
+
+// PreviewWageSync returns the changes syncing from the HR system would
+// make, without making them.
+func (h *WageSyncHandler) PreviewWageSync(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.wageSyncService.PreviewWageSync(c.Context(), orgID, c.Params("provider"), personID)
+	if err != nil {
+		return wageSyncError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// SyncWages syncs member wages and wage bands from the HR system now.
+func (h *WageSyncHandler) SyncWages(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.wageSyncService.SyncWages(c.Context(), orgID, c.Params("provider"), personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return wageSyncError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func wageSyncError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.HasPrefix(msg, "reading the hr system"):
+		// The HR system's own errors may say anything
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type WarehouseExportHandler struct {
+	warehouseService service.WarehouseExportService
+}
+
+func NewWarehouseExportHandler(warehouseService service.WarehouseExportService) *WarehouseExportHandler {
+	return &WarehouseExportHandler{
+		warehouseService: warehouseService,
+	}
+}
+
+// GetWarehouseExport returns the state of the export to a data warehouse.
+func (h *WarehouseExportHandler) GetWarehouseExport(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.warehouseService.GetWarehouseExport(c.Context(), orgID, c.Params("provider"), personID)
+	if err != nil {
+		return warehouseExportError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// RunWarehouseExport queues an export run now.
+func (h *WarehouseExportHandler) RunWarehouseExport(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.warehouseService.RunWarehouseExport(c.Context(), orgID, c.Params("provider"), personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return warehouseExportError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(res)
+}
+
+// ResetWarehouseExport clears the watermarks so the next run exports
+// everything again.
+func (h *WarehouseExportHandler) ResetWarehouseExport(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.warehouseService.ResetWarehouseExport(c.Context(), orgID, c.Params("provider"), personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return warehouseExportError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// GetWarehouseSchema describes the tables the export writes.
+func (h *WarehouseExportHandler) GetWarehouseSchema(c *fiber.Ctx) error {
+	return c.JSON(h.warehouseService.WarehouseSchema(c.Context()))
+}
+
+func warehouseExportError(c *fiber.Ctx, err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "already running"):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type WebhookHandler struct {
+	webhookService service.WebhookService
+}
+
+func NewWebhookHandler(webhookService service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *WebhookHandler) ListEndpoints(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	res, err := h.webhookService.ListEndpoints(c.Context(), orgID, personID)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (h *WebhookHandler) CreateEndpoint(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	var req service.CreateWebhookEndpointRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+
+	req.IPAddress = c.IP()
+	req.UserAgent = string(c.Request().Header.UserAgent())
+
+	res, err := h.webhookService.CreateEndpoint(c.Context(), orgID, personID, req)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+func (h *WebhookHandler) DeleteEndpoint(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, endpointID, ok := webhookParams(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization or webhook id"})
+	}
+
+	err := h.webhookService.DeleteEndpoint(c.Context(), orgID, endpointID, personID, c.IP(), string(c.Request().Header.UserAgent()))
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// PingEndpoint queues a ping event to the endpoint and returns its delivery.
+func (h *WebhookHandler) PingEndpoint(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, endpointID, ok := webhookParams(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization or webhook id"})
+	}
+
+	res, err := h.webhookService.PingEndpoint(c.Context(), orgID, endpointID, personID)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(res)
+}
+
+// ListDeliveries returns the endpoint's 100 most recent deliveries.
+func (h *WebhookHandler) ListDeliveries(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, endpointID, ok := webhookParams(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization or webhook id"})
+	}
+
+	pagination := service.Pagination{Page: 1, PageSize: 100}
+
+	res, _, err := h.webhookService.ListDeliveries(c.Context(), orgID, endpointID, personID, pagination)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// ListDeliveriesV2 is ListDeliveries with page and page_size parameters and
+// pagination metadata.
+func (h *WebhookHandler) ListDeliveriesV2(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, endpointID, ok := webhookParams(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization or webhook id"})
+	}
+
+	pagination, ok := parsePagination(c)
+	if !ok {
+		return invalidPagination(c)
+	}
+
+	res, total, err := h.webhookService.ListDeliveries(c.Context(), orgID, endpointID, personID, pagination)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.JSON(newPage(res, pagination, total))
+}
+
+// Redeliver queues a past delivery's event again and returns the new
+// delivery.
+func (h *WebhookHandler) Redeliver(c *fiber.Ctx) error {
+	personID := c.Locals("person_id").(uuid.UUID)
+	orgID, endpointID, ok := webhookParams(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization or webhook id"})
+	}
+	deliveryID, err := uuid.Parse(c.Params("deliveryId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid delivery id"})
+	}
+
+	res, err := h.webhookService.Redeliver(c.Context(), orgID, endpointID, deliveryID, personID)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(res)
+}
+
+func webhookParams(c *fiber.Ctx) (orgID, endpointID uuid.UUID, ok bool) {
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return orgID, endpointID, false
+	}
+	endpointID, err = uuid.Parse(c.Params("webhookId"))
+	return orgID, endpointID, err == nil
+}
+
+func webhookError(c *fiber.Ctx, err error) error {
+	if de, ok := asDomainError(err); ok {
+		return domainError(c, de)
+	}
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case strings.Contains(msg, "not found"):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case strings.HasPrefix(msg, "invalid"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
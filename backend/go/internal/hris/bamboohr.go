@@ -0,0 +1,53 @@
+package hris
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const bambooHRAPI = "https://api.bamboohr.com/api/gateway.php/"
+
+// BambooHR reads employees through a BambooHR custom report. The API key
+// needs access to the fields read, e.g. workEmail and payRate.
+type BambooHR struct {
+	subdomain string
+	apiKey    string
+	client    *http.Client
+}
+
+func NewBambooHR(subdomain, apiKey string, timeout time.Duration) *BambooHR {
+	return &BambooHR{subdomain: subdomain, apiKey: apiKey, client: &http.Client{Timeout: timeout}}
+}
+
+func (b *BambooHR) Records(ctx context.Context, fields []string) ([]Record, error) {
+	body, err := json.Marshal(map[string]interface{}{"title": "Meeting cost wage sync", "fields": fields})
+	if err != nil {
+		return nil, err
+	}
+	endpoint := bambooHRAPI + url.PathEscape(b.subdomain) + "/v1/reports/custom?format=JSON&onlyCurrent=true"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	// The API key is the user name; the password is ignored
+	req.SetBasicAuth(b.apiKey, "x")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	raw, err := fetch(b.client, req)
+	if err != nil {
+		return nil, fmt.Errorf("bamboohr: %w", err)
+	}
+	var report struct {
+		Employees []map[string]interface{} `json:"employees"`
+	}
+	if err := json.Unmarshal(raw, &report); err != nil {
+		return nil, fmt.Errorf("bamboohr: decoding report: %w", err)
+	}
+	return jsonRecords(report.Employees), nil
+}
@@ -0,0 +1,140 @@
+package hris
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/outbound"
+	"golang.org/x/crypto/ssh"
+)
+
+// File reads employees from a CSV file, one per row after a header row
+// naming the fields, fetched over HTTPS or SFTP.
+//
+// An https:// file is fetched with the secret, if any, as a bearer token.
+// An sftp://user@host[:port]/path file is read signing in with the secret
+// as the password, and only from the server whose public key is hostKey.
+// Either is only read from a public address, without following redirects.
+type File struct {
+	url     *url.URL
+	secret  string
+	hostKey ssh.PublicKey
+	timeout time.Duration
+}
+
+// NewFile checks fileURL, and hostKey for SFTP, given in authorized_keys
+// format.
+func NewFile(fileURL, secret, hostKey string, timeout time.Duration) (*File, error) {
+	u, err := url.Parse(strings.TrimSpace(fileURL))
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("url must be an https:// or sftp:// URL")
+	}
+	f := &File{url: u, secret: secret, timeout: timeout}
+	switch u.Scheme {
+	case "https":
+	case "sftp":
+		if u.User.Username() == "" || u.Path == "" {
+			return nil, fmt.Errorf("sftp url must name the user and file, as sftp://user@host/path")
+		}
+		if f.hostKey, _, _, _, err = ssh.ParseAuthorizedKey([]byte(hostKey)); err != nil {
+			return nil, fmt.Errorf("host_key must be the server's public key in authorized_keys format")
+		}
+	default:
+		return nil, fmt.Errorf("url must be an https:// or sftp:// URL")
+	}
+	return f, nil
+}
+
+func (f *File) Records(ctx context.Context, fields []string) ([]Record, error) {
+	var (
+		raw []byte
+		err error
+	)
+	if f.url.Scheme == "sftp" {
+		raw, err = f.readSFTP(ctx)
+	} else {
+		raw, err = f.readHTTPS(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", f.url.Redacted(), err)
+	}
+	return parseCSV(raw)
+}
+
+func (f *File) readHTTPS(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if f.secret != "" {
+		req.Header.Set("Authorization", "Bearer "+f.secret)
+	}
+	return fetch(outbound.Client(f.timeout), req)
+}
+
+func (f *File) readSFTP(ctx context.Context) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	addr := f.url.Host
+	if f.url.Port() == "" {
+		addr = net.JoinHostPort(f.url.Hostname(), "22")
+	}
+	conn, err := outbound.Dialer(f.timeout).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	// Closing the connection abandons the transfer once ctx is done
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, &ssh.ClientConfig{
+		User:            f.url.User.Username(),
+		Auth:            []ssh.AuthMethod{ssh.Password(f.secret)},
+		HostKeyCallback: ssh.FixedHostKey(f.hostKey),
+		Timeout:         f.timeout,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	client := ssh.NewClient(sshConn, chans, reqs)
+	defer client.Close()
+
+	return sftpReadFile(client, f.url.Path, maxResponseSize)
+}
+
+// parseCSV reads rows into Records keyed by the header row's names.
+func parseCSV(raw []byte) ([]Record, error) {
+	// Spreadsheet exports often start with a byte order mark
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(raw, []byte("\ufeff"))))
+	r.TrimLeadingSpace = true
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("parsing csv: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("parsing csv: no header row")
+	}
+
+	header := rows[0]
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+	records := make([]Record, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		rec := make(Record, len(header))
+		for i, name := range header {
+			rec[name] = strings.TrimSpace(row[i])
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
@@ -0,0 +1,67 @@
+// Package hris reads employee pay records from HR systems: BambooHR,
+// Workday custom reports, and CSV files served over HTTPS or SFTP.
+package hris
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// maxResponseSize bounds what is read of an HR system's response or file.
+const maxResponseSize = 32 << 20
+
+// Record is one employee's fields, by the HR system's names for them.
+type Record map[string]string
+
+// Source reads the records of an organization's current employees.
+type Source interface {
+	// Records returns a Record per employee. fields are the fields the
+	// caller reads, for systems that return only the fields asked for;
+	// others return every field they have.
+	Records(ctx context.Context, fields []string) ([]Record, error)
+}
+
+// fetch sends req and returns the body of a 2xx response.
+func fetch(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxResponseSize {
+		return nil, fmt.Errorf("response is larger than %d bytes", maxResponseSize)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("responded %d", resp.StatusCode)
+	}
+	return body, nil
+}
+
+// jsonRecords turns JSON objects into Records, keeping the fields with
+// string, number or boolean values.
+func jsonRecords(objects []map[string]interface{}) []Record {
+	records := make([]Record, len(objects))
+	for i, obj := range objects {
+		r := make(Record, len(obj))
+		for k, v := range obj {
+			switch v := v.(type) {
+			case string:
+				r[k] = v
+			case float64:
+				r[k] = strconv.FormatFloat(v, 'f', -1, 64)
+			case bool:
+				r[k] = strconv.FormatBool(v)
+			}
+		}
+		records[i] = r
+	}
+	return records
+}
@@ -0,0 +1,186 @@
+package hris
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTP (version 3, draft-ietf-secsh-filexfer-02) packet types and status
+// codes. Only what reading a file takes is implemented.
+const (
+	sftpInit    = 1
+	sftpVersion = 2
+	sftpOpen    = 3
+	sftpClose   = 4
+	sftpRead    = 5
+	sftpStatus  = 101
+	sftpHandle  = 102
+	sftpData    = 103
+
+	sftpStatusEOF = 1
+	sftpOpenRead  = 0x1
+
+	// sftpChunk is how much each read asks for; servers send at most
+	// 32 KiB however much is asked for
+	sftpChunk = 32 << 10
+	// sftpMaxPacket bounds the packets accepted from the server
+	sftpMaxPacket = 256 << 10
+)
+
+// sftpConn exchanges SFTP packets over an SSH session's stdio.
+type sftpConn struct {
+	w  io.Writer
+	r  io.Reader
+	id uint32
+}
+
+// sftpReadFile reads the file at path over SFTP, failing if it is larger
+// than limit bytes.
+func sftpReadFile(client *ssh.Client, path string, limit int) ([]byte, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	w, err := session.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	r, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := session.RequestSubsystem("sftp"); err != nil {
+		return nil, fmt.Errorf("starting sftp: %w", err)
+	}
+	c := &sftpConn{w: w, r: r}
+	return c.readFile(path, limit)
+}
+
+// readFile starts the SFTP session and reads the file at path.
+func (c *sftpConn) readFile(path string, limit int) ([]byte, error) {
+	if err := c.send(sftpInit, uint32be(3)); err != nil {
+		return nil, err
+	}
+	if typ, _, err := c.recv(); err != nil {
+		return nil, err
+	} else if typ != sftpVersion {
+		return nil, fmt.Errorf("sftp: unexpected packet %d", typ)
+	}
+
+	// Open with no attributes
+	handle, err := c.call(sftpOpen, sftpString(path), uint32be(sftpOpenRead), uint32be(0))
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	h := sftpString(string(handle))
+	defer c.call(sftpClose, h)
+
+	var data []byte
+	for {
+		chunk, err := c.call(sftpRead, h, uint64be(uint64(len(data))), uint32be(sftpChunk))
+		if err == io.EOF || (err == nil && len(chunk) == 0) {
+			return data, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		data = append(data, chunk...)
+		if len(data) > limit {
+			return nil, fmt.Errorf("%s is larger than %d bytes", path, limit)
+		}
+	}
+}
+
+// call sends a request and returns the string its HANDLE or DATA reply
+// carries, io.EOF for an end-of-file status, and nil for a successful one.
+func (c *sftpConn) call(typ byte, fields ...[]byte) ([]byte, error) {
+	c.id++
+	if err := c.send(typ, append([][]byte{uint32be(c.id)}, fields...)...); err != nil {
+		return nil, err
+	}
+	rtyp, payload, err := c.recv()
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) < 4 || binary.BigEndian.Uint32(payload) != c.id {
+		return nil, fmt.Errorf("sftp: unexpected reply")
+	}
+	payload = payload[4:]
+
+	switch rtyp {
+	case sftpHandle, sftpData:
+		s, ok := readSFTPString(payload)
+		if !ok {
+			return nil, fmt.Errorf("sftp: malformed reply")
+		}
+		return s, nil
+	case sftpStatus:
+		if len(payload) < 4 {
+			return nil, fmt.Errorf("sftp: malformed status")
+		}
+		code := binary.BigEndian.Uint32(payload)
+		switch code {
+		case 0:
+			return nil, nil
+		case sftpStatusEOF:
+			return nil, io.EOF
+		}
+		msg, _ := readSFTPString(payload[4:])
+		return nil, fmt.Errorf("sftp status %d: %s", code, msg)
+	}
+	return nil, fmt.Errorf("sftp: unexpected packet %d", rtyp)
+}
+
+func (c *sftpConn) send(typ byte, fields ...[]byte) error {
+	n := 1
+	for _, f := range fields {
+		n += len(f)
+	}
+	pkt := make([]byte, 0, 4+n)
+	pkt = binary.BigEndian.AppendUint32(pkt, uint32(n))
+	pkt = append(pkt, typ)
+	for _, f := range fields {
+		pkt = append(pkt, f...)
+	}
+	_, err := c.w.Write(pkt)
+	return err
+}
+
+func (c *sftpConn) recv() (byte, []byte, error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(c.r, hdr[:]); err != nil {
+		return 0, nil, fmt.Errorf("sftp: %w", err)
+	}
+	n := binary.BigEndian.Uint32(hdr[:])
+	if n == 0 || n > sftpMaxPacket {
+		return 0, nil, fmt.Errorf("sftp: packet of %d bytes", n)
+	}
+	pkt := make([]byte, n)
+	if _, err := io.ReadFull(c.r, pkt); err != nil {
+		return 0, nil, fmt.Errorf("sftp: %w", err)
+	}
+	return pkt[0], pkt[1:], nil
+}
+
+func uint32be(v uint32) []byte { return binary.BigEndian.AppendUint32(nil, v) }
+
+func uint64be(v uint64) []byte { return binary.BigEndian.AppendUint64(nil, v) }
+
+func sftpString(s string) []byte {
+	return append(uint32be(uint32(len(s))), s...)
+}
+
+func readSFTPString(b []byte) ([]byte, bool) {
+	if len(b) < 4 {
+		return nil, false
+	}
+	n := binary.BigEndian.Uint32(b)
+	if uint64(n) > uint64(len(b)-4) {
+		return nil, false
+	}
+	return b[4 : 4+n], true
+}
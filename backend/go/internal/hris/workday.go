@@ -0,0 +1,56 @@
+package hris
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/outbound"
+)
+
+// Workday reads employees from a Workday custom report shared as a web
+// service (Reports-as-a-Service), signing in as an integration system
+// user. Each report entry is an employee. The report URL is only fetched
+// from a public address, without following redirects.
+type Workday struct {
+	reportURL string
+	username  string
+	password  string
+	client    *http.Client
+}
+
+func NewWorkday(reportURL, username, password string, timeout time.Duration) *Workday {
+	return &Workday{reportURL: reportURL, username: username, password: password, client: outbound.Client(timeout)}
+}
+
+func (w *Workday) Records(ctx context.Context, fields []string) ([]Record, error) {
+	u, err := url.Parse(w.reportURL)
+	if err != nil {
+		return nil, fmt.Errorf("workday: invalid report url: %w", err)
+	}
+	q := u.Query()
+	q.Set("format", "json")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(w.username, w.password)
+	req.Header.Set("Accept", "application/json")
+
+	raw, err := fetch(w.client, req)
+	if err != nil {
+		return nil, fmt.Errorf("workday: %w", err)
+	}
+	var report struct {
+		Entries []map[string]interface{} `json:"Report_Entry"`
+	}
+	if err := json.Unmarshal(raw, &report); err != nil {
+		return nil, fmt.Errorf("workday: decoding report: %w", err)
+	}
+	return jsonRecords(report.Entries), nil
+}
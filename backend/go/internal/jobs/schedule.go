@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"github.com/yourorg/meeting-cost/backend/go/internal/config"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// Schedule registers the periodic tasks enabled in cfg.
+func Schedule(s *queue.Scheduler, cfg *config.Config) error {
+	if cfg.Purge.Interval > 0 {
+		if err := s.Register("purge_deleted", "@every "+cfg.Purge.Interval.String(),
+			service.TaskPurgeDeleted, service.PurgeDeletedPayload{Retention: cfg.Purge.Retention}); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Partitions.Schedule; spec != "" && spec != "off" {
+		if err := s.Register("maintain_partitions", spec, service.TaskPartitions,
+			service.PartitionsPayload{Ahead: cfg.Partitions.Ahead, Retention: cfg.Partitions.Retention}); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Archive.Schedule; cfg.Archive.After > 0 && spec != "" && spec != "off" {
+		if err := s.Register("archive_meetings", spec, service.TaskArchiveMeetings,
+			service.ArchiveMeetingsPayload{After: cfg.Archive.After}); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.CompactionSchedule; spec != "" && spec != "off" {
+		if err := s.Register("compact_increments", spec, service.TaskCompactIncrements, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.SessionCleanupSchedule; spec != "" && spec != "off" {
+		if err := s.Register("cleanup_sessions", spec, service.TaskCleanupSessions, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.DigestSchedule; spec != "" && spec != "off" {
+		if err := s.Register("weekly_digests", spec, service.TaskWeeklyDigests, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.CostAlertSchedule; spec != "" && spec != "off" {
+		if err := s.Register("check_cost_alerts", spec, service.TaskCheckCostAlerts, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.OverrunSchedule; spec != "" && spec != "off" {
+		if err := s.Register("check_overruns", spec, service.TaskCheckOverruns, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.TrialSchedule; spec != "" && spec != "off" && cfg.Billing.TrialDays > 0 {
+		if err := s.Register("check_trials", spec, service.TaskCheckTrials, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.ReportExportPurgeSchedule; spec != "" && spec != "off" {
+		if err := s.Register("purge_report_exports", spec, service.TaskPurgeReportExports, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.APIUsagePurgeSchedule; spec != "" && spec != "off" {
+		if err := s.Register("purge_api_usage", spec, service.TaskPurgeAPIUsage, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.ReportRollupSchedule; spec != "" && spec != "off" {
+		if err := s.Register("refresh_report_rollups", spec, service.TaskRefreshReportRollups, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.AccountDeletionSchedule; spec != "" && spec != "off" {
+		if err := s.Register("process_account_deletions", spec, service.TaskProcessAccountDeletions, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.WageSyncSchedule; spec != "" && spec != "off" {
+		if err := s.Register("queue_wage_syncs", spec, service.TaskQueueWageSyncs, nil); err != nil {
+			return err
+		}
+	}
+
+	if spec := cfg.Queue.WarehouseExportSchedule; spec != "" && spec != "off" {
+		if err := s.Register("queue_warehouse_exports", spec, service.TaskQueueWarehouseExports, nil); err != nil {
+			return err
+		}
+	}
+
+	// Usage only goes anywhere once Stripe is configured
+	if spec := cfg.Queue.UsageReportSchedule; spec != "" && spec != "off" && cfg.Billing.StripeSecretKey != "" {
+		if err := s.Register("report_usage", spec, service.TaskReportUsage, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
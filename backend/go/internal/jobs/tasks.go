@@ -0,0 +1,149 @@
+// Package jobs wires background tasks and their schedules to the services
+// that run them.
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/container"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// Register wires every task handler to the services in ctn.
+func Register(srv *queue.Server, ctn *container.Container) {
+	srv.Handle(service.TaskPurgeDeleted, func(ctx context.Context, t *queue.Task) error {
+		var p service.PurgeDeletedPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		_, err := ctn.MaintenanceService.PurgeDeleted(ctx, p.Retention)
+		return err
+	})
+	srv.Handle(service.TaskPartitions, func(ctx context.Context, t *queue.Task) error {
+		var p service.PartitionsPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		_, err := ctn.MaintenanceService.MaintainPartitions(ctx, p.Ahead, p.Retention)
+		return err
+	})
+	srv.Handle(service.TaskArchiveMeetings, func(ctx context.Context, t *queue.Task) error {
+		var p service.ArchiveMeetingsPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		_, err := ctn.ArchiveService.ArchiveMeetings(ctx, p.After)
+		return err
+	})
+	srv.Handle(service.TaskCompactIncrements, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.MaintenanceService.CompactIncrements(ctx)
+		return err
+	})
+	srv.Handle(service.TaskCleanupSessions, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.MaintenanceService.CleanupSessions(ctx)
+		return err
+	})
+	srv.Handle(service.TaskDeliverWebhook, func(ctx context.Context, t *queue.Task) error {
+		var p service.DeliverWebhookPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		return ctn.WebhookService.Deliver(ctx, p.DeliveryID)
+	})
+	srv.Handle(service.TaskSendEmail, func(ctx context.Context, t *queue.Task) error {
+		var p service.SendEmailPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		return ctn.EmailService.Deliver(ctx, p)
+	})
+	srv.Handle(service.TaskNotify, func(ctx context.Context, t *queue.Task) error {
+		var p service.SendNotificationPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		return ctn.NotifyService.Deliver(ctx, p)
+	})
+	srv.Handle(service.TaskWeeklyDigests, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.DigestService.QueueWeeklyDigests(ctx, time.Now())
+		return err
+	})
+	srv.Handle(service.TaskWeeklyDigest, func(ctx context.Context, t *queue.Task) error {
+		var p service.WeeklyDigestPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		_, err := ctn.DigestService.SendWeeklyDigest(ctx, p.OrganizationID, p.PeriodEnd)
+		return err
+	})
+	srv.Handle(service.TaskCheckCostAlerts, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.CostAlertService.CheckActiveMeetings(ctx)
+		return err
+	})
+	srv.Handle(service.TaskCheckOverruns, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.MeetingService.CheckOverruns(ctx)
+		return err
+	})
+	srv.Handle(service.TaskReportUsage, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.UsageService.ReportUsage(ctx)
+		return err
+	})
+	srv.Handle(service.TaskCheckTrials, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.SubscriptionService.CheckTrials(ctx, time.Now())
+		return err
+	})
+	srv.Handle(service.TaskSendSurveys, func(ctx context.Context, t *queue.Task) error {
+		var p service.SendSurveysPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		return ctn.SurveyService.SendSurveys(ctx, p.MeetingID)
+	})
+	srv.Handle(service.TaskGenerateReportExport, func(ctx context.Context, t *queue.Task) error {
+		var p service.GenerateReportExportPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		return ctn.ReportExportService.GenerateExport(ctx, p.ExportID)
+	})
+	srv.Handle(service.TaskPurgeReportExports, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.ReportExportService.PurgeExpired(ctx, time.Now())
+		return err
+	})
+	srv.Handle(service.TaskPurgeAPIUsage, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.APIUsageService.PurgeExpired(ctx, time.Now())
+		return err
+	})
+	srv.Handle(service.TaskRefreshReportRollups, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.ReportService.RefreshRollups(ctx, time.Now())
+		return err
+	})
+	srv.Handle(service.TaskProcessAccountDeletions, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.DeletionService.ProcessDue(ctx, time.Now())
+		return err
+	})
+	srv.Handle(service.TaskQueueWageSyncs, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.WageSyncService.QueueWageSyncs(ctx)
+		return err
+	})
+	srv.Handle(service.TaskSyncWages, func(ctx context.Context, t *queue.Task) error {
+		var p service.SyncWagesPayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		return ctn.WageSyncService.RunScheduledWageSync(ctx, p.OrganizationID, p.Provider)
+	})
+	srv.Handle(service.TaskQueueWarehouseExports, func(ctx context.Context, t *queue.Task) error {
+		_, err := ctn.WarehouseService.QueueWarehouseExports(ctx)
+		return err
+	})
+	srv.Handle(service.TaskExportToWarehouse, func(ctx context.Context, t *queue.Task) error {
+		var p service.ExportToWarehousePayload
+		if err := t.Unmarshal(&p); err != nil {
+			return err
+		}
+		return ctn.WarehouseService.ExportToWarehouse(ctx, p.OrganizationID, p.Provider)
+	})
+}
@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/container"
+)
+
+// RunCostTicker publishes every active meeting's live cost each interval
+// until ctx is done. Every replica may run one: ticks fall on multiples of
+// interval, and each is claimed in the broker, so costs are published once
+// per tick however many tickers are running.
+func RunCostTicker(ctx context.Context, ctn *container.Container, interval time.Duration) {
+	timer := time.NewTimer(time.Until(time.Now().Truncate(interval).Add(interval)))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-timer.C:
+			timer.Reset(time.Until(now.Truncate(interval).Add(interval)))
+
+			// Held for half the interval, past any skew between replicas
+			// but clear of the next tick
+			won, err := ctn.QueueBroker.Claim(ctx, "cost_tick", interval/2)
+			if err != nil {
+				ctn.Logger.Error("claiming cost tick failed", "error", err)
+				continue
+			}
+			if !won {
+				continue
+			}
+			if _, err := ctn.MeetingService.BroadcastCosts(ctx); err != nil {
+				ctn.Logger.Error("broadcasting meeting costs failed", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// Level names, from most to least verbose.
+const (
+	LevelDebug = "debug"
+	LevelInfo  = "info"
+	LevelWarn  = "warn"
+	LevelError = "error"
+)
+
+// Level is the minimum level written by the loggers built with it, and can
+// be changed while they run.
+type Level struct {
+	atomic zap.AtomicLevel
+}
+
+// NewLevel returns a Level starting at name.
+func NewLevel(name string) (*Level, error) {
+	l := &Level{atomic: zap.NewAtomicLevel()}
+	if err := l.Set(name); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Set changes the level to name: debug, info, warn or error.
+func (l *Level) Set(name string) error {
+	switch name {
+	case LevelDebug, LevelInfo, LevelWarn, LevelError:
+	default:
+		return fmt.Errorf("invalid log level %q: must be debug, info, warn or error", name)
+	}
+	return l.atomic.UnmarshalText([]byte(name))
+}
+
+// String returns the level's name.
+func (l *Level) String() string {
+	return l.atomic.String()
+}
@@ -0,0 +1,209 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redaction modes, how much of the logs Redact masks.
+const (
+	RedactFull    = "full"    // Every level, for production
+	RedactVerbose = "verbose" // Info and above; debug entries are written as they are
+)
+
+// Redaction placeholders that replace masked values.
+const (
+	redacted      = "[redacted]"
+	redactedEmail = "[email]"
+	redactedIP    = "[ip]"
+	redactedToken = "[token]"
+)
+
+// sensitiveKeys are field, header and JSON keys whose values are always
+// masked, compared in lower case.
+var sensitiveKeys = map[string]bool{
+	"authorization":   true,
+	"cookie":          true,
+	"set-cookie":      true,
+	"ip":              true,
+	"ip_address":      true,
+	"x-forwarded-for": true,
+	"x-real-ip":       true,
+	"code":            true,
+	"otp":             true,
+}
+
+// sensitiveKeyParts mask any key containing them, such as access_token or
+// hourly_wage.
+var sensitiveKeyParts = []string{"password", "token", "secret", "wage", "email", "signature", "api_key"}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	ipv4Pattern  = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	jwtPattern   = regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`)
+)
+
+type redactingLogger struct {
+	next Logger
+	// rawDebug writes debug entries without masking
+	rawDebug bool
+}
+
+// Redact wraps l so emails, tokens, IP addresses and wages are masked in
+// log entries: values under sensitive keys, such as password or
+// hourly_wage, are replaced whole, and emails, IPv4 addresses and JWTs are
+// replaced wherever they appear in other values, including JSON and
+// form-encoded bodies and header maps. Messages are written as they are,
+// so they must not contain such data. mode is RedactFull or RedactVerbose.
+func Redact(l Logger, mode string) Logger {
+	return &redactingLogger{next: l, rawDebug: mode == RedactVerbose}
+}
+
+func (l *redactingLogger) Debug(msg string, keysAndValues ...interface{}) {
+	if l.rawDebug {
+		l.next.Debug(msg, keysAndValues...)
+		return
+	}
+	l.next.Debug(msg, redactFields(keysAndValues)...)
+}
+
+func (l *redactingLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.next.Info(msg, redactFields(keysAndValues)...)
+}
+
+func (l *redactingLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.next.Warn(msg, redactFields(keysAndValues)...)
+}
+
+func (l *redactingLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.next.Error(msg, redactFields(keysAndValues)...)
+}
+
+// With masks the fields up front, so they stay masked in debug entries
+// too.
+func (l *redactingLogger) With(keysAndValues ...interface{}) Logger {
+	return &redactingLogger{next: l.next.With(redactFields(keysAndValues)...), rawDebug: l.rawDebug}
+}
+
+func (l *redactingLogger) WithContext(ctx context.Context) Logger {
+	return &redactingLogger{next: l.next.WithContext(ctx), rawDebug: l.rawDebug}
+}
+
+func (l *redactingLogger) Sync() error {
+	return l.next.Sync()
+}
+
+// redactFields masks alternating keys and values.
+func redactFields(keysAndValues []interface{}) []interface{} {
+	out := make([]interface{}, len(keysAndValues))
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key, _ := keysAndValues[i].(string)
+		out[i] = keysAndValues[i]
+		if i+1 < len(keysAndValues) {
+			out[i+1] = redactField(key, keysAndValues[i+1])
+		}
+	}
+	return out
+}
+
+// redactField masks a value logged under key.
+func redactField(key string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	if sensitiveKey(key) {
+		return redacted
+	}
+	switch v := value.(type) {
+	case string:
+		return redactString(v)
+	case []byte:
+		return redactString(string(v))
+	case error:
+		return redactText(v.Error())
+	case map[string]string:
+		m := make(map[string]string, len(v))
+		for k, s := range v {
+			m[k], _ = redactField(k, s).(string)
+		}
+		return m
+	case map[string][]string:
+		m := make(map[string][]string, len(v))
+		for k, ss := range v {
+			if sensitiveKey(k) {
+				m[k] = []string{redacted}
+				continue
+			}
+			m[k] = make([]string, len(ss))
+			for j, s := range ss {
+				m[k][j] = redactText(s)
+			}
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, x := range v {
+			m[k] = redactField(k, x)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for j, x := range v {
+			s[j] = redactField("", x)
+		}
+		return s
+	}
+	return value
+}
+
+// redactString masks a string value, reading it as a JSON or form-encoded
+// body when it is one so keys such as password are masked too.
+func redactString(s string) string {
+	trimmed := strings.TrimSpace(s)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var body interface{}
+		if err := json.Unmarshal([]byte(trimmed), &body); err == nil {
+			if b, err := json.Marshal(redactField("", body)); err == nil {
+				return string(b)
+			}
+		}
+	}
+	if strings.Contains(s, "=") && !strings.ContainsAny(s, " \n") {
+		if form, err := url.ParseQuery(s); err == nil && len(form) > 0 {
+			for k, vs := range form {
+				for j := range vs {
+					if sensitiveKey(k) {
+						vs[j] = redacted
+					} else {
+						vs[j] = redactText(vs[j])
+					}
+				}
+			}
+			return form.Encode()
+		}
+	}
+	return redactText(s)
+}
+
+// redactText replaces emails, IPv4 addresses and JWTs in free text.
+func redactText(s string) string {
+	s = jwtPattern.ReplaceAllString(s, redactedToken)
+	s = emailPattern.ReplaceAllString(s, redactedEmail)
+	return ipv4Pattern.ReplaceAllString(s, redactedIP)
+}
+
+func sensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveKeys[key] {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -12,8 +12,10 @@ type zapLogger struct {
 }
 
 // NewZapLogger creates a new Zap-based Logger configured for the given
-// environment ("development" or "production").
-func NewZapLogger(env string) (Logger, error) {
+// environment ("development" or "production"). It writes entries at level
+// and above, or when level is nil, at the environment's default: info in
+// production and debug elsewhere.
+func NewZapLogger(env string, level *Level) (Logger, error) {
 	var cfg zap.Config
 	if env == "production" {
 		cfg = zap.NewProductionConfig()
@@ -24,6 +26,9 @@ func NewZapLogger(env string) (Logger, error) {
 	// Always log to stdout so Docker can capture logs.
 	cfg.OutputPaths = []string{"stdout"}
 	cfg.ErrorOutputPaths = []string{"stderr"}
+	if level != nil {
+		cfg.Level = level.atomic
+	}
 
 	z, err := cfg.Build(zap.AddCaller())
 	if err != nil {
@@ -81,7 +86,7 @@ func NewNopLogger() Logger {
 
 // DefaultLogger is a convenience for early bootstrap before DI container is built.
 func DefaultLogger() Logger {
-	l, err := NewZapLogger(os.Getenv("ENV"))
+	l, err := NewZapLogger(os.Getenv("ENV"), nil)
 	if err != nil {
 		return NewNopLogger()
 	}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// RegisterSQLDB exports database/sql pool stats (open, in-use and idle
+// connections, wait count and duration) as go_sql_* metrics labelled with
+// name.
+func RegisterSQLDB(reg prometheus.Registerer, name string, db *sql.DB) {
+	reg.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterPgxPool exports pgx pool stats labelled with name.
+func RegisterPgxPool(reg prometheus.Registerer, name string, pool *pgxpool.Pool) {
+	reg.MustRegister(newPgxPoolCollector(pool, name))
+}
+
+type pgxPoolCollector struct {
+	pool *pgxpool.Pool
+
+	maxConns        *prometheus.Desc
+	totalConns      *prometheus.Desc
+	acquiredConns   *prometheus.Desc
+	idleConns       *prometheus.Desc
+	acquireCount    *prometheus.Desc
+	acquireDuration *prometheus.Desc
+	emptyAcquire    *prometheus.Desc
+	canceledAcquire *prometheus.Desc
+}
+
+func newPgxPoolCollector(pool *pgxpool.Pool, name string) *pgxPoolCollector {
+	desc := func(metric, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "pgxpool", metric),
+			help, nil, prometheus.Labels{"db_name": name},
+		)
+	}
+	return &pgxPoolCollector{
+		pool:            pool,
+		maxConns:        desc("max_conns", "Maximum size of the pool."),
+		totalConns:      desc("total_conns", "Connections currently in the pool."),
+		acquiredConns:   desc("acquired_conns", "Connections currently in use."),
+		idleConns:       desc("idle_conns", "Connections currently idle."),
+		acquireCount:    desc("acquire_total", "Successful acquires from the pool."),
+		acquireDuration: desc("acquire_duration_seconds_total", "Time spent acquiring connections."),
+		emptyAcquire:    desc("empty_acquire_total", "Acquires that waited because the pool was empty."),
+		canceledAcquire: desc("canceled_acquire_total", "Acquires canceled by their context."),
+	}
+}
+
+func (c *pgxPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxConns
+	ch <- c.totalConns
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquire
+	ch <- c.canceledAcquire
+}
+
+func (c *pgxPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquire, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+}
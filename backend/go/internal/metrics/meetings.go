@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+// meetingsTimeout bounds the query behind each scrape of the meeting
+// gauges.
+const meetingsTimeout = 5 * time.Second
+
+// RegisterMeetings exports each organization's running meetings and what
+// they have cost so far, read from repo on every scrape.
+func RegisterMeetings(reg prometheus.Registerer, repo repository.MeetingRepository) {
+	reg.MustRegister(&meetingsCollector{
+		repo: repo,
+		active: prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "", "active_meetings"),
+			"Meetings currently running, by organization.",
+			[]string{"organization_id"}, nil,
+		),
+		cost: prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "", "live_meeting_cost"),
+			"What the meetings currently running have cost so far, by organization.",
+			[]string{"organization_id"}, nil,
+		),
+	})
+}
+
+type meetingsCollector struct {
+	repo repository.MeetingRepository
+
+	active *prometheus.Desc
+	cost   *prometheus.Desc
+}
+
+func (c *meetingsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.active
+	ch <- c.cost
+}
+
+// Collect reports a failed query as an invalid metric, which fails the
+// scrape rather than exporting organizations as having nothing running.
+func (c *meetingsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), meetingsTimeout)
+	defer cancel()
+
+	totals, err := c.repo.ActiveTotals(ctx, time.Now())
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.active, err)
+		return
+	}
+	for _, t := range totals {
+		org := t.OrganizationID.String()
+		ch <- prometheus.MustNewConstMetric(c.active, prometheus.GaugeValue, float64(t.Meetings), org)
+		ch <- prometheus.MustNewConstMetric(c.cost, prometheus.GaugeValue, t.Cost, org)
+	}
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminRequired guards operator endpoints with a static token sent in the
+// X-Admin-Token header. An empty token disables the endpoints entirely.
+func AdminRequired(token string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if token == "" {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "admin API is disabled",
+			})
+		}
+
+		provided := c.Get("X-Admin-Token")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "invalid admin token",
+			})
+		}
+
+		return c.Next()
+	}
+}
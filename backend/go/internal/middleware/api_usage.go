@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository/requester"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// APIUsage counts each authenticated request toward the organization it
+// was made for, once it has been answered. Extension requests count toward
+// their token's organization. Session requests count toward the
+// organization in an /organizations/:id path, else the organization_id
+// query parameter, else the session's active organization, and only when
+// the person is an active member of it, so nobody can add to another
+// organization's counts. Requests for no organization aren't counted.
+func APIUsage(apiUsageService service.APIUsageService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+
+		orgID, tokenID, ok := apiUsageCredential(c)
+		if !ok {
+			return err
+		}
+		status := c.Response().StatusCode()
+		if err != nil {
+			if fe, ok := err.(*fiber.Error); ok {
+				status = fe.Code
+			} else {
+				status = fiber.StatusInternalServerError
+			}
+		}
+		apiUsageService.Record(orgID, tokenID, status, start)
+		return err
+	}
+}
+
+// apiUsageCredential returns the organization a request counts toward and
+// its extension token, uuid.Nil for a session.
+func apiUsageCredential(c *fiber.Ctx) (uuid.UUID, uuid.UUID, bool) {
+	if id, ok := c.Locals("extension").(service.ExtensionIdentity); ok {
+		return id.OrganizationID, id.TokenID, true
+	}
+	r := requester.FromContext(c.Context())
+	if r == nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	// Requests refused before their route matched have no route, so the
+	// path is read by hand
+	var orgID uuid.UUID
+	if path := tenantPath(c); len(path) >= 2 && path[0] == "organizations" {
+		orgID, _ = uuid.Parse(path[1])
+	}
+	if orgID == uuid.Nil {
+		orgID, _ = uuid.Parse(c.Query("organization_id"))
+	}
+	if orgID == uuid.Nil {
+		orgID, _ = c.Locals("active_organization_id").(uuid.UUID)
+	}
+	if orgID == uuid.Nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	profile, err := r.Profile(c.Context(), orgID)
+	if err != nil || profile == nil || !profile.IsActive {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return orgID, uuid.Nil, true
+}
@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// Audit trail route groups, matched against route patterns below the API
+// version prefix.
+var auditTrailGroups = map[string]*regexp.Regexp{
+	"auth":    regexp.MustCompile(`^/auth(/|$)`),
+	"members": regexp.MustCompile(`^/organizations/:id/members(/|$)`),
+	"wages":   regexp.MustCompile(`^(/organizations/:id/members/:memberId/wage(-band)?|/organizations/:id/wage-bands(/.*)?|/meetings/:id/wage)$`),
+}
+
+// auditTrailBodyLimit is the largest body recorded; larger ones are
+// recorded by size only.
+const auditTrailBodyLimit = 8 << 10
+
+// auditTrailSecrets are the JSON keys, compared in lower case, whose values
+// are never recorded.
+var auditTrailSecrets = []string{"password", "token", "secret", "code", "signature"}
+
+var apiVersionPrefix = regexp.MustCompile(`^/api/v\d+`)
+
+// AuditTrail records every request to the named route groups, with its
+// metadata and its JSON request and response bodies minus passwords,
+// tokens and secrets, in the audit log as an http_request entry. It errors
+// on an unknown group.
+func AuditTrail(auditLogService service.AuditLogService, groups []string) (fiber.Handler, error) {
+	var patterns []*regexp.Regexp
+	for _, g := range groups {
+		p, ok := auditTrailGroups[g]
+		if !ok {
+			return nil, fmt.Errorf("unknown audit trail group %q", g)
+		}
+		patterns = append(patterns, p)
+	}
+
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+
+		// The route is only known once it has been matched
+		route := apiVersionPrefix.ReplaceAllString(c.Route().Path, "")
+		group := ""
+		for i, p := range patterns {
+			if p.MatchString(route) {
+				group = groups[i]
+				break
+			}
+		}
+		if group == "" {
+			return err
+		}
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			if fe, ok := err.(*fiber.Error); ok {
+				status = fe.Code
+			} else {
+				status = fiber.StatusInternalServerError
+			}
+		}
+		details := map[string]interface{}{
+			"group":       group,
+			"method":      c.Method(),
+			"route":       c.Route().Path,
+			"path":        c.Path(),
+			"status":      status,
+			"duration_ms": time.Since(start).Milliseconds(),
+		}
+		if q := c.Queries(); len(q) > 0 {
+			query := make(map[string]interface{}, len(q))
+			for k, v := range q {
+				query[k] = v
+			}
+			details["query"] = sanitizeAuditValue(query)
+		}
+		if body := auditTrailBody(c.Body()); body != nil {
+			details["request_body"] = body
+		}
+		if body := auditTrailBody(c.Response().Body()); body != nil {
+			details["response_body"] = body
+		}
+
+		params := service.LogParams{
+			Action:       "http_request",
+			ResourceType: "http_request",
+			ResourceID:   uuid.Nil,
+			Details:      details,
+			IPAddress:    c.IP(),
+			UserAgent:    string(c.Request().Header.UserAgent()),
+		}
+		if personID, ok := c.Locals("person_id").(uuid.UUID); ok {
+			params.PersonID = &personID
+		}
+		if orgID, perr := uuid.Parse(c.Params("id")); perr == nil && strings.HasPrefix(route, "/organizations/") {
+			params.OrganizationID = &orgID
+		}
+		_ = auditLogService.Log(c.UserContext(), params)
+
+		return err
+	}, nil
+}
+
+// auditTrailBody returns a JSON body with its secrets masked, the size of
+// a body too large or not JSON, or nil for no body.
+func auditTrailBody(body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	if len(body) > auditTrailBodyLimit {
+		return map[string]interface{}{"bytes": len(body)}
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return map[string]interface{}{"bytes": len(body)}
+	}
+	return sanitizeAuditValue(v)
+}
+
+// sanitizeAuditValue masks the values of secret keys throughout v.
+func sanitizeAuditValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, x := range v {
+			if auditTrailSecret(k) {
+				out[k] = "[redacted]"
+				continue
+			}
+			out[k] = sanitizeAuditValue(x)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, x := range v {
+			out[i] = sanitizeAuditValue(x)
+		}
+		return out
+	}
+	return v
+}
+
+func auditTrailSecret(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range auditTrailSecrets {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
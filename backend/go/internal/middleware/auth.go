@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/yourorg/meeting-cost/backend/go/internal/auth"
 	"github.com/yourorg/meeting-cost/backend/go/internal/service"
 )
 
@@ -35,9 +36,14 @@ func AuthRequired(authService service.AuthService) fiber.Handler {
 			})
 		}
 
-		// 4. Store person ID and email in locals for downstream handlers
+		// 4. Store person ID, email, session ID and active organization in locals for downstream handlers
 		c.Locals("person_id", sessionInfo.PersonID)
 		c.Locals("email", sessionInfo.Email)
+		c.Locals("session_id", sessionInfo.SessionID)
+		if sessionInfo.ActiveOrganizationID != nil {
+			c.Locals("active_organization_id", *sessionInfo.ActiveOrganizationID)
+		}
+		setMembershipClaims(c, sessionInfo)
 
 		return c.Next()
 	}
@@ -58,9 +64,25 @@ func OptionalAuth(authService service.AuthService) fiber.Handler {
 			if err == nil {
 				c.Locals("person_id", sessionInfo.PersonID)
 				c.Locals("email", sessionInfo.Email)
+				c.Locals("session_id", sessionInfo.SessionID)
+				if sessionInfo.ActiveOrganizationID != nil {
+					c.Locals("active_organization_id", *sessionInfo.ActiveOrganizationID)
+				}
+				setMembershipClaims(c, sessionInfo)
 			}
 		}
 
 		return c.Next()
 	}
 }
+
+// setMembershipClaims puts the session's membership claims in the request
+// context, where services pass them on to permission checks.
+func setMembershipClaims(c *fiber.Ctx, sessionInfo *service.SessionInfo) {
+	if sessionInfo.Memberships != nil {
+		c.Context().SetUserValue(auth.ContextKeyMembershipClaims, &auth.MembershipClaims{
+			PersonID:    sessionInfo.PersonID,
+			Memberships: sessionInfo.Memberships,
+		})
+	}
+}
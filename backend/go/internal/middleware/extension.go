@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// ExtensionTokenRequired authenticates the browser extension by the
+// extension token it sends as a bearer token. It stores the token's
+// service.ExtensionIdentity in locals as "extension", and its person as
+// "person_id" for the middleware after it. Session tokens aren't accepted,
+// nor are extension tokens anywhere else.
+func ExtensionTokenRequired(extensionService service.ExtensionService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token, ok := strings.CutPrefix(c.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "missing extension token",
+			})
+		}
+
+		id, err := extensionService.Authenticate(c.Context(), token)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "invalid or revoked extension token",
+			})
+		}
+
+		c.Locals("extension", *id)
+		c.Locals("person_id", id.PersonID)
+		return c.Next()
+	}
+}
+
+// extensionSchemes are the origin schemes of browser extensions.
+var extensionSchemes = []string{"chrome-extension://", "moz-extension://", "safari-web-extension://"}
+
+// ExtensionOrigins returns a CORS origin check for the extension API that
+// allows the given origins, or any browser extension when there are none.
+func ExtensionOrigins(origins []string) func(origin string) bool {
+	return func(origin string) bool {
+		if len(origins) > 0 {
+			for _, o := range origins {
+				if strings.EqualFold(o, origin) {
+					return true
+				}
+			}
+			return false
+		}
+		for _, scheme := range extensionSchemes {
+			if strings.HasPrefix(origin, scheme) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// IntrospectionRequired guards token introspection with a static token that
+// internal services send as a bearer token. An empty token disables the
+// endpoint entirely.
+func IntrospectionRequired(token string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if token == "" {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "token introspection is disabled",
+			})
+		}
+
+		provided, ok := strings.CutPrefix(c.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "invalid introspection token",
+			})
+		}
+
+		return c.Next()
+	}
+}
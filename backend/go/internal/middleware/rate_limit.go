@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+	apperrors "github.com/yourorg/meeting-cost/backend/go/internal/errors"
+)
+
+// RateLimit allows each client IP max requests per window, answering 429
+// with a Retry-After header past that. Counts are kept in memory, so each
+// API instance limits on its own.
+func RateLimit(max int, window time.Duration) fiber.Handler {
+	return limiter.New(limiter.Config{
+		Max:        max,
+		Expiration: window,
+		LimitReached: func(c *fiber.Ctx) error {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(window.Seconds())))
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"error": "too many requests; try again later",
+				"code":  apperrors.CodeRateLimit,
+			})
+		},
+	})
+}
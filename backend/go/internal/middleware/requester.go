@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository/requester"
+)
+
+// RequesterContext puts a Requester for the signed-in person in the request
+// context, so their memberships and roles are read at most once however
+// many handlers, guards and services look them up. It goes after
+// AuthRequired and before the middleware that reads memberships.
+func RequesterContext(loader *requester.Loader) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if personID, ok := c.Locals("person_id").(uuid.UUID); ok {
+			c.Context().SetUserValue(requester.ContextKeyRequester, loader.New(personID))
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"database/sql"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/rls"
+)
+
+// RowLevelSecurity runs the request's database statements on a connection
+// scoped to the signed-in person and the organizations they are an active
+// member of, so the row-level security policies hide every other
+// organization's meetings from it. Checking in or out with a share token
+// runs unscoped, as non-members may do it. It goes after AuthRequired.
+func RowLevelSecurity(db *sql.DB, profileRepo repository.PersonOrganizationProfileRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		personID, ok := c.Locals("person_id").(uuid.UUID)
+		if !ok || shareTokenCheckIn(c, tenantPath(c)) {
+			return c.Next()
+		}
+
+		profiles, err := profileRepo.GetByPerson(c.Context(), personID)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "failed to load memberships",
+			})
+		}
+		scope := rls.Scope{PersonID: personID}
+		for _, p := range profiles {
+			if p.IsActive {
+				scope.OrganizationIDs = append(scope.OrganizationIDs, p.OrganizationID)
+			}
+		}
+
+		conn := rls.NewConn(db, scope)
+		defer conn.Release()
+		c.Context().SetUserValue(rls.ContextKeyConn, conn)
+		return c.Next()
+	}
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/auth"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+// TenantGuard rejects with 403 any request naming an organization the
+// signed-in person isn't an active member of, before it reaches a service:
+// the organization in /organizations/:id paths, the organization_id query
+// parameter, and the organization of the meeting in /meetings/:id paths.
+// It backs up the services' own permission checks, so a check missed there
+// can't reach another tenant's data. Joining an organization and checking
+// in with a share token are left to their services, as they are open to
+// non-members. It goes after AuthRequired.
+func TenantGuard(profileRepo repository.PersonOrganizationProfileRepository, meetingRepo repository.MeetingRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		personID, ok := c.Locals("person_id").(uuid.UUID)
+		if !ok {
+			return c.Next()
+		}
+
+		path := tenantPath(c)
+		var orgIDs []uuid.UUID
+		if s := c.Query("organization_id"); s != "" {
+			if orgID, err := uuid.Parse(s); err == nil {
+				orgIDs = append(orgIDs, orgID)
+			}
+		}
+		if len(path) >= 2 {
+			id, err := uuid.Parse(path[1])
+			switch {
+			case err != nil:
+			case path[0] == "organizations":
+				if !(len(path) == 3 && path[2] == "join") {
+					orgIDs = append(orgIDs, id)
+				}
+			case path[0] == "meetings":
+				if !shareTokenCheckIn(c, path) {
+					// A missing meeting is left to answer 404
+					if meeting, err := meetingRepo.GetByID(c.Context(), id); err == nil {
+						orgIDs = append(orgIDs, meeting.OrganizationID)
+					}
+				}
+			}
+		}
+
+		claims := auth.MembershipClaimsFromContext(c.Context())
+		for _, orgID := range orgIDs {
+			// Claims can predate joining, so only their presence is trusted
+			if _, ok := claims.For(personID, orgID); ok {
+				continue
+			}
+			profile, err := profileRepo.GetByPersonAndOrg(c.Context(), personID, orgID)
+			if err != nil || !profile.IsActive {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"error": "forbidden: not a member of this organization",
+				})
+			}
+		}
+
+		return c.Next()
+	}
+}
+
+// tenantPath splits the request's path below the API version prefix. Group
+// middleware runs before the route is matched, so the path is read by hand.
+func tenantPath(c *fiber.Ctx) []string {
+	return strings.Split(strings.Trim(apiVersionPrefix.ReplaceAllString(c.Path(), ""), "/"), "/")
+}
+
+// shareTokenCheckIn reports whether the request checks in or out of a
+// meeting with a share token, which non-members may do.
+func shareTokenCheckIn(c *fiber.Ctx, path []string) bool {
+	return len(path) == 3 && path[0] == "meetings" && (path[2] == "checkin" || path[2] == "checkout") && c.Query("token") != ""
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrorEnvelope is the body of every /api/v2 error response.
+type ErrorEnvelope struct {
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody describes what went wrong. Code is a stable snake_case name for
+// the HTTP status, such as "not_found"; Message is for humans.
+type ErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Deprecated marks every response as coming from a deprecated API version,
+// pointing clients at successor. A zero sunset omits the Sunset header.
+func Deprecated(successor string, sunset time.Time) fiber.Handler {
+	link := "<" + successor + `>; rel="successor-version"`
+	return func(c *fiber.Ctx) error {
+		c.Set("Deprecation", "true")
+		c.Append("Link", link)
+		if !sunset.IsZero() {
+			c.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+		}
+		return c.Next()
+	}
+}
+
+// EnvelopeErrors rewrites the {"error": "message"} bodies that handlers and
+// middleware return into an ErrorEnvelope. It lets /api/v2 share handlers
+// with /api/v1, whose error shape must not change.
+func EnvelopeErrors() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			status, message := fiber.StatusInternalServerError, err.Error()
+			var fe *fiber.Error
+			if errors.As(err, &fe) {
+				status, message = fe.Code, fe.Message
+			}
+			return c.Status(status).JSON(envelope(status, message))
+		}
+
+		status := c.Response().StatusCode()
+		if status < fiber.StatusBadRequest {
+			return nil
+		}
+
+		message := http.StatusText(status)
+		var body struct {
+			Error string `json:"error"`
+		}
+		if raw := c.Response().Body(); len(raw) > 0 {
+			if err := json.Unmarshal(raw, &body); err == nil && body.Error != "" {
+				message = body.Error
+			}
+		}
+		return c.JSON(envelope(status, message))
+	}
+}
+
+func envelope(status int, message string) ErrorEnvelope {
+	code := strings.ToLower(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+	if code == "" {
+		code = "error"
+	}
+	return ErrorEnvelope{Error: ErrorBody{Code: code, Message: message}}
+}
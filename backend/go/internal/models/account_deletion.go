@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AccountDeletion is a person's request to erase their account. It awaits
+// confirmation with the token emailed to them, then a grace period in which
+// it can be cancelled, before the person is anonymized.
+type AccountDeletion struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	PersonID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_account_deletion_person" json:"person_id"`
+
+	// Confirmation
+	TokenHash      string     `gorm:"type:varchar(64);not null" json:"-"` // SHA256 of the emailed token
+	TokenExpiresAt time.Time  `gorm:"not null" json:"token_expires_at"`
+	ConfirmedAt    *time.Time `json:"confirmed_at,omitempty"`
+
+	// ScheduledFor is when a confirmed deletion anonymizes the person
+	ScheduledFor *time.Time `gorm:"index:idx_account_deletion_scheduled" json:"scheduled_for,omitempty"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+}
+
+// TableName overrides the table name.
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (d *AccountDeletion) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
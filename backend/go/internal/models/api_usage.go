@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIUsage counts the requests made for an organization in one hour with
+// one credential: an extension token, or the members' sessions.
+type APIUsage struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_api_usage_org_token_hour" json:"organization_id"`
+	// TokenID is the extension token the requests carried, or uuid.Nil
+	// for those signed in with a session
+	TokenID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_api_usage_org_token_hour" json:"token_id"`
+	// Hour is the start of the hour, UTC
+	Hour time.Time `gorm:"not null;uniqueIndex:idx_api_usage_org_token_hour;index:idx_api_usage_hour" json:"hour"`
+
+	Requests int64 `gorm:"not null;default:0" json:"requests"`
+	// Errors are the requests answered with a 4xx or 5xx status
+	Errors int64 `gorm:"not null;default:0" json:"errors"`
+	// LastRequestAt is when the last of the requests was made
+	LastRequestAt time.Time `gorm:"not null" json:"last_request_at"`
+}
+
+// TableName overrides the table name.
+func (APIUsage) TableName() string {
+	return "api_usage"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (u *APIUsage) BeforeCreate(tx *gorm.DB) error {
+	if u.ID == uuid.Nil {
+		u.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
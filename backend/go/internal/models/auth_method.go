@@ -22,9 +22,9 @@ type AuthMethod struct {
 	ProviderID string `gorm:"not null;uniqueIndex:idx_auth_method_provider" json:"provider_id"` // External provider's user ID
 	Email      string `gorm:"index:idx_auth_method_email" json:"email"`   // Email from provider
 
-	// OAuth tokens (stored encrypted at application level)
-	AccessToken  string     `gorm:"type:text" json:"-"`
-	RefreshToken string     `gorm:"type:text" json:"-"`
+	// OAuth tokens, encrypted at rest with ENCRYPTION_KEYS
+	AccessToken  string     `gorm:"type:text;serializer:encrypted" json:"-"`
+	RefreshToken string     `gorm:"type:text;serializer:encrypted" json:"-"`
 	TokenExpiry  *time.Time `json:"token_expiry,omitempty"`
 
 	// Password (hashed, only for email provider)
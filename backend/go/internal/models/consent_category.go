@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Built-in consent categories, which consent records also keep in their
+// boolean columns for older clients.
+const (
+	ConsentNecessary  = "necessary"
+	ConsentAnalytics  = "analytics"
+	ConsentMarketing  = "marketing"
+	ConsentFunctional = "functional"
+)
+
+// ConsentCategory is a purpose people can consent to, such as analytics or
+// AI processing. The built-in categories exist without a row; a row with a
+// built-in key overrides its name and description.
+type ConsentCategory struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Key         string `gorm:"type:varchar(50);not null;uniqueIndex:idx_consent_category_key" json:"key"`
+	Name        string `gorm:"type:varchar(100);not null" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+	// Required categories are always granted and can't be declined
+	Required bool `gorm:"not null;default:false" json:"required"`
+	// Position orders categories in the consent banner
+	Position int `gorm:"not null;default:0" json:"position"`
+}
+
+// TableName overrides the table name.
+func (ConsentCategory) TableName() string {
+	return "consent_categories"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (c *ConsentCategory) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
+
+// DefaultConsentCategories returns the built-in categories.
+func DefaultConsentCategories() []*ConsentCategory {
+	return []*ConsentCategory{
+		{Key: ConsentNecessary, Name: "Necessary", Description: "Required for the site to work, such as keeping you signed in.", Required: true, Position: 0},
+		{Key: ConsentFunctional, Name: "Functional", Description: "Remember your preferences, such as theme and language.", Position: 1},
+		{Key: ConsentAnalytics, Name: "Analytics", Description: "Help us understand how the site is used.", Position: 2},
+		{Key: ConsentMarketing, Name: "Marketing", Description: "Measure and personalize our marketing.", Position: 3},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -25,6 +26,13 @@ type CookieConsent struct {
 	AnalyticsCookies  bool `gorm:"default:false" json:"analytics_cookies"`
 	MarketingCookies  bool `gorm:"default:false" json:"marketing_cookies"`
 	FunctionalCookies bool `gorm:"default:false" json:"functional_cookies"`
+	// Categories maps every consent category's key to whether it was
+	// granted; records from before categories were configurable have only
+	// the columns above
+	Categories datatypes.JSON `gorm:"type:jsonb" json:"categories,omitempty"`
+	// DoNotSell opts out of the sale or sharing of personal information
+	// under CCPA/CPRA; it declines marketing while set
+	DoNotSell bool `gorm:"not null;default:false" json:"do_not_sell"`
 
 	// Consent metadata
 	ConsentVersion string    `gorm:"type:varchar(50);not null" json:"consent_version"` // Version of consent policy
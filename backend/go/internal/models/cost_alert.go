@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CostAlert notifies its owner when a meeting's cost reaches Threshold. An
+// alert without a MeetingID watches every meeting of the organization.
+type CostAlert struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID  `gorm:"type:uuid;not null;index:idx_cost_alert_org" json:"organization_id"`
+	PersonID       uuid.UUID  `gorm:"type:uuid;not null;index" json:"person_id"`
+	MeetingID      *uuid.UUID `gorm:"type:uuid;index" json:"meeting_id,omitempty"`
+	Threshold      float64    `gorm:"type:decimal(12,2);not null" json:"threshold"`
+
+	// LastTriggeredAt is when the alert last fired for any meeting
+	LastTriggeredAt *time.Time `json:"last_triggered_at,omitempty"`
+}
+
+// TableName overrides the table name.
+func (CostAlert) TableName() string {
+	return "cost_alerts"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (a *CostAlert) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
+
+// CostAlertTrigger records that an alert fired for a meeting, so it fires
+// at most once per meeting.
+type CostAlertTrigger struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	AlertID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_cost_alert_trigger" json:"alert_id"`
+	MeetingID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_cost_alert_trigger" json:"meeting_id"`
+	// Cost is the meeting's cost when the alert fired
+	Cost float64 `gorm:"type:decimal(12,2);not null" json:"cost"`
+}
+
+// TableName overrides the table name.
+func (CostAlertTrigger) TableName() string {
+	return "cost_alert_triggers"
+}
+
+// CostMilestone records that a meeting's cost passed one of the round
+// amounts announced in its organization's Slack channel, so each is
+// announced at most once per meeting.
+type CostMilestone struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index:idx_cost_milestone_org" json:"organization_id"`
+	MeetingID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_cost_milestone" json:"meeting_id"`
+	Milestone      float64   `gorm:"type:decimal(12,2);not null;uniqueIndex:idx_cost_milestone" json:"milestone"`
+	// Cost is the meeting's cost when it passed the milestone
+	Cost float64 `gorm:"type:decimal(12,2);not null" json:"cost"`
+	// PostedAt is when it was announced; nil when the organization's
+	// throttle held it back or posting failed
+	PostedAt *time.Time `gorm:"index:idx_cost_milestone_org" json:"posted_at,omitempty"`
+}
+
+// TableName overrides the table name.
+func (CostMilestone) TableName() string {
+	return "cost_milestones"
+}
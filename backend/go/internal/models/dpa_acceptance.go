@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DPAAcceptance records an organization accepting a version of the data
+// processing agreement.
+type DPAAcceptance struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_dpa_acceptance_version" json:"organization_id"`
+	Version        string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_dpa_acceptance_version" json:"version"`
+	AcceptedByID   uuid.UUID `gorm:"type:uuid;not null" json:"accepted_by_id"`
+	AcceptedAt     time.Time `gorm:"not null" json:"accepted_at"`
+	IPAddress      string    `json:"ip_address,omitempty"`
+	UserAgent      string    `json:"user_agent,omitempty"`
+}
+
+// TableName overrides the table name.
+func (DPAAcceptance) TableName() string {
+	return "dpa_acceptances"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (a *DPAAcceptance) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
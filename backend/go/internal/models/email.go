@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Email delivery statuses.
+const (
+	EmailQueued = "queued" // Rendered and waiting for the worker
+	EmailSent   = "sent"   // Accepted by the provider
+	EmailFailed = "failed" // The last attempt failed; the queue may retry it
+)
+
+// EmailDelivery logs one outgoing email. Bodies are not kept, since they can
+// carry single-use links.
+type EmailDelivery struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Recipient         string     `gorm:"not null;index" json:"recipient"`
+	Template          string     `gorm:"size:50;not null" json:"template"`
+	Subject           string     `gorm:"not null" json:"subject"`
+	Driver            string     `gorm:"size:20;not null" json:"driver"`
+	Status            string     `gorm:"size:20;not null;default:'queued';index" json:"status"`
+	Attempts          int        `gorm:"default:0" json:"attempts"`
+	ProviderMessageID string     `json:"provider_message_id,omitempty"`
+	Error             string     `gorm:"type:text" json:"error,omitempty"`
+	SentAt            *time.Time `json:"sent_at,omitempty"`
+}
+
+// TableName overrides the table name.
+func (EmailDelivery) TableName() string {
+	return "email_deliveries"
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ExtensionToken lets a person's browser extension track meetings in one
+// organization through the extension API, and nothing else. Only its hash
+// is stored.
+type ExtensionToken struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	PersonID       uuid.UUID `gorm:"type:uuid;not null;index:idx_extension_token_person" json:"person_id"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null" json:"organization_id"`
+	// Name tells the person's tokens apart, e.g. the browser it is in
+	Name      string `gorm:"type:varchar(100)" json:"name"`
+	TokenHash string `gorm:"type:varchar(64);not null;uniqueIndex:idx_extension_token_hash" json:"-"` // SHA256 of the token
+
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// TableName overrides the table name.
+func (ExtensionToken) TableName() string {
+	return "extension_tokens"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (t *ExtensionToken) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Holiday is a day an organization doesn't work, such as a public
+// holiday or a company-wide day off. Meetings that start on one are
+// reported apart from those in working hours.
+type Holiday struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_holiday_date" json:"organization_id"`
+	// Date is the day in the organization's timezone, held as midnight UTC
+	Date time.Time `gorm:"type:date;not null;uniqueIndex:idx_holiday_date" json:"date"`
+	Name string    `gorm:"type:varchar(100);not null" json:"name"`
+}
+
+// TableName overrides the table name.
+func (Holiday) TableName() string {
+	return "holidays"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (h *Holiday) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
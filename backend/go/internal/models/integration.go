@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Integration providers.
+const (
+	IntegrationZoom   = "zoom"
+	IntegrationGoogle = "google"
+	IntegrationSlack  = "slack"
+	IntegrationTeams  = "teams"
+
+	// HR systems that member wages are synced from
+	IntegrationBambooHR = "bamboohr"
+	IntegrationWorkday  = "workday"
+	IntegrationCSV      = "csv"
+
+	// Data warehouses meeting data is exported to
+	IntegrationBigQuery  = "bigquery"
+	IntegrationSnowflake = "snowflake"
+	IntegrationS3        = "s3"
+)
+
+// Integration statuses.
+const (
+	IntegrationConnected    = "connected"
+	IntegrationDisconnected = "disconnected"
+)
+
+// Integration is an organization's connection to an outside service, such
+// as its Zoom account or Slack workspace, with the organization's settings
+// for it. Disconnecting clears the tokens but keeps the settings.
+type Integration struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_integration_provider" json:"organization_id"`
+	Provider       string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_integration_provider" json:"provider"`
+	Status         string    `gorm:"type:varchar(20);not null;default:'disconnected'" json:"status"`
+
+	// ExternalAccount names the connected account, e.g. the Slack workspace
+	ExternalAccount string `gorm:"type:varchar(255)" json:"external_account,omitempty"`
+
+	// Tokens the provider issued for the organization, encrypted at rest
+	AccessToken    string     `gorm:"type:text;serializer:encrypted" json:"-"`
+	RefreshToken   string     `gorm:"type:text;serializer:encrypted" json:"-"`
+	TokenExpiresAt *time.Time `json:"-"`
+
+	// Settings is a JSON object of provider-specific options
+	Settings datatypes.JSON `gorm:"type:jsonb" json:"settings,omitempty"`
+
+	ConnectedByID  *uuid.UUID `gorm:"type:uuid" json:"connected_by_id,omitempty"`
+	ConnectedAt    *time.Time `json:"connected_at,omitempty"`
+	DisconnectedAt *time.Time `json:"disconnected_at,omitempty"`
+}
+
+// TableName overrides the table name.
+func (Integration) TableName() string {
+	return "integrations"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (i *Integration) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Invoice statuses, as Stripe reports them.
+const (
+	InvoiceDraft         = "draft"
+	InvoiceOpen          = "open"
+	InvoicePaid          = "paid"
+	InvoiceVoid          = "void"
+	InvoiceUncollectible = "uncollectible"
+)
+
+// Invoice is a Stripe invoice for an organization's subscription, kept in
+// sync by Stripe's invoice events.
+type Invoice struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index:idx_invoice_org" json:"organization_id"`
+	SubscriptionID uuid.UUID `gorm:"type:uuid;not null;index" json:"subscription_id"`
+
+	Number     string    `gorm:"type:varchar(100)" json:"number"`
+	Status     string    `gorm:"type:varchar(50);not null" json:"status"` // "draft", "open", "paid", "void", "uncollectible"
+	Currency   string    `gorm:"type:varchar(3);default:'USD'" json:"currency"`
+	AmountDue  float64   `gorm:"type:decimal(10,2);not null" json:"amount_due"`
+	AmountPaid float64   `gorm:"type:decimal(10,2);not null" json:"amount_paid"`
+	IssuedAt   time.Time `gorm:"not null;index:idx_invoice_org" json:"issued_at"`
+	// The billing period the invoice covers
+	PeriodStart time.Time  `json:"period_start"`
+	PeriodEnd   time.Time  `json:"period_end"`
+	PaidAt      *time.Time `json:"paid_at,omitempty"`
+
+	// Stripe integration
+	StripeInvoiceID  string `gorm:"type:varchar(255);uniqueIndex:idx_invoice_stripe" json:"stripe_invoice_id"`
+	HostedInvoiceURL string `gorm:"type:text" json:"hosted_invoice_url,omitempty"`
+	InvoicePDF       string `gorm:"type:text" json:"invoice_pdf,omitempty"`
+}
+
+// TableName overrides the table name.
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (i *Invoice) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
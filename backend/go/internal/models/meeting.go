@@ -36,6 +36,51 @@ type Meeting struct {
 	TotalDuration int     `gorm:"default:0" json:"total_duration"` // seconds
 	MaxAttendees  int     `gorm:"default:0" json:"max_attendees"`
 
+	// AttendeeSeconds sums each attendee's time over closed increments
+	AttendeeSeconds int64 `gorm:"not null;default:0" json:"attendee_seconds"`
+
+	// ScheduledStart is when the meeting was meant to start, if it was
+	// scheduled. LateStartSeconds and LateStartCost record, from its first
+	// start, how late it began and what the attendees who checked in
+	// beforehand cost while they waited.
+	ScheduledStart   *time.Time `json:"scheduled_start,omitempty"`
+	LateStartSeconds int        `gorm:"not null;default:0" json:"late_start_seconds"`
+	LateStartCost    float64    `gorm:"type:decimal(12,2);not null;default:0" json:"late_start_cost"`
+
+	// ScheduledEnd is when the meeting was meant to end, if it was
+	// scheduled. OverrunAt is when it was first seen running past then, and
+	// OverrunSeconds and OverrunCost record, each time it stops, how much
+	// of its time and cost came after.
+	ScheduledEnd   *time.Time `json:"scheduled_end,omitempty"`
+	OverrunAt      *time.Time `json:"overrun_at,omitempty"`
+	OverrunSeconds int        `gorm:"not null;default:0" json:"overrun_seconds"`
+	OverrunCost    float64    `gorm:"type:decimal(12,2);not null;default:0" json:"overrun_cost"`
+
+	// FinalizedAt is when an admin locked the stopped meeting's increments
+	// and totals; from then on only corrections change them
+	FinalizedAt   *time.Time `json:"finalized_at,omitempty"`
+	FinalizedByID *uuid.UUID `gorm:"type:uuid" json:"finalized_by_id,omitempty"`
+
+	// ExpectedAttendees and ProjectedCost are what a scheduled meeting is
+	// expected to take and cost at the organization's default wage.
+	// ApprovalStatus is ApprovalPending while a projection over the
+	// organization's approval threshold awaits an approver's decision,
+	// and records the decision after
+	ExpectedAttendees   int        `gorm:"not null;default:0" json:"expected_attendees"`
+	ProjectedCost       float64    `gorm:"type:decimal(12,2);not null;default:0" json:"projected_cost"`
+	ApprovalStatus      string     `gorm:"type:varchar(20);not null;default:''" json:"approval_status,omitempty"`
+	ApprovalDecidedAt   *time.Time `json:"approval_decided_at,omitempty"`
+	ApprovalDecidedByID *uuid.UUID `gorm:"type:uuid" json:"approval_decided_by_id,omitempty"`
+	ApprovalNote        string     `gorm:"type:text;not null;default:''" json:"approval_note,omitempty"`
+
+	// UnarchivedAt is when the meeting was last restored from the archive;
+	// it isn't archived again until it is as old as the archive age
+	UnarchivedAt *time.Time `json:"unarchived_at,omitempty"`
+
+	// IncrementsCompactedAt is when runs of identical increments were last
+	// merged, which happens each time the meeting stops
+	IncrementsCompactedAt *time.Time `json:"-"`
+
 	// Relationships (for preloading)
 	Organization Organization        `gorm:"foreignKey:OrganizationID" json:"-"`
 	CreatedBy    Person              `gorm:"foreignKey:CreatedByID" json:"-"`
@@ -43,6 +88,13 @@ type Meeting struct {
 	Participants []MeetingParticipant `gorm:"foreignKey:MeetingID" json:"-"`
 }
 
+// Meeting approval statuses; meetings that need no approval have none.
+const (
+	ApprovalPending  = "pending"
+	ApprovalApproved = "approved"
+	ApprovalRejected = "rejected"
+)
+
 // TableName overrides the table name.
 func (Meeting) TableName() string {
 	return "meetings"
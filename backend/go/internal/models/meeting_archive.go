@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MeetingArchive is a meeting moved out of the live tables once it is old.
+// Data holds the gzipped JSON rows of the meeting and its increments,
+// participants and ratings, which restoring puts back; the other fields
+// summarize it for listings.
+type MeetingArchive struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"` // When it was archived
+
+	MeetingID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"meeting_id"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index:idx_meeting_archive_org" json:"organization_id"`
+
+	Purpose         string     `gorm:"type:text" json:"purpose"`
+	StartedAt       *time.Time `gorm:"index:idx_meeting_archive_org" json:"started_at,omitempty"`
+	StoppedAt       *time.Time `json:"stopped_at,omitempty"`
+	TotalCost       float64    `gorm:"type:decimal(12,2);not null;default:0" json:"total_cost"`
+	TotalDuration   int        `gorm:"not null;default:0" json:"total_duration"`
+	MaxAttendees    int        `gorm:"not null;default:0" json:"max_attendees"`
+	AttendeeSeconds int64      `gorm:"not null;default:0" json:"attendee_seconds"`
+	IncrementCount  int        `gorm:"not null;default:0" json:"increment_count"`
+
+	// Size is the length of Data in bytes
+	Size int    `gorm:"not null;default:0" json:"size"`
+	Data []byte `gorm:"not null" json:"-"`
+}
+
+// TableName overrides the table name.
+func (MeetingArchive) TableName() string {
+	return "meeting_archives"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (a *MeetingArchive) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
+
+// MeetingArchiveDay is what an archived meeting's increments cost on one
+// UTC day, which the daily report totals count in place of the increments.
+type MeetingArchiveDay struct {
+	MeetingID uuid.UUID `gorm:"type:uuid;primaryKey" json:"meeting_id"`
+	Day       time.Time `gorm:"type:date;primaryKey" json:"day"`
+	Cost      float64   `gorm:"type:decimal(14,2);not null;default:0" json:"cost"`
+	Seconds   int64     `gorm:"not null;default:0" json:"seconds"`
+}
+
+// TableName overrides the table name.
+func (MeetingArchiveDay) TableName() string {
+	return "meeting_archive_days"
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MeetingRating is a participant's answer to the survey sent when a meeting
+// stops. Each participant rates a meeting once; answering again changes the
+// rating.
+type MeetingRating struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	MeetingID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_meeting_rating" json:"meeting_id"`
+	PersonID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_meeting_rating;index" json:"person_id"`
+	Rating    int       `gorm:"not null" json:"rating"`
+	// Source is how the answer came in: RatingSourceLink or RatingSourceApp
+	Source string `gorm:"type:varchar(20);not null" json:"source"`
+}
+
+// Rating sources.
+const (
+	RatingSourceLink = "link" // The signed link in the survey notification
+	RatingSourceApp  = "app"  // A signed-in client, prompted over the websocket
+)
+
+// TableName overrides the table name.
+func (MeetingRating) TableName() string {
+	return "meeting_ratings"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (r *MeetingRating) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreference turns one notification event on or off for one
+// channel of a person. Without a row the channel's default applies.
+type NotificationPreference struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	PersonID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_notification_pref" json:"person_id"`
+	Event    string    `gorm:"size:50;not null;uniqueIndex:idx_notification_pref" json:"event"`
+	Channel  string    `gorm:"size:20;not null;uniqueIndex:idx_notification_pref" json:"channel"`
+	Enabled  bool      `gorm:"not null" json:"enabled"`
+}
+
+// TableName overrides the table name.
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
+
+// PushSubscription is a browser registered to receive a person's Web Push
+// notifications.
+type PushSubscription struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	PersonID  uuid.UUID `gorm:"type:uuid;not null;index" json:"person_id"`
+	Endpoint  string    `gorm:"not null;uniqueIndex" json:"endpoint"`
+	P256dh    string    `gorm:"not null" json:"-"`
+	Auth      string    `gorm:"not null" json:"-"`
+	UserAgent string    `json:"user_agent,omitempty"`
+}
+
+// TableName overrides the table name.
+func (PushSubscription) TableName() string {
+	return "push_subscriptions"
+}
@@ -21,13 +21,85 @@ type Organization struct {
 	Description string `gorm:"type:text" json:"description"`
 
 	// Default wage settings
-	DefaultWage     float64 `gorm:"type:decimal(10,2);default:0" json:"default_wage"` // Default hourly wage
-	UseBlendedWage bool    `gorm:"default:false" json:"use_blended_wage"`              // Use blended wage instead of individual
+	DefaultWage    float64 `gorm:"type:decimal(10,2);default:0" json:"default_wage"` // Default hourly wage
+	UseBlendedWage bool    `gorm:"default:false" json:"use_blended_wage"`            // Use blended wage instead of individual
+
+	// SeatOverage is what adding a member beyond a per-seat plan's seats
+	// does: SeatOverageBlock or SeatOverageAddSeat
+	SeatOverage string `gorm:"type:varchar(20);not null;default:'block'" json:"seat_overage"`
+
+	// WageVisibility is who sees members' individual wages: one of the
+	// WageVisibility settings
+	WageVisibility string `gorm:"type:varchar(20);not null;default:'managers_and_self'" json:"wage_visibility"`
+
+	// AggregateOnly never stores members' individual wages: costs use the
+	// default wage as a blended rate and nothing is broken down per person
+	AggregateOnly bool `gorm:"not null;default:false" json:"aggregate_only"`
+
+	// Timezone is the IANA name of the zone the organization's reports
+	// count days, weeks and months in; empty falls back to the reader's
+	Timezone string `gorm:"type:varchar(64);not null;default:''" json:"timezone,omitempty"`
+
+	// Working hours, in the organization's timezone: WorkingDays are the
+	// weekdays worked, as comma-separated WorkingDayNames, and the working
+	// day runs from WorkdayStart to WorkdayEnd, as "15:04". All are empty
+	// when working hours are not set
+	WorkingDays  string `gorm:"type:varchar(32);not null;default:''" json:"working_days,omitempty"`
+	WorkdayStart string `gorm:"type:varchar(5);not null;default:''" json:"workday_start,omitempty"`
+	WorkdayEnd   string `gorm:"type:varchar(5);not null;default:''" json:"workday_end,omitempty"`
+
+	// MonthlyBudget is what the organization means to spend on meetings
+	// per calendar month, in the organization's timezone; nil without a
+	// budget
+	MonthlyBudget *float64 `gorm:"type:decimal(12,2)" json:"monthly_budget,omitempty"`
+
+	// ApprovalThreshold is the projected cost above which a scheduled
+	// meeting waits for an approver before it can start; nil when meetings
+	// need no approval
+	ApprovalThreshold *float64 `gorm:"type:decimal(12,2)" json:"approval_threshold,omitempty"`
+
+	// Benchmarks reports compare meetings with; nil when not set
+	TargetAttendeeHourCost *float64 `gorm:"type:decimal(10,2)" json:"target_attendee_hour_cost,omitempty"` // Cost of one attendee for an hour
+	TargetMeetingMinutes   *int     `json:"target_meeting_minutes,omitempty"`
+
+	// MeetingSurveys asks participants to rate each meeting once it stops
+	MeetingSurveys bool `gorm:"not null;default:false" json:"meeting_surveys"`
+
+	// Visibility is who can find the organization and join it without an
+	// invitation: one of the OrgVisibility settings
+	Visibility string `gorm:"type:varchar(20);not null;default:'invite_only'" json:"visibility"`
+	// Domain is the email domain, such as "example.com", whose people
+	// can find and join the organization when it is discoverable by domain
+	Domain string `gorm:"type:varchar(255);not null;default:'';index:idx_org_domain" json:"domain,omitempty"`
 
 	// Settings - flexible storage
 	Settings datatypes.JSON `gorm:"type:jsonb" json:"settings,omitempty"`
 }
 
+// Seat overage settings.
+const (
+	SeatOverageBlock   = "block"    // Refuse the member until seats are added
+	SeatOverageAddSeat = "add_seat" // Buy another seat, prorated
+)
+
+// Wage visibility settings.
+const (
+	WageVisibilityManagersAndSelf = "managers_and_self" // Members who manage members, and each member their own
+	WageVisibilityAdmins          = "admins"            // Organization admins only
+	WageVisibilityAggregate       = "aggregate"         // Nobody; admins see only averages
+)
+
+// Organization visibility settings.
+const (
+	OrgVisibilityInviteOnly = "invite_only" // Members join only when added
+	OrgVisibilityDomain     = "domain"      // People with an email at the organization's domain can find and join it
+	OrgVisibilityPublic     = "public"      // Anyone can find and join it
+)
+
+// WorkingDayNames name the days of the week in working hours, indexed by
+// time.Weekday.
+var WorkingDayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
 // TableName overrides the table name.
 func (Organization) TableName() string {
 	return "organizations"
@@ -7,6 +7,14 @@ import (
 	"gorm.io/gorm"
 )
 
+// Payment statuses.
+const (
+	PaymentSucceeded = "succeeded"
+	PaymentPending   = "pending"
+	PaymentFailed    = "failed"
+	PaymentRefunded  = "refunded"
+)
+
 // Payment represents a payment transaction.
 type Payment struct {
 	ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
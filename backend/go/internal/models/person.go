@@ -26,6 +26,10 @@ type Person struct {
 	// Metadata
 	Timezone string `gorm:"default:'UTC'" json:"timezone"`
 	Locale   string `gorm:"default:'en-US'" json:"locale"`
+
+	// ActiveOrganizationID is the organization the person last switched
+	// to, which new sessions start in
+	ActiveOrganizationID *uuid.UUID `gorm:"type:uuid" json:"active_organization_id,omitempty"`
 }
 
 // TableName overrides the table name.
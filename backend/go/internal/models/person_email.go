@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PersonEmail is a secondary email address of a person, such as a work
+// alias, that integrations match meeting participants by once it is
+// verified. It can't be used to sign in.
+type PersonEmail struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	PersonID uuid.UUID `gorm:"type:uuid;not null;index:idx_person_email_person" json:"person_id"`
+	Email    string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_person_email_email" json:"email"` // Lowercase
+
+	// Verification
+	VerifiedAt            *time.Time `json:"verified_at,omitempty"`
+	VerificationTokenHash string     `gorm:"type:varchar(64);index:idx_person_email_token" json:"-"` // SHA256 of the emailed token
+	VerificationExpiresAt *time.Time `json:"-"`
+}
+
+// TableName overrides the table name.
+func (PersonEmail) TableName() string {
+	return "person_emails"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (e *PersonEmail) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
@@ -26,9 +26,17 @@ type PersonOrganizationProfile struct {
 	LeftAt   *time.Time `json:"left_at,omitempty"` // Null if still active
 
 	// Wage information (nullable; uses org default if null)
-	HourlyWage    *float64   `gorm:"type:decimal(10,2)" json:"hourly_wage,omitempty"`
+	HourlyWage    *float64   `gorm:"type:text;serializer:encrypted_wage" json:"hourly_wage,omitempty"` // Encrypted at rest when wage encryption is on
 	WageUpdatedAt *time.Time `json:"wage_updated_at,omitempty"`
 
+	// WageBandID assigns the member a wage band in place of an exact wage;
+	// costs use the band's midpoint
+	WageBandID *uuid.UUID `gorm:"type:uuid" json:"wage_band_id,omitempty"`
+
+	// MeetingApprover designates the member to decide on meetings that
+	// need approval
+	MeetingApprover bool `gorm:"not null;default:false" json:"meeting_approver"`
+
 	// External IDs for meeting integration (Zoom, Teams, Slack, etc.)
 	ExternalIDs datatypes.JSON `gorm:"type:jsonb" json:"external_ids,omitempty"`
 
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportDailyCost is one organization's meeting totals for one UTC day,
+// kept up to date by the worker so reports need not scan every meeting and
+// increment.
+type ReportDailyCost struct {
+	OrganizationID uuid.UUID `gorm:"type:uuid;primaryKey" json:"organization_id"`
+	Day            time.Time `gorm:"type:date;primaryKey" json:"day"`
+
+	// Meetings started on the day, with their whole cost and duration
+	MeetingCount   int64   `gorm:"not null;default:0" json:"meeting_count"`
+	MeetingCost    float64 `gorm:"type:decimal(14,2);not null;default:0" json:"meeting_cost"`
+	MeetingSeconds int64   `gorm:"not null;default:0" json:"meeting_seconds"`
+	// AttendeeTotal sums the peak attendance of those meetings
+	AttendeeTotal int64 `gorm:"not null;default:0" json:"attendee_total"`
+	// AttendeeSeconds sums the attendee time of those meetings
+	AttendeeSeconds int64 `gorm:"not null;default:0" json:"attendee_seconds"`
+
+	// Meeting time on the day, by increment, whenever the meeting started
+	Cost    float64 `gorm:"type:decimal(14,2);not null;default:0" json:"cost"`
+	Seconds int64   `gorm:"not null;default:0" json:"seconds"`
+
+	RefreshedAt time.Time `gorm:"not null;index" json:"refreshed_at"`
+}
+
+// TableName overrides the table name.
+func (ReportDailyCost) TableName() string {
+	return "report_daily_costs"
+}
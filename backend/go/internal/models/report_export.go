@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Report export statuses.
+const (
+	ReportExportPending = "pending" // Waiting for the worker
+	ReportExportReady   = "ready"   // Rendered and downloadable until it expires
+	ReportExportFailed  = "failed"  // The last attempt failed; the queue may retry it
+)
+
+// ReportExport is a report rendered to a file by the worker, with the
+// parameters it was requested with. The file is kept in Data until
+// ExpiresAt.
+type ReportExport struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index" json:"organization_id"`
+	RequestedByID  uuid.UUID `gorm:"type:uuid;not null" json:"requested_by_id"`
+	Report         string    `gorm:"size:20;not null" json:"report"`
+	Format         string    `gorm:"size:10;not null" json:"format"`
+
+	// Report parameters
+	PeriodStart time.Time `gorm:"not null" json:"period_start"`
+	PeriodEnd   time.Time `gorm:"not null" json:"period_end"`
+	Interval    string    `gorm:"column:trend_interval;size:10" json:"interval,omitempty"`
+	Compare     bool      `gorm:"default:false" json:"compare"`
+	Limit       int       `gorm:"column:row_limit;default:0" json:"limit,omitempty"`
+
+	Status      string     `gorm:"size:20;not null;default:'pending'" json:"status"`
+	Attempts    int        `gorm:"default:0" json:"attempts"`
+	Error       string     `gorm:"type:text" json:"error,omitempty"`
+	FileName    string     `json:"file_name,omitempty"`
+	ContentType string     `json:"content_type,omitempty"`
+	Data        []byte     `json:"-"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   time.Time  `gorm:"not null;index" json:"expires_at"`
+}
+
+// TableName overrides the table name.
+func (ReportExport) TableName() string {
+	return "report_exports"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (e *ReportExport) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
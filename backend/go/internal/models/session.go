@@ -22,6 +22,10 @@ type Session struct {
 	ExpiresAt    time.Time `gorm:"not null;index:idx_session_expires" json:"expires_at"`
 	LastActivity time.Time `gorm:"not null" json:"last_activity"`
 
+	// ActiveOrganizationID is the organization requests default to when
+	// they name none
+	ActiveOrganizationID *uuid.UUID `gorm:"type:uuid" json:"active_organization_id,omitempty"`
+
 	// Metadata
 	UserAgent string `json:"user_agent,omitempty"`
 	IPAddress string `json:"ip_address,omitempty"`
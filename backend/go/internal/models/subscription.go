@@ -7,6 +7,22 @@ import (
 	"gorm.io/gorm"
 )
 
+// Plan types.
+const (
+	PlanFree       = "free"
+	PlanBasic      = "basic"
+	PlanPremium    = "premium"
+	PlanEnterprise = "enterprise"
+)
+
+// Subscription statuses.
+const (
+	SubscriptionActive   = "active"
+	SubscriptionCanceled = "canceled" // Access continues until CurrentPeriodEnd
+	SubscriptionPastDue  = "past_due"
+	SubscriptionTrialing = "trialing"
+)
+
 // Subscription represents an organization's subscription to the service.
 type Subscription struct {
 	ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
@@ -19,13 +35,22 @@ type Subscription struct {
 
 	// Subscription details
 	PlanType           string    `gorm:"type:varchar(50);not null" json:"plan_type"` // "free", "basic", "premium", "enterprise"
-	Status             string    `gorm:"type:varchar(50);not null" json:"status"`     // "active", "canceled", "past_due", "trialing"
+	Status             string    `gorm:"type:varchar(50);not null" json:"status"`    // "active", "canceled", "past_due", "trialing"
 	CurrentPeriodStart time.Time `json:"current_period_start"`
 	CurrentPeriodEnd   time.Time `json:"current_period_end"`
 
+	// Seats is how many active members a per-seat plan pays for; zero on
+	// other plans and trials
+	Seats int `gorm:"not null;default:0" json:"seats"`
+
+	// TrialEndingNotifiedAt is when admins were told the trial ends soon
+	TrialEndingNotifiedAt *time.Time `json:"trial_ending_notified_at,omitempty"`
+
 	// Stripe integration
-	StripeCustomerID       string `gorm:"type:varchar(255);uniqueIndex:idx_subscription_stripe_customer" json:"stripe_customer_id,omitempty"`
-	StripeSubscriptionID  string `gorm:"type:varchar(255);uniqueIndex:idx_subscription_stripe_sub" json:"stripe_subscription_id,omitempty"`
+	// Empty until the organization subscribes through Stripe, so uniqueness
+	// only applies to set values
+	StripeCustomerID     string `gorm:"type:varchar(255);uniqueIndex:idx_subscription_stripe_customer,where:stripe_customer_id <> ''" json:"stripe_customer_id,omitempty"`
+	StripeSubscriptionID string `gorm:"type:varchar(255);uniqueIndex:idx_subscription_stripe_sub,where:stripe_subscription_id <> ''" json:"stripe_subscription_id,omitempty"`
 
 	// Relationships
 	Organization Organization `gorm:"foreignKey:OrganizationID" json:"-"`
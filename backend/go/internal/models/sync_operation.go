@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SyncOperation records the outcome of a meeting operation a client
+// recorded offline and synced, so syncing it again returns that outcome
+// rather than applying it twice. OperationID is the client's ID for it.
+type SyncOperation struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	PersonID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_sync_operation,priority:1" json:"person_id"`
+	OperationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_sync_operation,priority:2" json:"operation_id"`
+	MeetingID   uuid.UUID `gorm:"type:uuid;not null" json:"meeting_id"`
+	Type        string    `gorm:"type:varchar(20);not null" json:"type"`
+
+	// Status is SyncPending while the operation is being applied
+	Status string `gorm:"type:varchar(20);not null" json:"status"`
+	Reason string `gorm:"type:text;not null;default:''" json:"reason,omitempty"`
+}
+
+// Sync operation statuses.
+const (
+	SyncPending  = "pending"
+	SyncApplied  = "applied"
+	SyncConflict = "conflict"
+)
+
+// TableName overrides the table name.
+func (SyncOperation) TableName() string {
+	return "sync_operations"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (o *SyncOperation) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UsageRecord is an organization's billable usage in one calendar month.
+// The increment pipeline adds to it as meeting time is recorded.
+type UsageRecord struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_usage_org_period" json:"organization_id"`
+	// Period is the first day of the month, UTC
+	Period time.Time `gorm:"type:date;not null;uniqueIndex:idx_usage_org_period" json:"period"`
+
+	// MeetingSeconds is the time meetings ran, however many attended
+	MeetingSeconds int64 `gorm:"not null;default:0" json:"meeting_seconds"`
+	// ActiveMembers is the most active members seen in the month
+	ActiveMembers int `gorm:"not null;default:0" json:"active_members"`
+
+	// Usage already reported to Stripe
+	ReportedMeetingMinutes int64      `gorm:"not null;default:0" json:"reported_meeting_minutes"`
+	ReportedActiveMembers  int        `gorm:"not null;default:0" json:"reported_active_members"`
+	ReportedAt             *time.Time `json:"reported_at,omitempty"`
+}
+
+// TableName overrides the table name.
+func (UsageRecord) TableName() string {
+	return "usage_records"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (u *UsageRecord) BeforeCreate(tx *gorm.DB) error {
+	if u.ID == uuid.Nil {
+		u.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
+
+// UsagePeriod returns the start of the month containing t, UTC.
+func UsagePeriod(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WageBand is a range of hourly wages, such as a job level, that members
+// can be assigned instead of an exact wage. A banded member's wage counts
+// as the band's midpoint.
+type WageBand struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_wage_band_name" json:"organization_id"`
+	Name           string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_wage_band_name" json:"name"`
+	MinWage        float64   `gorm:"type:decimal(10,2);not null" json:"min_wage"`
+	MaxWage        float64   `gorm:"type:decimal(10,2);not null" json:"max_wage"`
+}
+
+// Midpoint is the wage a member in the band counts as.
+func (b *WageBand) Midpoint() float64 {
+	return (b.MinWage + b.MaxWage) / 2
+}
+
+// TableName overrides the table name.
+func (WageBand) TableName() string {
+	return "wage_bands"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (b *WageBand) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WarehouseExport tracks an organization's export to the data warehouse it
+// connected as an integration. Each stream's watermark is the change time
+// and ID of the last row pushed; the next run resumes after it.
+type WarehouseExport struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_warehouse_export_provider" json:"organization_id"`
+	Provider       string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_warehouse_export_provider" json:"provider"`
+
+	// Watermarks of the meetings stream, which carries their increments,
+	// and of the memberships stream
+	MeetingsAt    *time.Time `json:"meetings_at,omitempty"`
+	MeetingsID    *uuid.UUID `gorm:"type:uuid" json:"meetings_id,omitempty"`
+	MembershipsAt *time.Time `json:"memberships_at,omitempty"`
+	MembershipsID *uuid.UUID `gorm:"type:uuid" json:"memberships_id,omitempty"`
+
+	// Rows pushed since the watermarks were last reset
+	MeetingRows    int64 `gorm:"not null;default:0" json:"meeting_rows"`
+	IncrementRows  int64 `gorm:"not null;default:0" json:"increment_rows"`
+	MembershipRows int64 `gorm:"not null;default:0" json:"membership_rows"`
+
+	// RunningUntil is when the run under way gives up its claim on the
+	// export; no other run starts before then
+	RunningUntil  *time.Time `json:"running_until,omitempty"`
+	LastRunAt     *time.Time `json:"last_run_at,omitempty"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	LastError     string     `gorm:"type:text;not null;default:''" json:"last_error,omitempty"`
+}
+
+// TableName overrides the table name.
+func (WarehouseExport) TableName() string {
+	return "warehouse_exports"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (w *WarehouseExport) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
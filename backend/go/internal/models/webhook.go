@@ -0,0 +1,95 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Webhook delivery statuses.
+const (
+	WebhookDeliveryPending   = "pending"   // Queued, not yet attempted
+	WebhookDeliveryRetrying  = "retrying"  // Failed at least once; another attempt is scheduled
+	WebhookDeliverySucceeded = "succeeded" // The endpoint answered 2xx
+	WebhookDeliveryFailed    = "failed"    // Dead-lettered after the last attempt
+)
+
+// WebhookEndpoint is an organization's URL that receives signed event
+// notifications.
+type WebhookEndpoint struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index:idx_webhook_endpoint_org" json:"organization_id"`
+	URL            string    `gorm:"not null" json:"url"`
+	Description    string    `json:"description,omitempty"`
+
+	// Secret keys the HMAC-SHA256 signature of every delivery; it is
+	// encrypted at rest
+	Secret string `gorm:"not null;serializer:encrypted" json:"-"`
+
+	// Events is a JSON array of subscribed event types; empty means all
+	Events datatypes.JSON `gorm:"type:jsonb" json:"events,omitempty"`
+	Active bool           `gorm:"default:true" json:"active"`
+
+	// Relationships
+	Organization Organization `gorm:"foreignKey:OrganizationID" json:"-"`
+}
+
+// TableName overrides the table name.
+func (WebhookEndpoint) TableName() string {
+	return "webhook_endpoints"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (w *WebhookEndpoint) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
+
+// WebhookDelivery is one event sent (or to be sent) to one endpoint, with
+// the outcome of its latest attempt. A manual redelivery creates a new row
+// with the same EventID.
+type WebhookDelivery struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time `gorm:"index:idx_webhook_delivery_endpoint,priority:2" json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	EndpointID uuid.UUID      `gorm:"type:uuid;not null;index:idx_webhook_delivery_endpoint,priority:1" json:"endpoint_id"`
+	EventID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_webhook_delivery_event" json:"event_id"`
+	EventType  string         `gorm:"type:varchar(100);not null" json:"event_type"`
+	Payload    datatypes.JSON `gorm:"type:jsonb;not null" json:"payload"`
+
+	Status        string     `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	Attempts      int        `gorm:"default:0" json:"attempts"`
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+
+	// Outcome of the latest attempt
+	ResponseStatus int    `json:"response_status,omitempty"`
+	ResponseBody   string `json:"response_body,omitempty"` // Truncated; kept for operators, not returned by the API
+	Error          string `json:"error,omitempty"`
+	DurationMs     int64  `json:"duration_ms,omitempty"`
+
+	// Relationships
+	Endpoint WebhookEndpoint `gorm:"foreignKey:EndpointID" json:"-"`
+}
+
+// TableName overrides the table name.
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// BeforeCreate ensures UUID is set if not already.
+func (w *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.Must(uuid.NewRandom())
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Package notify sends notifications over the channels that are not email:
+// Slack direct messages and Web Push.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const slackAPI = "https://slack.com/api/"
+
+// Slack sends messages as a Slack app's bot user. The bot token needs the
+// chat:write scope, and im:write and users:read.email for direct messages.
+type Slack struct {
+	token  string
+	client *http.Client
+}
+
+func NewSlack(token string, timeout time.Duration) *Slack {
+	return &Slack{token: token, client: &http.Client{Timeout: timeout}}
+}
+
+// DirectMessage sends text to the workspace member with the given email.
+func (s *Slack) DirectMessage(ctx context.Context, email, text string) error {
+	var user struct {
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	}
+	if err := s.call(ctx, http.MethodGet, "users.lookupByEmail?email="+url.QueryEscape(email), nil, &user); err != nil {
+		return fmt.Errorf("looking up slack user: %w", err)
+	}
+
+	// Posting to a user ID opens a DM with the bot
+	msg := map[string]interface{}{"channel": user.User.ID, "text": text}
+	if err := s.call(ctx, http.MethodPost, "chat.postMessage", msg, nil); err != nil {
+		return fmt.Errorf("posting slack message: %w", err)
+	}
+	return nil
+}
+
+// PostMessage posts text to a channel, given by ID or as #name, that the
+// bot user is a member of.
+func (s *Slack) PostMessage(ctx context.Context, channel, text string) error {
+	msg := map[string]interface{}{"channel": channel, "text": text}
+	if err := s.call(ctx, http.MethodPost, "chat.postMessage", msg, nil); err != nil {
+		return fmt.Errorf("posting slack message: %w", err)
+	}
+	return nil
+}
+
+// call invokes a Web API method, turning an "ok": false reply into an error.
+func (s *Slack) call(ctx context.Context, method, path string, body, out interface{}) error {
+	var reqBody *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	} else {
+		reqBody = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, slackAPI+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	raw := new(bytes.Buffer)
+	if _, err := raw.ReadFrom(resp.Body); err != nil {
+		return err
+	}
+	var status struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(raw.Bytes(), &status); err != nil {
+		return fmt.Errorf("slack responded %d", resp.StatusCode)
+	}
+	if !status.OK {
+		return fmt.Errorf("slack: %s", status.Error)
+	}
+	if out != nil {
+		return json.Unmarshal(raw.Bytes(), out)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yourorg/meeting-cost/backend/go/internal/outbound"
+)
+
+// ErrSubscriptionGone means the push service no longer accepts messages for
+// a subscription; it should be deleted.
+var ErrSubscriptionGone = errors.New("push subscription expired or unsubscribed")
+
+// PushSubscription is a browser's PushSubscription as returned by
+// pushManager.subscribe: the push service URL and the keys, base64url
+// encoded, to encrypt messages for it.
+type PushSubscription struct {
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+// WebPush sends encrypted Web Push messages (RFC 8291), authenticating to
+// push services with VAPID (RFC 8292).
+type WebPush struct {
+	key       *ecdsa.PrivateKey
+	publicKey string
+	subject   string
+	client    *http.Client
+}
+
+// NewWebPush creates a sender from a VAPID key pair: the base64url P-256
+// private scalar and uncompressed public point. subject is a mailto: or
+// https: contact for the push service operator.
+func NewWebPush(publicKey, privateKey, subject string, timeout time.Duration) (*WebPush, error) {
+	d, err := decodeBase64URL(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decoding VAPID private key: %w", err)
+	}
+	priv, err := ecdh.P256().NewPrivateKey(d)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+	pub := priv.PublicKey().Bytes()
+	if base64.RawURLEncoding.EncodeToString(pub) != publicKey {
+		return nil, fmt.Errorf("VAPID public key does not match the private key")
+	}
+
+	return &WebPush{
+		key: &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: elliptic.P256(),
+				X:     new(big.Int).SetBytes(pub[1:33]),
+				Y:     new(big.Int).SetBytes(pub[33:]),
+			},
+			D: new(big.Int).SetBytes(d),
+		},
+		publicKey: publicKey,
+		subject:   subject,
+		// Endpoints are whatever browsers, or anyone posting a
+		// subscription, say they are
+		client: outbound.Client(timeout),
+	}, nil
+}
+
+// PublicKey returns the applicationServerKey browsers subscribe with.
+func (w *WebPush) PublicKey() string {
+	return w.publicKey
+}
+
+// Send encrypts payload for sub and posts it to the push service.
+func (w *WebPush) Send(ctx context.Context, sub PushSubscription, payload []byte) error {
+	body, err := encrypt(sub, payload)
+	if err != nil {
+		return err
+	}
+
+	u, err := url.Parse(sub.Endpoint)
+	if err != nil || u.Scheme != "https" {
+		return fmt.Errorf("invalid push endpoint")
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": time.Now().Add(12 * time.Hour).Unix(),
+		"sub": w.subject,
+	}).SignedString(w.key)
+	if err != nil {
+		return fmt.Errorf("signing VAPID token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "vapid t="+token+", k="+w.publicKey)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", "86400")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending push message: %w", err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrSubscriptionGone
+	case resp.StatusCode/100 != 2:
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return fmt.Errorf("push service responded %d: %s", resp.StatusCode, detail)
+	}
+	return nil
+}
+
+// recordSize is the aes128gcm record size; payloads must fit in one record.
+const recordSize = 4096
+
+// encrypt encodes payload as a single aes128gcm record for sub (RFC 8291).
+func encrypt(sub PushSubscription, payload []byte) ([]byte, error) {
+	uaPublic, err := decodeBase64URL(sub.P256dh)
+	if err != nil {
+		return nil, fmt.Errorf("decoding p256dh: %w", err)
+	}
+	authSecret, err := decodeBase64URL(sub.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("decoding auth: %w", err)
+	}
+	uaKey, err := ecdh.P256().NewPublicKey(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh: %w", err)
+	}
+	if len(payload)+17+86 > recordSize {
+		return nil, fmt.Errorf("push payload too large")
+	}
+
+	asKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := asKey.PublicKey().Bytes()
+	shared, err := asKey.ECDH(uaKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mix the auth secret and both public keys into the input keying
+	// material, then derive the content key and nonce from a random salt
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPublic...), asPublic...)
+	prkKey, err := hkdf.Extract(sha256.New, shared, authSecret)
+	if err != nil {
+		return nil, err
+	}
+	ikm, err := hkdf.Expand(sha256.New, prkKey, string(keyInfo), 32)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	// 0x02 pads and marks the last (only) record
+	ciphertext := gcm.Seal(nil, nonce, append(payload, 0x02), nil)
+
+	header := make([]byte, 0, 16+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+	return append(header, ciphertext...), nil
+}
+
+// decodeBase64URL accepts base64url with or without padding, as browsers
+// and key generators differ.
+func decodeBase64URL(s string) ([]byte, error) {
+	if b, err := base64.RawURLEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
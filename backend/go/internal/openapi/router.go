@@ -0,0 +1,203 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Route documents one route at registration. Request and Response are
+// example values of the body types; a nil Response documents an empty body.
+type Route struct {
+	Summary     string
+	Description string
+	Query       []Query
+	Request     interface{}
+	Response    interface{}
+	// Status is the success status; it defaults to 200, or 204 without a
+	// Response.
+	Status int
+	// Errors lists the error statuses the handler can return besides the
+	// 400 and 401 implied by parameters and security.
+	Errors []int
+}
+
+// Query documents a query string parameter.
+type Query struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// Router registers routes on a fiber.Router and documents them in a
+// Document, so the spec cannot drift from the routes actually served.
+type Router struct {
+	router     fiber.Router
+	doc        *Document
+	prefix     string
+	tag        string
+	security   string
+	deprecated bool
+	errorType  reflect.Type
+}
+
+// NewRouter wraps r, which serves paths under prefix.
+func NewRouter(r fiber.Router, doc *Document, prefix string) *Router {
+	return &Router{router: r, doc: doc, prefix: prefix, errorType: reflect.TypeOf(ErrorResponse{})}
+}
+
+// Group creates a sub-router, like fiber.Router.Group.
+func (r *Router) Group(prefix string, handlers ...fiber.Handler) *Router {
+	g := *r
+	g.router = r.router.Group(prefix, handlers...)
+	g.prefix = r.prefix + prefix
+	return &g
+}
+
+// Tag returns a copy of r whose routes are tagged name.
+func (r *Router) Tag(name string) *Router {
+	g := *r
+	g.tag = name
+	return &g
+}
+
+// Security returns a copy of r whose routes require scheme, one of
+// BearerAuth, AdminToken, IntrospectionToken or ExtensionToken. The
+// middleware enforcing it is still passed to Group or the route.
+func (r *Router) Security(scheme string) *Router {
+	g := *r
+	g.security = scheme
+	return &g
+}
+
+// Deprecated returns a copy of r whose routes are marked deprecated.
+func (r *Router) Deprecated() *Router {
+	g := *r
+	g.deprecated = true
+	return &g
+}
+
+// ErrorBody returns a copy of r whose error responses have the type of
+// example instead of ErrorResponse.
+func (r *Router) ErrorBody(example interface{}) *Router {
+	g := *r
+	g.errorType = reflect.TypeOf(example)
+	return &g
+}
+
+// Get registers a GET route.
+func (r *Router) Get(path string, route Route, handlers ...fiber.Handler) {
+	r.Add(http.MethodGet, path, route, handlers...)
+}
+
+// Post registers a POST route.
+func (r *Router) Post(path string, route Route, handlers ...fiber.Handler) {
+	r.Add(http.MethodPost, path, route, handlers...)
+}
+
+// Put registers a PUT route.
+func (r *Router) Put(path string, route Route, handlers ...fiber.Handler) {
+	r.Add(http.MethodPut, path, route, handlers...)
+}
+
+// Patch registers a PATCH route.
+func (r *Router) Patch(path string, route Route, handlers ...fiber.Handler) {
+	r.Add(http.MethodPatch, path, route, handlers...)
+}
+
+// Delete registers a DELETE route.
+func (r *Router) Delete(path string, route Route, handlers ...fiber.Handler) {
+	r.Add(http.MethodDelete, path, route, handlers...)
+}
+
+// Add registers the route on the underlying router and documents it.
+func (r *Router) Add(method, path string, route Route, handlers ...fiber.Handler) {
+	r.router.Add(method, path, handlers...)
+	r.doc.addOperation(specPath(r.prefix+path), strings.ToLower(method), r.operation(route, r.prefix+path))
+}
+
+func (r *Router) operation(route Route, path string) *Operation {
+	op := &Operation{
+		Summary:     route.Summary,
+		Description: route.Description,
+		Responses:   make(map[string]Response),
+		Deprecated:  r.deprecated,
+	}
+	if r.tag != "" {
+		op.Tags = []string{r.tag}
+	}
+
+	badRequest := false
+	for _, seg := range strings.Split(path, "/") {
+		if !strings.HasPrefix(seg, ":") {
+			continue
+		}
+		name := strings.TrimSuffix(seg[1:], "?")
+		schema := &Schema{Type: "string"}
+		if name == "id" || strings.HasSuffix(name, "Id") {
+			schema.Format = "uuid"
+		}
+		op.Parameters = append(op.Parameters, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+		badRequest = true
+	}
+	for _, q := range route.Query {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        q.Name,
+			In:          "query",
+			Description: q.Description,
+			Required:    q.Required,
+			Schema:      &Schema{Type: "string"},
+		})
+		badRequest = badRequest || q.Required
+	}
+
+	if route.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  jsonContent(r.doc.schemaFor(reflect.TypeOf(route.Request))),
+		}
+		badRequest = true
+	}
+
+	status := route.Status
+	if status == 0 {
+		status = http.StatusOK
+		if route.Response == nil {
+			status = http.StatusNoContent
+		}
+	}
+	success := Response{Description: http.StatusText(status)}
+	if route.Response != nil {
+		success.Content = jsonContent(r.doc.schemaFor(reflect.TypeOf(route.Response)))
+	}
+	op.Responses[statusKey(status)] = success
+
+	if badRequest {
+		op.Responses[statusKey(http.StatusBadRequest)] = r.doc.errorResponse(http.StatusBadRequest, r.errorType)
+	}
+	if r.security != "" {
+		op.Security = []map[string][]string{{r.security: {}}}
+		op.Responses[statusKey(http.StatusUnauthorized)] = r.doc.errorResponse(http.StatusUnauthorized, r.errorType)
+		if r.security == AdminToken || r.security == IntrospectionToken {
+			// Also returned while the endpoints are disabled
+			op.Responses[statusKey(http.StatusForbidden)] = r.doc.errorResponse(http.StatusForbidden, r.errorType)
+		}
+	}
+	for _, code := range route.Errors {
+		op.Responses[statusKey(code)] = r.doc.errorResponse(code, r.errorType)
+	}
+	return op
+}
+
+// specPath converts a fiber path such as /meetings/:id/ to /meetings/{id}.
+func specPath(path string) string {
+	segs := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	for i, seg := range segs {
+		if strings.HasPrefix(seg, ":") {
+			segs[i] = "{" + strings.TrimSuffix(seg[1:], "?") + "}"
+		}
+	}
+	return strings.Join(segs, "/")
+}
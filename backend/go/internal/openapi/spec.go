@@ -0,0 +1,169 @@
+// Package openapi builds the OpenAPI 3 description of the HTTP API from the
+// routes as they are registered, and serves it with Swagger UI.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Security schemes understood by Router.Security.
+const (
+	BearerAuth         = "bearerAuth"
+	AdminToken         = "adminToken"
+	IntrospectionToken = "introspectionToken"
+	ExtensionToken     = "extensionToken"
+)
+
+// Document is an OpenAPI 3.0 document. Only the parts the API uses are
+// modelled.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+	Tags       []Tag               `json:"tags,omitempty"`
+	types      map[reflect.Type]string
+}
+
+// Info describes the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Tag groups operations in Swagger UI.
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// PathItem maps lower-case HTTP methods to operations.
+type PathItem map[string]*Operation
+
+// Operation describes one route.
+type Operation struct {
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	OperationID string                `json:"operationId,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+}
+
+// Parameter is a path or query parameter.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is a JSON request body.
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response is one documented response.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the reusable schemas and security schemes.
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme describes how a route authenticates.
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// ErrorResponse is the body of every error response.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// New creates an empty Document.
+func New(title, version string) *Document {
+	return &Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: title, Version: version},
+		Paths:   make(map[string]PathItem),
+		Components: Components{
+			Schemas: make(map[string]*Schema),
+			SecuritySchemes: map[string]SecurityScheme{
+				BearerAuth:         {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				AdminToken:         {Type: "apiKey", In: "header", Name: "X-Admin-Token"},
+				IntrospectionToken: {Type: "http", Scheme: "bearer"},
+				ExtensionToken:     {Type: "http", Scheme: "bearer"},
+			},
+		},
+		types: make(map[reflect.Type]string),
+	}
+}
+
+// Handler serves the document as JSON.
+func (d *Document) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(d)
+	}
+}
+
+func (d *Document) addOperation(path, method string, op *Operation) {
+	item, ok := d.Paths[path]
+	if !ok {
+		item = make(PathItem)
+		d.Paths[path] = item
+	}
+	item[method] = op
+
+	for _, tag := range op.Tags {
+		d.addTag(tag)
+	}
+}
+
+func (d *Document) addTag(name string) {
+	for _, t := range d.Tags {
+		if t.Name == name {
+			return
+		}
+	}
+	d.Tags = append(d.Tags, Tag{Name: name})
+	sort.Slice(d.Tags, func(i, j int) bool { return d.Tags[i].Name < d.Tags[j].Name })
+}
+
+func (d *Document) errorResponse(status int, body reflect.Type) Response {
+	return Response{
+		Description: http.StatusText(status),
+		Content:     jsonContent(d.schemaFor(body)),
+	}
+}
+
+func jsonContent(s *Schema) map[string]MediaType {
+	return map[string]MediaType{fiber.MIMEApplicationJSON: {Schema: s}}
+}
+
+func statusKey(status int) string {
+	return strconv.Itoa(status)
+}
@@ -0,0 +1,117 @@
+// Package outbound makes HTTP requests and connections to URLs that
+// organizations and users supply, such as webhook endpoints, push services
+// and HR system reports, refusing any that would reach an address off the
+// public internet: the API's own host, its private network or a cloud
+// metadata service.
+package outbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress is returned for hosts that resolve, or connections that
+// would go, to an address that isn't on the public internet.
+var ErrPrivateAddress = errors.New("address is not public")
+
+// nonPublic lists the special-purpose ranges that net/netip's own checks
+// don't cover.
+var nonPublic = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, and broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, which can reach any IPv4 address
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local-use NAT64
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+}
+
+// IsPublic reports whether ip is a public unicast address: not loopback,
+// private (RFC 1918, fc00::/7), link-local, which takes in the
+// 169.254.169.254 metadata service, multicast, unspecified or otherwise
+// reserved.
+func IsPublic(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, p := range nonPublic {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckHost checks that host, a name or an IP address, resolves only to
+// public addresses. It suits checking a URL when it is saved; connections
+// made through Dialer, Transport or Client are checked again as they are
+// made, since what a name resolves to can change after it was checked.
+func CheckHost(ctx context.Context, host string) error {
+	var addrs []netip.Addr
+	if ip, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{ip}
+	} else if addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", host); err != nil {
+		return fmt.Errorf("resolving %s: %w", host, err)
+	}
+	for _, ip := range addrs {
+		if IsPublic(ip) {
+			continue
+		}
+		if ip = ip.Unmap(); ip.String() == host {
+			return fmt.Errorf("%s: %w", host, ErrPrivateAddress)
+		}
+		return fmt.Errorf("%s resolves to %s: %w", host, ip, ErrPrivateAddress)
+	}
+	return nil
+}
+
+// Dialer returns a dialer that connects only to public addresses. The check
+// runs after resolution, on the address actually dialled, so it holds for
+// every address a name resolves to.
+func Dialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second, Control: dialPublic}
+}
+
+func dialPublic(network, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("dialing %s: %w", address, err)
+	}
+	if !IsPublic(ap.Addr()) {
+		return fmt.Errorf("dialing %s: %w", ap.Addr(), ErrPrivateAddress)
+	}
+	return nil
+}
+
+// Transport returns an HTTP transport that connects only to public
+// addresses. It ignores HTTP_PROXY and the like, since a proxy would
+// connect on its behalf, past the check.
+func Transport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = Dialer(30 * time.Second).DialContext
+	transport.Proxy = nil
+	return transport
+}
+
+// Client returns an HTTP client whose requests time out after timeout,
+// connect only to public addresses and don't follow redirects, which would
+// send the request, and its credentials, somewhere nobody configured.
+// Redirect responses are returned as they are.
+func Client(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport:     Transport(),
+		Timeout:       timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+}
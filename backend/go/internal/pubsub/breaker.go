@@ -0,0 +1,55 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/yourorg/meeting-cost/backend/go/internal/circuit"
+	"github.com/yourorg/meeting-cost/backend/go/internal/metrics"
+)
+
+// breakerPubSub drops published events while the circuit is open. Meeting
+// events are ephemeral (clients resync via the cost endpoint), so dropping is
+// preferable to blocking request paths on an unavailable Redis.
+type breakerPubSub struct {
+	next    PubSub
+	breaker *circuit.Breaker
+	dropped prometheus.Counter
+}
+
+// NewBreakerPubSub guards publishing on next with breaker and registers a
+// counter of dropped messages on reg.
+func NewBreakerPubSub(next PubSub, breaker *circuit.Breaker, reg prometheus.Registerer) PubSub {
+	p := &breakerPubSub{
+		next:    next,
+		breaker: breaker,
+		dropped: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "pubsub",
+			Name:      "dropped_total",
+			Help:      "Messages dropped because the Redis circuit was open.",
+		}),
+	}
+	reg.MustRegister(p.dropped)
+	return p
+}
+
+// Publish forwards to the underlying PubSub, or drops the message and returns
+// nil while the circuit is open.
+func (p *breakerPubSub) Publish(ctx context.Context, channel string, message interface{}) error {
+	if !p.breaker.Allow() {
+		p.dropped.Inc()
+		return nil
+	}
+	err := p.next.Publish(ctx, channel, message)
+	if err != nil {
+		p.breaker.Failure()
+		return err
+	}
+	p.breaker.Success()
+	return nil
+}
+
+func (p *breakerPubSub) Subscribe(ctx context.Context, channel string) <-chan string {
+	return p.next.Subscribe(ctx, channel)
+}
@@ -0,0 +1,66 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// memoryPubSub fans messages out to subscribers in the same process. It
+// stands in for Redis when the API runs without external dependencies.
+type memoryPubSub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan string]struct{}
+}
+
+// NewMemoryPubSub creates a new in-process PubSub.
+func NewMemoryPubSub() PubSub {
+	return &memoryPubSub{
+		subscribers: make(map[string]map[chan string]struct{}),
+	}
+}
+
+func (p *memoryPubSub) Publish(ctx context.Context, channel string, message interface{}) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("marshaling message: %w", err)
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for ch := range p.subscribers[channel] {
+		// Like Redis, slow subscribers miss messages rather than block publishers
+		select {
+		case ch <- string(data):
+		default:
+		}
+	}
+	return nil
+}
+
+func (p *memoryPubSub) Subscribe(ctx context.Context, channel string) <-chan string {
+	ch := make(chan string, 64)
+
+	p.mu.Lock()
+	if p.subscribers[channel] == nil {
+		p.subscribers[channel] = make(map[chan string]struct{})
+	}
+	p.subscribers[channel][ch] = struct{}{}
+	p.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		p.mu.Lock()
+		delete(p.subscribers[channel], ch)
+		if len(p.subscribers[channel]) == 0 {
+			delete(p.subscribers, channel)
+		}
+		p.mu.Unlock()
+		close(ch)
+	}()
+
+	return ch
+}
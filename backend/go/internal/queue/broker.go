@@ -0,0 +1,51 @@
+package queue
+
+import (
+	"context"
+	"time"
+)
+
+// Broker stores tasks between producers and workers. A dequeued task is
+// leased to one worker; if the lease expires before Done, Retry or Kill, the
+// task is handed out again.
+type Broker interface {
+	// Enqueue stores t, delayed until its process time if it has one.
+	Enqueue(ctx context.Context, t *Task) error
+	// Dequeue leases the next ready task until leaseUntil. It returns nil
+	// when nothing is ready.
+	Dequeue(ctx context.Context, leaseUntil time.Time) (*Task, error)
+	// Done removes a finished task.
+	Done(ctx context.Context, t *Task) error
+	// Retry reschedules a failed task for at.
+	Retry(ctx context.Context, t *Task, at time.Time) error
+	// Kill moves a task that will not be retried to the dead list.
+	Kill(ctx context.Context, t *Task) error
+	// Forward makes due scheduled tasks ready and reclaims expired leases.
+	Forward(ctx context.Context, now time.Time) error
+	// Claim reports whether the caller won key for ttl; only one caller
+	// wins until it expires.
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+// Client enqueues tasks for workers.
+type Client struct {
+	broker Broker
+}
+
+// NewClient creates a Client that enqueues onto broker.
+func NewClient(broker Broker) *Client {
+	return &Client{broker: broker}
+}
+
+// Enqueue schedules a task of taskType with payload encoded as JSON and
+// returns its ID.
+func (c *Client) Enqueue(ctx context.Context, taskType string, payload interface{}, opts ...Option) (string, error) {
+	t, err := newTask(taskType, payload, opts)
+	if err != nil {
+		return "", err
+	}
+	if err := c.broker.Enqueue(ctx, t); err != nil {
+		return "", err
+	}
+	return t.ID, nil
+}
@@ -0,0 +1,124 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryBroker struct {
+	mu        sync.Mutex
+	pending   []*Task
+	active    map[string]time.Time // task ID -> lease expiry
+	inflight  map[string]*Task
+	scheduled []*Task
+	dead      []*Task
+	claims    map[string]time.Time
+}
+
+// NewMemoryBroker creates a process-local Broker for demo mode. Tasks are
+// lost on exit.
+func NewMemoryBroker() Broker {
+	return &memoryBroker{
+		active:   make(map[string]time.Time),
+		inflight: make(map[string]*Task),
+		claims:   make(map[string]time.Time),
+	}
+}
+
+func (b *memoryBroker) Enqueue(ctx context.Context, t *Task) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if t.processAt.After(time.Now()) {
+		b.scheduled = append(b.scheduled, t)
+	} else {
+		b.pending = append(b.pending, t)
+	}
+	return nil
+}
+
+func (b *memoryBroker) Dequeue(ctx context.Context, leaseUntil time.Time) (*Task, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.pending) == 0 {
+		return nil, nil
+	}
+	t := b.pending[0]
+	b.pending = b.pending[1:]
+	b.active[t.ID] = leaseUntil
+	b.inflight[t.ID] = t
+	return t, nil
+}
+
+func (b *memoryBroker) Done(ctx context.Context, t *Task) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.release(t)
+	return nil
+}
+
+func (b *memoryBroker) Retry(ctx context.Context, t *Task, at time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.release(t)
+	t.processAt = at
+	b.scheduled = append(b.scheduled, t)
+	return nil
+}
+
+func (b *memoryBroker) Kill(ctx context.Context, t *Task) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.release(t)
+	b.dead = append(b.dead, t)
+	if len(b.dead) > deadLimit {
+		b.dead = b.dead[len(b.dead)-deadLimit:]
+	}
+	return nil
+}
+
+func (b *memoryBroker) Forward(ctx context.Context, now time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	waiting := b.scheduled[:0]
+	for _, t := range b.scheduled {
+		if t.processAt.After(now) {
+			waiting = append(waiting, t)
+		} else {
+			b.pending = append(b.pending, t)
+		}
+	}
+	b.scheduled = waiting
+
+	for id, until := range b.active {
+		if until.Before(now) {
+			b.pending = append(b.pending, b.inflight[id])
+			delete(b.active, id)
+			delete(b.inflight, id)
+		}
+	}
+	return nil
+}
+
+func (b *memoryBroker) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if until, ok := b.claims[key]; ok && until.After(now) {
+		return false, nil
+	}
+	b.claims[key] = now.Add(ttl)
+	return true, nil
+}
+
+func (b *memoryBroker) release(t *Task) {
+	delete(b.active, t.ID)
+	delete(b.inflight, t.ID)
+}
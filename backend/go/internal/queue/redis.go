@@ -0,0 +1,161 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// deadLimit caps the dead list so a poison task type cannot fill Redis.
+const deadLimit = 10000
+
+// forwardBatch bounds the work one Forward call does per set.
+const forwardBatch = 100
+
+var (
+	dequeueScript = redis.NewScript(`
+local raw = redis.call('RPOPLPUSH', KEYS[1], KEYS[2])
+if raw then
+	redis.call('ZADD', KEYS[3], ARGV[1], raw)
+end
+return raw`)
+
+	// finishScript drops a task from active and optionally re-adds it,
+	// re-encoded, to the scheduled set (ARGV[3] = score) or the dead list.
+	finishScript = redis.NewScript(`
+redis.call('LREM', KEYS[1], 1, ARGV[1])
+redis.call('ZREM', KEYS[2], ARGV[1])
+if ARGV[2] == 'retry' then
+	redis.call('ZADD', KEYS[3], ARGV[4], ARGV[3])
+elseif ARGV[2] == 'kill' then
+	redis.call('LPUSH', KEYS[4], ARGV[3])
+	redis.call('LTRIM', KEYS[4], 0, tonumber(ARGV[5]) - 1)
+end
+return 1`)
+
+	forwardScript = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, raw in ipairs(due) do
+	redis.call('ZREM', KEYS[1], raw)
+	redis.call('LPUSH', KEYS[3], raw)
+end
+local expired = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, raw in ipairs(expired) do
+	redis.call('ZREM', KEYS[2], raw)
+	redis.call('LREM', KEYS[4], 1, raw)
+	redis.call('LPUSH', KEYS[3], raw)
+end
+return #due + #expired`)
+)
+
+type redisBroker struct {
+	client *redis.Client
+	prefix string
+
+	pending   string
+	active    string
+	leases    string
+	scheduled string
+	dead      string
+}
+
+// NewRedisBroker creates a Broker that keeps the named queue in Redis under
+// "queue:<name>:*" keys.
+func NewRedisBroker(client *redis.Client, name string) Broker {
+	prefix := "queue:" + name + ":"
+	return &redisBroker{
+		client:    client,
+		prefix:    prefix,
+		pending:   prefix + "pending",
+		active:    prefix + "active",
+		leases:    prefix + "leases",
+		scheduled: prefix + "scheduled",
+		dead:      prefix + "dead",
+	}
+}
+
+func (b *redisBroker) Enqueue(ctx context.Context, t *Task) error {
+	raw, err := encode(t)
+	if err != nil {
+		return err
+	}
+	if t.processAt.After(time.Now()) {
+		err = b.client.ZAdd(ctx, b.scheduled, redis.Z{Score: float64(t.processAt.Unix()), Member: raw}).Err()
+	} else {
+		err = b.client.LPush(ctx, b.pending, raw).Err()
+	}
+	if err != nil {
+		return fmt.Errorf("enqueuing %s: %w", t.Type, err)
+	}
+	return nil
+}
+
+func (b *redisBroker) Dequeue(ctx context.Context, leaseUntil time.Time) (*Task, error) {
+	raw, err := dequeueScript.Run(ctx, b.client, []string{b.pending, b.active, b.leases}, leaseUntil.Unix()).Text()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dequeuing: %w", err)
+	}
+	t, err := decode(raw)
+	if err != nil {
+		// Unreadable tasks can never succeed; park them for inspection
+		_ = b.finish(ctx, raw, "kill", raw, 0)
+		return nil, err
+	}
+	return t, nil
+}
+
+func (b *redisBroker) Done(ctx context.Context, t *Task) error {
+	return b.finish(ctx, t.raw, "done", "", 0)
+}
+
+func (b *redisBroker) Retry(ctx context.Context, t *Task, at time.Time) error {
+	raw, err := encode(t)
+	if err != nil {
+		return err
+	}
+	return b.finish(ctx, t.raw, "retry", raw, at.Unix())
+}
+
+func (b *redisBroker) Kill(ctx context.Context, t *Task) error {
+	raw, err := encode(t)
+	if err != nil {
+		return err
+	}
+	return b.finish(ctx, t.raw, "kill", raw, 0)
+}
+
+func (b *redisBroker) finish(ctx context.Context, raw, action, next string, score int64) error {
+	err := finishScript.Run(ctx, b.client,
+		[]string{b.active, b.leases, b.scheduled, b.dead},
+		raw, action, next, score, deadLimit,
+	).Err()
+	if err != nil {
+		return fmt.Errorf("finishing task (%s): %w", action, err)
+	}
+	return nil
+}
+
+func (b *redisBroker) Forward(ctx context.Context, now time.Time) error {
+	err := forwardScript.Run(ctx, b.client,
+		[]string{b.scheduled, b.leases, b.pending, b.active},
+		now.Unix(), forwardBatch,
+	).Err()
+	if err != nil {
+		return fmt.Errorf("forwarding tasks: %w", err)
+	}
+	return nil
+}
+
+func (b *redisBroker) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	ok, err := b.client.SetNX(ctx, b.prefix+"claim:"+key, 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("claiming %s: %w", key, err)
+	}
+	return ok, nil
+}
@@ -0,0 +1,74 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+)
+
+// Scheduler enqueues tasks on cron schedules. Every worker replica may run
+// one: each tick is claimed in the broker until just before the next, so a
+// periodic task is enqueued once however many schedulers are running.
+type Scheduler struct {
+	cron   *cron.Cron
+	broker Broker
+	client *Client
+	log    logger.Logger
+}
+
+// NewScheduler creates a Scheduler that enqueues onto broker.
+func NewScheduler(broker Broker, log logger.Logger) *Scheduler {
+	return &Scheduler{
+		cron:   cron.New(),
+		broker: broker,
+		client: NewClient(broker),
+		log:    log,
+	}
+}
+
+// Register enqueues a taskType task with payload on spec, in standard cron
+// syntax or a descriptor such as "@hourly" or "@every 30m". name identifies
+// the entry across replicas.
+func (s *Scheduler) Register(name, spec, taskType string, payload interface{}, opts ...Option) error {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("parsing schedule %q for %s: %w", spec, name, err)
+	}
+
+	s.cron.Schedule(schedule, cron.FuncJob(func() {
+		ctx := context.Background()
+		now := time.Now()
+
+		// Hold the claim until just before the next tick
+		ttl := schedule.Next(now).Sub(now) - time.Second
+		if ttl < time.Second {
+			ttl = time.Second
+		}
+		won, err := s.broker.Claim(ctx, "schedule:"+name, ttl)
+		if err != nil {
+			s.log.Error("claiming scheduled task failed", "name", name, "error", err)
+			return
+		}
+		if !won {
+			return
+		}
+
+		id, err := s.client.Enqueue(ctx, taskType, payload, opts...)
+		if err != nil {
+			s.log.Error("enqueuing scheduled task failed", "name", name, "type", taskType, "error", err)
+			return
+		}
+		s.log.Info("enqueued scheduled task", "name", name, "type", taskType, "task_id", id)
+	}))
+	return nil
+}
+
+// Run fires scheduled entries until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.cron.Start()
+	<-ctx.Done()
+	<-s.cron.Stop().Done()
+}
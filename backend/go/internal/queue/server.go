@@ -0,0 +1,203 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/metrics"
+)
+
+// HandlerFunc processes one task. Returning an error retries the task with
+// backoff until its MaxRetry is exhausted.
+type HandlerFunc func(ctx context.Context, t *Task) error
+
+// ServerOptions tunes a Server.
+type ServerOptions struct {
+	// Concurrency is the number of tasks processed at once.
+	Concurrency int
+	// Lease is how long a worker may hold a task before it is handed to
+	// another worker; it should exceed the slowest handler.
+	Lease time.Duration
+	// PollInterval is how long an idle worker waits before checking for
+	// tasks again.
+	PollInterval time.Duration
+}
+
+// Server runs registered handlers for tasks taken from a Broker.
+type Server struct {
+	broker   Broker
+	opts     ServerOptions
+	log      logger.Logger
+	handlers map[string]HandlerFunc
+
+	processed *prometheus.CounterVec
+	duration  *prometheus.HistogramVec
+}
+
+// NewServer creates a Server. Metrics are registered on reg.
+func NewServer(broker Broker, opts ServerOptions, log logger.Logger, reg prometheus.Registerer) *Server {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = 30 * time.Minute
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+
+	s := &Server{
+		broker:   broker,
+		opts:     opts,
+		log:      log,
+		handlers: make(map[string]HandlerFunc),
+		processed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "jobs",
+			Name:      "processed_total",
+			Help:      "Tasks processed by type and result (success, retry, dead).",
+		}, []string{"type", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "jobs",
+			Name:      "duration_seconds",
+			Help:      "Handler run time by task type.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 4, 8),
+		}, []string{"type"}),
+	}
+	reg.MustRegister(s.processed, s.duration)
+	return s
+}
+
+// Handle registers h for tasks of taskType. It must be called before Run.
+func (s *Server) Handle(taskType string, h HandlerFunc) {
+	s.handlers[taskType] = h
+}
+
+// Run processes tasks until ctx is done, then waits for in-flight tasks to
+// finish.
+func (s *Server) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.forward(ctx)
+	}()
+
+	for i := 0; i < s.opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// forward periodically promotes scheduled tasks and reclaims expired leases.
+func (s *Server) forward(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.broker.Forward(ctx, now); err != nil && ctx.Err() == nil {
+				s.log.Error("forwarding tasks failed", "error", err)
+			}
+		}
+	}
+}
+
+func (s *Server) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		t, err := s.broker.Dequeue(ctx, time.Now().Add(s.opts.Lease))
+		if err != nil && ctx.Err() == nil {
+			s.log.Error("dequeuing task failed", "error", err)
+		}
+		if t == nil {
+			s.idle(ctx)
+			continue
+		}
+		// Let the task finish even if shutdown begins mid-way; the lease
+		// covers a worker that dies instead
+		s.process(context.WithoutCancel(ctx), t)
+	}
+}
+
+// idle sleeps for about one poll interval, jittered so workers spread out.
+func (s *Server) idle(ctx context.Context) {
+	d := s.opts.PollInterval/2 + time.Duration(rand.Int63n(int64(s.opts.PollInterval)))
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
+
+func (s *Server) process(ctx context.Context, t *Task) {
+	h, ok := s.handlers[t.Type]
+	if !ok {
+		s.finish(ctx, t, fmt.Errorf("no handler registered: %w", ErrSkipRetry))
+		return
+	}
+
+	start := time.Now()
+	err := s.run(ctx, h, t)
+	s.duration.WithLabelValues(t.Type).Observe(time.Since(start).Seconds())
+	s.finish(ctx, t, err)
+}
+
+// run calls h, turning a panic into an error so one bad task cannot take the
+// worker down.
+func (s *Server) run(ctx context.Context, h HandlerFunc, t *Task) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return h(ctx, t)
+}
+
+func (s *Server) finish(ctx context.Context, t *Task, err error) {
+	var result string
+	switch {
+	case err == nil:
+		result = "success"
+		err = s.broker.Done(ctx, t)
+	case errors.Is(err, ErrSkipRetry) || t.Retried >= t.MaxRetry:
+		result = "dead"
+		s.log.Error("task failed permanently", "task_id", t.ID, "type", t.Type, "retried", t.Retried, "error", err)
+		t.LastError = err.Error()
+		err = s.broker.Kill(ctx, t)
+	default:
+		result = "retry"
+		s.log.Warn("task failed; retrying", "task_id", t.ID, "type", t.Type, "retried", t.Retried, "error", err)
+		t.LastError = err.Error()
+		t.Retried++
+		err = s.broker.Retry(ctx, t, time.Now().Add(backoff(t.Retried)))
+	}
+	s.processed.WithLabelValues(t.Type, result).Inc()
+	if err != nil {
+		s.log.Error("recording task result failed", "task_id", t.ID, "type", t.Type, "result", result, "error", err)
+	}
+}
+
+// backoff grows exponentially from 10s and is capped at an hour.
+func backoff(retried int) time.Duration {
+	d := 10 * time.Second << min(retried-1, 9)
+	if d > time.Hour {
+		d = time.Hour
+	}
+	return d
+}
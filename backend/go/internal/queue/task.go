@@ -0,0 +1,100 @@
+// Package queue is a small Redis-backed job queue. Producers enqueue tasks
+// with a Client; a Server in the worker process dequeues them, runs the
+// handler registered for their type, and retries failures with backoff.
+// Delivery is at-least-once, so handlers must be idempotent.
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultMaxRetry is how many times a failed task is retried unless the
+// producer passes MaxRetry.
+const DefaultMaxRetry = 5
+
+// ErrSkipRetry, when returned (or wrapped) by a handler, sends the task
+// straight to the dead list, for failures that retrying cannot fix.
+var ErrSkipRetry = errors.New("skip retry")
+
+// Task is a unit of work. Payload holds the producer's JSON-encoded
+// arguments.
+type Task struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+	Retried    int             `json:"retried"`
+	MaxRetry   int             `json:"max_retry"`
+	LastError  string          `json:"last_error,omitempty"`
+	EnqueuedAt time.Time       `json:"enqueued_at"`
+
+	// raw is the encoding the broker stored, used to find the task again.
+	raw string
+	// processAt delays a task; zero means as soon as possible.
+	processAt time.Time
+}
+
+// Unmarshal decodes the task payload into v.
+func (t *Task) Unmarshal(v interface{}) error {
+	if err := json.Unmarshal(t.Payload, v); err != nil {
+		return fmt.Errorf("decoding %s payload: %w", t.Type, err)
+	}
+	return nil
+}
+
+// Option configures an enqueued task.
+type Option func(*Task)
+
+// MaxRetry sets how many times the task is retried after failing.
+func MaxRetry(n int) Option {
+	return func(t *Task) { t.MaxRetry = n }
+}
+
+// ProcessAt delays the task until at.
+func ProcessAt(at time.Time) Option {
+	return func(t *Task) { t.processAt = at }
+}
+
+// ProcessIn delays the task by d.
+func ProcessIn(d time.Duration) Option {
+	return func(t *Task) { t.processAt = time.Now().Add(d) }
+}
+
+func newTask(taskType string, payload interface{}, opts []Option) (*Task, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %s payload: %w", taskType, err)
+	}
+	t := &Task{
+		ID:         uuid.NewString(),
+		Type:       taskType,
+		Payload:    data,
+		MaxRetry:   DefaultMaxRetry,
+		EnqueuedAt: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t, nil
+}
+
+func encode(t *Task) (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("encoding task: %w", err)
+	}
+	return string(b), nil
+}
+
+func decode(raw string) (*Task, error) {
+	var t Task
+	if err := json.Unmarshal([]byte(raw), &t); err != nil {
+		return nil, fmt.Errorf("decoding task: %w", err)
+	}
+	t.raw = raw
+	return &t, nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// AccountDeletionRepository handles people's requests to erase their
+// accounts.
+type AccountDeletionRepository interface {
+	Create(ctx context.Context, deletion *models.AccountDeletion) error
+	GetByPerson(ctx context.Context, personID uuid.UUID) (*models.AccountDeletion, error)
+	// ListDue returns the confirmed deletions scheduled at or before now
+	// that have not completed.
+	ListDue(ctx context.Context, now time.Time) ([]*models.AccountDeletion, error)
+	Update(ctx context.Context, deletion *models.AccountDeletion) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
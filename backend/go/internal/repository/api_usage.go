@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// APIUsageRepository handles organizations' hourly API request counts.
+type APIUsageRepository interface {
+	// Add adds each count's requests and errors to the organization's
+	// count for its token and hour, creating it if needed, and moves its
+	// last request time forward.
+	Add(ctx context.Context, counts []*models.APIUsage) error
+	// ListByOrganization returns the organization's counts from hour
+	// since onwards, oldest first.
+	ListByOrganization(ctx context.Context, orgID uuid.UUID, since time.Time) ([]*models.APIUsage, error)
+	// DeleteBefore deletes every count of an hour before the cutoff and
+	// returns how many it deleted.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// ArchiveRepository moves old meetings out of the live tables into
+// compressed archives, and back.
+type ArchiveRepository interface {
+	// ArchiveMeetings archives up to limit stopped meetings that stopped
+	// before the cutoff, with their increments, participants and ratings,
+	// and returns how many it archived. Meetings restored after the cutoff
+	// are left alone.
+	ArchiveMeetings(ctx context.Context, before time.Time, limit int) (int, error)
+	// ListByOrganization lists an organization's archived meetings, most
+	// recently started first.
+	ListByOrganization(ctx context.Context, orgID uuid.UUID, pagination Pagination) ([]*models.MeetingArchive, int64, error)
+	GetByMeeting(ctx context.Context, meetingID uuid.UUID) (*models.MeetingArchive, error)
+	// Restore puts an archived meeting's rows back, marks it unarchived at
+	// the given time and removes the archive.
+	Restore(ctx context.Context, meetingID uuid.UUID, at time.Time) error
+}
+
+// CompressArchive gzips an archive document.
+func CompressArchive(doc []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(doc); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecompressArchive reverses CompressArchive.
+func DecompressArchive(data []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
@@ -2,11 +2,18 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 )
 
 // AuditLogRepository handles all database operations for AuditLog entities.
 type AuditLogRepository interface {
 	Create(ctx context.Context, auditLog *models.AuditLog) error
+	// CreateBatch inserts the entries in as few statements as it can.
+	CreateBatch(ctx context.Context, auditLogs []*models.AuditLog) error
+	// ListByOrganization returns the organization's entries for any of
+	// actions logged at or after since, oldest first.
+	ListByOrganization(ctx context.Context, orgID uuid.UUID, actions []string, since time.Time) ([]*models.AuditLog, error)
 }
@@ -23,7 +23,9 @@ type AuthRepository interface {
 	GetSessionsByPerson(ctx context.Context, personID uuid.UUID) ([]*models.Session, error)
 	UpdateSession(ctx context.Context, session *models.Session) error
 	DeleteSession(ctx context.Context, id uuid.UUID) error
-	DeleteExpiredSessions(ctx context.Context) error
+	// DeleteExpiredSessions hard-deletes expired sessions and returns how
+	// many were removed.
+	DeleteExpiredSessions(ctx context.Context) (int64, error)
 	DeleteSessionsByPerson(ctx context.Context, personID uuid.UUID) error
 }
 
@@ -0,0 +1,156 @@
+// Package claims provides a PermissionRepository that answers permission
+// checks from the membership claims of the request's access token before
+// asking the repository it wraps, and repositories that revoke those claims
+// when the memberships, roles or role permissions they carry change.
+package claims
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/auth"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type permissionRepository struct {
+	repository.PermissionRepository
+	revocations *auth.MembershipRevocations
+}
+
+// NewPermissionRepository wraps next so that permission checks the
+// requester's roles grant organization-wide are answered from their token's
+// membership claims. Anything else, including checks the claims would deny,
+// goes to next: permissions granted to the person directly or on one
+// resource are not in the claims. Changing the person's roles revokes their
+// claims, and changing a role, or the permissions it grants, revokes the
+// claims of everyone it is assigned to.
+func NewPermissionRepository(next repository.PermissionRepository, revocations *auth.MembershipRevocations) repository.PermissionRepository {
+	return &permissionRepository{PermissionRepository: next, revocations: revocations}
+}
+
+func (r *permissionRepository) HasPermission(ctx context.Context, personID, orgID uuid.UUID, resourceName string, resourceID *uuid.UUID, activity string) (bool, error) {
+	if m, ok := auth.MembershipClaimsFromContext(ctx).For(personID, orgID); ok && m.Allows(resourceName, activity) {
+		return true, nil
+	}
+	return r.PermissionRepository.HasPermission(ctx, personID, orgID, resourceName, resourceID, activity)
+}
+
+// HasPermissions asks next only about the checks the claims don't allow,
+// and not at all when they allow every one.
+func (r *permissionRepository) HasPermissions(ctx context.Context, personID, orgID uuid.UUID, checks []repository.PermissionCheck) ([]bool, error) {
+	allowed := make([]bool, len(checks))
+	var rest []repository.PermissionCheck
+	var restIdx []int
+	m, ok := auth.MembershipClaimsFromContext(ctx).For(personID, orgID)
+	for i, check := range checks {
+		if ok && m.Allows(check.ResourceName, check.Activity) {
+			allowed[i] = true
+			continue
+		}
+		rest = append(rest, check)
+		restIdx = append(restIdx, i)
+	}
+	if len(rest) == 0 {
+		return allowed, nil
+	}
+
+	restAllowed, err := r.PermissionRepository.HasPermissions(ctx, personID, orgID, rest)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range restIdx {
+		allowed[i] = restAllowed[j]
+	}
+	return allowed, nil
+}
+
+func (r *permissionRepository) AssignRole(ctx context.Context, assignment *models.RoleAssignment) error {
+	if err := r.PermissionRepository.AssignRole(ctx, assignment); err != nil {
+		return err
+	}
+	_ = r.revocations.Revoke(ctx, assignment.PersonID)
+	return nil
+}
+
+func (r *permissionRepository) UnassignRole(ctx context.Context, roleID, personID, orgID uuid.UUID) error {
+	if err := r.PermissionRepository.UnassignRole(ctx, roleID, personID, orgID); err != nil {
+		return err
+	}
+	_ = r.revocations.Revoke(ctx, personID)
+	return nil
+}
+
+// revokeHolders revokes the claims of everyone assigned roleID.
+func (r *permissionRepository) revokeHolders(ctx context.Context, roleID uuid.UUID) {
+	personIDs, err := r.PermissionRepository.GetRoleHolders(ctx, roleID)
+	if err != nil {
+		return
+	}
+	for _, personID := range personIDs {
+		_ = r.revocations.Revoke(ctx, personID)
+	}
+}
+
+// revokePermission revokes the claims of everyone the role permission
+// applies to. Permissions granted to a person directly aren't in claims.
+func (r *permissionRepository) revokePermission(ctx context.Context, permission *models.Permission) {
+	if permission != nil && permission.ResourceType == "role" {
+		r.revokeHolders(ctx, permission.ResourceID)
+	}
+}
+
+func (r *permissionRepository) UpdateRole(ctx context.Context, role *models.Role) error {
+	if err := r.PermissionRepository.UpdateRole(ctx, role); err != nil {
+		return err
+	}
+	r.revokeHolders(ctx, role.ID)
+	return nil
+}
+
+// DeleteRole reads who holds the role before it is deleted, and revokes
+// their claims once it is.
+func (r *permissionRepository) DeleteRole(ctx context.Context, id uuid.UUID) error {
+	personIDs, _ := r.PermissionRepository.GetRoleHolders(ctx, id)
+	if err := r.PermissionRepository.DeleteRole(ctx, id); err != nil {
+		return err
+	}
+	for _, personID := range personIDs {
+		_ = r.revocations.Revoke(ctx, personID)
+	}
+	return nil
+}
+
+func (r *permissionRepository) CreatePermission(ctx context.Context, permission *models.Permission) error {
+	if err := r.PermissionRepository.CreatePermission(ctx, permission); err != nil {
+		return err
+	}
+	r.revokePermission(ctx, permission)
+	return nil
+}
+
+// UpdatePermission revokes claims for the role the permission belonged to
+// as well as the one it belongs to now.
+func (r *permissionRepository) UpdatePermission(ctx context.Context, permission *models.Permission) error {
+	old, _ := r.PermissionRepository.GetPermissionByID(ctx, permission.ID)
+	if err := r.PermissionRepository.UpdatePermission(ctx, permission); err != nil {
+		return err
+	}
+	r.revokePermission(ctx, old)
+	if old == nil || old.ResourceType != permission.ResourceType || old.ResourceID != permission.ResourceID {
+		r.revokePermission(ctx, permission)
+	}
+	return nil
+}
+
+func (r *permissionRepository) DeletePermission(ctx context.Context, id uuid.UUID) error {
+	permission, err := r.PermissionRepository.GetPermissionByID(ctx, id)
+	if err != nil {
+		return r.PermissionRepository.DeletePermission(ctx, id)
+	}
+	if err := r.PermissionRepository.DeletePermission(ctx, id); err != nil {
+		return err
+	}
+	r.revokePermission(ctx, permission)
+	return nil
+}
@@ -0,0 +1,68 @@
+package claims
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/auth"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type profileRepository struct {
+	repository.PersonOrganizationProfileRepository
+	revocations *auth.MembershipRevocations
+}
+
+// NewProfileRepository wraps next so that adding, activating, deactivating,
+// updating or deleting a membership revokes the person's membership claims,
+// which would otherwise keep a removed member's access until their token
+// expired.
+func NewProfileRepository(next repository.PersonOrganizationProfileRepository, revocations *auth.MembershipRevocations) repository.PersonOrganizationProfileRepository {
+	return &profileRepository{PersonOrganizationProfileRepository: next, revocations: revocations}
+}
+
+func (r *profileRepository) Create(ctx context.Context, profile *models.PersonOrganizationProfile) error {
+	if err := r.PersonOrganizationProfileRepository.Create(ctx, profile); err != nil {
+		return err
+	}
+	_ = r.revocations.Revoke(ctx, profile.PersonID)
+	return nil
+}
+
+func (r *profileRepository) Update(ctx context.Context, profile *models.PersonOrganizationProfile) error {
+	if err := r.PersonOrganizationProfileRepository.Update(ctx, profile); err != nil {
+		return err
+	}
+	_ = r.revocations.Revoke(ctx, profile.PersonID)
+	return nil
+}
+
+func (r *profileRepository) Activate(ctx context.Context, personID, orgID uuid.UUID) error {
+	if err := r.PersonOrganizationProfileRepository.Activate(ctx, personID, orgID); err != nil {
+		return err
+	}
+	_ = r.revocations.Revoke(ctx, personID)
+	return nil
+}
+
+func (r *profileRepository) Deactivate(ctx context.Context, personID, orgID uuid.UUID) error {
+	if err := r.PersonOrganizationProfileRepository.Deactivate(ctx, personID, orgID); err != nil {
+		return err
+	}
+	_ = r.revocations.Revoke(ctx, personID)
+	return nil
+}
+
+// Delete reads whose membership it is before it is deleted.
+func (r *profileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	profile, err := r.PersonOrganizationProfileRepository.GetByID(ctx, id)
+	if err != nil {
+		return r.PersonOrganizationProfileRepository.Delete(ctx, id)
+	}
+	if err := r.PersonOrganizationProfileRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	_ = r.revocations.Revoke(ctx, profile.PersonID)
+	return nil
+}
@@ -26,3 +26,13 @@ type ConsentRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
+
+// ConsentCategoryRepository handles the configured consent categories.
+type ConsentCategoryRepository interface {
+	// List returns the configured categories by position, then key.
+	List(ctx context.Context) ([]*models.ConsentCategory, error)
+	GetByKey(ctx context.Context, key string) (*models.ConsentCategory, error)
+	// Save creates the category, or updates the one with its key.
+	Save(ctx context.Context, category *models.ConsentCategory) error
+	Delete(ctx context.Context, key string) error
+}
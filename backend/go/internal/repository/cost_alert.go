@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// CostAlertRepository handles cost threshold alerts and the record of which
+// meetings they have fired for, and the record of the cost milestones
+// meetings have passed.
+type CostAlertRepository interface {
+	Create(ctx context.Context, alert *models.CostAlert) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.CostAlert, error)
+	// ListByPerson returns the person's alerts in the organization, oldest
+	// first.
+	ListByPerson(ctx context.Context, orgID, personID uuid.UUID) ([]*models.CostAlert, error)
+	Update(ctx context.Context, alert *models.CostAlert) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListDue returns the organization's alerts watching the meeting whose
+	// threshold cost has reached and that have not fired for it yet.
+	ListDue(ctx context.Context, orgID, meetingID uuid.UUID, cost float64) ([]*models.CostAlert, error)
+	// RecordTrigger records that an alert fired for a meeting. It returns
+	// false, without error, when the alert had already fired for it.
+	RecordTrigger(ctx context.Context, trigger *models.CostAlertTrigger) (bool, error)
+
+	// RecordMilestone records that a meeting passed a cost milestone. It
+	// returns false, without error, when the meeting had already passed it.
+	RecordMilestone(ctx context.Context, milestone *models.CostMilestone) (bool, error)
+	// MarkMilestonePosted records when a milestone was announced.
+	MarkMilestonePosted(ctx context.Context, id uuid.UUID, at time.Time) error
+	// LastMilestonePostedAt returns when the organization last announced a
+	// milestone, or nil if it never has.
+	LastMilestonePostedAt(ctx context.Context, orgID uuid.UUID) (*time.Time, error)
+}
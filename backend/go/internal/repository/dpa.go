@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// DPARepository handles organizations' data processing agreement
+// acceptances.
+type DPARepository interface {
+	Create(ctx context.Context, acceptance *models.DPAAcceptance) error
+	// GetByVersion returns the organization's acceptance of version.
+	GetByVersion(ctx context.Context, orgID uuid.UUID, version string) (*models.DPAAcceptance, error)
+	// ListByOrganization returns the organization's acceptances, newest
+	// first.
+	ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.DPAAcceptance, error)
+}
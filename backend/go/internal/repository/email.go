@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// EmailDeliveryRepository logs outgoing emails.
+type EmailDeliveryRepository interface {
+	Create(ctx context.Context, delivery *models.EmailDelivery) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.EmailDelivery, error)
+	Update(ctx context.Context, delivery *models.EmailDelivery) error
+}
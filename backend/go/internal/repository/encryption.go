@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/encryption"
+)
+
+// EncryptionRepository maintains the columns encrypted at rest.
+type EncryptionRepository interface {
+	// Reencrypt rewrites every encrypted column value that is in plaintext
+	// or under a key other than the keyring's primary, and returns the
+	// number of rows rewritten per table.
+	Reencrypt(ctx context.Context, keyring *encryption.Keyring) (map[string]int64, error)
+}
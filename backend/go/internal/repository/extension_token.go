@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// ExtensionTokenRepository handles the tokens browser extensions use.
+type ExtensionTokenRepository interface {
+	Create(ctx context.Context, token *models.ExtensionToken) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ExtensionToken, error)
+	// GetByTokenHash returns the token with the hash, revoked or not.
+	GetByTokenHash(ctx context.Context, tokenHash string) (*models.ExtensionToken, error)
+	// ListByPerson returns the person's tokens, revoked ones included,
+	// oldest first.
+	ListByPerson(ctx context.Context, personID uuid.UUID) ([]*models.ExtensionToken, error)
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) error
+	MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+	DeleteByPerson(ctx context.Context, personID uuid.UUID) error
+}
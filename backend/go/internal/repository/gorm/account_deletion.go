@@ -0,0 +1,68 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type accountDeletionRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountDeletionRepository creates a new GORM-based
+// AccountDeletionRepository.
+func NewAccountDeletionRepository(db *gorm.DB) repository.AccountDeletionRepository {
+	return &accountDeletionRepository{
+		db: db,
+	}
+}
+
+func (r *accountDeletionRepository) Create(ctx context.Context, deletion *models.AccountDeletion) error {
+	if err := r.db.WithContext(ctx).Create(deletion).Error; err != nil {
+		return fmt.Errorf("creating account deletion: %w", err)
+	}
+	return nil
+}
+
+func (r *accountDeletionRepository) GetByPerson(ctx context.Context, personID uuid.UUID) (*models.AccountDeletion, error) {
+	var deletion models.AccountDeletion
+	if err := r.db.WithContext(ctx).First(&deletion, "person_id = ?", personID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("account deletion not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting account deletion: %w", err)
+	}
+	return &deletion, nil
+}
+
+func (r *accountDeletionRepository) ListDue(ctx context.Context, now time.Time) ([]*models.AccountDeletion, error) {
+	var deletions []*models.AccountDeletion
+	if err := r.db.WithContext(ctx).
+		Where("scheduled_for <= ? AND completed_at IS NULL", now).
+		Order("scheduled_for ASC").
+		Find(&deletions).Error; err != nil {
+		return nil, fmt.Errorf("listing due account deletions: %w", err)
+	}
+	return deletions, nil
+}
+
+func (r *accountDeletionRepository) Update(ctx context.Context, deletion *models.AccountDeletion) error {
+	if err := r.db.WithContext(ctx).Save(deletion).Error; err != nil {
+		return fmt.Errorf("updating account deletion: %w", err)
+	}
+	return nil
+}
+
+func (r *accountDeletionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.AccountDeletion{}, "id = ?", id).Error; err != nil {
+		return fmt.Errorf("deleting account deletion: %w", err)
+	}
+	return nil
+}
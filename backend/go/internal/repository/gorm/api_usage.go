@@ -0,0 +1,62 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type apiUsageRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIUsageRepository creates a new GORM-based APIUsageRepository.
+func NewAPIUsageRepository(db *gorm.DB) repository.APIUsageRepository {
+	return &apiUsageRepository{
+		db: db,
+	}
+}
+
+func (r *apiUsageRepository) Add(ctx context.Context, counts []*models.APIUsage) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "organization_id"}, {Name: "token_id"}, {Name: "hour"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"requests":        gorm.Expr("api_usage.requests + EXCLUDED.requests"),
+			"errors":          gorm.Expr("api_usage.errors + EXCLUDED.errors"),
+			"last_request_at": gorm.Expr("GREATEST(api_usage.last_request_at, EXCLUDED.last_request_at)"),
+			"updated_at":      gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}).Create(counts).Error
+	if err != nil {
+		return fmt.Errorf("adding api usage: %w", err)
+	}
+	return nil
+}
+
+func (r *apiUsageRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, since time.Time) ([]*models.APIUsage, error) {
+	var counts []*models.APIUsage
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND hour >= ?", orgID, since).
+		Order("hour ASC").
+		Find(&counts).Error; err != nil {
+		return nil, fmt.Errorf("listing api usage: %w", err)
+	}
+	return counts, nil
+}
+
+func (r *apiUsageRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Where("hour < ?", before).Delete(&models.APIUsage{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("deleting api usage: %w", res.Error)
+	}
+	return res.RowsAffected, nil
+}
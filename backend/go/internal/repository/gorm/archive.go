@@ -0,0 +1,260 @@
+package gorm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// archiveTables are the tables an archive holds rows of, keyed in the
+// archive document by table name, parents first.
+var archiveTables = []struct {
+	name   string
+	column string // The column holding the meeting's ID
+}{
+	{"meetings", "id"},
+	{"increments", "meeting_id"},
+	{"meeting_participants", "meeting_id"},
+	{"meeting_ratings", "meeting_id"},
+}
+
+type archiveRepository struct {
+	db    *gorm.DB
+	cache cache.Cache
+}
+
+// NewArchiveRepository creates a new GORM-based ArchiveRepository. The
+// cache is the meeting repositories', whose entries archiving invalidates.
+func NewArchiveRepository(db *gorm.DB, cache cache.Cache) repository.ArchiveRepository {
+	return &archiveRepository{
+		db:    db,
+		cache: cache,
+	}
+}
+
+func (r *archiveRepository) ArchiveMeetings(ctx context.Context, before time.Time, limit int) (int, error) {
+	var ids []uuid.UUID
+	err := r.archivable(r.db.WithContext(ctx), before).
+		Order("stopped_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, fmt.Errorf("finding meetings to archive: %w", err)
+	}
+
+	archived := 0
+	for _, id := range ids {
+		meeting, err := r.archiveMeeting(ctx, id, before)
+		if err != nil {
+			return archived, fmt.Errorf("archiving meeting %s: %w", id, err)
+		}
+		if meeting == nil {
+			continue // Restarted or deleted since it was found
+		}
+		archived++
+
+		// Invalidate cache
+		_ = r.cache.Delete(ctx, cache.KeyMeeting(id))
+		_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(id))
+		if meeting.ExternalID != "" {
+			_ = r.cache.Delete(ctx, cache.KeyMeetingByExternalID(meeting.ExternalType, meeting.ExternalID))
+		}
+	}
+	return archived, nil
+}
+
+// archivable limits query to the meetings that can be archived at the
+// cutoff.
+func (r *archiveRepository) archivable(query *gorm.DB, before time.Time) *gorm.DB {
+	return query.Model(&models.Meeting{}).
+		Where("is_active = ? AND stopped_at < ?", false, before).
+		Where("unarchived_at IS NULL OR unarchived_at < ?", before)
+}
+
+// archiveMeeting archives one meeting in a transaction, returning nil if
+// it can no longer be archived.
+func (r *archiveRepository) archiveMeeting(ctx context.Context, id uuid.UUID, before time.Time) (*models.Meeting, error) {
+	var meeting models.Meeting
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := r.archivable(tx.Clauses(clause.Locking{Strength: "UPDATE"}), before).
+			Where("id = ?", id).
+			First(&meeting).Error
+		if err != nil {
+			return err
+		}
+
+		// Every row goes in, soft-deleted ones included, so restoring
+		// brings the meeting back as it was
+		fields := make([]string, len(archiveTables))
+		for i, t := range archiveTables {
+			fields[i] = fmt.Sprintf("'%[1]s', (SELECT COALESCE(jsonb_agg(to_jsonb(t)), '[]') FROM %[1]s t WHERE t.%[2]s = @id)", t.name, t.column)
+		}
+		var doc string
+		if err := tx.Raw("SELECT jsonb_build_object("+strings.Join(fields, ", ")+")::text", map[string]interface{}{"id": id}).
+			Scan(&doc).Error; err != nil {
+			return fmt.Errorf("reading rows: %w", err)
+		}
+		data, err := repository.CompressArchive([]byte(doc))
+		if err != nil {
+			return fmt.Errorf("compressing: %w", err)
+		}
+
+		var increments int64
+		if err := tx.Model(&models.Increment{}).Where("meeting_id = ?", id).Count(&increments).Error; err != nil {
+			return fmt.Errorf("counting increments: %w", err)
+		}
+		archive := &models.MeetingArchive{
+			MeetingID:       meeting.ID,
+			OrganizationID:  meeting.OrganizationID,
+			Purpose:         meeting.Purpose,
+			StartedAt:       meeting.StartedAt,
+			StoppedAt:       meeting.StoppedAt,
+			TotalCost:       meeting.TotalCost,
+			TotalDuration:   meeting.TotalDuration,
+			MaxAttendees:    meeting.MaxAttendees,
+			AttendeeSeconds: meeting.AttendeeSeconds,
+			IncrementCount:  int(increments),
+			Size:            len(data),
+			Data:            data,
+		}
+		if err := tx.Create(archive).Error; err != nil {
+			return fmt.Errorf("creating archive: %w", err)
+		}
+		// The daily report totals count these in place of the increments
+		if err := tx.Exec(`
+			INSERT INTO meeting_archive_days (meeting_id, day, cost, seconds)
+			SELECT meeting_id, (start_time AT TIME ZONE 'UTC')::date, SUM(cost), SUM(elapsed_time)
+			FROM increments
+			WHERE meeting_id = ? AND deleted_at IS NULL
+			GROUP BY 1, 2`, id).Error; err != nil {
+			return fmt.Errorf("creating archive days: %w", err)
+		}
+
+		for i := len(archiveTables) - 1; i >= 0; i-- {
+			t := archiveTables[i]
+			if err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", t.name, t.column), id).Error; err != nil {
+				return fmt.Errorf("deleting %s: %w", t.name, err)
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &meeting, nil
+}
+
+func (r *archiveRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, pagination repository.Pagination) ([]*models.MeetingArchive, int64, error) {
+	var archives []*models.MeetingArchive
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.MeetingArchive{}).
+		Omit("data").
+		Where("organization_id = ?", orgID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("counting archived meetings: %w", err)
+	}
+	if pagination.PageSize > 0 {
+		query = query.Offset(pagination.Offset()).Limit(pagination.Limit())
+	}
+	if err := query.Order("started_at DESC").Find(&archives).Error; err != nil {
+		return nil, 0, fmt.Errorf("querying archived meetings: %w", err)
+	}
+	return archives, total, nil
+}
+
+func (r *archiveRepository) GetByMeeting(ctx context.Context, meetingID uuid.UUID) (*models.MeetingArchive, error) {
+	var archive models.MeetingArchive
+	if err := r.db.WithContext(ctx).First(&archive, "meeting_id = ?", meetingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("archived meeting not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting archived meeting: %w", err)
+	}
+	return &archive, nil
+}
+
+func (r *archiveRepository) Restore(ctx context.Context, meetingID uuid.UUID, at time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var archive models.MeetingArchive
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&archive, "meeting_id = ?", meetingID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("archived meeting not found: %w", err)
+			}
+			return fmt.Errorf("getting archived meeting: %w", err)
+		}
+
+		doc, err := repository.DecompressArchive(archive.Data)
+		if err != nil {
+			return fmt.Errorf("decompressing archive: %w", err)
+		}
+		var tables map[string][]map[string]json.RawMessage
+		if err := json.Unmarshal(doc, &tables); err != nil {
+			return fmt.Errorf("decoding archive: %w", err)
+		}
+		for _, t := range archiveTables {
+			if err := restoreRows(tx, t.name, tables[t.name]); err != nil {
+				return fmt.Errorf("restoring %s: %w", t.name, err)
+			}
+		}
+
+		if err := tx.Model(&models.Meeting{}).Where("id = ?", meetingID).Update("unarchived_at", at).Error; err != nil {
+			return fmt.Errorf("marking meeting unarchived: %w", err)
+		}
+		if err := tx.Delete(&models.MeetingArchiveDay{}, "meeting_id = ?", meetingID).Error; err != nil {
+			return fmt.Errorf("deleting archive days: %w", err)
+		}
+		if err := tx.Delete(&archive).Error; err != nil {
+			return fmt.Errorf("deleting archive: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(meetingID))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(meetingID))
+	return nil
+}
+
+// restoreRows inserts archived rows into table. Columns added since they
+// were archived take their defaults, and columns since dropped are left
+// out.
+func restoreRows(tx *gorm.DB, table string, rows []map[string]json.RawMessage) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var columns []string
+	if err := tx.Raw("SELECT column_name FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ?", table).
+		Scan(&columns).Error; err != nil {
+		return fmt.Errorf("listing columns: %w", err)
+	}
+	var kept []string
+	for _, column := range columns {
+		if _, ok := rows[0][column]; ok {
+			kept = append(kept, `"`+column+`"`)
+		}
+	}
+
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return err
+	}
+	list := strings.Join(kept, ", ")
+	return tx.Exec(fmt.Sprintf("INSERT INTO %[1]s (%[2]s) SELECT %[2]s FROM jsonb_populate_recordset(NULL::%[1]s, ?::jsonb)", table, list), string(data)).Error
+}
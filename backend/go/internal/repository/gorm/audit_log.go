@@ -3,7 +3,9 @@ package gorm
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
 	"gorm.io/gorm"
@@ -26,3 +28,25 @@ func (r *auditLogRepository) Create(ctx context.Context, auditLog *models.AuditL
 	}
 	return nil
 }
+
+func (r *auditLogRepository) CreateBatch(ctx context.Context, auditLogs []*models.AuditLog) error {
+	if len(auditLogs) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Create(auditLogs).Error; err != nil {
+		return fmt.Errorf("creating audit logs: %w", err)
+	}
+	return nil
+}
+
+func (r *auditLogRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, actions []string, since time.Time) ([]*models.AuditLog, error) {
+	var logs []*models.AuditLog
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND action IN ? AND created_at >= ?", orgID, actions, since).
+		Order("created_at ASC").
+		Find(&logs).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing audit logs: %w", err)
+	}
+	return logs, nil
+}
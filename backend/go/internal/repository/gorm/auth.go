@@ -16,13 +16,15 @@ import (
 type authRepository struct {
 	db    *gorm.DB
 	cache cache.Cache
+	ttls  cache.TTLs
 }
 
 // NewAuthRepository creates a new GORM-based AuthRepository.
-func NewAuthRepository(db *gorm.DB, cache cache.Cache) repository.AuthRepository {
+func NewAuthRepository(db *gorm.DB, cache cache.Cache, ttls cache.TTLs) repository.AuthRepository {
 	return &authRepository{
 		db:    db,
 		cache: cache,
+		ttls:  ttls,
 	}
 }
 
@@ -36,46 +38,38 @@ func (r *authRepository) CreateAuthMethod(ctx context.Context, method *models.Au
 }
 
 func (r *authRepository) GetAuthMethodByID(ctx context.Context, id uuid.UUID) (*models.AuthMethod, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyAuthMethod(id)
-	var method models.AuthMethod
-	if err := r.cache.Get(ctx, cacheKey, &method); err == nil {
-		return &method, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&method, "id = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("auth method not found: %w", err)
+	method, err := cache.LoadThrough(ctx, r.cache, cache.KeyAuthMethod(id), r.ttls.AuthMethod, func(ctx context.Context) (models.AuthMethod, error) {
+		var method models.AuthMethod
+		if err := r.db.WithContext(ctx).First(&method, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return method, fmt.Errorf("auth method not found: %w", err)
+			}
+			return method, fmt.Errorf("getting auth method by id: %w", err)
 		}
-		return nil, fmt.Errorf("getting auth method by id: %w", err)
+		return method, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, method, 1*time.Hour)
-
 	return &method, nil
 }
 
 func (r *authRepository) GetAuthMethodByProvider(ctx context.Context, provider, providerID string) (*models.AuthMethod, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyAuthMethodByProvider(provider, providerID)
-	var method models.AuthMethod
-	if err := r.cache.Get(ctx, cacheKey, &method); err == nil {
-		return &method, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&method, "provider = ? AND provider_id = ?", provider, providerID).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("auth method not found by provider: %w", err)
+	method, err := cache.LoadThrough(ctx, r.cache, cache.KeyAuthMethodByProvider(provider, providerID), r.ttls.AuthMethod, func(ctx context.Context) (models.AuthMethod, error) {
+		var method models.AuthMethod
+		if err := r.db.WithContext(ctx).First(&method, "provider = ? AND provider_id = ?", provider, providerID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return method, fmt.Errorf("auth method not found by provider: %w", err)
+			}
+			return method, fmt.Errorf("getting auth method by provider: %w", err)
 		}
-		return nil, fmt.Errorf("getting auth method by provider: %w", err)
+		return method, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, method, 1*time.Hour)
-
 	return &method, nil
 }
 
@@ -189,13 +183,25 @@ func (r *authRepository) DeleteSession(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
-func (r *authRepository) DeleteExpiredSessions(ctx context.Context) error {
+func (r *authRepository) DeleteExpiredSessions(ctx context.Context) (int64, error) {
 	// Not ideal for cache invalidation as we don't know the hashes,
 	// but expired sessions shouldn't be in cache due to TTL.
-	if err := r.db.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&models.Session{}).Error; err != nil {
-		return fmt.Errorf("deleting expired sessions: %w", err)
+	// Hard delete in batches, like the purge; nothing reads expired sessions.
+	now := time.Now()
+	var total int64
+	for {
+		res := r.db.WithContext(ctx).Exec(
+			"DELETE FROM sessions WHERE id IN (SELECT id FROM sessions WHERE expires_at < ? LIMIT ?)",
+			now, purgeBatchSize,
+		)
+		if res.Error != nil {
+			return total, fmt.Errorf("deleting expired sessions: %w", res.Error)
+		}
+		total += res.RowsAffected
+		if res.RowsAffected < purgeBatchSize {
+			return total, nil
+		}
 	}
-	return nil
 }
 
 func (r *authRepository) DeleteSessionsByPerson(ctx context.Context, personID uuid.UUID) error {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
@@ -16,13 +15,15 @@ import (
 type consentRepository struct {
 	db    *gorm.DB
 	cache cache.Cache
+	ttls  cache.TTLs
 }
 
 // NewConsentRepository creates a new GORM-based ConsentRepository.
-func NewConsentRepository(db *gorm.DB, cache cache.Cache) repository.ConsentRepository {
+func NewConsentRepository(db *gorm.DB, cache cache.Cache, ttls cache.TTLs) repository.ConsentRepository {
 	return &consentRepository{
 		db:    db,
 		cache: cache,
+		ttls:  ttls,
 	}
 }
 
@@ -50,46 +51,38 @@ func (r *consentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.
 }
 
 func (r *consentRepository) GetCurrentBySession(ctx context.Context, sessionID string) (*models.CookieConsent, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyConsentBySession(sessionID)
-	var consent models.CookieConsent
-	if err := r.cache.Get(ctx, cacheKey, &consent); err == nil {
-		return &consent, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).Order("created_at DESC").First(&consent).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("consent not found for session: %w", err)
+	consent, err := cache.LoadThrough(ctx, r.cache, cache.KeyConsentBySession(sessionID), r.ttls.Consent, func(ctx context.Context) (models.CookieConsent, error) {
+		var consent models.CookieConsent
+		if err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).Order("created_at DESC").First(&consent).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return consent, fmt.Errorf("consent not found for session: %w", err)
+			}
+			return consent, fmt.Errorf("getting current consent by session: %w", err)
 		}
-		return nil, fmt.Errorf("getting current consent by session: %w", err)
+		return consent, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, consent, 1*time.Hour)
-
 	return &consent, nil
 }
 
 func (r *consentRepository) GetCurrentByPerson(ctx context.Context, personID uuid.UUID) (*models.CookieConsent, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyConsentByPerson(personID)
-	var consent models.CookieConsent
-	if err := r.cache.Get(ctx, cacheKey, &consent); err == nil {
-		return &consent, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).Where("person_id = ?", personID).Order("created_at DESC").First(&consent).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("consent not found for person: %w", err)
+	consent, err := cache.LoadThrough(ctx, r.cache, cache.KeyConsentByPerson(personID), r.ttls.Consent, func(ctx context.Context) (models.CookieConsent, error) {
+		var consent models.CookieConsent
+		if err := r.db.WithContext(ctx).Where("person_id = ?", personID).Order("created_at DESC").First(&consent).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return consent, fmt.Errorf("consent not found for person: %w", err)
+			}
+			return consent, fmt.Errorf("getting current consent by person: %w", err)
 		}
-		return nil, fmt.Errorf("getting current consent by person: %w", err)
+		return consent, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, consent, 1*time.Hour)
-
 	return &consent, nil
 }
 
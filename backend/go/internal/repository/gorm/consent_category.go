@@ -0,0 +1,65 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type consentCategoryRepository struct {
+	db *gorm.DB
+}
+
+// NewConsentCategoryRepository creates a new GORM-based
+// ConsentCategoryRepository.
+func NewConsentCategoryRepository(db *gorm.DB) repository.ConsentCategoryRepository {
+	return &consentCategoryRepository{
+		db: db,
+	}
+}
+
+func (r *consentCategoryRepository) List(ctx context.Context) ([]*models.ConsentCategory, error) {
+	var categories []*models.ConsentCategory
+	if err := r.db.WithContext(ctx).Order("position, key").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("listing consent categories: %w", err)
+	}
+	return categories, nil
+}
+
+func (r *consentCategoryRepository) GetByKey(ctx context.Context, key string) (*models.ConsentCategory, error) {
+	var category models.ConsentCategory
+	if err := r.db.WithContext(ctx).First(&category, "key = ?", key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("consent category not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting consent category: %w", err)
+	}
+	return &category, nil
+}
+
+func (r *consentCategoryRepository) Save(ctx context.Context, category *models.ConsentCategory) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "name", "description", "required", "position"}),
+	}).Create(category).Error
+	if err != nil {
+		return fmt.Errorf("saving consent category: %w", err)
+	}
+	return nil
+}
+
+func (r *consentCategoryRepository) Delete(ctx context.Context, key string) error {
+	result := r.db.WithContext(ctx).Delete(&models.ConsentCategory{}, "key = ?", key)
+	if result.Error != nil {
+		return fmt.Errorf("deleting consent category: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("consent category not found")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type costAlertRepository struct {
+	db *gorm.DB
+}
+
+// NewCostAlertRepository creates a new GORM-based CostAlertRepository.
+func NewCostAlertRepository(db *gorm.DB) repository.CostAlertRepository {
+	return &costAlertRepository{
+		db: db,
+	}
+}
+
+func (r *costAlertRepository) Create(ctx context.Context, alert *models.CostAlert) error {
+	if err := r.db.WithContext(ctx).Create(alert).Error; err != nil {
+		return fmt.Errorf("creating cost alert: %w", err)
+	}
+	return nil
+}
+
+func (r *costAlertRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CostAlert, error) {
+	var alert models.CostAlert
+	if err := r.db.WithContext(ctx).First(&alert, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("cost alert not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting cost alert: %w", err)
+	}
+	return &alert, nil
+}
+
+func (r *costAlertRepository) ListByPerson(ctx context.Context, orgID, personID uuid.UUID) ([]*models.CostAlert, error) {
+	var alerts []*models.CostAlert
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND person_id = ?", orgID, personID).
+		Order("created_at ASC").
+		Find(&alerts).Error; err != nil {
+		return nil, fmt.Errorf("listing cost alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+func (r *costAlertRepository) Update(ctx context.Context, alert *models.CostAlert) error {
+	if err := r.db.WithContext(ctx).Save(alert).Error; err != nil {
+		return fmt.Errorf("updating cost alert: %w", err)
+	}
+	return nil
+}
+
+func (r *costAlertRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.CostAlert{}, "id = ?", id).Error; err != nil {
+		return fmt.Errorf("deleting cost alert: %w", err)
+	}
+	return nil
+}
+
+func (r *costAlertRepository) ListDue(ctx context.Context, orgID, meetingID uuid.UUID, cost float64) ([]*models.CostAlert, error) {
+	var alerts []*models.CostAlert
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND (meeting_id = ? OR meeting_id IS NULL) AND threshold <= ?", orgID, meetingID, cost).
+		Where("NOT EXISTS (SELECT 1 FROM cost_alert_triggers t WHERE t.alert_id = cost_alerts.id AND t.meeting_id = ?)", meetingID).
+		Order("threshold ASC").
+		Find(&alerts).Error; err != nil {
+		return nil, fmt.Errorf("listing due cost alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+func (r *costAlertRepository) RecordTrigger(ctx context.Context, trigger *models.CostAlertTrigger) (bool, error) {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(trigger)
+	if res.Error != nil {
+		return false, fmt.Errorf("recording cost alert trigger: %w", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *costAlertRepository) RecordMilestone(ctx context.Context, milestone *models.CostMilestone) (bool, error) {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(milestone)
+	if res.Error != nil {
+		return false, fmt.Errorf("recording cost milestone: %w", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *costAlertRepository) MarkMilestonePosted(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&models.CostMilestone{}).Where("id = ?", id).Update("posted_at", at).Error; err != nil {
+		return fmt.Errorf("marking cost milestone posted: %w", err)
+	}
+	return nil
+}
+
+func (r *costAlertRepository) LastMilestonePostedAt(ctx context.Context, orgID uuid.UUID) (*time.Time, error) {
+	var last *time.Time
+	if err := r.db.WithContext(ctx).Model(&models.CostMilestone{}).
+		Where("organization_id = ?", orgID).
+		Select("MAX(posted_at)").
+		Scan(&last).Error; err != nil {
+		return nil, fmt.Errorf("getting last cost milestone: %w", err)
+	}
+	return last, nil
+}
@@ -0,0 +1,56 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type dpaRepository struct {
+	db *gorm.DB
+}
+
+// NewDPARepository creates a new GORM-based DPARepository.
+func NewDPARepository(db *gorm.DB) repository.DPARepository {
+	return &dpaRepository{
+		db: db,
+	}
+}
+
+func (r *dpaRepository) Create(ctx context.Context, acceptance *models.DPAAcceptance) error {
+	if err := r.db.WithContext(ctx).Create(acceptance).Error; err != nil {
+		return fmt.Errorf("creating dpa acceptance: %w", err)
+	}
+	return nil
+}
+
+func (r *dpaRepository) GetByVersion(ctx context.Context, orgID uuid.UUID, version string) (*models.DPAAcceptance, error) {
+	var acceptance models.DPAAcceptance
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND version = ?", orgID, version).
+		First(&acceptance).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("dpa acceptance not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting dpa acceptance: %w", err)
+	}
+	return &acceptance, nil
+}
+
+func (r *dpaRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.DPAAcceptance, error) {
+	var acceptances []*models.DPAAcceptance
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ?", orgID).
+		Order("accepted_at DESC").
+		Find(&acceptances).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing dpa acceptances: %w", err)
+	}
+	return acceptances, nil
+}
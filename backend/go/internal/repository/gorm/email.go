@@ -0,0 +1,48 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type emailDeliveryRepository struct {
+	db *gorm.DB
+}
+
+// NewEmailDeliveryRepository creates a new GORM-based EmailDeliveryRepository.
+func NewEmailDeliveryRepository(db *gorm.DB) repository.EmailDeliveryRepository {
+	return &emailDeliveryRepository{
+		db: db,
+	}
+}
+
+func (r *emailDeliveryRepository) Create(ctx context.Context, delivery *models.EmailDelivery) error {
+	if err := r.db.WithContext(ctx).Create(delivery).Error; err != nil {
+		return fmt.Errorf("creating email delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *emailDeliveryRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.EmailDelivery, error) {
+	var delivery models.EmailDelivery
+	if err := r.db.WithContext(ctx).First(&delivery, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("email delivery not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting email delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+func (r *emailDeliveryRepository) Update(ctx context.Context, delivery *models.EmailDelivery) error {
+	if err := r.db.WithContext(ctx).Save(delivery).Error; err != nil {
+		return fmt.Errorf("updating email delivery: %w", err)
+	}
+	return nil
+}
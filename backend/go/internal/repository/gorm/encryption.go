@@ -0,0 +1,161 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/encryption"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// encryptedColumns lists the columns models tag with the encrypted
+// serializer, by table.
+var encryptedColumns = []struct {
+	table   string
+	columns []string
+}{
+	{"auth_methods", []string{"access_token", "refresh_token"}},
+	{"integrations", []string{"access_token", "refresh_token"}},
+	{"webhook_endpoints", []string{"secret"}},
+}
+
+// wagesTable holds members' hourly wages, which are encrypted per
+// organization rather than by the encrypted serializer.
+const wagesTable = "person_organization_profiles"
+
+// reencryptBatchSize bounds the rows read at a time while re-encrypting.
+const reencryptBatchSize = 500
+
+type encryptionRepository struct {
+	db *gorm.DB
+}
+
+// NewEncryptionRepository creates a new GORM-based EncryptionRepository.
+func NewEncryptionRepository(db *gorm.DB) repository.EncryptionRepository {
+	return &encryptionRepository{
+		db: db,
+	}
+}
+
+func (r *encryptionRepository) Reencrypt(ctx context.Context, keyring *encryption.Keyring) (map[string]int64, error) {
+	rewritten := make(map[string]int64, len(encryptedColumns))
+	for _, t := range encryptedColumns {
+		n, err := r.reencryptTable(ctx, keyring, t.table, t.columns)
+		rewritten[t.table] = n
+		if err != nil {
+			return rewritten, fmt.Errorf("re-encrypting %s: %w", t.table, err)
+		}
+	}
+
+	n, err := r.reencryptWages(ctx, keyring)
+	rewritten[wagesTable] += n
+	if err != nil {
+		return rewritten, fmt.Errorf("re-encrypting wages: %w", err)
+	}
+	return rewritten, nil
+}
+
+// reencryptTable walks the table by ID, soft-deleted rows included, reading
+// the stored values without the serializer so they can be compared with
+// the primary key.
+func (r *encryptionRepository) reencryptTable(ctx context.Context, keyring *encryption.Keyring, table string, columns []string) (int64, error) {
+	var rewritten int64
+	after := uuid.Nil
+	for {
+		var rows []map[string]interface{}
+		err := r.db.WithContext(ctx).Table(table).
+			Select(append([]string{"id::text AS id"}, columns...)).
+			Where("id > ?", after).
+			Order("id").
+			Limit(reencryptBatchSize).
+			Find(&rows).Error
+		if err != nil {
+			return rewritten, err
+		}
+
+		for _, row := range rows {
+			id, err := uuid.Parse(fmt.Sprint(row["id"]))
+			if err != nil {
+				return rewritten, fmt.Errorf("reading id %v: %w", row["id"], err)
+			}
+			after = id
+
+			updates := make(map[string]interface{})
+			for _, col := range columns {
+				value, _ := row[col].(string)
+				rotated, changed, err := keyring.Rotate(value)
+				if err != nil {
+					return rewritten, fmt.Errorf("%s of %s: %w", col, id, err)
+				}
+				if changed {
+					updates[col] = rotated
+				}
+			}
+			if len(updates) == 0 {
+				continue
+			}
+			if err := r.db.WithContext(ctx).Table(table).Where("id = ?", id).UpdateColumns(updates).Error; err != nil {
+				return rewritten, err
+			}
+			rewritten++
+		}
+
+		if len(rows) < reencryptBatchSize {
+			return rewritten, nil
+		}
+	}
+}
+
+// reencryptWages moves stored hourly wages to the primary key and the
+// current wage scope, encrypting plaintext wages when wage encryption is
+// on.
+func (r *encryptionRepository) reencryptWages(ctx context.Context, keyring *encryption.Keyring) (int64, error) {
+	var rewritten int64
+	after := uuid.Nil
+	for {
+		var rows []struct {
+			ID             string
+			OrganizationID string
+			HourlyWage     string
+		}
+		err := r.db.WithContext(ctx).Table(wagesTable).
+			Select("id::text AS id", "organization_id::text AS organization_id", "hourly_wage").
+			Where("id > ? AND hourly_wage IS NOT NULL", after).
+			Order("id").
+			Limit(reencryptBatchSize).
+			Find(&rows).Error
+		if err != nil {
+			return rewritten, err
+		}
+
+		for _, row := range rows {
+			id, err := uuid.Parse(row.ID)
+			if err != nil {
+				return rewritten, fmt.Errorf("reading id %v: %w", row.ID, err)
+			}
+			after = id
+			orgID, err := uuid.Parse(row.OrganizationID)
+			if err != nil {
+				return rewritten, fmt.Errorf("reading organization of %s: %w", id, err)
+			}
+
+			rotated, changed, err := encryption.RotateWage(keyring, orgID, row.HourlyWage)
+			if err != nil {
+				return rewritten, fmt.Errorf("hourly_wage of %s: %w", id, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := r.db.WithContext(ctx).Table(wagesTable).Where("id = ?", id).UpdateColumn("hourly_wage", rotated).Error; err != nil {
+				return rewritten, err
+			}
+			rewritten++
+		}
+
+		if len(rows) < reencryptBatchSize {
+			return rewritten, nil
+		}
+	}
+}
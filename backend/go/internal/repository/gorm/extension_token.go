@@ -0,0 +1,87 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type extensionTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewExtensionTokenRepository creates a new GORM-based
+// ExtensionTokenRepository.
+func NewExtensionTokenRepository(db *gorm.DB) repository.ExtensionTokenRepository {
+	return &extensionTokenRepository{
+		db: db,
+	}
+}
+
+func (r *extensionTokenRepository) Create(ctx context.Context, token *models.ExtensionToken) error {
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
+		return fmt.Errorf("creating extension token: %w", err)
+	}
+	return nil
+}
+
+func (r *extensionTokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ExtensionToken, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+func (r *extensionTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.ExtensionToken, error) {
+	return r.first(ctx, "token_hash = ?", tokenHash)
+}
+
+func (r *extensionTokenRepository) first(ctx context.Context, query string, args ...interface{}) (*models.ExtensionToken, error) {
+	var token models.ExtensionToken
+	if err := r.db.WithContext(ctx).Where(query, args...).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("extension token not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting extension token: %w", err)
+	}
+	return &token, nil
+}
+
+func (r *extensionTokenRepository) ListByPerson(ctx context.Context, personID uuid.UUID) ([]*models.ExtensionToken, error) {
+	var tokens []*models.ExtensionToken
+	if err := r.db.WithContext(ctx).
+		Where("person_id = ?", personID).
+		Order("created_at ASC").
+		Find(&tokens).Error; err != nil {
+		return nil, fmt.Errorf("listing extension tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+func (r *extensionTokenRepository) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&models.ExtensionToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at).Error; err != nil {
+		return fmt.Errorf("revoking extension token: %w", err)
+	}
+	return nil
+}
+
+func (r *extensionTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&models.ExtensionToken{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", at).Error; err != nil {
+		return fmt.Errorf("marking extension token used: %w", err)
+	}
+	return nil
+}
+
+func (r *extensionTokenRepository) DeleteByPerson(ctx context.Context, personID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.ExtensionToken{}, "person_id = ?", personID).Error; err != nil {
+		return fmt.Errorf("deleting extension tokens: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type holidayRepository struct {
+	db *gorm.DB
+}
+
+// NewHolidayRepository creates a new GORM-based HolidayRepository.
+func NewHolidayRepository(db *gorm.DB) repository.HolidayRepository {
+	return &holidayRepository{
+		db: db,
+	}
+}
+
+func (r *holidayRepository) Create(ctx context.Context, holiday *models.Holiday) error {
+	if err := r.db.WithContext(ctx).Create(holiday).Error; err != nil {
+		return fmt.Errorf("creating holiday: %w", err)
+	}
+	return nil
+}
+
+func (r *holidayRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Holiday, error) {
+	var holiday models.Holiday
+	if err := r.db.WithContext(ctx).First(&holiday, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("holiday not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting holiday: %w", err)
+	}
+	return &holiday, nil
+}
+
+func (r *holidayRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, from, to time.Time) ([]*models.Holiday, error) {
+	query := r.db.WithContext(ctx).Where("organization_id = ?", orgID)
+	if !from.IsZero() {
+		query = query.Where("date >= ?", from.Format(time.DateOnly))
+	}
+	if !to.IsZero() {
+		query = query.Where("date < ?", to.Format(time.DateOnly))
+	}
+	var holidays []*models.Holiday
+	if err := query.Order("date ASC").Find(&holidays).Error; err != nil {
+		return nil, fmt.Errorf("listing holidays: %w", err)
+	}
+	return holidays, nil
+}
+
+func (r *holidayRepository) Update(ctx context.Context, holiday *models.Holiday) error {
+	if err := r.db.WithContext(ctx).Save(holiday).Error; err != nil {
+		return fmt.Errorf("updating holiday: %w", err)
+	}
+	return nil
+}
+
+func (r *holidayRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.Holiday{}, "id = ?", id).Error; err != nil {
+		return fmt.Errorf("deleting holiday: %w", err)
+	}
+	return nil
+}
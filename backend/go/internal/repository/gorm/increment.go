@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
@@ -16,13 +15,15 @@ import (
 type incrementRepository struct {
 	db    *gorm.DB
 	cache cache.Cache
+	ttls  cache.TTLs
 }
 
 // NewIncrementRepository creates a new GORM-based IncrementRepository.
-func NewIncrementRepository(db *gorm.DB, cache cache.Cache) repository.IncrementRepository {
+func NewIncrementRepository(db *gorm.DB, cache cache.Cache, ttls cache.TTLs) repository.IncrementRepository {
 	return &incrementRepository{
 		db:    db,
 		cache: cache,
+		ttls:  ttls,
 	}
 }
 
@@ -48,44 +49,42 @@ func (r *incrementRepository) CreateBatch(ctx context.Context, increments []*mod
 }
 
 func (r *incrementRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Increment, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyIncrement(id)
-	var increment models.Increment
-	if err := r.cache.Get(ctx, cacheKey, &increment); err == nil {
-		return &increment, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&increment, "id = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("increment not found: %w", err)
+	increment, err := cache.LoadThrough(ctx, r.cache, cache.KeyIncrement(id), r.ttls.Increment, func(ctx context.Context) (models.Increment, error) {
+		var increment models.Increment
+		if err := r.db.WithContext(ctx).First(&increment, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return increment, fmt.Errorf("increment not found: %w", err)
+			}
+			return increment, fmt.Errorf("getting increment by id: %w", err)
 		}
-		return nil, fmt.Errorf("getting increment by id: %w", err)
+		return increment, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, increment, 1*time.Hour)
-
 	return &increment, nil
 }
 
 func (r *incrementRepository) GetByMeeting(ctx context.Context, meetingID uuid.UUID) ([]*models.Increment, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyMeetingIncrements(meetingID)
-	var increments []*models.Increment
-	if err := r.cache.Get(ctx, cacheKey, &increments); err == nil {
+	increments, err := cache.LoadThrough(ctx, r.cache, cache.KeyMeetingIncrements(meetingID), r.ttls.IncrementList, func(ctx context.Context) ([]models.Increment, error) {
+		var increments []models.Increment
+		if err := r.db.WithContext(ctx).Where("meeting_id = ?", meetingID).Order("start_time ASC").Find(&increments).Error; err != nil {
+			return nil, fmt.Errorf("getting increments by meeting: %w", err)
+		}
 		return increments, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).Where("meeting_id = ?", meetingID).Order("start_time ASC").Find(&increments).Error; err != nil {
-		return nil, fmt.Errorf("getting increments by meeting: %w", err)
+	// Hand each caller its own copies, since the loaded slice may be shared
+	result := make([]*models.Increment, len(increments))
+	for i := range increments {
+		inc := increments[i]
+		result[i] = &inc
 	}
-
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, increments, 15*time.Minute)
-
-	return increments, nil
+	return result, nil
 }
 
 func (r *incrementRepository) Update(ctx context.Context, increment *models.Increment) error {
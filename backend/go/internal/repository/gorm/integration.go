@@ -0,0 +1,73 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type integrationRepository struct {
+	db *gorm.DB
+}
+
+// NewIntegrationRepository creates a new GORM-based IntegrationRepository.
+func NewIntegrationRepository(db *gorm.DB) repository.IntegrationRepository {
+	return &integrationRepository{
+		db: db,
+	}
+}
+
+func (r *integrationRepository) Create(ctx context.Context, integration *models.Integration) error {
+	if err := r.db.WithContext(ctx).Create(integration).Error; err != nil {
+		return fmt.Errorf("creating integration: %w", err)
+	}
+	return nil
+}
+
+func (r *integrationRepository) GetByProvider(ctx context.Context, orgID uuid.UUID, provider string) (*models.Integration, error) {
+	var integration models.Integration
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND provider = ?", orgID, provider).
+		First(&integration).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("integration not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting integration: %w", err)
+	}
+	return &integration, nil
+}
+
+func (r *integrationRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.Integration, error) {
+	var integrations []*models.Integration
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ?", orgID).
+		Order("provider ASC").
+		Find(&integrations).Error; err != nil {
+		return nil, fmt.Errorf("listing integrations: %w", err)
+	}
+	return integrations, nil
+}
+
+func (r *integrationRepository) ListConnected(ctx context.Context, provider string) ([]*models.Integration, error) {
+	var integrations []*models.Integration
+	if err := r.db.WithContext(ctx).
+		Where("provider = ? AND status = ?", provider, models.IntegrationConnected).
+		Order("organization_id ASC").
+		Find(&integrations).Error; err != nil {
+		return nil, fmt.Errorf("listing connected integrations: %w", err)
+	}
+	return integrations, nil
+}
+
+func (r *integrationRepository) Update(ctx context.Context, integration *models.Integration) error {
+	if err := r.db.WithContext(ctx).Save(integration).Error; err != nil {
+		return fmt.Errorf("updating integration: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,18 +12,21 @@ import (
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type meetingRepository struct {
 	db    *gorm.DB
 	cache cache.Cache
+	ttls  cache.TTLs
 }
 
 // NewMeetingRepository creates a new GORM-based MeetingRepository.
-func NewMeetingRepository(db *gorm.DB, cache cache.Cache) repository.MeetingRepository {
+func NewMeetingRepository(db *gorm.DB, cache cache.Cache, ttls cache.TTLs) repository.MeetingRepository {
 	return &meetingRepository{
 		db:    db,
 		cache: cache,
+		ttls:  ttls,
 	}
 }
 
@@ -33,47 +37,80 @@ func (r *meetingRepository) Create(ctx context.Context, meeting *models.Meeting)
 	return nil
 }
 
+func (r *meetingRepository) CreateWithIncrements(ctx context.Context, meeting *models.Meeting, increments []*models.Increment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(meeting).Error; err != nil {
+			return fmt.Errorf("creating meeting: %w", err)
+		}
+		for _, inc := range increments {
+			inc.MeetingID = meeting.ID
+			if err := tx.Create(inc).Error; err != nil {
+				return fmt.Errorf("adding increment: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
 func (r *meetingRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Meeting, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyMeeting(id)
-	var meeting models.Meeting
-	if err := r.cache.Get(ctx, cacheKey, &meeting); err == nil {
-		return &meeting, nil
+	meeting, err := cache.LoadThrough(ctx, r.cache, cache.KeyMeeting(id), r.ttls.Meeting, func(ctx context.Context) (models.Meeting, error) {
+		var meeting models.Meeting
+		if err := r.db.WithContext(ctx).First(&meeting, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return meeting, fmt.Errorf("meeting not found: %w", err)
+			}
+			return meeting, fmt.Errorf("getting meeting by id: %w", err)
+		}
+		return meeting, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &meeting, nil
+}
+
+// GetByIDWithPreloads loads a meeting and the requested associations. It
+// bypasses the cache since associations change on every increment cycle.
+func (r *meetingRepository) GetByIDWithPreloads(ctx context.Context, id uuid.UUID, preloads repository.MeetingPreloads) (*models.Meeting, error) {
+	query := r.db.WithContext(ctx)
+	if preloads.Increments {
+		query = query.Preload("Increments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_time ASC")
+		})
+	}
+	if preloads.Participants {
+		query = query.Preload("Participants", func(db *gorm.DB) *gorm.DB {
+			return db.Order("joined_at ASC")
+		}).Preload("Participants.Person")
 	}
 
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&meeting, "id = ?", id).Error; err != nil {
+	var meeting models.Meeting
+	if err := query.First(&meeting, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("meeting not found: %w", err)
 		}
-		return nil, fmt.Errorf("getting meeting by id: %w", err)
+		return nil, fmt.Errorf("getting meeting with preloads: %w", err)
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, meeting, 15*time.Minute)
-
 	return &meeting, nil
 }
 
 func (r *meetingRepository) GetByExternalID(ctx context.Context, externalType, externalID string) (*models.Meeting, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyMeetingByExternalID(externalType, externalID)
-	var meeting models.Meeting
-	if err := r.cache.Get(ctx, cacheKey, &meeting); err == nil {
-		return &meeting, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&meeting, "external_type = ? AND external_id = ?", externalType, externalID).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("meeting not found by external id: %w", err)
+	meeting, err := cache.LoadThrough(ctx, r.cache, cache.KeyMeetingByExternalID(externalType, externalID), r.ttls.Meeting, func(ctx context.Context) (models.Meeting, error) {
+		var meeting models.Meeting
+		if err := r.db.WithContext(ctx).First(&meeting, "external_type = ? AND external_id = ?", externalType, externalID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return meeting, fmt.Errorf("meeting not found by external id: %w", err)
+			}
+			return meeting, fmt.Errorf("getting meeting by external id: %w", err)
 		}
-		return nil, fmt.Errorf("getting meeting by external id: %w", err)
+		return meeting, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, meeting, 15*time.Minute)
-
 	return &meeting, nil
 }
 
@@ -110,6 +147,12 @@ func (r *meetingRepository) List(ctx context.Context, filters repository.Meeting
 	if filters.StartedBefore != nil {
 		query = query.Where("started_at <= ?", *filters.StartedBefore)
 	}
+	if filters.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filters.CreatedBefore)
+	}
+	if filters.Purpose != nil {
+		query = query.Where(`purpose ILIKE ? ESCAPE '\'`, containsPattern(*filters.Purpose))
+	}
 	if filters.ExternalType != nil {
 		query = query.Where("external_type = ?", *filters.ExternalType)
 	}
@@ -145,6 +188,68 @@ func (r *meetingRepository) List(ctx context.Context, filters repository.Meeting
 	return meetings, total, nil
 }
 
+func (r *meetingRepository) ListDeleted(ctx context.Context, orgID uuid.UUID, pagination repository.Pagination) ([]*models.Meeting, int64, error) {
+	var meetings []*models.Meeting
+	var total int64
+
+	query := r.db.WithContext(ctx).Unscoped().Model(&models.Meeting{}).
+		Where("organization_id = ? AND deleted_at IS NOT NULL", orgID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("counting deleted meetings: %w", err)
+	}
+	if pagination.PageSize > 0 {
+		query = query.Offset(pagination.Offset()).Limit(pagination.Limit())
+	}
+	if err := query.Order("deleted_at DESC").Find(&meetings).Error; err != nil {
+		return nil, 0, fmt.Errorf("querying deleted meetings: %w", err)
+	}
+	return meetings, total, nil
+}
+
+func (r *meetingRepository) ListOverrunning(ctx context.Context, end time.Time) ([]*models.Meeting, error) {
+	var meetings []*models.Meeting
+	err := r.db.WithContext(ctx).
+		Where("is_active AND overrun_at IS NULL AND scheduled_end < ?", end).
+		Order("scheduled_end").
+		Find(&meetings).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing overrunning meetings: %w", err)
+	}
+	return meetings, nil
+}
+
+func (r *meetingRepository) ListUncompacted(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Where("NOT is_active AND increments_compacted_at IS NULL").
+		Order("stopped_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing uncompacted meetings: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *meetingRepository) ActiveTotals(ctx context.Context, now time.Time) ([]*repository.ActiveTotals, error) {
+	// The open increment accrues as the service's live cost does, by the
+	// whole second
+	var totals []*repository.ActiveTotals
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT m.organization_id, COUNT(*) AS meetings,
+			SUM(m.total_cost + COALESCE(FLOOR(EXTRACT(EPOCH FROM (@now - i.start_time))) / 3600 * i.attendee_count * i.average_wage, 0)) AS cost
+		FROM meetings m
+		LEFT JOIN increments i ON i.meeting_id = m.id AND i.stop_time = @open AND i.deleted_at IS NULL
+		WHERE m.is_active AND m.deleted_at IS NULL
+		GROUP BY m.organization_id`,
+		map[string]interface{}{"now": now, "open": time.Time{}}).
+		Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf("totalling active meetings: %w", err)
+	}
+	return totals, nil
+}
+
 func (r *meetingRepository) Update(ctx context.Context, meeting *models.Meeting) error {
 	if err := r.db.WithContext(ctx).Save(meeting).Error; err != nil {
 		return fmt.Errorf("updating meeting: %w", err)
@@ -178,21 +283,194 @@ func (r *meetingRepository) Start(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (r *meetingRepository) Stop(ctx context.Context, id uuid.UUID) error {
-	now := time.Now()
+// StartWithIncrement marks the meeting active and opens its first increment in
+// one transaction, so a meeting can never be active without an open increment.
+// The meeting's started_at is taken from the increment's start time.
+func (r *meetingRepository) StartWithIncrement(ctx context.Context, id uuid.UUID, first *models.Increment) error {
+	startedAt := first.StartTime
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&models.Meeting{}).
+			Where("id = ? AND is_active = ? AND finalized_at IS NULL", id, false).
+			Updates(map[string]interface{}{
+				"is_active":  true,
+				"started_at": &startedAt,
+			})
+		if res.Error != nil {
+			return fmt.Errorf("starting meeting: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("meeting is already active, finalized or does not exist")
+		}
+
+		first.MeetingID = id
+		if err := tx.Create(first).Error; err != nil {
+			return fmt.Errorf("adding first increment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invalidate cache
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(id))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(id))
+	return nil
+}
+
+// Stop closes the open increment and marks the meeting stopped in one
+// transaction, under the same lock CycleIncrement takes, so a cycle can
+// neither close the increment again nor open another after the stop.
+func (r *meetingRepository) Stop(ctx context.Context, id uuid.UUID, at time.Time, closeOpen repository.IncrementCloseFunc) (*models.Increment, error) {
+	var open *models.Increment
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockActiveMeeting(tx, id); err != nil {
+			return err
+		}
+
+		var err error
+		if open, err = openIncrement(tx, id); err != nil {
+			return err
+		}
+		if open != nil {
+			if err := closeOpen(open); err != nil {
+				return err
+			}
+			if err := closeIncrement(tx, id, open); err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&models.Meeting{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"is_active":  false,
+				"stopped_at": &at,
+				// New increments are due a compaction
+				"increments_compacted_at": nil,
+			}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("stopping meeting: %w", err)
+	}
+
+	// Invalidate cache
+	if open != nil {
+		_ = r.cache.Delete(ctx, cache.KeyIncrement(open.ID))
+	}
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(id))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(id))
+	return open, nil
+}
+
+func (r *meetingRepository) MarkOverrun(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Where("id = ? AND overrun_at IS NULL", id).
+		Update("overrun_at", at)
+	if res.Error != nil {
+		return false, fmt.Errorf("marking meeting overrun: %w", res.Error)
+	}
+
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(id))
+	return res.RowsAffected > 0, nil
+}
+
+func (r *meetingRepository) Finalize(ctx context.Context, id uuid.UUID, personID uuid.UUID, at time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Where("id = ? AND is_active = ? AND finalized_at IS NULL", id, false).
+		Updates(map[string]interface{}{
+			"finalized_at":    at,
+			"finalized_by_id": personID,
+		})
+	if res.Error != nil {
+		return false, fmt.Errorf("finalizing meeting: %w", res.Error)
+	}
+
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(id))
+	return res.RowsAffected > 0, nil
+}
+
+func (r *meetingRepository) RecordOverrun(ctx context.Context, id uuid.UUID, seconds int, cost float64) error {
 	err := r.db.WithContext(ctx).Model(&models.Meeting{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
-			"is_active": false,
-			"stopped_at":  &now,
+			"overrun_seconds": seconds,
+			"overrun_cost":    cost,
 		}).Error
+	if err != nil {
+		return fmt.Errorf("recording overrun: %w", err)
+	}
+
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(id))
+	return nil
+}
+
+func (r *meetingRepository) RecordLateStart(ctx context.Context, id uuid.UUID, seconds int, cost float64) error {
+	err := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"late_start_seconds": seconds,
+			"late_start_cost":    cost,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("recording late start: %w", err)
+	}
+
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(id))
+	return nil
+}
+
+// RecalculateTotals refreshes the meeting's cached totals and every closed
+// increment's running total with two set-based statements instead of touching
+// each increment individually.
+func (r *meetingRepository) RecalculateTotals(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Running totals over closed increments, in start order.
+		if err := tx.Exec(`
+			UPDATE increments AS i
+			SET total_cost = t.running_total
+			FROM (
+				SELECT id, SUM(cost) OVER (ORDER BY start_time, id) AS running_total
+				FROM increments
+				WHERE meeting_id = ? AND stop_time <> ? AND deleted_at IS NULL
+			) AS t
+			WHERE i.id = t.id AND i.total_cost IS DISTINCT FROM t.running_total`,
+			id, time.Time{},
+		).Error; err != nil {
+			return fmt.Errorf("updating running totals: %w", err)
+		}
 
+		// Meeting totals; the open increment only contributes to max attendees.
+		if err := tx.Exec(`
+			UPDATE meetings AS m
+			SET total_cost = t.total_cost,
+				total_duration = t.total_duration,
+				max_attendees = t.max_attendees,
+				attendee_seconds = t.attendee_seconds,
+				updated_at = ?
+			FROM (
+				SELECT
+					COALESCE(SUM(cost) FILTER (WHERE stop_time <> ?), 0) AS total_cost,
+					COALESCE(SUM(elapsed_time) FILTER (WHERE stop_time <> ?), 0) AS total_duration,
+					COALESCE(MAX(attendee_count), 0) AS max_attendees,
+					COALESCE(SUM(elapsed_time::bigint * attendee_count) FILTER (WHERE stop_time <> ?), 0) AS attendee_seconds
+				FROM increments
+				WHERE meeting_id = ? AND deleted_at IS NULL
+			) AS t
+			WHERE m.id = ?`,
+			time.Now(), time.Time{}, time.Time{}, time.Time{}, id, id,
+		).Error; err != nil {
+			return fmt.Errorf("updating meeting totals: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("stopping meeting: %w", err)
+		return err
 	}
 
 	// Invalidate cache
 	_ = r.cache.Delete(ctx, cache.KeyMeeting(id))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(id))
 	return nil
 }
 
@@ -215,6 +493,35 @@ func (r *meetingRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (r *meetingRepository) DeleteMany(ctx context.Context, ids []uuid.UUID) error {
+	var meetings []*models.Meeting
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id IN ?", ids).Find(&meetings).Error; err != nil {
+			return fmt.Errorf("getting meetings: %w", err)
+		}
+		if len(meetings) != len(ids) {
+			return fmt.Errorf("meeting not found")
+		}
+		if err := tx.Delete(&models.Meeting{}, "id IN ?", ids).Error; err != nil {
+			return fmt.Errorf("deleting meetings: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invalidate cache
+	for _, meeting := range meetings {
+		_ = r.cache.Delete(ctx, cache.KeyMeeting(meeting.ID))
+		if meeting.ExternalID != "" {
+			_ = r.cache.Delete(ctx, cache.KeyMeetingByExternalID(meeting.ExternalType, meeting.ExternalID))
+		}
+	}
+
+	return nil
+}
+
 func (r *meetingRepository) GetIncrements(ctx context.Context, meetingID uuid.UUID) ([]*models.Increment, error) {
 	var increments []*models.Increment
 	if err := r.db.WithContext(ctx).Where("meeting_id = ?", meetingID).Order("start_time ASC").Find(&increments).Error; err != nil {
@@ -223,6 +530,21 @@ func (r *meetingRepository) GetIncrements(ctx context.Context, meetingID uuid.UU
 	return increments, nil
 }
 
+func (r *meetingRepository) GetOpenIncrement(ctx context.Context, meetingID uuid.UUID) (*models.Increment, error) {
+	var open models.Increment
+	err := r.db.WithContext(ctx).
+		Where("meeting_id = ? AND stop_time = ?", meetingID, time.Time{}).
+		Order("start_time DESC").
+		First(&open).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting open increment: %w", err)
+	}
+	return &open, nil
+}
+
 func (r *meetingRepository) AddIncrement(ctx context.Context, increment *models.Increment) error {
 	if err := r.db.WithContext(ctx).Create(increment).Error; err != nil {
 		return fmt.Errorf("adding increment: %w", err)
@@ -230,6 +552,233 @@ func (r *meetingRepository) AddIncrement(ctx context.Context, increment *models.
 	return nil
 }
 
+// CycleIncrement closes the open increment and opens the next one in a single
+// transaction. The meeting row is locked with SELECT ... FOR UPDATE so that
+// concurrent cycles for the same meeting are serialized and never observe the
+// same open increment, and a stopped meeting is refused once the lock is held.
+func (r *meetingRepository) CycleIncrement(ctx context.Context, meetingID uuid.UUID, cycle repository.IncrementCycleFunc) (*models.Increment, error) {
+	var open *models.Increment
+	var next *models.Increment
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockActiveMeeting(tx, meetingID); err != nil {
+			return err
+		}
+
+		var err error
+		if open, err = openIncrement(tx, meetingID); err != nil {
+			return err
+		}
+
+		next, err = cycle(open)
+		if err != nil {
+			return err
+		}
+
+		if open != nil {
+			if err := closeIncrement(tx, meetingID, open); err != nil {
+				return err
+			}
+		}
+		if next != nil {
+			if err := tx.Create(next).Error; err != nil {
+				return fmt.Errorf("adding increment: %w", err)
+			}
+			if err := tx.Exec("UPDATE meetings SET max_attendees = GREATEST(max_attendees, ?) WHERE id = ?",
+				next.AttendeeCount, meetingID).Error; err != nil {
+				return fmt.Errorf("updating max attendees: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cycling increment: %w", err)
+	}
+
+	// Invalidate cache
+	if open != nil {
+		_ = r.cache.Delete(ctx, cache.KeyIncrement(open.ID))
+	}
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(meetingID))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(meetingID))
+
+	return next, nil
+}
+
+// lockActiveMeeting locks the meeting's row for the rest of tx and fails
+// unless it is running.
+func lockActiveMeeting(tx *gorm.DB, meetingID uuid.UUID) error {
+	var meeting models.Meeting
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "is_active").
+		First(&meeting, "id = ?", meetingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("meeting not found: %w", err)
+		}
+		return fmt.Errorf("locking meeting: %w", err)
+	}
+	if !meeting.IsActive {
+		return fmt.Errorf("meeting is not active")
+	}
+	return nil
+}
+
+// openIncrement returns the meeting's open increment in tx, or nil if it has
+// none.
+func openIncrement(tx *gorm.DB, meetingID uuid.UUID) (*models.Increment, error) {
+	var open models.Increment
+	err := tx.Where("meeting_id = ? AND stop_time = ?", meetingID, time.Time{}).
+		Order("start_time DESC").
+		First(&open).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting open increment: %w", err)
+	}
+	return &open, nil
+}
+
+// closeIncrement saves the increment a cycle or stop has just handled, and
+// adds it to the meeting's totals if it closed.
+func closeIncrement(tx *gorm.DB, meetingID uuid.UUID, inc *models.Increment) error {
+	if !inc.StopTime.IsZero() {
+		// The cast rounds the cost as the increment's column does
+		if err := tx.Raw(`
+			UPDATE meetings
+			SET total_cost = total_cost + CAST(? AS decimal(12,2)),
+				total_duration = total_duration + ?,
+				attendee_seconds = attendee_seconds + ?,
+				updated_at = ?
+			WHERE id = ?
+			RETURNING total_cost`,
+			inc.Cost, inc.ElapsedTime, int64(inc.ElapsedTime)*int64(inc.AttendeeCount), time.Now(), meetingID,
+		).Scan(&inc.TotalCost).Error; err != nil {
+			return fmt.Errorf("adding increment to meeting totals: %w", err)
+		}
+	}
+	if err := tx.Save(inc).Error; err != nil {
+		return fmt.Errorf("closing increment: %w", err)
+	}
+	return nil
+}
+
+func (r *meetingRepository) UndoIncrement(ctx context.Context, meetingID uuid.UUID) (*models.Increment, *models.Increment, error) {
+	var open, prev models.Increment
+	undone := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var meeting models.Meeting
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			First(&meeting, "id = ?", meetingID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("meeting not found: %w", err)
+			}
+			return fmt.Errorf("locking meeting: %w", err)
+		}
+
+		err := tx.Where("meeting_id = ? AND stop_time = ?", meetingID, time.Time{}).
+			Order("start_time DESC").
+			First(&open).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("getting open increment: %w", err)
+		}
+
+		err = tx.Where("meeting_id = ? AND stop_time = ?", meetingID, open.StartTime).
+			Order("start_time DESC").
+			First(&prev).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("getting previous increment: %w", err)
+		}
+
+		if err := tx.Delete(&open).Error; err != nil {
+			return fmt.Errorf("deleting increment: %w", err)
+		}
+		prev.StopTime = time.Time{}
+		prev.ElapsedTime = 0
+		prev.Cost = 0
+		prev.TotalCost = 0
+		if err := tx.Save(&prev).Error; err != nil {
+			return fmt.Errorf("reopening increment: %w", err)
+		}
+		undone = true
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("undoing increment: %w", err)
+	}
+	if !undone {
+		return nil, nil, nil
+	}
+
+	// Invalidate cache
+	_ = r.cache.Delete(ctx, cache.KeyIncrement(open.ID))
+	_ = r.cache.Delete(ctx, cache.KeyIncrement(prev.ID))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(meetingID))
+
+	return &prev, &open, nil
+}
+
+func (r *meetingRepository) CompactIncrements(ctx context.Context, meetingID uuid.UUID, at time.Time) (int, error) {
+	var changed, merged []*models.Increment
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var meeting models.Meeting
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "is_active").
+			First(&meeting, "id = ?", meetingID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("meeting not found: %w", err)
+			}
+			return fmt.Errorf("locking meeting: %w", err)
+		}
+		if meeting.IsActive {
+			return nil
+		}
+
+		var increments []*models.Increment
+		if err := tx.Where("meeting_id = ?", meetingID).Find(&increments).Error; err != nil {
+			return fmt.Errorf("getting increments: %w", err)
+		}
+		changed, merged = repository.MergeIncrements(increments)
+		for _, inc := range changed {
+			if err := tx.Save(inc).Error; err != nil {
+				return fmt.Errorf("saving merged increment: %w", err)
+			}
+		}
+		if len(merged) > 0 {
+			ids := make([]uuid.UUID, len(merged))
+			for i, inc := range merged {
+				ids[i] = inc.ID
+			}
+			if err := tx.Unscoped().Delete(&models.Increment{}, "id IN ?", ids).Error; err != nil {
+				return fmt.Errorf("deleting merged increments: %w", err)
+			}
+		}
+		return tx.Model(&models.Meeting{}).Where("id = ?", meetingID).
+			UpdateColumn("increments_compacted_at", at).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("compacting increments: %w", err)
+	}
+
+	// Invalidate cache
+	for _, inc := range append(changed, merged...) {
+		_ = r.cache.Delete(ctx, cache.KeyIncrement(inc.ID))
+	}
+	_ = r.cache.Delete(ctx, cache.KeyMeeting(meetingID))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(meetingID))
+
+	return len(merged), nil
+}
+
 func (r *meetingRepository) GetParticipants(ctx context.Context, meetingID uuid.UUID) ([]*models.MeetingParticipant, error) {
 	var participants []*models.MeetingParticipant
 	if err := r.db.WithContext(ctx).Where("meeting_id = ?", meetingID).Preload("Person").Find(&participants).Error; err != nil {
@@ -253,3 +802,21 @@ func (r *meetingRepository) RemoveParticipant(ctx context.Context, meetingID, pe
 	}
 	return nil
 }
+
+func (r *meetingRepository) UpdateParticipant(ctx context.Context, participant *models.MeetingParticipant) error {
+	if err := r.db.WithContext(ctx).Model(participant).
+		Select("joined_at", "left_at", "duration", "updated_at").
+		Updates(participant).Error; err != nil {
+		return fmt.Errorf("updating participant: %w", err)
+	}
+	return nil
+}
+
+// likeEscaper escapes LIKE's wildcards and its escape character, '\'.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern is an ILIKE pattern matching values that contain s
+// literally, for use with ESCAPE '\'.
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
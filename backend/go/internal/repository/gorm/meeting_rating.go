@@ -0,0 +1,49 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type meetingRatingRepository struct {
+	db *gorm.DB
+}
+
+// NewMeetingRatingRepository creates a new GORM-based MeetingRatingRepository.
+func NewMeetingRatingRepository(db *gorm.DB) repository.MeetingRatingRepository {
+	return &meetingRatingRepository{
+		db: db,
+	}
+}
+
+func (r *meetingRatingRepository) Save(ctx context.Context, rating *models.MeetingRating) error {
+	rating.UpdatedAt = time.Now()
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "meeting_id"}, {Name: "person_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rating", "source", "updated_at"}),
+	}).Create(rating).Error
+	if err != nil {
+		return fmt.Errorf("saving meeting rating: %w", err)
+	}
+	return nil
+}
+
+func (r *meetingRatingRepository) Get(ctx context.Context, meetingID, personID uuid.UUID) (*models.MeetingRating, error) {
+	var rating models.MeetingRating
+	err := r.db.WithContext(ctx).First(&rating, "meeting_id = ? AND person_id = ?", meetingID, personID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("meeting rating not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting meeting rating: %w", err)
+	}
+	return &rating, nil
+}
@@ -0,0 +1,75 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new GORM-based NotificationRepository.
+func NewNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
+
+func (r *notificationRepository) ListPreferences(ctx context.Context, personID uuid.UUID) ([]*models.NotificationPreference, error) {
+	var prefs []*models.NotificationPreference
+	if err := r.db.WithContext(ctx).Where("person_id = ?", personID).Find(&prefs).Error; err != nil {
+		return nil, fmt.Errorf("listing notification preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func (r *notificationRepository) SavePreferences(ctx context.Context, prefs []*models.NotificationPreference) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "person_id"}, {Name: "event"}, {Name: "channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&prefs).Error
+	if err != nil {
+		return fmt.Errorf("saving notification preferences: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) SavePushSubscription(ctx context.Context, sub *models.PushSubscription) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "endpoint"}},
+		DoUpdates: clause.AssignmentColumns([]string{"person_id", "p256dh", "auth", "user_agent", "updated_at"}),
+	}).Create(sub).Error
+	if err != nil {
+		return fmt.Errorf("saving push subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) ListPushSubscriptions(ctx context.Context, personID uuid.UUID) ([]*models.PushSubscription, error) {
+	var subs []*models.PushSubscription
+	if err := r.db.WithContext(ctx).Where("person_id = ?", personID).Find(&subs).Error; err != nil {
+		return nil, fmt.Errorf("listing push subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+func (r *notificationRepository) DeletePushSubscription(ctx context.Context, personID, id uuid.UUID) error {
+	res := r.db.WithContext(ctx).Where("id = ? AND person_id = ?", id, personID).Delete(&models.PushSubscription{})
+	if res.Error != nil {
+		return fmt.Errorf("deleting push subscription: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("push subscription not found: %w", gorm.ErrRecordNotFound)
+	}
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
@@ -16,13 +15,15 @@ import (
 type organizationRepository struct {
 	db    *gorm.DB
 	cache cache.Cache
+	ttls  cache.TTLs
 }
 
 // NewOrganizationRepository creates a new GORM-based OrganizationRepository.
-func NewOrganizationRepository(db *gorm.DB, cache cache.Cache) repository.OrganizationRepository {
+func NewOrganizationRepository(db *gorm.DB, cache cache.Cache, ttls cache.TTLs) repository.OrganizationRepository {
 	return &organizationRepository{
 		db:    db,
 		cache: cache,
+		ttls:  ttls,
 	}
 }
 
@@ -34,46 +35,38 @@ func (r *organizationRepository) Create(ctx context.Context, org *models.Organiz
 }
 
 func (r *organizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyOrganization(id)
-	var org models.Organization
-	if err := r.cache.Get(ctx, cacheKey, &org); err == nil {
-		return &org, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&org, "id = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("organization not found: %w", err)
+	org, err := cache.LoadThrough(ctx, r.cache, cache.KeyOrganization(id), r.ttls.Organization, func(ctx context.Context) (models.Organization, error) {
+		var org models.Organization
+		if err := r.db.WithContext(ctx).First(&org, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return org, fmt.Errorf("organization not found: %w", err)
+			}
+			return org, fmt.Errorf("getting organization by id: %w", err)
 		}
-		return nil, fmt.Errorf("getting organization by id: %w", err)
+		return org, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, org, 1*time.Hour)
-
 	return &org, nil
 }
 
 func (r *organizationRepository) GetBySlug(ctx context.Context, slug string) (*models.Organization, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyOrganizationBySlug(slug)
-	var org models.Organization
-	if err := r.cache.Get(ctx, cacheKey, &org); err == nil {
-		return &org, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&org, "slug = ?", slug).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("organization not found by slug: %w", err)
+	org, err := cache.LoadThrough(ctx, r.cache, cache.KeyOrganizationBySlug(slug), r.ttls.Organization, func(ctx context.Context) (models.Organization, error) {
+		var org models.Organization
+		if err := r.db.WithContext(ctx).First(&org, "slug = ?", slug).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return org, fmt.Errorf("organization not found by slug: %w", err)
+			}
+			return org, fmt.Errorf("getting organization by slug: %w", err)
 		}
-		return nil, fmt.Errorf("getting organization by slug: %w", err)
+		return org, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, org, 1*time.Hour)
-
 	return &org, nil
 }
 
@@ -85,7 +78,7 @@ func (r *organizationRepository) List(ctx context.Context, filters repository.Or
 
 	// Apply filters
 	if filters.Name != nil {
-		query = query.Where("name ILIKE ?", "%"+*filters.Name+"%")
+		query = query.Where(`name ILIKE ? ESCAPE '\'`, containsPattern(*filters.Name))
 	}
 	if filters.Slug != nil {
 		query = query.Where("slug = ?", *filters.Slug)
@@ -94,6 +87,14 @@ func (r *organizationRepository) List(ctx context.Context, filters repository.Or
 		query = query.Joins("JOIN person_organization_profiles ON person_organization_profiles.organization_id = organizations.id").
 			Where("person_organization_profiles.person_id = ?", *filters.MemberID)
 	}
+	if filters.Discoverable {
+		if len(filters.Domains) > 0 {
+			query = query.Where("organizations.visibility = ? OR (organizations.visibility = ? AND organizations.domain IN ?)",
+				models.OrgVisibilityPublic, models.OrgVisibilityDomain, filters.Domains)
+		} else {
+			query = query.Where("organizations.visibility = ?", models.OrgVisibilityPublic)
+		}
+	}
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
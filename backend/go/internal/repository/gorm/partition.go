@@ -0,0 +1,134 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// incrementPartitionFormat names the monthly partitions of increments, such
+// as increments_2026_10.
+const incrementPartitionFormat = "increments_%04d_%02d"
+
+type partitionRepository struct {
+	db *gorm.DB
+}
+
+// NewPartitionRepository creates a new GORM-based PartitionRepository.
+func NewPartitionRepository(db *gorm.DB) repository.PartitionRepository {
+	return &partitionRepository{
+		db: db,
+	}
+}
+
+func (r *partitionRepository) CreateIncrementPartitions(ctx context.Context, from, through time.Time) ([]string, error) {
+	existing, err := r.incrementPartitions(ctx)
+	if err != nil || existing == nil {
+		return nil, err
+	}
+
+	var created []string
+	last := monthStart(through)
+	for start := monthStart(from); !start.After(last); start = start.AddDate(0, 1, 0) {
+		name := fmt.Sprintf(incrementPartitionFormat, start.Year(), start.Month())
+		if existing[name] {
+			continue
+		}
+		if err := r.createIncrementPartition(ctx, name, start, start.AddDate(0, 1, 0)); err != nil {
+			return created, fmt.Errorf("creating partition %s: %w", name, err)
+		}
+		created = append(created, name)
+	}
+	return created, nil
+}
+
+func (r *partitionRepository) createIncrementPartition(ctx context.Context, name string, start, end time.Time) error {
+	create := fmt.Sprintf("CREATE TABLE %s PARTITION OF increments FOR VALUES FROM ('%s') TO ('%s')",
+		name, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	bounds := map[string]interface{}{"start": start, "end": end}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var waiting bool
+		if err := tx.Raw("SELECT EXISTS (SELECT 1 FROM increments_default WHERE start_time >= @start AND start_time < @end)", bounds).
+			Scan(&waiting).Error; err != nil {
+			return err
+		}
+		if !waiting {
+			return tx.Exec(create).Error
+		}
+
+		// A partition can't be added over rows in the default partition,
+		// so they are moved into it with the default detached
+		for _, stmt := range []string{
+			"ALTER TABLE increments DETACH PARTITION increments_default",
+			create,
+			"INSERT INTO increments SELECT * FROM increments_default WHERE start_time >= @start AND start_time < @end",
+			"DELETE FROM increments_default WHERE start_time >= @start AND start_time < @end",
+			"ALTER TABLE increments ATTACH PARTITION increments_default DEFAULT",
+		} {
+			if err := tx.Exec(stmt, bounds).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *partitionRepository) DropIncrementPartitions(ctx context.Context, before time.Time) ([]string, error) {
+	existing, err := r.incrementPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dropped []string
+	for name := range existing {
+		var year, month int
+		if _, err := fmt.Sscanf(name, incrementPartitionFormat, &year, &month); err != nil {
+			continue // The default partition
+		}
+		end := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC)
+		if end.After(before) {
+			continue
+		}
+		if err := r.db.WithContext(ctx).Exec(fmt.Sprintf("DROP TABLE %s", name)).Error; err != nil {
+			return dropped, fmt.Errorf("dropping partition %s: %w", name, err)
+		}
+		dropped = append(dropped, name)
+	}
+	return dropped, nil
+}
+
+// incrementPartitions returns the names of the partitions of increments, or
+// nil when it isn't partitioned.
+func (r *partitionRepository) incrementPartitions(ctx context.Context) (map[string]bool, error) {
+	var partitioned bool
+	if err := r.db.WithContext(ctx).
+		Raw("SELECT EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = to_regclass('increments'))").
+		Scan(&partitioned).Error; err != nil {
+		return nil, fmt.Errorf("checking increments partitioning: %w", err)
+	}
+	if !partitioned {
+		return nil, nil
+	}
+
+	var names []string
+	if err := r.db.WithContext(ctx).
+		Raw("SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid WHERE i.inhparent = 'increments'::regclass").
+		Scan(&names).Error; err != nil {
+		return nil, fmt.Errorf("listing increments partitions: %w", err)
+	}
+	existing := make(map[string]bool, len(names))
+	for _, name := range names {
+		existing[name] = true
+	}
+	return existing, nil
+}
+
+// monthStart returns the start of t's month in UTC.
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
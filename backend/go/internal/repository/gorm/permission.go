@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
@@ -16,13 +15,15 @@ import (
 type permissionRepository struct {
 	db    *gorm.DB
 	cache cache.Cache
+	ttls  cache.TTLs
 }
 
 // NewPermissionRepository creates a new GORM-based PermissionRepository.
-func NewPermissionRepository(db *gorm.DB, cache cache.Cache) repository.PermissionRepository {
+func NewPermissionRepository(db *gorm.DB, cache cache.Cache, ttls cache.TTLs) repository.PermissionRepository {
 	return &permissionRepository{
 		db:    db,
 		cache: cache,
+		ttls:  ttls,
 	}
 }
 
@@ -36,20 +37,19 @@ func (r *permissionRepository) CreateRole(ctx context.Context, role *models.Role
 }
 
 func (r *permissionRepository) GetRoleByID(ctx context.Context, id uuid.UUID) (*models.Role, error) {
-	cacheKey := cache.KeyRole(id)
-	var role models.Role
-	if err := r.cache.Get(ctx, cacheKey, &role); err == nil {
-		return &role, nil
-	}
-
-	if err := r.db.WithContext(ctx).First(&role, "id = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("role not found: %w", err)
+	role, err := cache.LoadThrough(ctx, r.cache, cache.KeyRole(id), r.ttls.Role, func(ctx context.Context) (models.Role, error) {
+		var role models.Role
+		if err := r.db.WithContext(ctx).First(&role, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return role, fmt.Errorf("role not found: %w", err)
+			}
+			return role, fmt.Errorf("getting role by id: %w", err)
 		}
-		return nil, fmt.Errorf("getting role by id: %w", err)
+		return role, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	_ = r.cache.Set(ctx, cacheKey, role, 1*time.Hour)
 	return &role, nil
 }
 
@@ -67,6 +67,7 @@ func (r *permissionRepository) UpdateRole(ctx context.Context, role *models.Role
 		return fmt.Errorf("updating role: %w", err)
 	}
 	_ = r.cache.Delete(ctx, cache.KeyRole(role.ID))
+	_ = r.cache.DeletePrefix(ctx, cache.KeyPrefixHasPermission)
 	return nil
 }
 
@@ -75,6 +76,7 @@ func (r *permissionRepository) DeleteRole(ctx context.Context, id uuid.UUID) err
 		return fmt.Errorf("deleting role: %w", err)
 	}
 	_ = r.cache.Delete(ctx, cache.KeyRole(id))
+	_ = r.cache.DeletePrefix(ctx, cache.KeyPrefixHasPermission)
 	return nil
 }
 
@@ -84,21 +86,21 @@ func (r *permissionRepository) CreatePermission(ctx context.Context, permission
 	if err := r.db.WithContext(ctx).Create(permission).Error; err != nil {
 		return fmt.Errorf("creating permission: %w", err)
 	}
+	_ = r.cache.DeletePrefix(ctx, cache.KeyPrefixHasPermission)
 	return nil
 }
 
 func (r *permissionRepository) GetPermissionByID(ctx context.Context, id uuid.UUID) (*models.Permission, error) {
-	cacheKey := cache.KeyPermission(id)
-	var permission models.Permission
-	if err := r.cache.Get(ctx, cacheKey, &permission); err == nil {
-		return &permission, nil
-	}
-
-	if err := r.db.WithContext(ctx).First(&permission, "id = ?", id).Error; err != nil {
-		return nil, fmt.Errorf("getting permission by id: %w", err)
+	permission, err := cache.LoadThrough(ctx, r.cache, cache.KeyPermission(id), r.ttls.Permission, func(ctx context.Context) (models.Permission, error) {
+		var permission models.Permission
+		if err := r.db.WithContext(ctx).First(&permission, "id = ?", id).Error; err != nil {
+			return permission, fmt.Errorf("getting permission by id: %w", err)
+		}
+		return permission, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	_ = r.cache.Set(ctx, cacheKey, permission, 1*time.Hour)
 	return &permission, nil
 }
 
@@ -149,6 +151,7 @@ func (r *permissionRepository) UpdatePermission(ctx context.Context, permission
 		return fmt.Errorf("updating permission: %w", err)
 	}
 	_ = r.cache.Delete(ctx, cache.KeyPermission(permission.ID))
+	_ = r.cache.DeletePrefix(ctx, cache.KeyPrefixHasPermission)
 	return nil
 }
 
@@ -157,6 +160,7 @@ func (r *permissionRepository) DeletePermission(ctx context.Context, id uuid.UUI
 		return fmt.Errorf("deleting permission: %w", err)
 	}
 	_ = r.cache.Delete(ctx, cache.KeyPermission(id))
+	_ = r.cache.DeletePrefix(ctx, cache.KeyPrefixHasPermission)
 	return nil
 }
 
@@ -167,7 +171,7 @@ func (r *permissionRepository) AssignRole(ctx context.Context, assignment *model
 		return fmt.Errorf("assigning role: %w", err)
 	}
 	// Invalidate permission checks for this user
-	// (Hard to invalidate specific ones without knowing resource/activity)
+	_ = r.cache.DeletePrefix(ctx, cache.KeyPrefixHasPermissionForPerson(assignment.PersonID))
 	return nil
 }
 
@@ -177,6 +181,7 @@ func (r *permissionRepository) UnassignRole(ctx context.Context, roleID, personI
 		Delete(&models.RoleAssignment{}).Error; err != nil {
 		return fmt.Errorf("unassigning role: %w", err)
 	}
+	_ = r.cache.DeletePrefix(ctx, cache.KeyPrefixHasPermissionForPerson(personID))
 	return nil
 }
 
@@ -193,20 +198,75 @@ func (r *permissionRepository) GetRolesByPerson(ctx context.Context, personID, o
 	return roles, nil
 }
 
+func (r *permissionRepository) GetRoleHolders(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) {
+	var personIDs []uuid.UUID
+	if err := r.db.WithContext(ctx).
+		Model(&models.RoleAssignment{}).
+		Where("role_id = ?", roleID).
+		Distinct().
+		Pluck("person_id", &personIDs).Error; err != nil {
+		return nil, fmt.Errorf("getting role holders: %w", err)
+	}
+	return personIDs, nil
+}
+
 // Permission checking
 
 func (r *permissionRepository) HasPermission(ctx context.Context, personID, orgID uuid.UUID, resourceName string, resourceID *uuid.UUID, activity string) (bool, error) {
-	// 1. Check cache
 	cacheKey := cache.KeyHasPermission(personID, orgID, resourceName, resourceID, activity)
-	var hasPermission bool
-	if err := r.cache.Get(ctx, cacheKey, &hasPermission); err == nil {
-		return hasPermission, nil
+	return cache.LoadThrough(ctx, r.cache, cacheKey, r.ttls.HasPermission, func(ctx context.Context) (bool, error) {
+		return r.queryHasPermission(ctx, personID, orgID, resourceName, resourceID, activity)
+	})
+}
+
+func (r *permissionRepository) HasPermissions(ctx context.Context, personID, orgID uuid.UUID, checks []repository.PermissionCheck) ([]bool, error) {
+	grants, err := cache.LoadThrough(ctx, r.cache, cache.KeyPermissionGrants(personID, orgID), r.ttls.HasPermission, func(ctx context.Context) ([]repository.PermissionGrant, error) {
+		return r.queryGrants(ctx, personID, orgID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 2. Query DB
+	allowed := make([]bool, len(checks))
+	for i, check := range checks {
+		allowed[i] = check.Allowed(grants)
+	}
+	return allowed, nil
+}
+
+// queryGrants loads the permissions the person holds in the organization
+// through their roles and directly, bypassing the cache.
+func (r *permissionRepository) queryGrants(ctx context.Context, personID, orgID uuid.UUID) ([]repository.PermissionGrant, error) {
+	var grants []repository.PermissionGrant
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT permissions.resource_name, permissions.activity, permissions.target_resource_id
+		FROM permissions
+		JOIN role_assignments ON role_assignments.role_id = permissions.resource_id
+		WHERE permissions.resource_type = 'role'
+			AND role_assignments.person_id = @person
+			AND (role_assignments.organization_id = @org OR role_assignments.organization_id IS NULL)
+			AND permissions.allowed
+		UNION
+		SELECT resource_name, activity, target_resource_id
+		FROM permissions
+		WHERE resource_type = 'person' AND resource_id = @person
+			AND (organization_id = @org OR organization_id IS NULL)
+			AND allowed`,
+		map[string]interface{}{"person": personID, "org": orgID}).
+		Scan(&grants).Error
+	if err != nil {
+		return nil, fmt.Errorf("loading permission grants: %w", err)
+	}
+	return grants, nil
+}
+
+// queryHasPermission evaluates a permission check against the database,
+// bypassing the cache.
+func (r *permissionRepository) queryHasPermission(ctx context.Context, personID, orgID uuid.UUID, resourceName string, resourceID *uuid.UUID, activity string) (bool, error) {
 	// We check if any role assigned to the person in this org has the required permission,
 	// OR if the person has the permission directly assigned.
 	var count int64
+	var hasPermission bool
 
 	// Query for role-based permissions
 	roleQuery := r.db.WithContext(ctx).
@@ -252,8 +312,5 @@ func (r *permissionRepository) HasPermission(ctx context.Context, personID, orgI
 		hasPermission = count > 0
 	}
 
-	// 3. Set cache (Short TTL as permissions might change)
-	_ = r.cache.Set(ctx, cacheKey, hasPermission, 1*time.Minute)
-
 	return hasPermission, nil
 }
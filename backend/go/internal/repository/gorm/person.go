@@ -16,13 +16,15 @@ import (
 type personRepository struct {
 	db    *gorm.DB
 	cache cache.Cache
+	ttls  cache.TTLs
 }
 
 // NewPersonRepository creates a new GORM-based PersonRepository.
-func NewPersonRepository(db *gorm.DB, cache cache.Cache) repository.PersonRepository {
+func NewPersonRepository(db *gorm.DB, cache cache.Cache, ttls cache.TTLs) repository.PersonRepository {
 	return &personRepository{
 		db:    db,
 		cache: cache,
+		ttls:  ttls,
 	}
 }
 
@@ -34,46 +36,38 @@ func (r *personRepository) Create(ctx context.Context, person *models.Person) er
 }
 
 func (r *personRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Person, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyPerson(id)
-	var person models.Person
-	if err := r.cache.Get(ctx, cacheKey, &person); err == nil {
-		return &person, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&person, "id = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("person not found: %w", err)
+	person, err := cache.LoadThrough(ctx, r.cache, cache.KeyPerson(id), r.ttls.Person, func(ctx context.Context) (models.Person, error) {
+		var person models.Person
+		if err := r.db.WithContext(ctx).First(&person, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return person, fmt.Errorf("person not found: %w", err)
+			}
+			return person, fmt.Errorf("getting person by id: %w", err)
 		}
-		return nil, fmt.Errorf("getting person by id: %w", err)
+		return person, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache (TTL: 1 hour for persons as they don't change frequently)
-	_ = r.cache.Set(ctx, cacheKey, person, 1*time.Hour)
-
 	return &person, nil
 }
 
 func (r *personRepository) GetByEmail(ctx context.Context, email string) (*models.Person, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyPersonByEmail(email)
-	var person models.Person
-	if err := r.cache.Get(ctx, cacheKey, &person); err == nil {
-		return &person, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&person, "email = ?", email).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("person not found by email: %w", err)
+	person, err := cache.LoadThrough(ctx, r.cache, cache.KeyPersonByEmail(email), r.ttls.Person, func(ctx context.Context) (models.Person, error) {
+		var person models.Person
+		if err := r.db.WithContext(ctx).First(&person, "email = ?", email).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return person, fmt.Errorf("person not found by email: %w", err)
+			}
+			return person, fmt.Errorf("getting person by email: %w", err)
 		}
-		return nil, fmt.Errorf("getting person by email: %w", err)
+		return person, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, person, 1*time.Hour)
-
 	return &person, nil
 }
 
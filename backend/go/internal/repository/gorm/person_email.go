@@ -0,0 +1,86 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type personEmailRepository struct {
+	db *gorm.DB
+}
+
+// NewPersonEmailRepository creates a new GORM-based PersonEmailRepository.
+func NewPersonEmailRepository(db *gorm.DB) repository.PersonEmailRepository {
+	return &personEmailRepository{
+		db: db,
+	}
+}
+
+func (r *personEmailRepository) Create(ctx context.Context, email *models.PersonEmail) error {
+	if err := r.db.WithContext(ctx).Create(email).Error; err != nil {
+		return fmt.Errorf("creating person email: %w", err)
+	}
+	return nil
+}
+
+func (r *personEmailRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PersonEmail, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+func (r *personEmailRepository) GetByEmail(ctx context.Context, email string) (*models.PersonEmail, error) {
+	return r.first(ctx, "email = ?", strings.ToLower(email))
+}
+
+func (r *personEmailRepository) GetByVerificationTokenHash(ctx context.Context, tokenHash string) (*models.PersonEmail, error) {
+	return r.first(ctx, "verification_token_hash = ?", tokenHash)
+}
+
+func (r *personEmailRepository) first(ctx context.Context, query string, arg interface{}) (*models.PersonEmail, error) {
+	var email models.PersonEmail
+	if err := r.db.WithContext(ctx).First(&email, query, arg).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("email not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting person email: %w", err)
+	}
+	return &email, nil
+}
+
+func (r *personEmailRepository) ListByPerson(ctx context.Context, personID uuid.UUID) ([]*models.PersonEmail, error) {
+	var emails []*models.PersonEmail
+	if err := r.db.WithContext(ctx).
+		Where("person_id = ?", personID).
+		Order("created_at ASC").
+		Find(&emails).Error; err != nil {
+		return nil, fmt.Errorf("listing person emails: %w", err)
+	}
+	return emails, nil
+}
+
+func (r *personEmailRepository) Update(ctx context.Context, email *models.PersonEmail) error {
+	if err := r.db.WithContext(ctx).Save(email).Error; err != nil {
+		return fmt.Errorf("updating person email: %w", err)
+	}
+	return nil
+}
+
+func (r *personEmailRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.PersonEmail{}, "id = ?", id).Error; err != nil {
+		return fmt.Errorf("deleting person email: %w", err)
+	}
+	return nil
+}
+
+func (r *personEmailRepository) DeleteByPerson(ctx context.Context, personID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.PersonEmail{}, "person_id = ?", personID).Error; err != nil {
+		return fmt.Errorf("deleting person emails: %w", err)
+	}
+	return nil
+}
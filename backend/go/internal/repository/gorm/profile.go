@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/encryption"
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
 	"gorm.io/gorm"
@@ -16,13 +17,15 @@ import (
 type profileRepository struct {
 	db    *gorm.DB
 	cache cache.Cache
+	ttls  cache.TTLs
 }
 
 // NewPersonOrganizationProfileRepository creates a new GORM-based PersonOrganizationProfileRepository.
-func NewPersonOrganizationProfileRepository(db *gorm.DB, cache cache.Cache) repository.PersonOrganizationProfileRepository {
+func NewPersonOrganizationProfileRepository(db *gorm.DB, cache cache.Cache, ttls cache.TTLs) repository.PersonOrganizationProfileRepository {
 	return &profileRepository{
 		db:    db,
 		cache: cache,
+		ttls:  ttls,
 	}
 }
 
@@ -34,46 +37,38 @@ func (r *profileRepository) Create(ctx context.Context, profile *models.PersonOr
 }
 
 func (r *profileRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PersonOrganizationProfile, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyProfile(id)
-	var profile models.PersonOrganizationProfile
-	if err := r.cache.Get(ctx, cacheKey, &profile); err == nil {
-		return &profile, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).First(&profile, "id = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("profile not found: %w", err)
+	profile, err := cache.LoadThrough(ctx, r.cache, cache.KeyProfile(id), r.ttls.Profile, func(ctx context.Context) (models.PersonOrganizationProfile, error) {
+		var profile models.PersonOrganizationProfile
+		if err := r.db.WithContext(ctx).First(&profile, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return profile, fmt.Errorf("profile not found: %w", err)
+			}
+			return profile, fmt.Errorf("getting profile by id: %w", err)
 		}
-		return nil, fmt.Errorf("getting profile by id: %w", err)
+		return profile, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, profile, 1*time.Hour)
-
 	return &profile, nil
 }
 
 func (r *profileRepository) GetByPersonAndOrg(ctx context.Context, personID, orgID uuid.UUID) (*models.PersonOrganizationProfile, error) {
-	// 1. Check cache
-	cacheKey := cache.KeyProfileByPersonAndOrg(personID, orgID)
-	var profile models.PersonOrganizationProfile
-	if err := r.cache.Get(ctx, cacheKey, &profile); err == nil {
-		return &profile, nil
-	}
-
-	// 2. Query DB
-	if err := r.db.WithContext(ctx).Where("person_id = ? AND organization_id = ?", personID, orgID).First(&profile).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("profile not found by person and org: %w", err)
+	profile, err := cache.LoadThrough(ctx, r.cache, cache.KeyProfileByPersonAndOrg(personID, orgID), r.ttls.Profile, func(ctx context.Context) (models.PersonOrganizationProfile, error) {
+		var profile models.PersonOrganizationProfile
+		if err := r.db.WithContext(ctx).Where("person_id = ? AND organization_id = ?", personID, orgID).First(&profile).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return profile, fmt.Errorf("profile not found by person and org: %w", err)
+			}
+			return profile, fmt.Errorf("getting profile by person and org: %w", err)
 		}
-		return nil, fmt.Errorf("getting profile by person and org: %w", err)
+		return profile, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Set cache
-	_ = r.cache.Set(ctx, cacheKey, profile, 1*time.Hour)
-
 	return &profile, nil
 }
 
@@ -110,11 +105,18 @@ func (r *profileRepository) Update(ctx context.Context, profile *models.PersonOr
 }
 
 func (r *profileRepository) UpdateWage(ctx context.Context, personID, orgID uuid.UUID, wage float64) error {
+	// Map updates skip the column's serializer, so encrypt the wage here
+	stored, err := encryption.EncryptWage(orgID, &wage)
+	if err != nil {
+		return fmt.Errorf("encrypting wage: %w", err)
+	}
+
 	now := time.Now()
-	err := r.db.WithContext(ctx).Model(&models.PersonOrganizationProfile{}).
+	err = r.db.WithContext(ctx).Model(&models.PersonOrganizationProfile{}).
 		Where("person_id = ? AND organization_id = ?", personID, orgID).
 		Updates(map[string]interface{}{
-			"hourly_wage":     wage,
+			"hourly_wage":     stored,
+			"wage_band_id":    nil,
 			"wage_updated_at": &now,
 		}).Error
 
@@ -128,6 +130,60 @@ func (r *profileRepository) UpdateWage(ctx context.Context, personID, orgID uuid
 	return nil
 }
 
+func (r *profileRepository) SetMeetingApprover(ctx context.Context, personID, orgID uuid.UUID, approver bool) error {
+	err := r.db.WithContext(ctx).Model(&models.PersonOrganizationProfile{}).
+		Where("person_id = ? AND organization_id = ?", personID, orgID).
+		Update("meeting_approver", approver).Error
+	if err != nil {
+		return fmt.Errorf("updating meeting approver: %w", err)
+	}
+
+	_ = r.cache.Delete(ctx, cache.KeyProfileByPersonAndOrg(personID, orgID))
+	return nil
+}
+
+func (r *profileRepository) SetWageBand(ctx context.Context, personID, orgID uuid.UUID, bandID *uuid.UUID) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).Model(&models.PersonOrganizationProfile{}).
+		Where("person_id = ? AND organization_id = ?", personID, orgID).
+		Updates(map[string]interface{}{
+			"wage_band_id":    bandID,
+			"hourly_wage":     nil,
+			"wage_updated_at": &now,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("updating wage band: %w", err)
+	}
+
+	_ = r.cache.Delete(ctx, cache.KeyProfileByPersonAndOrg(personID, orgID))
+	return nil
+}
+
+func (r *profileRepository) ClearWages(ctx context.Context, orgID uuid.UUID) error {
+	var profiles []*models.PersonOrganizationProfile
+	if err := r.db.WithContext(ctx).Select("id", "person_id").Where("organization_id = ?", orgID).Find(&profiles).Error; err != nil {
+		return fmt.Errorf("getting profiles by organization: %w", err)
+	}
+
+	now := time.Now()
+	err := r.db.WithContext(ctx).Model(&models.PersonOrganizationProfile{}).
+		Where("organization_id = ?", orgID).
+		Updates(map[string]interface{}{
+			"hourly_wage":     nil,
+			"wage_band_id":    nil,
+			"wage_updated_at": &now,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("clearing wages: %w", err)
+	}
+
+	for _, p := range profiles {
+		_ = r.cache.Delete(ctx, cache.KeyProfile(p.ID))
+		_ = r.cache.Delete(ctx, cache.KeyProfileByPersonAndOrg(p.PersonID, orgID))
+	}
+	return nil
+}
+
 func (r *profileRepository) Activate(ctx context.Context, personID, orgID uuid.UUID) error {
 	now := time.Now()
 	err := r.db.WithContext(ctx).Model(&models.PersonOrganizationProfile{}).
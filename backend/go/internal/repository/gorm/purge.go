@@ -0,0 +1,62 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// purgeBatchSize bounds each DELETE so a large backlog never holds long locks
+// on tables that live meetings are writing to.
+const purgeBatchSize = 1000
+
+// purgeTables lists the soft-deleted tables in dependency order: children of
+// meetings go first, and also lose rows whose meeting is being purged.
+var purgeTables = []struct {
+	name  string
+	where string
+}{
+	{"increments", "deleted_at < @before OR meeting_id IN (SELECT id FROM meetings WHERE deleted_at < @before)"},
+	{"meeting_participants", "deleted_at < @before OR meeting_id IN (SELECT id FROM meetings WHERE deleted_at < @before)"},
+	{"meeting_ratings", "meeting_id IN (SELECT id FROM meetings WHERE deleted_at < @before)"},
+	{"meetings", "deleted_at < @before"},
+	{"cookie_consents", "deleted_at < @before"},
+	{"permissions", "deleted_at < @before"},
+	{"webhook_deliveries", "endpoint_id IN (SELECT id FROM webhook_endpoints WHERE deleted_at < @before)"},
+	{"webhook_endpoints", "deleted_at < @before"},
+}
+
+type purgeRepository struct {
+	db *gorm.DB
+}
+
+// NewPurgeRepository creates a new GORM-based PurgeRepository.
+func NewPurgeRepository(db *gorm.DB) repository.PurgeRepository {
+	return &purgeRepository{
+		db: db,
+	}
+}
+
+func (r *purgeRepository) PurgeDeleted(ctx context.Context, before time.Time) (map[string]int64, error) {
+	purged := make(map[string]int64, len(purgeTables))
+	for _, t := range purgeTables {
+		query := fmt.Sprintf(
+			"DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE %[2]s LIMIT %[3]d)",
+			t.name, t.where, purgeBatchSize,
+		)
+		for {
+			res := r.db.WithContext(ctx).Exec(query, map[string]interface{}{"before": before})
+			if res.Error != nil {
+				return purged, fmt.Errorf("purging %s: %w", t.name, res.Error)
+			}
+			purged[t.name] += res.RowsAffected
+			if res.RowsAffected < purgeBatchSize {
+				break
+			}
+		}
+	}
+	return purged, nil
+}
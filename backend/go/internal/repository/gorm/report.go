@@ -0,0 +1,486 @@
+package gorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository creates a new GORM-based ReportRepository.
+func NewReportRepository(db *gorm.DB) repository.ReportRepository {
+	return &reportRepository{
+		db: db,
+	}
+}
+
+// rollupOverlap is how far before the last refresh RefreshRollups looks
+// for changes, to catch writes that committed after it last looked.
+const rollupOverlap = 10 * time.Minute
+
+// rollupBatch bounds how many days one statement refreshes.
+const rollupBatch = 500
+
+// summaryTotals are the sums behind a MeetingSummary, which add up across
+// the parts of a range.
+type summaryTotals struct {
+	MeetingCount    int64
+	TotalCost       float64
+	TotalSeconds    int64
+	AttendeeTotal   int64
+	AttendeeSeconds int64
+}
+
+func (r *reportRepository) Summary(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*repository.MeetingSummary, error) {
+	start, end, err := r.rolledUp(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var totals summaryTotals
+	add := func(t summaryTotals) {
+		totals.MeetingCount += t.MeetingCount
+		totals.TotalCost += t.TotalCost
+		totals.TotalSeconds += t.TotalSeconds
+		totals.AttendeeTotal += t.AttendeeTotal
+		totals.AttendeeSeconds += t.AttendeeSeconds
+	}
+	if start.Before(end) {
+		var t summaryTotals
+		err := r.db.WithContext(ctx).Model(&models.ReportDailyCost{}).
+			Select(`COALESCE(SUM(meeting_count), 0) AS meeting_count,
+				COALESCE(SUM(meeting_cost), 0) AS total_cost,
+				COALESCE(SUM(meeting_seconds), 0) AS total_seconds,
+				COALESCE(SUM(attendee_total), 0) AS attendee_total,
+				COALESCE(SUM(attendee_seconds), 0) AS attendee_seconds`).
+			Where("organization_id = ? AND day >= ? AND day < ?", orgID, start.Format(time.DateOnly), end.Format(time.DateOnly)).
+			Scan(&t).Error
+		if err != nil {
+			return nil, fmt.Errorf("summarizing daily costs: %w", err)
+		}
+		add(t)
+	}
+	// The rest of the range comes from the meetings themselves
+	for _, span := range [][2]time.Time{{from, start}, {end, to}} {
+		if !span[0].Before(span[1]) {
+			continue
+		}
+		var t summaryTotals
+		err := r.db.WithContext(ctx).Model(&models.Meeting{}).
+			Select(`COUNT(*) AS meeting_count,
+				COALESCE(SUM(total_cost), 0) AS total_cost,
+				COALESCE(SUM(total_duration), 0) AS total_seconds,
+				COALESCE(SUM(max_attendees), 0) AS attendee_total,
+				COALESCE(SUM(attendee_seconds), 0) AS attendee_seconds`).
+			Where("organization_id = ? AND started_at >= ? AND started_at < ?", orgID, span[0], span[1]).
+			Scan(&t).Error
+		if err != nil {
+			return nil, fmt.Errorf("summarizing meetings: %w", err)
+		}
+		add(t)
+	}
+
+	summary := &repository.MeetingSummary{
+		MeetingCount:    totals.MeetingCount,
+		TotalCost:       totals.TotalCost,
+		TotalSeconds:    totals.TotalSeconds,
+		AttendeeSeconds: totals.AttendeeSeconds,
+	}
+	if totals.MeetingCount > 0 {
+		summary.AvgAttendees = float64(totals.AttendeeTotal) / float64(totals.MeetingCount)
+	}
+	return summary, nil
+}
+
+func (r *reportRepository) Trend(ctx context.Context, orgID uuid.UUID, from, to time.Time, interval string, loc *time.Location) ([]*repository.TrendBucket, error) {
+	// The aggregates are of UTC days, which other zones' days straddle
+	start, end := from, from
+	if loc == time.UTC {
+		var err error
+		if start, end, err = r.rolledUp(ctx, from, to); err != nil {
+			return nil, err
+		}
+	}
+
+	var parts []*repository.TrendBucket
+	if start.Before(end) {
+		err := r.db.WithContext(ctx).Model(&models.ReportDailyCost{}).
+			Select(`date_trunc(?, day::timestamp) AS start,
+				COALESCE(SUM(cost), 0) AS cost,
+				COALESCE(SUM(seconds), 0) AS seconds`, interval).
+			Where("organization_id = ? AND day >= ? AND day < ?", orgID, start.Format(time.DateOnly), end.Format(time.DateOnly)).
+			Group("1").
+			Scan(&parts).Error
+		if err != nil {
+			return nil, fmt.Errorf("aggregating daily costs: %w", err)
+		}
+	}
+	// Increments are bucketed by when they started, so a meeting spanning
+	// midnight is split across days
+	for _, span := range [][2]time.Time{{from, start}, {end, to}} {
+		if !span[0].Before(span[1]) {
+			continue
+		}
+		var live []*repository.TrendBucket
+		err := r.db.WithContext(ctx).Table("increments").
+			Select(`date_trunc(?, increments.start_time AT TIME ZONE ?) AS start,
+				COALESCE(SUM(increments.cost), 0) AS cost,
+				COALESCE(SUM(increments.elapsed_time), 0) AS seconds`, interval, loc.String()).
+			Joins("JOIN meetings ON meetings.id = increments.meeting_id AND meetings.deleted_at IS NULL").
+			Where("meetings.organization_id = ? AND increments.deleted_at IS NULL", orgID).
+			Where("increments.start_time >= ? AND increments.start_time < ?", span[0], span[1]).
+			Group("1").
+			Scan(&live).Error
+		if err != nil {
+			return nil, fmt.Errorf("aggregating trend: %w", err)
+		}
+		parts = append(parts, live...)
+	}
+
+	// Parts of the range can share a bucket
+	byStart := make(map[time.Time]*repository.TrendBucket)
+	buckets := make([]*repository.TrendBucket, 0, len(parts))
+	for _, p := range parts {
+		// date_trunc of a timestamp without time zone comes back zoneless
+		p.Start = time.Date(p.Start.Year(), p.Start.Month(), p.Start.Day(), 0, 0, 0, 0, loc)
+		if b, ok := byStart[p.Start]; ok {
+			b.Cost += p.Cost
+			b.Seconds += p.Seconds
+			continue
+		}
+		byStart[p.Start] = p
+		buckets = append(buckets, p)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Start.Before(buckets[j].Start) })
+	return buckets, nil
+}
+
+func (r *reportRepository) TopMeetings(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*repository.MeetingCost, error) {
+	var meetings []*repository.MeetingCost
+	err := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Select(`meetings.id AS meeting_id, meetings.purpose, meetings.started_at,
+			meetings.total_duration AS seconds, meetings.max_attendees, meetings.attendee_seconds, meetings.total_cost,
+			meetings.created_by_id AS organizer_id,
+			persons.first_name AS organizer_first_name, persons.last_name AS organizer_last_name`).
+		Joins("LEFT JOIN persons ON persons.id = meetings.created_by_id").
+		Where("meetings.organization_id = ? AND meetings.started_at >= ? AND meetings.started_at < ?", orgID, from, to).
+		Order("meetings.total_cost DESC").
+		Limit(limit).
+		Scan(&meetings).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing top meetings: %w", err)
+	}
+	return meetings, nil
+}
+
+func (r *reportRepository) TopSeries(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*repository.SeriesCost, error) {
+	var series []*repository.SeriesCost
+	err := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Select(`MIN(TRIM(purpose)) AS purpose,
+			COUNT(*) AS meeting_count,
+			SUM(total_cost) AS total_cost,
+			SUM(total_duration) AS total_seconds,
+			AVG(max_attendees) AS avg_attendees,
+			SUM(attendee_seconds) AS attendee_seconds,
+			MAX(started_at) AS last_started_at`).
+		Where("organization_id = ? AND started_at >= ? AND started_at < ?", orgID, from, to).
+		Where("TRIM(purpose) <> ''").
+		Group("LOWER(TRIM(purpose))").
+		Having("COUNT(*) > 1").
+		Order("SUM(total_cost) DESC").
+		Limit(limit).
+		Scan(&series).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing top series: %w", err)
+	}
+	return series, nil
+}
+
+func (r *reportRepository) RatingSummary(ctx context.Context, orgID uuid.UUID, from, to time.Time, poorRating float64) (*repository.RatingSummary, error) {
+	var summary repository.RatingSummary
+	err := r.db.WithContext(ctx).Raw(`
+		WITH rated AS (
+			SELECT m.id, m.total_cost, COUNT(*) AS responses, SUM(r.rating) AS rating_total
+			FROM meetings m
+			JOIN meeting_ratings r ON r.meeting_id = m.id
+			WHERE m.organization_id = @org AND m.deleted_at IS NULL
+				AND m.started_at >= @from AND m.started_at < @to
+			GROUP BY m.id
+		)
+		SELECT COUNT(*) AS meeting_count,
+			COALESCE(SUM(responses), 0) AS responses,
+			COALESCE(SUM(rating_total), 0) AS rating_total,
+			COALESCE(SUM(total_cost), 0) AS total_cost,
+			COUNT(*) FILTER (WHERE rating_total <= @poor * responses) AS poorly_rated_count,
+			COALESCE(SUM(total_cost) FILTER (WHERE rating_total <= @poor * responses), 0) AS poorly_rated_cost
+		FROM rated`,
+		map[string]interface{}{"org": orgID, "from": from, "to": to, "poor": poorRating},
+	).Scan(&summary).Error
+	if err != nil {
+		return nil, fmt.Errorf("summarizing meeting ratings: %w", err)
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) RatedMeetings(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*repository.RatedMeeting, error) {
+	var meetings []*repository.RatedMeeting
+	err := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Select(`meetings.id AS meeting_id, meetings.purpose, meetings.started_at,
+			meetings.total_duration AS seconds, meetings.max_attendees, meetings.total_cost,
+			COUNT(*) AS responses, AVG(meeting_ratings.rating) AS avg_rating`).
+		Joins("JOIN meeting_ratings ON meeting_ratings.meeting_id = meetings.id").
+		Where("meetings.organization_id = ? AND meetings.started_at >= ? AND meetings.started_at < ?", orgID, from, to).
+		Group("meetings.id").
+		Order("meetings.total_cost DESC").
+		Limit(limit).
+		Scan(&meetings).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing rated meetings: %w", err)
+	}
+	return meetings, nil
+}
+
+func (r *reportRepository) LateStarts(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*repository.LateStartSummary, error) {
+	var summary repository.LateStartSummary
+	err := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Select(`COUNT(*) AS scheduled_count,
+			COUNT(*) FILTER (WHERE late_start_seconds > 0) AS late_count,
+			COALESCE(SUM(late_start_seconds), 0) AS late_seconds,
+			COALESCE(SUM(late_start_cost), 0) AS late_cost`).
+		Where("organization_id = ? AND scheduled_start IS NOT NULL AND started_at >= ? AND started_at < ?", orgID, from, to).
+		Scan(&summary).Error
+	if err != nil {
+		return nil, fmt.Errorf("summarizing late starts: %w", err)
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) Overruns(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*repository.OverrunSummary, error) {
+	var summary repository.OverrunSummary
+	err := r.db.WithContext(ctx).Model(&models.Meeting{}).
+		Select(`COUNT(*) AS scheduled_count,
+			COUNT(*) FILTER (WHERE overrun_seconds > 0) AS overrun_count,
+			COALESCE(SUM(overrun_seconds), 0) AS overrun_seconds,
+			COALESCE(SUM(overrun_cost), 0) AS overrun_cost`).
+		Where("organization_id = ? AND scheduled_end IS NOT NULL AND started_at >= ? AND started_at < ?", orgID, from, to).
+		Scan(&summary).Error
+	if err != nil {
+		return nil, fmt.Errorf("summarizing overruns: %w", err)
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) OffHours(ctx context.Context, orgID uuid.UUID, from, to time.Time, hours *repository.WorkingHours) (*repository.OffHoursSummary, error) {
+	days := make([]int, len(hours.Days))
+	for i, d := range hours.Days {
+		days[i] = int(d)
+	}
+	// Classified as WorkingHours.Classify does: by the local date and time
+	// of the start, as text
+	var summary repository.OffHoursSummary
+	err := r.db.WithContext(ctx).Raw(`
+		WITH local AS (
+			SELECT total_cost, total_duration, started_at AT TIME ZONE @tz AS started
+			FROM meetings
+			WHERE organization_id = @org AND deleted_at IS NULL
+				AND started_at >= @from AND started_at < @to
+		), classified AS (
+			SELECT total_cost, total_duration,
+				CASE
+					WHEN to_char(started, 'YYYY-MM-DD') IN @holidays THEN @holiday
+					WHEN EXTRACT(DOW FROM started)::int NOT IN @days
+						OR to_char(started, 'HH24:MI') < @start OR to_char(started, 'HH24:MI') >= @end THEN @off_hours
+				END AS class
+			FROM local
+		)
+		SELECT COUNT(*) FILTER (WHERE class = @off_hours) AS off_hours_count,
+			COALESCE(SUM(total_duration) FILTER (WHERE class = @off_hours), 0) AS off_hours_seconds,
+			COALESCE(SUM(total_cost) FILTER (WHERE class = @off_hours), 0) AS off_hours_cost,
+			COUNT(*) FILTER (WHERE class = @holiday) AS holiday_count,
+			COALESCE(SUM(total_duration) FILTER (WHERE class = @holiday), 0) AS holiday_seconds,
+			COALESCE(SUM(total_cost) FILTER (WHERE class = @holiday), 0) AS holiday_cost
+		FROM classified`,
+		map[string]interface{}{
+			"org": orgID, "from": from, "to": to, "tz": hours.Location.String(),
+			"holidays": hours.Holidays, "days": days, "start": hours.Start, "end": hours.End,
+			"holiday": repository.Holiday, "off_hours": repository.OffHours,
+		},
+	).Scan(&summary).Error
+	if err != nil {
+		return nil, fmt.Errorf("summarizing off-hours meetings: %w", err)
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) RefreshRollups(ctx context.Context, now time.Time) (int64, error) {
+	last, err := r.lastRefresh(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var since time.Time
+	if !last.IsZero() {
+		since = last.Add(-rollupOverlap)
+	}
+
+	// The days of meetings that changed, and of their increments, and
+	// of meetings archived since
+	var days []struct {
+		OrganizationID uuid.UUID
+		Day            time.Time
+	}
+	err = r.db.WithContext(ctx).Raw(`
+		SELECT m.organization_id, (m.started_at AT TIME ZONE 'UTC')::date AS day
+		FROM meetings m
+		WHERE m.started_at IS NOT NULL AND (m.updated_at >= @since OR m.deleted_at >= @since)
+		UNION
+		SELECT m.organization_id, (i.start_time AT TIME ZONE 'UTC')::date AS day
+		FROM increments i
+		JOIN meetings m ON m.id = i.meeting_id
+		WHERE i.updated_at >= @since OR i.deleted_at >= @since OR m.deleted_at >= @since
+		UNION
+		SELECT a.organization_id, (a.started_at AT TIME ZONE 'UTC')::date AS day
+		FROM meeting_archives a
+		WHERE a.started_at IS NOT NULL AND a.created_at >= @since
+		UNION
+		SELECT a.organization_id, d.day
+		FROM meeting_archive_days d
+		JOIN meeting_archives a ON a.meeting_id = d.meeting_id
+		WHERE a.created_at >= @since`,
+		map[string]interface{}{"since": since},
+	).Scan(&days).Error
+	if err != nil {
+		return 0, fmt.Errorf("finding changed days: %w", err)
+	}
+	if len(days) == 0 {
+		return 0, nil
+	}
+
+	// All or nothing, since the newest refreshed_at marks what is done
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for batch := range slices.Chunk(days, rollupBatch) {
+			values := make([]string, len(batch))
+			args := make([]interface{}, 0, 2*len(batch)+1)
+			for i, d := range batch {
+				values[i] = "(?::uuid, ?::date)"
+				args = append(args, d.OrganizationID, d.Day.Format(time.DateOnly))
+			}
+			args = append(args, now)
+
+			err := tx.Exec(`
+				WITH days (organization_id, day) AS (VALUES `+strings.Join(values, ", ")+`),
+				bounds AS (
+					SELECT organization_id, day,
+						day::timestamp AT TIME ZONE 'UTC' AS day_start,
+						(day + 1)::timestamp AT TIME ZONE 'UTC' AS day_end
+					FROM days
+				),
+				meeting_totals AS (
+					SELECT b.organization_id, b.day,
+						COUNT(m.id) AS meeting_count,
+						COALESCE(SUM(m.total_cost), 0) AS meeting_cost,
+						COALESCE(SUM(m.total_duration), 0) AS meeting_seconds,
+						COALESCE(SUM(m.max_attendees), 0) AS attendee_total,
+						COALESCE(SUM(m.attendee_seconds), 0) AS attendee_seconds
+					FROM bounds b
+					LEFT JOIN (
+						SELECT id, organization_id, started_at, total_cost, total_duration, max_attendees, attendee_seconds
+						FROM meetings WHERE deleted_at IS NULL
+						UNION ALL
+						SELECT meeting_id, organization_id, started_at, total_cost, total_duration, max_attendees, attendee_seconds
+						FROM meeting_archives
+					) m ON m.organization_id = b.organization_id
+						AND m.started_at >= b.day_start AND m.started_at < b.day_end
+					GROUP BY b.organization_id, b.day
+				),
+				increment_totals AS (
+					SELECT b.organization_id, b.day,
+						COALESCE(SUM(i.cost), 0) AS cost,
+						COALESCE(SUM(i.elapsed_time), 0) AS seconds
+					FROM bounds b
+					LEFT JOIN (
+						SELECT m.organization_id, i.start_time, i.cost, i.elapsed_time
+						FROM increments i JOIN meetings m ON m.id = i.meeting_id AND m.deleted_at IS NULL
+						WHERE i.deleted_at IS NULL
+						UNION ALL
+						SELECT a.organization_id, d.day::timestamp AT TIME ZONE 'UTC', d.cost, d.seconds
+						FROM meeting_archive_days d JOIN meeting_archives a ON a.meeting_id = d.meeting_id
+					) i ON i.organization_id = b.organization_id
+						AND i.start_time >= b.day_start AND i.start_time < b.day_end
+					GROUP BY b.organization_id, b.day
+				)
+				INSERT INTO report_daily_costs
+					(organization_id, day, meeting_count, meeting_cost, meeting_seconds, attendee_total, attendee_seconds, cost, seconds, refreshed_at)
+				SELECT mt.organization_id, mt.day, mt.meeting_count, mt.meeting_cost, mt.meeting_seconds, mt.attendee_total,
+					mt.attendee_seconds, it.cost, it.seconds, ?::timestamptz
+				FROM meeting_totals mt
+				JOIN increment_totals it ON it.organization_id = mt.organization_id AND it.day = mt.day
+				ON CONFLICT (organization_id, day) DO UPDATE SET
+					meeting_count = EXCLUDED.meeting_count,
+					meeting_cost = EXCLUDED.meeting_cost,
+					meeting_seconds = EXCLUDED.meeting_seconds,
+					attendee_total = EXCLUDED.attendee_total,
+					attendee_seconds = EXCLUDED.attendee_seconds,
+					cost = EXCLUDED.cost,
+					seconds = EXCLUDED.seconds,
+					refreshed_at = EXCLUDED.refreshed_at`,
+				args...,
+			).Error
+			if err != nil {
+				return fmt.Errorf("refreshing daily costs: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(days)), nil
+}
+
+// lastRefresh returns when RefreshRollups last refreshed any day, or zero
+// if it never has.
+func (r *reportRepository) lastRefresh(ctx context.Context) (time.Time, error) {
+	var last sql.NullTime
+	err := r.db.WithContext(ctx).Model(&models.ReportDailyCost{}).
+		Select("MAX(refreshed_at)").
+		Scan(&last).Error
+	if err != nil {
+		return time.Time{}, fmt.Errorf("getting last rollup refresh: %w", err)
+	}
+	return last.Time, nil
+}
+
+// rolledUp returns the whole UTC days of [from, to) that the daily
+// aggregates cover, those before the day of the last refresh, as a range
+// of midnights. The range is empty when they cover none of it.
+func (r *reportRepository) rolledUp(ctx context.Context, from, to time.Time) (time.Time, time.Time, error) {
+	last, err := r.lastRefresh(ctx)
+	if err != nil || last.IsZero() {
+		return from, from, err
+	}
+
+	start := repository.TruncateInterval(from, repository.IntervalDay, time.UTC)
+	if start.Before(from) {
+		start = start.AddDate(0, 0, 1)
+	}
+	end := repository.TruncateInterval(to, repository.IntervalDay, time.UTC)
+	if covered := repository.TruncateInterval(last, repository.IntervalDay, time.UTC); covered.Before(end) {
+		end = covered
+	}
+	if !start.Before(end) {
+		return from, from, nil
+	}
+	return start, end, nil
+}
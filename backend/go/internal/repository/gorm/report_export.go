@@ -0,0 +1,57 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type reportExportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportExportRepository creates a new GORM-based ReportExportRepository.
+func NewReportExportRepository(db *gorm.DB) repository.ReportExportRepository {
+	return &reportExportRepository{
+		db: db,
+	}
+}
+
+func (r *reportExportRepository) Create(ctx context.Context, export *models.ReportExport) error {
+	if err := r.db.WithContext(ctx).Create(export).Error; err != nil {
+		return fmt.Errorf("creating report export: %w", err)
+	}
+	return nil
+}
+
+func (r *reportExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ReportExport, error) {
+	var export models.ReportExport
+	if err := r.db.WithContext(ctx).First(&export, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("report export not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting report export: %w", err)
+	}
+	return &export, nil
+}
+
+func (r *reportExportRepository) Update(ctx context.Context, export *models.ReportExport) error {
+	if err := r.db.WithContext(ctx).Save(export).Error; err != nil {
+		return fmt.Errorf("updating report export: %w", err)
+	}
+	return nil
+}
+
+func (r *reportExportRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Where("expires_at < ?", before).Delete(&models.ReportExport{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("deleting expired report exports: %w", res.Error)
+	}
+	return res.RowsAffected, nil
+}
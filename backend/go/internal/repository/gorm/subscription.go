@@ -0,0 +1,155 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type subscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewSubscriptionRepository creates a new GORM-based SubscriptionRepository.
+func NewSubscriptionRepository(db *gorm.DB) repository.SubscriptionRepository {
+	return &subscriptionRepository{
+		db: db,
+	}
+}
+
+func (r *subscriptionRepository) Create(ctx context.Context, sub *models.Subscription) error {
+	if err := r.db.WithContext(ctx).Omit("Organization", "Payments").Create(sub).Error; err != nil {
+		return fmt.Errorf("creating subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *subscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Subscription, error) {
+	var sub models.Subscription
+	if err := r.db.WithContext(ctx).First(&sub, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("subscription not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+func (r *subscriptionRepository) GetByOrganization(ctx context.Context, orgID uuid.UUID) (*models.Subscription, error) {
+	var sub models.Subscription
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ?", orgID).
+		Order("created_at DESC").
+		First(&sub).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+func (r *subscriptionRepository) GetByStripeSubscriptionID(ctx context.Context, stripeID string) (*models.Subscription, error) {
+	var sub models.Subscription
+	if err := r.db.WithContext(ctx).First(&sub, "stripe_subscription_id = ?", stripeID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+func (r *subscriptionRepository) Update(ctx context.Context, sub *models.Subscription) error {
+	if err := r.db.WithContext(ctx).Omit("Organization", "Payments").Save(sub).Error; err != nil {
+		return fmt.Errorf("updating subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *subscriptionRepository) ListLocalTrials(ctx context.Context) ([]*models.Subscription, error) {
+	var subs []*models.Subscription
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND (stripe_subscription_id IS NULL OR stripe_subscription_id = '')", models.SubscriptionTrialing).
+		Order("current_period_end ASC").
+		Find(&subs).Error; err != nil {
+		return nil, fmt.Errorf("listing trials: %w", err)
+	}
+	return subs, nil
+}
+
+func (r *subscriptionRepository) SavePayment(ctx context.Context, payment *models.Payment) error {
+	err := r.db.WithContext(ctx).Omit("Subscription").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "stripe_payment_intent_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"amount", "currency", "status", "paid_at", "receipt_url", "updated_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Neq{Column: clause.Column{Table: "payments", Name: "status"}, Value: models.PaymentSucceeded},
+		}},
+	}).Create(payment).Error
+	if err != nil {
+		return fmt.Errorf("saving payment: %w", err)
+	}
+	return nil
+}
+
+func (r *subscriptionRepository) ListPayments(ctx context.Context, subscriptionID uuid.UUID, pagination repository.Pagination) ([]*models.Payment, int64, error) {
+	var payments []*models.Payment
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Payment{}).Where("subscription_id = ?", subscriptionID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("counting payments: %w", err)
+	}
+
+	if pagination.PageSize > 0 {
+		query = query.Offset(pagination.Offset()).Limit(pagination.Limit())
+	}
+	if err := query.Order("created_at DESC").Find(&payments).Error; err != nil {
+		return nil, 0, fmt.Errorf("listing payments: %w", err)
+	}
+	return payments, total, nil
+}
+
+func (r *subscriptionRepository) SaveInvoice(ctx context.Context, invoice *models.Invoice) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "stripe_invoice_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"number", "status", "currency", "amount_due", "amount_paid", "period_start", "period_end",
+			"paid_at", "hosted_invoice_url", "invoice_pdf", "updated_at",
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Not(clause.IN{
+				Column: clause.Column{Table: "invoices", Name: "status"},
+				Values: []interface{}{models.InvoicePaid, models.InvoiceVoid},
+			}),
+		}},
+	}).Create(invoice).Error
+	if err != nil {
+		return fmt.Errorf("saving invoice: %w", err)
+	}
+	return nil
+}
+
+func (r *subscriptionRepository) ListInvoices(ctx context.Context, orgID uuid.UUID, pagination repository.Pagination) ([]*models.Invoice, int64, error) {
+	var invoices []*models.Invoice
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Invoice{}).Where("organization_id = ?", orgID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("counting invoices: %w", err)
+	}
+
+	if pagination.PageSize > 0 {
+		query = query.Offset(pagination.Offset()).Limit(pagination.Limit())
+	}
+	if err := query.Order("issued_at DESC").Find(&invoices).Error; err != nil {
+		return nil, 0, fmt.Errorf("listing invoices: %w", err)
+	}
+	return invoices, total, nil
+}
@@ -0,0 +1,55 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type syncOperationRepository struct {
+	db *gorm.DB
+}
+
+// NewSyncOperationRepository creates a new GORM-based
+// SyncOperationRepository.
+func NewSyncOperationRepository(db *gorm.DB) repository.SyncOperationRepository {
+	return &syncOperationRepository{
+		db: db,
+	}
+}
+
+func (r *syncOperationRepository) Claim(ctx context.Context, op *models.SyncOperation) (*models.SyncOperation, error) {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(op)
+	if res.Error != nil {
+		return nil, fmt.Errorf("claiming sync operation: %w", res.Error)
+	}
+	if res.RowsAffected > 0 {
+		return nil, nil
+	}
+
+	var existing models.SyncOperation
+	if err := r.db.WithContext(ctx).
+		First(&existing, "person_id = ? AND operation_id = ?", op.PersonID, op.OperationID).Error; err != nil {
+		return nil, fmt.Errorf("getting synced operation: %w", err)
+	}
+	return &existing, nil
+}
+
+func (r *syncOperationRepository) Update(ctx context.Context, op *models.SyncOperation) error {
+	if err := r.db.WithContext(ctx).Save(op).Error; err != nil {
+		return fmt.Errorf("updating sync operation: %w", err)
+	}
+	return nil
+}
+
+func (r *syncOperationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.SyncOperation{}, "id = ?", id).Error; err != nil {
+		return fmt.Errorf("deleting sync operation: %w", err)
+	}
+	return nil
+}
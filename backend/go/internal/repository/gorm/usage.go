@@ -0,0 +1,81 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type usageRepository struct {
+	db *gorm.DB
+}
+
+// NewUsageRepository creates a new GORM-based UsageRepository.
+func NewUsageRepository(db *gorm.DB) repository.UsageRepository {
+	return &usageRepository{
+		db: db,
+	}
+}
+
+func (r *usageRepository) AddUsage(ctx context.Context, orgID uuid.UUID, period time.Time, seconds int64, activeMembers int) error {
+	record := &models.UsageRecord{
+		OrganizationID: orgID,
+		Period:         period,
+		MeetingSeconds: seconds,
+		ActiveMembers:  activeMembers,
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "organization_id"}, {Name: "period"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"meeting_seconds": gorm.Expr("usage_records.meeting_seconds + EXCLUDED.meeting_seconds"),
+			"active_members":  gorm.Expr("GREATEST(usage_records.active_members, EXCLUDED.active_members)"),
+			"updated_at":      gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}).Create(record).Error
+	if err != nil {
+		return fmt.Errorf("adding usage: %w", err)
+	}
+	return nil
+}
+
+func (r *usageRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, since time.Time) ([]*models.UsageRecord, error) {
+	var records []*models.UsageRecord
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND period >= ?", orgID, since).
+		Order("period DESC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("listing usage: %w", err)
+	}
+	return records, nil
+}
+
+func (r *usageRepository) ListUnreported(ctx context.Context, since time.Time) ([]*models.UsageRecord, error) {
+	var records []*models.UsageRecord
+	if err := r.db.WithContext(ctx).
+		Where("period >= ?", since).
+		Where("meeting_seconds / 60 > reported_meeting_minutes OR active_members <> reported_active_members").
+		Order("period ASC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("listing unreported usage: %w", err)
+	}
+	return records, nil
+}
+
+func (r *usageRepository) MarkReported(ctx context.Context, id uuid.UUID, minutes int64, activeMembers int, at time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&models.UsageRecord{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"reported_meeting_minutes": minutes,
+			"reported_active_members":  activeMembers,
+			"reported_at":              at,
+		}).Error; err != nil {
+		return fmt.Errorf("marking usage reported: %w", err)
+	}
+	return nil
+}
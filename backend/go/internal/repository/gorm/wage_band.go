@@ -0,0 +1,67 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type wageBandRepository struct {
+	db *gorm.DB
+}
+
+// NewWageBandRepository creates a new GORM-based WageBandRepository.
+func NewWageBandRepository(db *gorm.DB) repository.WageBandRepository {
+	return &wageBandRepository{
+		db: db,
+	}
+}
+
+func (r *wageBandRepository) Create(ctx context.Context, band *models.WageBand) error {
+	if err := r.db.WithContext(ctx).Create(band).Error; err != nil {
+		return fmt.Errorf("creating wage band: %w", err)
+	}
+	return nil
+}
+
+func (r *wageBandRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.WageBand, error) {
+	var band models.WageBand
+	if err := r.db.WithContext(ctx).First(&band, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("wage band not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting wage band: %w", err)
+	}
+	return &band, nil
+}
+
+func (r *wageBandRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.WageBand, error) {
+	var bands []*models.WageBand
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ?", orgID).
+		Order("min_wage ASC, max_wage ASC").
+		Find(&bands).Error; err != nil {
+		return nil, fmt.Errorf("listing wage bands: %w", err)
+	}
+	return bands, nil
+}
+
+func (r *wageBandRepository) Update(ctx context.Context, band *models.WageBand) error {
+	if err := r.db.WithContext(ctx).Save(band).Error; err != nil {
+		return fmt.Errorf("updating wage band: %w", err)
+	}
+	return nil
+}
+
+func (r *wageBandRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	// Assigned members are unassigned by the foreign key
+	if err := r.db.WithContext(ctx).Delete(&models.WageBand{}, "id = ?", id).Error; err != nil {
+		return fmt.Errorf("deleting wage band: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// meetingChangedAt and membershipChangedAt compute in SQL what
+// repository.MeetingChangedAt does in Go. A membership also changes with
+// its person's name or email.
+const (
+	meetingChangedAt    = "GREATEST(meetings.updated_at, COALESCE(meetings.increments_compacted_at, meetings.updated_at), COALESCE(meetings.deleted_at, meetings.updated_at))"
+	membershipChangedAt = "GREATEST(p.updated_at, persons.updated_at, COALESCE(p.deleted_at, p.updated_at))"
+)
+
+type warehouseExportRepository struct {
+	db *gorm.DB
+}
+
+// NewWarehouseExportRepository creates a new GORM-based WarehouseExportRepository.
+func NewWarehouseExportRepository(db *gorm.DB) repository.WarehouseExportRepository {
+	return &warehouseExportRepository{
+		db: db,
+	}
+}
+
+func (r *warehouseExportRepository) Create(ctx context.Context, export *models.WarehouseExport) error {
+	if err := r.db.WithContext(ctx).Create(export).Error; err != nil {
+		return fmt.Errorf("creating warehouse export: %w", err)
+	}
+	return nil
+}
+
+func (r *warehouseExportRepository) GetByProvider(ctx context.Context, orgID uuid.UUID, provider string) (*models.WarehouseExport, error) {
+	var export models.WarehouseExport
+	if err := r.db.WithContext(ctx).First(&export, "organization_id = ? AND provider = ?", orgID, provider).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("warehouse export not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting warehouse export: %w", err)
+	}
+	return &export, nil
+}
+
+func (r *warehouseExportRepository) Update(ctx context.Context, export *models.WarehouseExport) error {
+	if err := r.db.WithContext(ctx).Save(export).Error; err != nil {
+		return fmt.Errorf("updating warehouse export: %w", err)
+	}
+	return nil
+}
+
+func (r *warehouseExportRepository) Claim(ctx context.Context, id uuid.UUID, now, until time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&models.WarehouseExport{}).
+		Where("id = ? AND (running_until IS NULL OR running_until <= ?)", id, now).
+		Update("running_until", until)
+	if res.Error != nil {
+		return false, fmt.Errorf("claiming warehouse export: %w", res.Error)
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *warehouseExportRepository) ChangedMeetings(ctx context.Context, orgID uuid.UUID, after repository.Watermark, until time.Time, limit int) ([]*repository.MeetingChange, error) {
+	query := r.db.WithContext(ctx).Unscoped().
+		Where("organization_id = ? AND "+meetingChangedAt+" <= ?", orgID, until)
+	if !after.At.IsZero() {
+		query = query.Where("("+meetingChangedAt+", meetings.id) > (?, ?)", after.At, after.ID)
+	}
+	var meetings []*models.Meeting
+	if err := query.Order(meetingChangedAt + ", meetings.id").Limit(limit).Find(&meetings).Error; err != nil {
+		return nil, fmt.Errorf("listing changed meetings: %w", err)
+	}
+
+	var ids []uuid.UUID
+	for _, m := range meetings {
+		if !m.DeletedAt.Valid {
+			ids = append(ids, m.ID)
+		}
+	}
+	byMeeting := make(map[uuid.UUID][]models.Increment, len(ids))
+	if len(ids) > 0 {
+		var increments []models.Increment
+		if err := r.db.WithContext(ctx).Where("meeting_id IN ?", ids).
+			Order("start_time ASC, id ASC").Find(&increments).Error; err != nil {
+			return nil, fmt.Errorf("listing changed meetings' increments: %w", err)
+		}
+		for _, inc := range increments {
+			byMeeting[inc.MeetingID] = append(byMeeting[inc.MeetingID], inc)
+		}
+	}
+
+	changes := make([]*repository.MeetingChange, len(meetings))
+	for i, m := range meetings {
+		m.Increments = byMeeting[m.ID]
+		changes[i] = &repository.MeetingChange{Meeting: m, ChangedAt: repository.MeetingChangedAt(m)}
+	}
+	return changes, nil
+}
+
+func (r *warehouseExportRepository) ChangedMemberships(ctx context.Context, orgID uuid.UUID, after repository.Watermark, until time.Time, limit int) ([]*repository.MembershipChange, error) {
+	// Wages aren't selected: they never leave for the warehouse
+	query := r.db.WithContext(ctx).Table("person_organization_profiles AS p").
+		Select("p.id AS profile_id, p.person_id, persons.email, persons.first_name, persons.last_name, "+
+			"p.is_active, p.joined_at, p.left_at, p.meeting_approver, p.created_at, p.deleted_at, "+
+			membershipChangedAt+" AS changed_at").
+		Joins("JOIN persons ON persons.id = p.person_id").
+		Where("p.organization_id = ? AND "+membershipChangedAt+" <= ?", orgID, until)
+	if !after.At.IsZero() {
+		query = query.Where("("+membershipChangedAt+", p.id) > (?, ?)", after.At, after.ID)
+	}
+	var changes []*repository.MembershipChange
+	if err := query.Order(membershipChangedAt + ", p.id").Limit(limit).Scan(&changes).Error; err != nil {
+		return nil, fmt.Errorf("listing changed memberships: %w", err)
+	}
+	return changes, nil
+}
@@ -0,0 +1,113 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new GORM-based WebhookRepository.
+func NewWebhookRepository(db *gorm.DB) repository.WebhookRepository {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+func (r *webhookRepository) CreateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error {
+	if err := r.db.WithContext(ctx).Create(endpoint).Error; err != nil {
+		return fmt.Errorf("creating webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) GetEndpoint(ctx context.Context, id uuid.UUID) (*models.WebhookEndpoint, error) {
+	var endpoint models.WebhookEndpoint
+	if err := r.db.WithContext(ctx).First(&endpoint, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("webhook endpoint not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting webhook endpoint: %w", err)
+	}
+	return &endpoint, nil
+}
+
+func (r *webhookRepository) ListEndpoints(ctx context.Context, orgID uuid.UUID) ([]*models.WebhookEndpoint, error) {
+	var endpoints []*models.WebhookEndpoint
+	if err := r.db.WithContext(ctx).
+		Where("organization_id = ?", orgID).
+		Order("created_at ASC").
+		Find(&endpoints).Error; err != nil {
+		return nil, fmt.Errorf("listing webhook endpoints: %w", err)
+	}
+	return endpoints, nil
+}
+
+func (r *webhookRepository) UpdateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error {
+	if err := r.db.WithContext(ctx).Save(endpoint).Error; err != nil {
+		return fmt.Errorf("updating webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.WebhookEndpoint{}, "id = ?", id).Error; err != nil {
+		return fmt.Errorf("deleting webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if err := r.db.WithContext(ctx).Omit("Endpoint").Create(delivery).Error; err != nil {
+		return fmt.Errorf("creating webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	// Unscoped so a delivery already queued still resolves, and then fails,
+	// if its endpoint is deleted meanwhile
+	if err := r.db.WithContext(ctx).
+		Preload("Endpoint", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		First(&delivery, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("webhook delivery not found: %w", err)
+		}
+		return nil, fmt.Errorf("getting webhook delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+func (r *webhookRepository) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if err := r.db.WithContext(ctx).Omit("Endpoint").Save(delivery).Error; err != nil {
+		return fmt.Errorf("updating webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) ListDeliveries(ctx context.Context, endpointID uuid.UUID, pagination repository.Pagination) ([]*models.WebhookDelivery, int64, error) {
+	var deliveries []*models.WebhookDelivery
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.WebhookDelivery{}).Where("endpoint_id = ?", endpointID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("counting webhook deliveries: %w", err)
+	}
+
+	if pagination.PageSize > 0 {
+		query = query.Offset(pagination.Offset()).Limit(pagination.Limit())
+	}
+	if err := query.Order("created_at DESC").Find(&deliveries).Error; err != nil {
+		return nil, 0, fmt.Errorf("listing webhook deliveries: %w", err)
+	}
+	return deliveries, total, nil
+}
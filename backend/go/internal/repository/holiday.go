@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// HolidayRepository handles organizations' holiday calendars.
+type HolidayRepository interface {
+	Create(ctx context.Context, holiday *models.Holiday) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Holiday, error)
+	// ListByOrganization returns the organization's holidays dated in
+	// [from, to), earliest first; a zero from or to leaves that end open.
+	ListByOrganization(ctx context.Context, orgID uuid.UUID, from, to time.Time) ([]*models.Holiday, error)
+	Update(ctx context.Context, holiday *models.Holiday) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
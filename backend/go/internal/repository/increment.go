@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
@@ -25,3 +26,36 @@ type IncrementRepository interface {
 	DeleteByMeeting(ctx context.Context, meetingID uuid.UUID) error
 }
 
+
+// MergeIncrements folds each run of closed increments that follow straight
+// on from one another with the same attendee count, wage and purpose into
+// the first of the run, which ends where the run ends and carries its
+// elapsed time, cost and running total. It returns the increments it
+// changed and those merged into them. Open increments are never merged.
+func MergeIncrements(increments []*models.Increment) (changed, merged []*models.Increment) {
+	closed := make([]*models.Increment, 0, len(increments))
+	for _, inc := range increments {
+		if !inc.StopTime.IsZero() {
+			closed = append(closed, inc)
+		}
+	}
+	sort.SliceStable(closed, func(i, j int) bool { return closed[i].StartTime.Before(closed[j].StartTime) })
+
+	var run *models.Increment
+	for _, inc := range closed {
+		if run != nil && run.StopTime.Equal(inc.StartTime) && run.AttendeeCount == inc.AttendeeCount &&
+			run.AverageWage == inc.AverageWage && run.Purpose == inc.Purpose {
+			if len(changed) == 0 || changed[len(changed)-1] != run {
+				changed = append(changed, run)
+			}
+			run.StopTime = inc.StopTime
+			run.ElapsedTime += inc.ElapsedTime
+			run.Cost += inc.Cost
+			run.TotalCost = inc.TotalCost
+			merged = append(merged, inc)
+			continue
+		}
+		run = inc
+	}
+	return changed, merged
+}
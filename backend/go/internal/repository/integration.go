@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// IntegrationRepository handles organizations' integrations.
+type IntegrationRepository interface {
+	Create(ctx context.Context, integration *models.Integration) error
+	// GetByProvider returns the organization's integration with provider.
+	GetByProvider(ctx context.Context, orgID uuid.UUID, provider string) (*models.Integration, error)
+	ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.Integration, error)
+	// ListConnected returns every organization's connected integration
+	// with provider.
+	ListConnected(ctx context.Context, provider string) ([]*models.Integration, error)
+	Update(ctx context.Context, integration *models.Integration) error
+}
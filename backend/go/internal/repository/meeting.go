@@ -12,29 +12,108 @@ import (
 type MeetingRepository interface {
 	// Create
 	Create(ctx context.Context, meeting *models.Meeting) error
+	// CreateWithIncrements creates a meeting and its increments atomically.
+	CreateWithIncrements(ctx context.Context, meeting *models.Meeting, increments []*models.Increment) error
 
 	// Read
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Meeting, error)
+	GetByIDWithPreloads(ctx context.Context, id uuid.UUID, preloads MeetingPreloads) (*models.Meeting, error)
 	GetByExternalID(ctx context.Context, externalType, externalID string) (*models.Meeting, error)
 	GetByDeduplicationHash(ctx context.Context, hash string) (*models.Meeting, error)
 	List(ctx context.Context, filters MeetingFilters, pagination Pagination) ([]*models.Meeting, int64, error)
+	// ListDeleted returns the organization's soft-deleted meetings, most
+	// recently deleted first.
+	ListDeleted(ctx context.Context, orgID uuid.UUID, pagination Pagination) ([]*models.Meeting, int64, error)
+	// ListOverrunning returns the active meetings scheduled to end before
+	// end that are not yet marked as overrunning.
+	ListOverrunning(ctx context.Context, end time.Time) ([]*models.Meeting, error)
+	// ListUncompacted returns up to limit stopped meetings whose increments
+	// haven't been compacted since they last stopped, longest stopped first.
+	ListUncompacted(ctx context.Context, limit int) ([]uuid.UUID, error)
+	// ActiveTotals returns, for each organization with meetings running,
+	// how many there are and what they have cost as of now.
+	ActiveTotals(ctx context.Context, now time.Time) ([]*ActiveTotals, error)
 
 	// Update
 	Update(ctx context.Context, meeting *models.Meeting) error
 	Start(ctx context.Context, id uuid.UUID) error
-	Stop(ctx context.Context, id uuid.UUID) error
+	StartWithIncrement(ctx context.Context, id uuid.UUID, first *models.Increment) error
+	// Stop marks the running meeting stopped at at, which is now unless the
+	// stop was recorded offline, and closes its open increment as closeOpen
+	// decides, while the meeting is locked as CycleIncrement locks it. The
+	// closed increment is added to the meeting's totals and returned, nil if
+	// none was open. It fails if the meeting isn't running.
+	Stop(ctx context.Context, id uuid.UUID, at time.Time, closeOpen IncrementCloseFunc) (*models.Increment, error)
+	// RecordLateStart saves how late a scheduled meeting started and what
+	// the wait cost.
+	RecordLateStart(ctx context.Context, id uuid.UUID, seconds int, cost float64) error
+	// MarkOverrun sets when an active meeting was first seen running past
+	// its scheduled end, and reports false if it was already set.
+	MarkOverrun(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)
+	// Finalize locks a stopped meeting as finalized by personID at at, and
+	// reports false if it is running or already finalized.
+	Finalize(ctx context.Context, id uuid.UUID, personID uuid.UUID, at time.Time) (bool, error)
+	// RecordOverrun saves how much of a meeting's time and cost came after
+	// its scheduled end.
+	RecordOverrun(ctx context.Context, id uuid.UUID, seconds int, cost float64) error
+	RecalculateTotals(ctx context.Context, id uuid.UUID) error
 
 	// Delete (soft delete)
 	Delete(ctx context.Context, id uuid.UUID) error
+	// DeleteMany soft-deletes the meetings in one transaction, deleting none
+	// if any of them is missing.
+	DeleteMany(ctx context.Context, ids []uuid.UUID) error
 
 	// Increments
 	GetIncrements(ctx context.Context, meetingID uuid.UUID) ([]*models.Increment, error)
+	// GetOpenIncrement returns the meeting's open increment, or nil if it
+	// has none.
+	GetOpenIncrement(ctx context.Context, meetingID uuid.UUID) (*models.Increment, error)
 	AddIncrement(ctx context.Context, increment *models.Increment) error
+	// CycleIncrement closes the meeting's open increment and opens the next
+	// as cycle decides, while the meeting is locked. The closed increment
+	// is added to the meeting's totals and running total as it closes, so
+	// the meeting's totals and its open increment always give its cost. It
+	// fails if the meeting isn't running.
+	CycleIncrement(ctx context.Context, meetingID uuid.UUID, cycle IncrementCycleFunc) (*models.Increment, error)
+	// UndoIncrement reverts the meeting's last increment cycle while it is
+	// locked: it deletes the open increment and reopens the one closed when
+	// it opened, returning both. It returns nil increments if the open
+	// increment did not follow straight on from another.
+	UndoIncrement(ctx context.Context, meetingID uuid.UUID) (reopened, removed *models.Increment, err error)
+	// CompactIncrements merges the stopped meeting's runs of adjacent
+	// increments that MergeIncrements finds while it is locked, deleting
+	// the merged ones outright, marks it compacted at at, and returns how
+	// many increments it removed. A running meeting is left alone.
+	CompactIncrements(ctx context.Context, meetingID uuid.UUID, at time.Time) (int, error)
 
 	// Participants
 	GetParticipants(ctx context.Context, meetingID uuid.UUID) ([]*models.MeetingParticipant, error)
 	AddParticipant(ctx context.Context, participant *models.MeetingParticipant) error
 	RemoveParticipant(ctx context.Context, meetingID, personID uuid.UUID) error
+	// UpdateParticipant saves a participant's joined and left times and
+	// duration.
+	UpdateParticipant(ctx context.Context, participant *models.MeetingParticipant) error
+}
+
+// IncrementCycleFunc receives the meeting's currently open increment (nil if
+// there is none), closes it in place, and returns the increment to open next.
+// It runs while the meeting is locked, so it must not call back into
+// repository methods that lock the same meeting.
+type IncrementCycleFunc func(open *models.Increment) (*models.Increment, error)
+
+// ActiveTotals is an organization's running meetings and their cost.
+type ActiveTotals struct {
+	OrganizationID uuid.UUID
+	Meetings       int
+	Cost           float64
+}
+
+// MeetingPreloads selects which associations GetByIDWithPreloads loads
+// alongside the meeting.
+type MeetingPreloads struct {
+	Increments   bool
+	Participants bool
 }
 
 type MeetingFilters struct {
@@ -43,7 +122,14 @@ type MeetingFilters struct {
 	IsActive       *bool
 	StartedAfter   *time.Time
 	StartedBefore  *time.Time
-	ExternalType   *string
-	ExternalID     *string
+	CreatedBefore  *time.Time
+	// Purpose matches meetings whose purpose contains it, ignoring case.
+	Purpose      *string
+	ExternalType *string
+	ExternalID   *string
 }
 
+// IncrementCloseFunc closes the meeting's open increment in place as the
+// meeting stops. Like IncrementCycleFunc, it runs while the meeting is
+// locked.
+type IncrementCloseFunc func(open *models.Increment) error
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// MeetingRatingRepository stores participants' answers to meeting surveys.
+type MeetingRatingRepository interface {
+	// Save records the rating, replacing the person's earlier rating of
+	// the meeting.
+	Save(ctx context.Context, rating *models.MeetingRating) error
+	// Get returns the person's rating of the meeting.
+	Get(ctx context.Context, meetingID, personID uuid.UUID) (*models.MeetingRating, error)
+}
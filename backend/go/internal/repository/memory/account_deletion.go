@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type accountDeletionRepository struct {
+	store *Store
+}
+
+// NewAccountDeletionRepository creates a new in-memory
+// AccountDeletionRepository.
+func NewAccountDeletionRepository(store *Store) repository.AccountDeletionRepository {
+	return &accountDeletionRepository{store: store}
+}
+
+func (r *accountDeletionRepository) Create(ctx context.Context, deletion *models.AccountDeletion) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, d := range r.store.accountDeletions {
+		if d.PersonID == deletion.PersonID {
+			return fmt.Errorf("creating account deletion: %w", ErrDuplicate)
+		}
+	}
+	stamp(&deletion.ID, &deletion.CreatedAt, &deletion.UpdatedAt)
+	r.store.accountDeletions[deletion.ID] = *deletion
+	return nil
+}
+
+func (r *accountDeletionRepository) GetByPerson(ctx context.Context, personID uuid.UUID) (*models.AccountDeletion, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, d := range r.store.accountDeletions {
+		if d.PersonID == personID {
+			return &d, nil
+		}
+	}
+	return nil, fmt.Errorf("account deletion not found: %w", ErrNotFound)
+}
+
+func (r *accountDeletionRepository) ListDue(ctx context.Context, now time.Time) ([]*models.AccountDeletion, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	deletions := collect(r.store.accountDeletions, func(d models.AccountDeletion) bool {
+		return d.ScheduledFor != nil && !d.ScheduledFor.After(now) && d.CompletedAt == nil
+	})
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i].ScheduledFor.Before(*deletions[j].ScheduledFor)
+	})
+	return deletions, nil
+}
+
+func (r *accountDeletionRepository) Update(ctx context.Context, deletion *models.AccountDeletion) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.accountDeletions[deletion.ID]; !ok {
+		return fmt.Errorf("updating account deletion: %w", ErrNotFound)
+	}
+	deletion.UpdatedAt = time.Now()
+	r.store.accountDeletions[deletion.ID] = *deletion
+	return nil
+}
+
+func (r *accountDeletionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.accountDeletions, id)
+	return nil
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type apiUsageRepository struct {
+	store *Store
+}
+
+// NewAPIUsageRepository creates a new in-memory APIUsageRepository.
+func NewAPIUsageRepository(store *Store) repository.APIUsageRepository {
+	return &apiUsageRepository{store: store}
+}
+
+func (r *apiUsageRepository) Add(ctx context.Context, counts []*models.APIUsage) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, c := range counts {
+		if u, ok := r.find(c); ok {
+			u.Requests += c.Requests
+			u.Errors += c.Errors
+			if c.LastRequestAt.After(u.LastRequestAt) {
+				u.LastRequestAt = c.LastRequestAt
+			}
+			u.UpdatedAt = time.Now()
+			r.store.apiUsage[u.ID] = u
+			continue
+		}
+		u := *c
+		stamp(&u.ID, &u.CreatedAt, &u.UpdatedAt)
+		r.store.apiUsage[u.ID] = u
+	}
+	return nil
+}
+
+// find returns the stored count of c's organization, token and hour. The
+// caller must hold mu.
+func (r *apiUsageRepository) find(c *models.APIUsage) (models.APIUsage, bool) {
+	for _, u := range r.store.apiUsage {
+		if u.OrganizationID == c.OrganizationID && u.TokenID == c.TokenID && u.Hour.Equal(c.Hour) {
+			return u, true
+		}
+	}
+	return models.APIUsage{}, false
+}
+
+func (r *apiUsageRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, since time.Time) ([]*models.APIUsage, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	counts := collect(r.store.apiUsage, func(u models.APIUsage) bool {
+		return u.OrganizationID == orgID && !u.Hour.Before(since)
+	})
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Hour.Before(counts[j].Hour) })
+	return counts, nil
+}
+
+func (r *apiUsageRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var n int64
+	for id, u := range r.store.apiUsage {
+		if u.Hour.Before(before) {
+			delete(r.store.apiUsage, id)
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,170 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+// archiveDocument is what an archive's Data holds in the memory store.
+type archiveDocument struct {
+	Meeting      models.Meeting
+	Increments   []models.Increment
+	Participants []models.MeetingParticipant
+	Ratings      []models.MeetingRating
+}
+
+type archiveRepository struct {
+	store *Store
+}
+
+// NewArchiveRepository creates a new in-memory ArchiveRepository.
+func NewArchiveRepository(store *Store) repository.ArchiveRepository {
+	return &archiveRepository{store: store}
+}
+
+func (r *archiveRepository) ArchiveMeetings(ctx context.Context, before time.Time, limit int) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	candidates := collect(r.store.meetings, func(m models.Meeting) bool {
+		return !m.IsActive && m.StoppedAt != nil && m.StoppedAt.Before(before) &&
+			(m.UnarchivedAt == nil || m.UnarchivedAt.Before(before))
+	})
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].StoppedAt.Before(*candidates[j].StoppedAt) })
+
+	archived := 0
+	for _, m := range candidates[:min(len(candidates), limit)] {
+		doc := archiveDocument{Meeting: *m}
+		days := make(map[time.Time]*models.MeetingArchiveDay)
+		for id, inc := range r.store.increments {
+			if inc.MeetingID != m.ID {
+				continue
+			}
+			doc.Increments = append(doc.Increments, inc)
+			delete(r.store.increments, id)
+
+			day := repository.TruncateInterval(inc.StartTime, repository.IntervalDay, time.UTC)
+			d, ok := days[day]
+			if !ok {
+				d = &models.MeetingArchiveDay{MeetingID: m.ID, Day: day}
+				days[day] = d
+			}
+			d.Cost += inc.Cost
+			d.Seconds += int64(inc.ElapsedTime)
+		}
+		for id, p := range r.store.participants {
+			if p.MeetingID == m.ID {
+				doc.Participants = append(doc.Participants, p)
+				delete(r.store.participants, id)
+			}
+		}
+		for id, rating := range r.store.meetingRatings {
+			if rating.MeetingID == m.ID {
+				doc.Ratings = append(doc.Ratings, rating)
+				delete(r.store.meetingRatings, id)
+			}
+		}
+
+		raw, err := json.Marshal(doc)
+		if err != nil {
+			return archived, fmt.Errorf("archiving meeting %s: %w", m.ID, err)
+		}
+		data, err := repository.CompressArchive(raw)
+		if err != nil {
+			return archived, fmt.Errorf("archiving meeting %s: %w", m.ID, err)
+		}
+		archive := models.MeetingArchive{
+			MeetingID:       m.ID,
+			OrganizationID:  m.OrganizationID,
+			Purpose:         m.Purpose,
+			StartedAt:       m.StartedAt,
+			StoppedAt:       m.StoppedAt,
+			TotalCost:       m.TotalCost,
+			TotalDuration:   m.TotalDuration,
+			MaxAttendees:    m.MaxAttendees,
+			AttendeeSeconds: m.AttendeeSeconds,
+			IncrementCount:  len(doc.Increments),
+			Size:            len(data),
+			Data:            data,
+		}
+		var updatedAt time.Time
+		stamp(&archive.ID, &archive.CreatedAt, &updatedAt)
+		r.store.meetingArchives[m.ID] = archive
+		for _, d := range days {
+			r.store.meetingArchiveDays[m.ID] = append(r.store.meetingArchiveDays[m.ID], *d)
+		}
+		delete(r.store.meetings, m.ID)
+		archived++
+	}
+	return archived, nil
+}
+
+func (r *archiveRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, pagination repository.Pagination) ([]*models.MeetingArchive, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	archives := collect(r.store.meetingArchives, func(a models.MeetingArchive) bool {
+		return a.OrganizationID == orgID
+	})
+	for _, a := range archives {
+		a.Data = nil
+	}
+	page, total := paginate(archives, func(a *models.MeetingArchive) time.Time {
+		if a.StartedAt == nil {
+			return time.Time{}
+		}
+		return *a.StartedAt
+	}, pagination)
+	return page, total, nil
+}
+
+func (r *archiveRepository) GetByMeeting(ctx context.Context, meetingID uuid.UUID) (*models.MeetingArchive, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	archive, ok := r.store.meetingArchives[meetingID]
+	if !ok {
+		return nil, fmt.Errorf("archived meeting not found: %w", ErrNotFound)
+	}
+	return &archive, nil
+}
+
+func (r *archiveRepository) Restore(ctx context.Context, meetingID uuid.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	archive, ok := r.store.meetingArchives[meetingID]
+	if !ok {
+		return fmt.Errorf("archived meeting not found: %w", ErrNotFound)
+	}
+	raw, err := repository.DecompressArchive(archive.Data)
+	if err != nil {
+		return fmt.Errorf("decompressing archive: %w", err)
+	}
+	var doc archiveDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return fmt.Errorf("decoding archive: %w", err)
+	}
+
+	doc.Meeting.UnarchivedAt = &at
+	r.store.meetings[meetingID] = doc.Meeting
+	for _, inc := range doc.Increments {
+		r.store.increments[inc.ID] = inc
+	}
+	for _, p := range doc.Participants {
+		r.store.participants[p.ID] = p
+	}
+	for _, rating := range doc.Ratings {
+		r.store.meetingRatings[rating.ID] = rating
+	}
+	delete(r.store.meetingArchives, meetingID)
+	delete(r.store.meetingArchiveDays, meetingID)
+	return nil
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type auditLogRepository struct {
+	store *Store
+}
+
+// NewAuditLogRepository creates a new in-memory AuditLogRepository.
+func NewAuditLogRepository(store *Store) repository.AuditLogRepository {
+	return &auditLogRepository{store: store}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, auditLog *models.AuditLog) error {
+	return r.CreateBatch(ctx, []*models.AuditLog{auditLog})
+}
+
+func (r *auditLogRepository) CreateBatch(ctx context.Context, auditLogs []*models.AuditLog) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, auditLog := range auditLogs {
+		if auditLog.ID == uuid.Nil {
+			auditLog.ID = uuid.Must(uuid.NewRandom())
+		}
+		if auditLog.CreatedAt.IsZero() {
+			auditLog.CreatedAt = time.Now()
+		}
+		r.store.auditLogs = append(r.store.auditLogs, *auditLog)
+	}
+	return nil
+}
+
+func (r *auditLogRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, actions []string, since time.Time) ([]*models.AuditLog, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var logs []*models.AuditLog
+	for _, l := range r.store.auditLogs {
+		if l.OrganizationID == nil || *l.OrganizationID != orgID || l.CreatedAt.Before(since) {
+			continue
+		}
+		for _, action := range actions {
+			if l.Action == action {
+				l := l
+				logs = append(logs, &l)
+				break
+			}
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].CreatedAt.Before(logs[j].CreatedAt) })
+	return logs, nil
+}
@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type authRepository struct {
+	store *Store
+}
+
+// NewAuthRepository creates a new in-memory AuthRepository.
+func NewAuthRepository(store *Store) repository.AuthRepository {
+	return &authRepository{store: store}
+}
+
+// AuthMethod operations
+
+func (r *authRepository) CreateAuthMethod(ctx context.Context, method *models.AuthMethod) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, m := range r.store.authMethods {
+		if m.Provider == method.Provider && m.ProviderID == method.ProviderID {
+			return fmt.Errorf("creating auth method: %w", ErrDuplicate)
+		}
+	}
+	stamp(&method.ID, &method.CreatedAt, &method.UpdatedAt)
+	row := *method
+	row.Person = models.Person{}
+	r.store.authMethods[row.ID] = row
+	return nil
+}
+
+func (r *authRepository) GetAuthMethodByID(ctx context.Context, id uuid.UUID) (*models.AuthMethod, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	method, ok := r.store.authMethods[id]
+	if !ok {
+		return nil, fmt.Errorf("auth method not found: %w", ErrNotFound)
+	}
+	return &method, nil
+}
+
+func (r *authRepository) GetAuthMethodByProvider(ctx context.Context, provider, providerID string) (*models.AuthMethod, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, method := range r.store.authMethods {
+		if method.Provider == provider && method.ProviderID == providerID {
+			return &method, nil
+		}
+	}
+	return nil, fmt.Errorf("auth method not found: %w", ErrNotFound)
+}
+
+func (r *authRepository) GetAuthMethodsByPerson(ctx context.Context, personID uuid.UUID) ([]*models.AuthMethod, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.authMethods, func(m models.AuthMethod) bool {
+		return m.PersonID == personID
+	}), nil
+}
+
+func (r *authRepository) UpdateAuthMethod(ctx context.Context, method *models.AuthMethod) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	method.UpdatedAt = time.Now()
+	row := *method
+	row.Person = models.Person{}
+	r.store.authMethods[row.ID] = row
+	return nil
+}
+
+func (r *authRepository) DeleteAuthMethod(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.authMethods[id]; !ok {
+		return fmt.Errorf("auth method not found: %w", ErrNotFound)
+	}
+	delete(r.store.authMethods, id)
+	return nil
+}
+
+// Session operations
+
+func (r *authRepository) CreateSession(ctx context.Context, session *models.Session) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, s := range r.store.sessions {
+		if s.TokenHash == session.TokenHash {
+			return fmt.Errorf("creating session: %w", ErrDuplicate)
+		}
+	}
+	stamp(&session.ID, &session.CreatedAt, &session.UpdatedAt)
+	row := *session
+	row.Person = models.Person{}
+	r.store.sessions[row.ID] = row
+	return nil
+}
+
+func (r *authRepository) GetSessionByTokenHash(ctx context.Context, tokenHash string) (*models.Session, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, session := range r.store.sessions {
+		if session.TokenHash == tokenHash {
+			return &session, nil
+		}
+	}
+	return nil, fmt.Errorf("session not found: %w", ErrNotFound)
+}
+
+func (r *authRepository) GetSessionsByPerson(ctx context.Context, personID uuid.UUID) ([]*models.Session, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.sessions, func(s models.Session) bool {
+		return s.PersonID == personID
+	}), nil
+}
+
+func (r *authRepository) UpdateSession(ctx context.Context, session *models.Session) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	session.UpdatedAt = time.Now()
+	row := *session
+	row.Person = models.Person{}
+	r.store.sessions[row.ID] = row
+	return nil
+}
+
+func (r *authRepository) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.sessions, id)
+	return nil
+}
+
+func (r *authRepository) DeleteExpiredSessions(ctx context.Context) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	var n int64
+	for id, s := range r.store.sessions {
+		if s.ExpiresAt.Before(now) {
+			delete(r.store.sessions, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *authRepository) DeleteSessionsByPerson(ctx context.Context, personID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, s := range r.store.sessions {
+		if s.PersonID == personID {
+			delete(r.store.sessions, id)
+		}
+	}
+	return nil
+}
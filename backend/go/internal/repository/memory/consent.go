@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type consentRepository struct {
+	store *Store
+}
+
+// NewConsentRepository creates a new in-memory ConsentRepository.
+func NewConsentRepository(store *Store) repository.ConsentRepository {
+	return &consentRepository{store: store}
+}
+
+func (r *consentRepository) Create(ctx context.Context, consent *models.CookieConsent) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&consent.ID, &consent.CreatedAt, &consent.UpdatedAt)
+	row := *consent
+	row.Person = models.Person{}
+	r.store.consents[row.ID] = row
+	return nil
+}
+
+func (r *consentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CookieConsent, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	consent, ok := r.store.consents[id]
+	if !ok {
+		return nil, fmt.Errorf("consent not found: %w", ErrNotFound)
+	}
+	return &consent, nil
+}
+
+func (r *consentRepository) GetCurrentBySession(ctx context.Context, sessionID string) (*models.CookieConsent, error) {
+	history, _ := r.GetHistoryBySession(ctx, sessionID)
+	if len(history) == 0 {
+		return nil, fmt.Errorf("consent not found for session: %w", ErrNotFound)
+	}
+	return history[0], nil
+}
+
+func (r *consentRepository) GetCurrentByPerson(ctx context.Context, personID uuid.UUID) (*models.CookieConsent, error) {
+	history, _ := r.GetHistoryByPerson(ctx, personID)
+	if len(history) == 0 {
+		return nil, fmt.Errorf("consent not found for person: %w", ErrNotFound)
+	}
+	return history[0], nil
+}
+
+func (r *consentRepository) GetHistoryBySession(ctx context.Context, sessionID string) ([]*models.CookieConsent, error) {
+	return r.history(func(c models.CookieConsent) bool {
+		return c.SessionID == sessionID
+	}), nil
+}
+
+func (r *consentRepository) GetHistoryByPerson(ctx context.Context, personID uuid.UUID) ([]*models.CookieConsent, error) {
+	return r.history(func(c models.CookieConsent) bool {
+		return c.PersonID != nil && *c.PersonID == personID
+	}), nil
+}
+
+func (r *consentRepository) Update(ctx context.Context, consent *models.CookieConsent) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	consent.UpdatedAt = time.Now()
+	row := *consent
+	row.Person = models.Person{}
+	r.store.consents[row.ID] = row
+	return nil
+}
+
+func (r *consentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.consents[id]; !ok {
+		return fmt.Errorf("consent not found: %w", ErrNotFound)
+	}
+	delete(r.store.consents, id)
+	return nil
+}
+
+// history returns matching consents, newest first.
+func (r *consentRepository) history(keep func(models.CookieConsent) bool) []*models.CookieConsent {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	consents, _ := paginate(collect(r.store.consents, keep), func(c *models.CookieConsent) time.Time { return c.CreatedAt }, repository.Pagination{})
+	return consents
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type consentCategoryRepository struct {
+	store *Store
+}
+
+// NewConsentCategoryRepository creates a new in-memory
+// ConsentCategoryRepository.
+func NewConsentCategoryRepository(store *Store) repository.ConsentCategoryRepository {
+	return &consentCategoryRepository{store: store}
+}
+
+func (r *consentCategoryRepository) List(ctx context.Context) ([]*models.ConsentCategory, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	categories := make([]*models.ConsentCategory, 0, len(r.store.consentCategories))
+	for _, c := range r.store.consentCategories {
+		c := c
+		categories = append(categories, &c)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if categories[i].Position != categories[j].Position {
+			return categories[i].Position < categories[j].Position
+		}
+		return categories[i].Key < categories[j].Key
+	})
+	return categories, nil
+}
+
+func (r *consentCategoryRepository) GetByKey(ctx context.Context, key string) (*models.ConsentCategory, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	category, ok := r.store.consentCategories[key]
+	if !ok {
+		return nil, fmt.Errorf("consent category not found: %w", ErrNotFound)
+	}
+	return &category, nil
+}
+
+func (r *consentCategoryRepository) Save(ctx context.Context, category *models.ConsentCategory) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if existing, ok := r.store.consentCategories[category.Key]; ok {
+		category.ID = existing.ID
+		category.CreatedAt = existing.CreatedAt
+		category.UpdatedAt = time.Now()
+	} else {
+		stamp(&category.ID, &category.CreatedAt, &category.UpdatedAt)
+	}
+	r.store.consentCategories[category.Key] = *category
+	return nil
+}
+
+func (r *consentCategoryRepository) Delete(ctx context.Context, key string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.consentCategories[key]; !ok {
+		return fmt.Errorf("consent category not found: %w", ErrNotFound)
+	}
+	delete(r.store.consentCategories, key)
+	return nil
+}
@@ -0,0 +1,154 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type costAlertRepository struct {
+	store *Store
+}
+
+// NewCostAlertRepository creates a new in-memory CostAlertRepository.
+func NewCostAlertRepository(store *Store) repository.CostAlertRepository {
+	return &costAlertRepository{store: store}
+}
+
+func (r *costAlertRepository) Create(ctx context.Context, alert *models.CostAlert) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&alert.ID, &alert.CreatedAt, &alert.UpdatedAt)
+	r.store.costAlerts[alert.ID] = *alert
+	return nil
+}
+
+func (r *costAlertRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CostAlert, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	alert, ok := r.store.costAlerts[id]
+	if !ok {
+		return nil, fmt.Errorf("cost alert not found: %w", ErrNotFound)
+	}
+	return &alert, nil
+}
+
+func (r *costAlertRepository) ListByPerson(ctx context.Context, orgID, personID uuid.UUID) ([]*models.CostAlert, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	alerts := collect(r.store.costAlerts, func(a models.CostAlert) bool {
+		return a.OrganizationID == orgID && a.PersonID == personID
+	})
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].CreatedAt.Before(alerts[j].CreatedAt) })
+	return alerts, nil
+}
+
+func (r *costAlertRepository) Update(ctx context.Context, alert *models.CostAlert) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.costAlerts[alert.ID]; !ok {
+		return fmt.Errorf("updating cost alert: %w", ErrNotFound)
+	}
+	alert.UpdatedAt = time.Now()
+	r.store.costAlerts[alert.ID] = *alert
+	return nil
+}
+
+func (r *costAlertRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.costAlerts, id)
+	for triggerID, t := range r.store.costAlertTriggers {
+		if t.AlertID == id {
+			delete(r.store.costAlertTriggers, triggerID)
+		}
+	}
+	return nil
+}
+
+func (r *costAlertRepository) ListDue(ctx context.Context, orgID, meetingID uuid.UUID, cost float64) ([]*models.CostAlert, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	fired := make(map[uuid.UUID]bool)
+	for _, t := range r.store.costAlertTriggers {
+		if t.MeetingID == meetingID {
+			fired[t.AlertID] = true
+		}
+	}
+	alerts := collect(r.store.costAlerts, func(a models.CostAlert) bool {
+		return a.OrganizationID == orgID &&
+			(a.MeetingID == nil || *a.MeetingID == meetingID) &&
+			a.Threshold <= cost &&
+			!fired[a.ID]
+	})
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Threshold < alerts[j].Threshold })
+	return alerts, nil
+}
+
+func (r *costAlertRepository) RecordTrigger(ctx context.Context, trigger *models.CostAlertTrigger) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, t := range r.store.costAlertTriggers {
+		if t.AlertID == trigger.AlertID && t.MeetingID == trigger.MeetingID {
+			return false, nil
+		}
+	}
+	var updatedAt time.Time
+	stamp(&trigger.ID, &trigger.CreatedAt, &updatedAt)
+	r.store.costAlertTriggers[trigger.ID] = *trigger
+	return true, nil
+}
+
+func (r *costAlertRepository) RecordMilestone(ctx context.Context, milestone *models.CostMilestone) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, m := range r.store.costMilestones {
+		if m.MeetingID == milestone.MeetingID && m.Milestone == milestone.Milestone {
+			return false, nil
+		}
+	}
+	var updatedAt time.Time
+	stamp(&milestone.ID, &milestone.CreatedAt, &updatedAt)
+	r.store.costMilestones[milestone.ID] = *milestone
+	return true, nil
+}
+
+func (r *costAlertRepository) MarkMilestonePosted(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	m, ok := r.store.costMilestones[id]
+	if !ok {
+		return fmt.Errorf("cost milestone not found: %w", ErrNotFound)
+	}
+	m.PostedAt = &at
+	r.store.costMilestones[id] = m
+	return nil
+}
+
+func (r *costAlertRepository) LastMilestonePostedAt(ctx context.Context, orgID uuid.UUID) (*time.Time, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var last *time.Time
+	for _, m := range r.store.costMilestones {
+		if m.OrganizationID == orgID && m.PostedAt != nil && (last == nil || m.PostedAt.After(*last)) {
+			at := *m.PostedAt
+			last = &at
+		}
+	}
+	return last, nil
+}
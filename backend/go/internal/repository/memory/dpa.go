@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type dpaRepository struct {
+	store *Store
+}
+
+// NewDPARepository creates a new in-memory DPARepository.
+func NewDPARepository(store *Store) repository.DPARepository {
+	return &dpaRepository{store: store}
+}
+
+func (r *dpaRepository) Create(ctx context.Context, acceptance *models.DPAAcceptance) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, a := range r.store.dpaAcceptances {
+		if a.OrganizationID == acceptance.OrganizationID && a.Version == acceptance.Version {
+			return fmt.Errorf("creating dpa acceptance: %w", ErrDuplicate)
+		}
+	}
+	var updatedAt time.Time
+	stamp(&acceptance.ID, &acceptance.CreatedAt, &updatedAt)
+	r.store.dpaAcceptances[acceptance.ID] = *acceptance
+	return nil
+}
+
+func (r *dpaRepository) GetByVersion(ctx context.Context, orgID uuid.UUID, version string) (*models.DPAAcceptance, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, a := range r.store.dpaAcceptances {
+		if a.OrganizationID == orgID && a.Version == version {
+			return &a, nil
+		}
+	}
+	return nil, fmt.Errorf("dpa acceptance not found: %w", ErrNotFound)
+}
+
+func (r *dpaRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.DPAAcceptance, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var acceptances []*models.DPAAcceptance
+	for _, a := range r.store.dpaAcceptances {
+		if a.OrganizationID == orgID {
+			a := a
+			acceptances = append(acceptances, &a)
+		}
+	}
+	sort.Slice(acceptances, func(i, j int) bool {
+		return acceptances[i].AcceptedAt.After(acceptances[j].AcceptedAt)
+	})
+	return acceptances, nil
+}
@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type emailDeliveryRepository struct {
+	store *Store
+}
+
+// NewEmailDeliveryRepository creates a new in-memory EmailDeliveryRepository.
+func NewEmailDeliveryRepository(store *Store) repository.EmailDeliveryRepository {
+	return &emailDeliveryRepository{store: store}
+}
+
+func (r *emailDeliveryRepository) Create(ctx context.Context, delivery *models.EmailDelivery) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&delivery.ID, &delivery.CreatedAt, &delivery.UpdatedAt)
+	r.store.emailDeliveries[delivery.ID] = *delivery
+	return nil
+}
+
+func (r *emailDeliveryRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.EmailDelivery, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	delivery, ok := r.store.emailDeliveries[id]
+	if !ok {
+		return nil, fmt.Errorf("email delivery not found: %w", ErrNotFound)
+	}
+	return &delivery, nil
+}
+
+func (r *emailDeliveryRepository) Update(ctx context.Context, delivery *models.EmailDelivery) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.emailDeliveries[delivery.ID]; !ok {
+		return fmt.Errorf("updating email delivery: %w", ErrNotFound)
+	}
+	delivery.UpdatedAt = time.Now()
+	r.store.emailDeliveries[delivery.ID] = *delivery
+	return nil
+}
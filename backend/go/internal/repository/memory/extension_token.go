@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type extensionTokenRepository struct {
+	store *Store
+}
+
+// NewExtensionTokenRepository creates a new in-memory
+// ExtensionTokenRepository.
+func NewExtensionTokenRepository(store *Store) repository.ExtensionTokenRepository {
+	return &extensionTokenRepository{store: store}
+}
+
+func (r *extensionTokenRepository) Create(ctx context.Context, token *models.ExtensionToken) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, t := range r.store.extensionTokens {
+		if t.TokenHash == token.TokenHash {
+			return fmt.Errorf("creating extension token: %w", ErrDuplicate)
+		}
+	}
+	stamp(&token.ID, &token.CreatedAt, &token.UpdatedAt)
+	r.store.extensionTokens[token.ID] = *token
+	return nil
+}
+
+func (r *extensionTokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ExtensionToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if t, ok := r.store.extensionTokens[id]; ok {
+		return &t, nil
+	}
+	return nil, fmt.Errorf("extension token not found: %w", ErrNotFound)
+}
+
+func (r *extensionTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.ExtensionToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, t := range r.store.extensionTokens {
+		if t.TokenHash == tokenHash {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("extension token not found: %w", ErrNotFound)
+}
+
+func (r *extensionTokenRepository) ListByPerson(ctx context.Context, personID uuid.UUID) ([]*models.ExtensionToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tokens := collect(r.store.extensionTokens, func(t models.ExtensionToken) bool {
+		return t.PersonID == personID
+	})
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+	})
+	return tokens, nil
+}
+
+func (r *extensionTokenRepository) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	t, ok := r.store.extensionTokens[id]
+	if !ok {
+		return fmt.Errorf("revoking extension token: %w", ErrNotFound)
+	}
+	if t.RevokedAt == nil {
+		t.RevokedAt = &at
+		t.UpdatedAt = time.Now()
+		r.store.extensionTokens[id] = t
+	}
+	return nil
+}
+
+func (r *extensionTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	t, ok := r.store.extensionTokens[id]
+	if !ok {
+		return fmt.Errorf("marking extension token used: %w", ErrNotFound)
+	}
+	t.LastUsedAt = &at
+	r.store.extensionTokens[id] = t
+	return nil
+}
+
+func (r *extensionTokenRepository) DeleteByPerson(ctx context.Context, personID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, t := range r.store.extensionTokens {
+		if t.PersonID == personID {
+			delete(r.store.extensionTokens, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type holidayRepository struct {
+	store *Store
+}
+
+// NewHolidayRepository creates a new in-memory HolidayRepository.
+func NewHolidayRepository(store *Store) repository.HolidayRepository {
+	return &holidayRepository{store: store}
+}
+
+func (r *holidayRepository) Create(ctx context.Context, holiday *models.Holiday) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, h := range r.store.holidays {
+		if h.OrganizationID == holiday.OrganizationID && h.Date.Equal(holiday.Date) {
+			return fmt.Errorf("creating holiday: %w", ErrDuplicate)
+		}
+	}
+	stamp(&holiday.ID, &holiday.CreatedAt, &holiday.UpdatedAt)
+	r.store.holidays[holiday.ID] = *holiday
+	return nil
+}
+
+func (r *holidayRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Holiday, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	holiday, ok := r.store.holidays[id]
+	if !ok {
+		return nil, fmt.Errorf("holiday not found: %w", ErrNotFound)
+	}
+	return &holiday, nil
+}
+
+func (r *holidayRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, from, to time.Time) ([]*models.Holiday, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	holidays := collect(r.store.holidays, func(h models.Holiday) bool {
+		return h.OrganizationID == orgID &&
+			(from.IsZero() || !h.Date.Before(from)) &&
+			(to.IsZero() || h.Date.Before(to))
+	})
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays, nil
+}
+
+func (r *holidayRepository) Update(ctx context.Context, holiday *models.Holiday) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.holidays[holiday.ID]; !ok {
+		return fmt.Errorf("updating holiday: %w", ErrNotFound)
+	}
+	for id, h := range r.store.holidays {
+		if id != holiday.ID && h.OrganizationID == holiday.OrganizationID && h.Date.Equal(holiday.Date) {
+			return fmt.Errorf("updating holiday: %w", ErrDuplicate)
+		}
+	}
+	holiday.UpdatedAt = time.Now()
+	r.store.holidays[holiday.ID] = *holiday
+	return nil
+}
+
+func (r *holidayRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.holidays, id)
+	return nil
+}
@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type incrementRepository struct {
+	store *Store
+}
+
+// NewIncrementRepository creates a new in-memory IncrementRepository.
+func NewIncrementRepository(store *Store) repository.IncrementRepository {
+	return &incrementRepository{store: store}
+}
+
+func (r *incrementRepository) Create(ctx context.Context, increment *models.Increment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&increment.ID, &increment.CreatedAt, &increment.UpdatedAt)
+	r.store.increments[increment.ID] = incrementRow(*increment)
+	return nil
+}
+
+func (r *incrementRepository) CreateBatch(ctx context.Context, increments []*models.Increment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, increment := range increments {
+		stamp(&increment.ID, &increment.CreatedAt, &increment.UpdatedAt)
+		r.store.increments[increment.ID] = incrementRow(*increment)
+	}
+	return nil
+}
+
+func (r *incrementRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Increment, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	increment, ok := r.store.increments[id]
+	if !ok {
+		return nil, fmt.Errorf("increment not found: %w", ErrNotFound)
+	}
+	return &increment, nil
+}
+
+func (r *incrementRepository) GetByMeeting(ctx context.Context, meetingID uuid.UUID) ([]*models.Increment, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.meetingIncrements(meetingID), nil
+}
+
+func (r *incrementRepository) Update(ctx context.Context, increment *models.Increment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	increment.UpdatedAt = time.Now()
+	r.store.increments[increment.ID] = incrementRow(*increment)
+	return nil
+}
+
+func (r *incrementRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.increments[id]; !ok {
+		return fmt.Errorf("increment not found: %w", ErrNotFound)
+	}
+	delete(r.store.increments, id)
+	return nil
+}
+
+func (r *incrementRepository) DeleteByMeeting(ctx context.Context, meetingID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, inc := range r.store.increments {
+		if inc.MeetingID == meetingID {
+			delete(r.store.increments, id)
+		}
+	}
+	return nil
+}
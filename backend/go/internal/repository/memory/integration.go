@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type integrationRepository struct {
+	store *Store
+}
+
+// NewIntegrationRepository creates a new in-memory IntegrationRepository.
+func NewIntegrationRepository(store *Store) repository.IntegrationRepository {
+	return &integrationRepository{store: store}
+}
+
+func (r *integrationRepository) Create(ctx context.Context, integration *models.Integration) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, i := range r.store.integrations {
+		if i.OrganizationID == integration.OrganizationID && i.Provider == integration.Provider {
+			return fmt.Errorf("creating integration: %w", ErrDuplicate)
+		}
+	}
+	stamp(&integration.ID, &integration.CreatedAt, &integration.UpdatedAt)
+	r.store.integrations[integration.ID] = *integration
+	return nil
+}
+
+func (r *integrationRepository) GetByProvider(ctx context.Context, orgID uuid.UUID, provider string) (*models.Integration, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, i := range r.store.integrations {
+		if i.OrganizationID == orgID && i.Provider == provider {
+			return &i, nil
+		}
+	}
+	return nil, fmt.Errorf("integration not found: %w", ErrNotFound)
+}
+
+func (r *integrationRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.Integration, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	integrations := collect(r.store.integrations, func(i models.Integration) bool { return i.OrganizationID == orgID })
+	sort.Slice(integrations, func(i, j int) bool { return integrations[i].Provider < integrations[j].Provider })
+	return integrations, nil
+}
+
+func (r *integrationRepository) ListConnected(ctx context.Context, provider string) ([]*models.Integration, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	integrations := collect(r.store.integrations, func(i models.Integration) bool {
+		return i.Provider == provider && i.Status == models.IntegrationConnected
+	})
+	sort.Slice(integrations, func(i, j int) bool {
+		return integrations[i].OrganizationID.String() < integrations[j].OrganizationID.String()
+	})
+	return integrations, nil
+}
+
+func (r *integrationRepository) Update(ctx context.Context, integration *models.Integration) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.integrations[integration.ID]; !ok {
+		return fmt.Errorf("updating integration: %w", ErrNotFound)
+	}
+	integration.UpdatedAt = time.Now()
+	r.store.integrations[integration.ID] = *integration
+	return nil
+}
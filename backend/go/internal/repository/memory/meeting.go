@@ -0,0 +1,637 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type meetingRepository struct {
+	store *Store
+}
+
+// NewMeetingRepository creates a new in-memory MeetingRepository.
+func NewMeetingRepository(store *Store) repository.MeetingRepository {
+	return &meetingRepository{store: store}
+}
+
+func (r *meetingRepository) Create(ctx context.Context, meeting *models.Meeting) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&meeting.ID, &meeting.CreatedAt, &meeting.UpdatedAt)
+	r.store.meetings[meeting.ID] = meetingRow(*meeting)
+	return nil
+}
+
+func (r *meetingRepository) CreateWithIncrements(ctx context.Context, meeting *models.Meeting, increments []*models.Increment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&meeting.ID, &meeting.CreatedAt, &meeting.UpdatedAt)
+	r.store.meetings[meeting.ID] = meetingRow(*meeting)
+	for _, inc := range increments {
+		inc.MeetingID = meeting.ID
+		stamp(&inc.ID, &inc.CreatedAt, &inc.UpdatedAt)
+		r.store.increments[inc.ID] = incrementRow(*inc)
+	}
+	return nil
+}
+
+func (r *meetingRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Meeting, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	meeting, ok := r.store.meetings[id]
+	if !ok {
+		return nil, fmt.Errorf("meeting not found: %w", ErrNotFound)
+	}
+	return &meeting, nil
+}
+
+func (r *meetingRepository) GetByIDWithPreloads(ctx context.Context, id uuid.UUID, preloads repository.MeetingPreloads) (*models.Meeting, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	meeting, ok := r.store.meetings[id]
+	if !ok {
+		return nil, fmt.Errorf("meeting not found: %w", ErrNotFound)
+	}
+	if preloads.Increments {
+		for _, inc := range r.store.meetingIncrements(id) {
+			meeting.Increments = append(meeting.Increments, *inc)
+		}
+	}
+	if preloads.Participants {
+		for _, p := range r.store.meetingParticipants(id) {
+			meeting.Participants = append(meeting.Participants, *p)
+		}
+		sort.SliceStable(meeting.Participants, func(i, j int) bool {
+			a, b := meeting.Participants[i].JoinedAt, meeting.Participants[j].JoinedAt
+			return a != nil && (b == nil || a.Before(*b))
+		})
+	}
+	return &meeting, nil
+}
+
+func (r *meetingRepository) GetByExternalID(ctx context.Context, externalType, externalID string) (*models.Meeting, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, meeting := range r.store.meetings {
+		if meeting.ExternalType == externalType && meeting.ExternalID == externalID {
+			return &meeting, nil
+		}
+	}
+	return nil, fmt.Errorf("meeting not found by external id: %w", ErrNotFound)
+}
+
+func (r *meetingRepository) GetByDeduplicationHash(ctx context.Context, hash string) (*models.Meeting, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, meeting := range r.store.meetings {
+		if meeting.DeduplicationHash == hash {
+			return &meeting, nil
+		}
+	}
+	return nil, fmt.Errorf("meeting not found by deduplication hash: %w", ErrNotFound)
+}
+
+func (r *meetingRepository) List(ctx context.Context, filters repository.MeetingFilters, pagination repository.Pagination) ([]*models.Meeting, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	meetings := collect(r.store.meetings, func(m models.Meeting) bool {
+		switch {
+		case filters.OrganizationID != nil && m.OrganizationID != *filters.OrganizationID,
+			filters.CreatedByID != nil && m.CreatedByID != *filters.CreatedByID,
+			filters.IsActive != nil && m.IsActive != *filters.IsActive,
+			filters.StartedAfter != nil && (m.StartedAt == nil || m.StartedAt.Before(*filters.StartedAfter)),
+			filters.StartedBefore != nil && (m.StartedAt == nil || m.StartedAt.After(*filters.StartedBefore)),
+			filters.CreatedBefore != nil && !m.CreatedAt.Before(*filters.CreatedBefore),
+			filters.Purpose != nil && !strings.Contains(strings.ToLower(m.Purpose), strings.ToLower(*filters.Purpose)),
+			filters.ExternalType != nil && m.ExternalType != *filters.ExternalType,
+			filters.ExternalID != nil && m.ExternalID != *filters.ExternalID:
+			return false
+		}
+		return true
+	})
+	meetings, total := paginate(meetings, func(m *models.Meeting) time.Time { return m.CreatedAt }, pagination)
+	return meetings, total, nil
+}
+
+// ListDeleted finds nothing, as the store deletes meetings outright.
+func (r *meetingRepository) ListDeleted(ctx context.Context, orgID uuid.UUID, pagination repository.Pagination) ([]*models.Meeting, int64, error) {
+	return nil, 0, nil
+}
+
+func (r *meetingRepository) ListOverrunning(ctx context.Context, end time.Time) ([]*models.Meeting, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	meetings := collect(r.store.meetings, func(m models.Meeting) bool {
+		return m.IsActive && m.OverrunAt == nil && m.ScheduledEnd != nil && m.ScheduledEnd.Before(end)
+	})
+	sort.Slice(meetings, func(i, j int) bool { return meetings[i].ScheduledEnd.Before(*meetings[j].ScheduledEnd) })
+	return meetings, nil
+}
+
+func (r *meetingRepository) ListUncompacted(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	meetings := collect(r.store.meetings, func(m models.Meeting) bool {
+		return !m.IsActive && m.IncrementsCompactedAt == nil
+	})
+	sort.Slice(meetings, func(i, j int) bool {
+		if meetings[i].StoppedAt == nil || meetings[j].StoppedAt == nil {
+			return meetings[i].StoppedAt == nil && meetings[j].StoppedAt != nil
+		}
+		return meetings[i].StoppedAt.Before(*meetings[j].StoppedAt)
+	})
+	ids := make([]uuid.UUID, 0, min(len(meetings), limit))
+	for _, m := range meetings[:min(len(meetings), limit)] {
+		ids = append(ids, m.ID)
+	}
+	return ids, nil
+}
+
+func (r *meetingRepository) ActiveTotals(ctx context.Context, now time.Time) ([]*repository.ActiveTotals, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byOrg := make(map[uuid.UUID]*repository.ActiveTotals)
+	var totals []*repository.ActiveTotals
+	for _, m := range r.store.meetings {
+		if !m.IsActive {
+			continue
+		}
+		t, ok := byOrg[m.OrganizationID]
+		if !ok {
+			t = &repository.ActiveTotals{OrganizationID: m.OrganizationID}
+			byOrg[m.OrganizationID] = t
+			totals = append(totals, t)
+		}
+		t.Meetings++
+		t.Cost += m.TotalCost
+		for _, inc := range r.store.meetingIncrements(m.ID) {
+			if inc.StopTime.IsZero() {
+				elapsed := int(now.Sub(inc.StartTime).Seconds())
+				t.Cost += float64(elapsed) / 3600 * float64(inc.AttendeeCount) * inc.AverageWage
+			}
+		}
+	}
+	return totals, nil
+}
+
+func (r *meetingRepository) Update(ctx context.Context, meeting *models.Meeting) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	meeting.UpdatedAt = time.Now()
+	r.store.meetings[meeting.ID] = meetingRow(*meeting)
+	return nil
+}
+
+func (r *meetingRepository) Start(ctx context.Context, id uuid.UUID) error {
+	now := time.Now()
+	return r.update(id, func(m *models.Meeting) {
+		m.IsActive = true
+		m.StartedAt = &now
+	})
+}
+
+func (r *meetingRepository) StartWithIncrement(ctx context.Context, id uuid.UUID, first *models.Increment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	meeting, ok := r.store.meetings[id]
+	if !ok || meeting.IsActive || meeting.FinalizedAt != nil {
+		return fmt.Errorf("meeting is already active, finalized or does not exist")
+	}
+
+	startedAt := first.StartTime
+	meeting.IsActive = true
+	meeting.StartedAt = &startedAt
+	meeting.UpdatedAt = time.Now()
+	r.store.meetings[id] = meeting
+
+	first.MeetingID = id
+	stamp(&first.ID, &first.CreatedAt, &first.UpdatedAt)
+	r.store.increments[first.ID] = incrementRow(*first)
+	return nil
+}
+
+func (r *meetingRepository) Stop(ctx context.Context, id uuid.UUID, at time.Time, closeOpen repository.IncrementCloseFunc) (*models.Increment, error) {
+	lock := r.store.meetingLock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	open, err := r.lockedOpenIncrement(id)
+	if err != nil {
+		return nil, fmt.Errorf("stopping meeting: %w", err)
+	}
+	if open != nil {
+		if err := closeOpen(open); err != nil {
+			return nil, fmt.Errorf("stopping meeting: %w", err)
+		}
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	meeting, ok := r.store.meetings[id]
+	if !ok {
+		return nil, fmt.Errorf("stopping meeting: meeting not found: %w", ErrNotFound)
+	}
+	if open != nil {
+		r.closeIncrement(&meeting, open)
+	}
+	meeting.IsActive = false
+	meeting.StoppedAt = &at
+	meeting.IncrementsCompactedAt = nil
+	meeting.UpdatedAt = time.Now()
+	r.store.meetings[id] = meeting
+	return open, nil
+}
+
+func (r *meetingRepository) RecordLateStart(ctx context.Context, id uuid.UUID, seconds int, cost float64) error {
+	return r.update(id, func(m *models.Meeting) {
+		m.LateStartSeconds = seconds
+		m.LateStartCost = cost
+	})
+}
+
+func (r *meetingRepository) MarkOverrun(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	marked := false
+	err := r.update(id, func(m *models.Meeting) {
+		if m.OverrunAt == nil {
+			m.OverrunAt = &at
+			marked = true
+		}
+	})
+	return marked, err
+}
+
+func (r *meetingRepository) Finalize(ctx context.Context, id uuid.UUID, personID uuid.UUID, at time.Time) (bool, error) {
+	finalized := false
+	err := r.update(id, func(m *models.Meeting) {
+		if !m.IsActive && m.FinalizedAt == nil {
+			m.FinalizedAt = &at
+			m.FinalizedByID = &personID
+			finalized = true
+		}
+	})
+	return finalized, err
+}
+
+func (r *meetingRepository) RecordOverrun(ctx context.Context, id uuid.UUID, seconds int, cost float64) error {
+	return r.update(id, func(m *models.Meeting) {
+		m.OverrunSeconds = seconds
+		m.OverrunCost = cost
+	})
+}
+
+func (r *meetingRepository) RecalculateTotals(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	meeting, ok := r.store.meetings[id]
+	if !ok {
+		return nil
+	}
+
+	var totalCost float64
+	var totalDuration, maxAttendees int
+	var attendeeSeconds int64
+	for _, inc := range r.store.meetingIncrements(id) {
+		maxAttendees = max(maxAttendees, inc.AttendeeCount)
+		if inc.StopTime.IsZero() {
+			continue
+		}
+		totalCost += inc.Cost
+		totalDuration += inc.ElapsedTime
+		attendeeSeconds += int64(inc.ElapsedTime) * int64(inc.AttendeeCount)
+		inc.TotalCost = totalCost
+		r.store.increments[inc.ID] = *inc
+	}
+
+	meeting.TotalCost = totalCost
+	meeting.TotalDuration = totalDuration
+	meeting.MaxAttendees = maxAttendees
+	meeting.AttendeeSeconds = attendeeSeconds
+	meeting.UpdatedAt = time.Now()
+	r.store.meetings[id] = meeting
+	return nil
+}
+
+func (r *meetingRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.meetings[id]; !ok {
+		return fmt.Errorf("meeting not found: %w", ErrNotFound)
+	}
+	delete(r.store.meetings, id)
+	return nil
+}
+
+func (r *meetingRepository) DeleteMany(ctx context.Context, ids []uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := r.store.meetings[id]; !ok {
+			return fmt.Errorf("meeting not found: %w", ErrNotFound)
+		}
+	}
+	for _, id := range ids {
+		delete(r.store.meetings, id)
+	}
+	return nil
+}
+
+func (r *meetingRepository) GetIncrements(ctx context.Context, meetingID uuid.UUID) ([]*models.Increment, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.meetingIncrements(meetingID), nil
+}
+
+func (r *meetingRepository) GetOpenIncrement(ctx context.Context, meetingID uuid.UUID) (*models.Increment, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var open *models.Increment
+	for _, inc := range r.store.meetingIncrements(meetingID) {
+		if inc.StopTime.IsZero() {
+			open = inc
+		}
+	}
+	return open, nil
+}
+
+func (r *meetingRepository) AddIncrement(ctx context.Context, increment *models.Increment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&increment.ID, &increment.CreatedAt, &increment.UpdatedAt)
+	r.store.increments[increment.ID] = incrementRow(*increment)
+	return nil
+}
+
+// CycleIncrement serializes cycles per meeting with a dedicated lock, so the
+// cycle callback may read from other repositories without deadlocking.
+func (r *meetingRepository) CycleIncrement(ctx context.Context, meetingID uuid.UUID, cycle repository.IncrementCycleFunc) (*models.Increment, error) {
+	lock := r.store.meetingLock(meetingID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	open, err := r.lockedOpenIncrement(meetingID)
+	if err != nil {
+		return nil, fmt.Errorf("cycling increment: %w", err)
+	}
+
+	next, err := cycle(open)
+	if err != nil {
+		return nil, fmt.Errorf("cycling increment: %w", err)
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	meeting, ok := r.store.meetings[meetingID]
+	if !ok {
+		return nil, fmt.Errorf("cycling increment: meeting not found: %w", ErrNotFound)
+	}
+	if open != nil {
+		r.closeIncrement(&meeting, open)
+	}
+	if next != nil {
+		stamp(&next.ID, &next.CreatedAt, &next.UpdatedAt)
+		r.store.increments[next.ID] = incrementRow(*next)
+		meeting.MaxAttendees = max(meeting.MaxAttendees, next.AttendeeCount)
+	}
+	r.store.meetings[meetingID] = meeting
+	return next, nil
+}
+
+// lockedOpenIncrement returns the open increment of the meeting, whose lock
+// the caller holds, or nil if it has none. It fails unless the meeting is
+// running.
+func (r *meetingRepository) lockedOpenIncrement(meetingID uuid.UUID) (*models.Increment, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	meeting, ok := r.store.meetings[meetingID]
+	if !ok {
+		return nil, fmt.Errorf("meeting not found: %w", ErrNotFound)
+	}
+	if !meeting.IsActive {
+		return nil, fmt.Errorf("meeting is not active")
+	}
+	var open *models.Increment
+	for _, inc := range r.store.meetingIncrements(meetingID) {
+		if inc.StopTime.IsZero() {
+			open = inc
+		}
+	}
+	return open, nil
+}
+
+// closeIncrement saves the increment a cycle or stop has just handled, and
+// adds it to meeting's totals if it closed. The caller must hold mu.
+func (r *meetingRepository) closeIncrement(meeting *models.Meeting, inc *models.Increment) {
+	inc.UpdatedAt = time.Now()
+	if !inc.StopTime.IsZero() {
+		meeting.TotalCost += inc.Cost
+		meeting.TotalDuration += inc.ElapsedTime
+		meeting.AttendeeSeconds += int64(inc.ElapsedTime) * int64(inc.AttendeeCount)
+		meeting.UpdatedAt = inc.UpdatedAt
+		inc.TotalCost = meeting.TotalCost
+	}
+	r.store.increments[inc.ID] = incrementRow(*inc)
+}
+
+func (r *meetingRepository) UndoIncrement(ctx context.Context, meetingID uuid.UUID) (*models.Increment, *models.Increment, error) {
+	lock := r.store.meetingLock(meetingID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.meetings[meetingID]; !ok {
+		return nil, nil, fmt.Errorf("undoing increment: meeting not found: %w", ErrNotFound)
+	}
+	var open, prev *models.Increment
+	increments := r.store.meetingIncrements(meetingID)
+	for _, inc := range increments {
+		if inc.StopTime.IsZero() {
+			open = inc
+		}
+	}
+	if open == nil {
+		return nil, nil, nil
+	}
+	for _, inc := range increments {
+		if inc.StopTime.Equal(open.StartTime) {
+			prev = inc
+		}
+	}
+	if prev == nil {
+		return nil, nil, nil
+	}
+
+	delete(r.store.increments, open.ID)
+	prev.StopTime = time.Time{}
+	prev.ElapsedTime = 0
+	prev.Cost = 0
+	prev.TotalCost = 0
+	prev.UpdatedAt = time.Now()
+	r.store.increments[prev.ID] = incrementRow(*prev)
+	return prev, open, nil
+}
+
+func (r *meetingRepository) CompactIncrements(ctx context.Context, meetingID uuid.UUID, at time.Time) (int, error) {
+	lock := r.store.meetingLock(meetingID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	meeting, ok := r.store.meetings[meetingID]
+	if !ok {
+		return 0, fmt.Errorf("compacting increments: meeting not found: %w", ErrNotFound)
+	}
+	if meeting.IsActive {
+		return 0, nil
+	}
+
+	changed, merged := repository.MergeIncrements(r.store.meetingIncrements(meetingID))
+	now := time.Now()
+	for _, inc := range changed {
+		inc.UpdatedAt = now
+		r.store.increments[inc.ID] = incrementRow(*inc)
+	}
+	for _, inc := range merged {
+		delete(r.store.increments, inc.ID)
+	}
+	meeting.IncrementsCompactedAt = &at
+	r.store.meetings[meetingID] = meeting
+	return len(merged), nil
+}
+
+func (r *meetingRepository) GetParticipants(ctx context.Context, meetingID uuid.UUID) ([]*models.MeetingParticipant, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.meetingParticipants(meetingID), nil
+}
+
+func (r *meetingRepository) AddParticipant(ctx context.Context, participant *models.MeetingParticipant) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, p := range r.store.participants {
+		if p.MeetingID == participant.MeetingID && p.PersonID == participant.PersonID {
+			return fmt.Errorf("adding participant: %w", ErrDuplicate)
+		}
+	}
+	stamp(&participant.ID, &participant.CreatedAt, &participant.UpdatedAt)
+	row := *participant
+	row.Meeting = models.Meeting{}
+	row.Person = models.Person{}
+	r.store.participants[row.ID] = row
+	return nil
+}
+
+func (r *meetingRepository) RemoveParticipant(ctx context.Context, meetingID, personID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, p := range r.store.participants {
+		if p.MeetingID == meetingID && p.PersonID == personID {
+			delete(r.store.participants, id)
+		}
+	}
+	return nil
+}
+
+func (r *meetingRepository) UpdateParticipant(ctx context.Context, participant *models.MeetingParticipant) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	row, ok := r.store.participants[participant.ID]
+	if !ok {
+		return fmt.Errorf("participant not found: %w", ErrNotFound)
+	}
+	row.JoinedAt = participant.JoinedAt
+	row.LeftAt = participant.LeftAt
+	row.Duration = participant.Duration
+	row.UpdatedAt = time.Now()
+	participant.UpdatedAt = row.UpdatedAt
+	r.store.participants[row.ID] = row
+	return nil
+}
+
+func (r *meetingRepository) update(id uuid.UUID, update func(m *models.Meeting)) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	meeting, ok := r.store.meetings[id]
+	if !ok {
+		return nil
+	}
+	update(&meeting)
+	meeting.UpdatedAt = time.Now()
+	r.store.meetings[id] = meeting
+	return nil
+}
+
+// meetingIncrements returns copies of a meeting's increments ordered by start
+// time. The caller must hold mu.
+func (s *Store) meetingIncrements(meetingID uuid.UUID) []*models.Increment {
+	increments := collect(s.increments, func(i models.Increment) bool {
+		return i.MeetingID == meetingID
+	})
+	sort.SliceStable(increments, func(i, j int) bool {
+		return increments[i].StartTime.Before(increments[j].StartTime)
+	})
+	return increments
+}
+
+// meetingParticipants returns copies of a meeting's participants with their
+// Person loaded. The caller must hold mu.
+func (s *Store) meetingParticipants(meetingID uuid.UUID) []*models.MeetingParticipant {
+	participants := collect(s.participants, func(p models.MeetingParticipant) bool {
+		return p.MeetingID == meetingID
+	})
+	for _, p := range participants {
+		p.Person = s.persons[p.PersonID]
+	}
+	return participants
+}
+
+// meetingRow drops preloaded associations so they are not stored with the
+// meeting.
+func meetingRow(m models.Meeting) models.Meeting {
+	m.Organization = models.Organization{}
+	m.CreatedBy = models.Person{}
+	m.Increments = nil
+	m.Participants = nil
+	return m
+}
+
+// incrementRow drops the preloaded meeting so it is not stored with the
+// increment.
+func incrementRow(i models.Increment) models.Increment {
+	i.Meeting = models.Meeting{}
+	return i
+}
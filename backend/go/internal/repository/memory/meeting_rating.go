@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type meetingRatingRepository struct {
+	store *Store
+}
+
+// NewMeetingRatingRepository creates a new in-memory MeetingRatingRepository.
+func NewMeetingRatingRepository(store *Store) repository.MeetingRatingRepository {
+	return &meetingRatingRepository{store: store}
+}
+
+func (r *meetingRatingRepository) Save(ctx context.Context, rating *models.MeetingRating) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, existing := range r.store.meetingRatings {
+		if existing.MeetingID == rating.MeetingID && existing.PersonID == rating.PersonID {
+			rating.ID = existing.ID
+			rating.CreatedAt = existing.CreatedAt
+			break
+		}
+	}
+	rating.UpdatedAt = time.Now()
+	stamp(&rating.ID, &rating.CreatedAt, &rating.UpdatedAt)
+	r.store.meetingRatings[rating.ID] = *rating
+	return nil
+}
+
+func (r *meetingRatingRepository) Get(ctx context.Context, meetingID, personID uuid.UUID) (*models.MeetingRating, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, rating := range r.store.meetingRatings {
+		if rating.MeetingID == meetingID && rating.PersonID == personID {
+			return &rating, nil
+		}
+	}
+	return nil, fmt.Errorf("meeting rating not found: %w", ErrNotFound)
+}
@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type notificationRepository struct {
+	store *Store
+}
+
+// NewNotificationRepository creates a new in-memory NotificationRepository.
+func NewNotificationRepository(store *Store) repository.NotificationRepository {
+	return &notificationRepository{store: store}
+}
+
+func (r *notificationRepository) ListPreferences(ctx context.Context, personID uuid.UUID) ([]*models.NotificationPreference, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.notificationPreferences, func(p models.NotificationPreference) bool {
+		return p.PersonID == personID
+	}), nil
+}
+
+func (r *notificationRepository) SavePreferences(ctx context.Context, prefs []*models.NotificationPreference) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, pref := range prefs {
+		for id, existing := range r.store.notificationPreferences {
+			if existing.PersonID == pref.PersonID && existing.Event == pref.Event && existing.Channel == pref.Channel {
+				pref.ID, pref.CreatedAt = id, existing.CreatedAt
+			}
+		}
+		stamp(&pref.ID, &pref.CreatedAt, &pref.UpdatedAt)
+		pref.UpdatedAt = time.Now()
+		r.store.notificationPreferences[pref.ID] = *pref
+	}
+	return nil
+}
+
+func (r *notificationRepository) SavePushSubscription(ctx context.Context, sub *models.PushSubscription) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, existing := range r.store.pushSubscriptions {
+		if existing.Endpoint == sub.Endpoint {
+			sub.ID, sub.CreatedAt = id, existing.CreatedAt
+		}
+	}
+	stamp(&sub.ID, &sub.CreatedAt, &sub.UpdatedAt)
+	sub.UpdatedAt = time.Now()
+	r.store.pushSubscriptions[sub.ID] = *sub
+	return nil
+}
+
+func (r *notificationRepository) ListPushSubscriptions(ctx context.Context, personID uuid.UUID) ([]*models.PushSubscription, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.pushSubscriptions, func(s models.PushSubscription) bool {
+		return s.PersonID == personID
+	}), nil
+}
+
+func (r *notificationRepository) DeletePushSubscription(ctx context.Context, personID, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	sub, ok := r.store.pushSubscriptions[id]
+	if !ok || sub.PersonID != personID {
+		return fmt.Errorf("push subscription not found: %w", ErrNotFound)
+	}
+	delete(r.store.pushSubscriptions, id)
+	return nil
+}
@@ -0,0 +1,135 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type organizationRepository struct {
+	store *Store
+}
+
+// NewOrganizationRepository creates a new in-memory OrganizationRepository.
+func NewOrganizationRepository(store *Store) repository.OrganizationRepository {
+	return &organizationRepository{store: store}
+}
+
+func (r *organizationRepository) Create(ctx context.Context, org *models.Organization) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, o := range r.store.organizations {
+		if o.Slug == org.Slug {
+			return fmt.Errorf("creating organization: %w", ErrDuplicate)
+		}
+	}
+	stamp(&org.ID, &org.CreatedAt, &org.UpdatedAt)
+	r.store.organizations[org.ID] = *org
+	return nil
+}
+
+func (r *organizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	org, ok := r.store.organizations[id]
+	if !ok {
+		return nil, fmt.Errorf("organization not found: %w", ErrNotFound)
+	}
+	return &org, nil
+}
+
+func (r *organizationRepository) GetBySlug(ctx context.Context, slug string) (*models.Organization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, org := range r.store.organizations {
+		if org.Slug == slug {
+			return &org, nil
+		}
+	}
+	return nil, fmt.Errorf("organization not found by slug: %w", ErrNotFound)
+}
+
+func (r *organizationRepository) List(ctx context.Context, filters repository.OrgFilters, pagination repository.Pagination) ([]*models.Organization, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	orgs := collect(r.store.organizations, func(o models.Organization) bool {
+		if filters.Name != nil && !strings.Contains(strings.ToLower(o.Name), strings.ToLower(*filters.Name)) {
+			return false
+		}
+		if filters.Slug != nil && o.Slug != *filters.Slug {
+			return false
+		}
+		if filters.MemberID != nil && !r.store.isMember(*filters.MemberID, o.ID, false) {
+			return false
+		}
+		if filters.Discoverable && o.Visibility != models.OrgVisibilityPublic &&
+			(o.Visibility != models.OrgVisibilityDomain || !slices.Contains(filters.Domains, o.Domain)) {
+			return false
+		}
+		return true
+	})
+	orgs, total := paginate(orgs, func(o *models.Organization) time.Time { return o.CreatedAt }, pagination)
+	return orgs, total, nil
+}
+
+func (r *organizationRepository) Update(ctx context.Context, org *models.Organization) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	org.UpdatedAt = time.Now()
+	r.store.organizations[org.ID] = *org
+	return nil
+}
+
+func (r *organizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.organizations[id]; !ok {
+		return fmt.Errorf("organization not found: %w", ErrNotFound)
+	}
+	delete(r.store.organizations, id)
+	return nil
+}
+
+func (r *organizationRepository) GetMembers(ctx context.Context, orgID uuid.UUID, activeOnly bool) ([]*models.PersonOrganizationProfile, error) {
+	return NewPersonOrganizationProfileRepository(r.store).GetByOrganization(ctx, orgID, activeOnly)
+}
+
+func (r *organizationRepository) AddMember(ctx context.Context, profile *models.PersonOrganizationProfile) error {
+	if err := NewPersonOrganizationProfileRepository(r.store).Create(ctx, profile); err != nil {
+		return fmt.Errorf("adding member to organization: %w", err)
+	}
+	return nil
+}
+
+func (r *organizationRepository) RemoveMember(ctx context.Context, personID, orgID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, p := range r.store.profiles {
+		if p.PersonID == personID && p.OrganizationID == orgID {
+			delete(r.store.profiles, id)
+		}
+	}
+	return nil
+}
+
+func (r *organizationRepository) UpdateMemberProfile(ctx context.Context, profile *models.PersonOrganizationProfile) error {
+	return NewPersonOrganizationProfileRepository(r.store).Update(ctx, profile)
+}
+
+func (r *organizationRepository) GetMeetings(ctx context.Context, orgID uuid.UUID, filters repository.MeetingFilters, pagination repository.Pagination) ([]*models.Meeting, int64, error) {
+	filters.OrganizationID = &orgID
+	return NewMeetingRepository(r.store).List(ctx, filters, pagination)
+}
@@ -0,0 +1,288 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type permissionRepository struct {
+	store *Store
+}
+
+// NewPermissionRepository creates a new in-memory PermissionRepository.
+func NewPermissionRepository(store *Store) repository.PermissionRepository {
+	return &permissionRepository{store: store}
+}
+
+// Role operations
+
+func (r *permissionRepository) CreateRole(ctx context.Context, role *models.Role) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, existing := range r.store.roles {
+		if existing.OrganizationID == role.OrganizationID && existing.Name == role.Name {
+			return fmt.Errorf("creating role: %w", ErrDuplicate)
+		}
+	}
+	stamp(&role.ID, &role.CreatedAt, &role.UpdatedAt)
+	row := *role
+	row.Organization = models.Organization{}
+	r.store.roles[row.ID] = row
+	return nil
+}
+
+func (r *permissionRepository) GetRoleByID(ctx context.Context, id uuid.UUID) (*models.Role, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	role, ok := r.store.roles[id]
+	if !ok {
+		return nil, fmt.Errorf("role not found: %w", ErrNotFound)
+	}
+	return &role, nil
+}
+
+func (r *permissionRepository) GetRolesByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.Role, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.roles, func(role models.Role) bool {
+		return role.OrganizationID == orgID || role.OrganizationID == uuid.Nil
+	}), nil
+}
+
+func (r *permissionRepository) UpdateRole(ctx context.Context, role *models.Role) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	role.UpdatedAt = time.Now()
+	row := *role
+	row.Organization = models.Organization{}
+	r.store.roles[row.ID] = row
+	return nil
+}
+
+func (r *permissionRepository) DeleteRole(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.roles, id)
+	return nil
+}
+
+// Permission operations
+
+func (r *permissionRepository) CreatePermission(ctx context.Context, permission *models.Permission) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&permission.ID, &permission.CreatedAt, &permission.UpdatedAt)
+	row := *permission
+	row.Organization = models.Organization{}
+	r.store.permissions[row.ID] = row
+	return nil
+}
+
+func (r *permissionRepository) GetPermissionByID(ctx context.Context, id uuid.UUID) (*models.Permission, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	permission, ok := r.store.permissions[id]
+	if !ok {
+		return nil, fmt.Errorf("getting permission by id: %w", ErrNotFound)
+	}
+	return &permission, nil
+}
+
+func (r *permissionRepository) GetPermissionsByRole(ctx context.Context, roleID uuid.UUID) ([]*models.Permission, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.permissions, func(p models.Permission) bool {
+		return p.ResourceType == "role" && p.ResourceID == roleID
+	}), nil
+}
+
+func (r *permissionRepository) GetPermissionsByPerson(ctx context.Context, personID uuid.UUID) ([]*models.Permission, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	roleIDs := make(map[uuid.UUID]bool)
+	for _, a := range r.store.roleAssignments {
+		if a.PersonID == personID {
+			roleIDs[a.RoleID] = true
+		}
+	}
+	return collect(r.store.permissions, func(p models.Permission) bool {
+		return p.ResourceType == "role" && roleIDs[p.ResourceID]
+	}), nil
+}
+
+func (r *permissionRepository) GetPermissionsByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.Permission, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.permissions, func(p models.Permission) bool {
+		if p.ResourceType != "role" {
+			return false
+		}
+		role, ok := r.store.roles[p.ResourceID]
+		return ok && (role.OrganizationID == orgID || role.OrganizationID == uuid.Nil)
+	}), nil
+}
+
+func (r *permissionRepository) UpdatePermission(ctx context.Context, permission *models.Permission) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	permission.UpdatedAt = time.Now()
+	row := *permission
+	row.Organization = models.Organization{}
+	r.store.permissions[row.ID] = row
+	return nil
+}
+
+func (r *permissionRepository) DeletePermission(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.permissions, id)
+	return nil
+}
+
+// Role assignment
+
+func (r *permissionRepository) AssignRole(ctx context.Context, assignment *models.RoleAssignment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, a := range r.store.roleAssignments {
+		if a.RoleID == assignment.RoleID && a.PersonID == assignment.PersonID && a.OrganizationID == assignment.OrganizationID {
+			return fmt.Errorf("assigning role: %w", ErrDuplicate)
+		}
+	}
+	stamp(&assignment.ID, &assignment.CreatedAt, &assignment.UpdatedAt)
+	row := *assignment
+	row.Role = models.Role{}
+	row.Person = models.Person{}
+	row.Organization = models.Organization{}
+	r.store.roleAssignments[row.ID] = row
+	return nil
+}
+
+func (r *permissionRepository) UnassignRole(ctx context.Context, roleID, personID, orgID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, a := range r.store.roleAssignments {
+		if a.RoleID == roleID && a.PersonID == personID && (a.OrganizationID == orgID || a.OrganizationID == uuid.Nil) {
+			delete(r.store.roleAssignments, id)
+		}
+	}
+	return nil
+}
+
+func (r *permissionRepository) GetRolesByPerson(ctx context.Context, personID, orgID uuid.UUID) ([]*models.Role, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	roleIDs := make(map[uuid.UUID]bool)
+	for _, a := range r.store.roleAssignments {
+		if a.PersonID == personID && (a.OrganizationID == orgID || a.OrganizationID == uuid.Nil) {
+			roleIDs[a.RoleID] = true
+		}
+	}
+	return collect(r.store.roles, func(role models.Role) bool {
+		return roleIDs[role.ID]
+	}), nil
+}
+
+func (r *permissionRepository) GetRoleHolders(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	seen := make(map[uuid.UUID]bool)
+	var personIDs []uuid.UUID
+	for _, a := range r.store.roleAssignments {
+		if a.RoleID == roleID && !seen[a.PersonID] {
+			seen[a.PersonID] = true
+			personIDs = append(personIDs, a.PersonID)
+		}
+	}
+	return personIDs, nil
+}
+
+// Permission checking
+
+// HasPermission mirrors the GORM implementation: a permission granted to any
+// of the person's roles in the organization, or directly to the person.
+func (r *permissionRepository) HasPermission(ctx context.Context, personID, orgID uuid.UUID, resourceName string, resourceID *uuid.UUID, activity string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	roleIDs := make(map[uuid.UUID]bool)
+	for _, a := range r.store.roleAssignments {
+		if a.PersonID == personID && (a.OrganizationID == orgID || a.OrganizationID == uuid.Nil) {
+			roleIDs[a.RoleID] = true
+		}
+	}
+
+	for _, p := range r.store.permissions {
+		if !p.Allowed || p.ResourceName != resourceName || p.Activity != activity {
+			continue
+		}
+		if p.TargetResourceID != nil && (resourceID == nil || *p.TargetResourceID != *resourceID) {
+			continue
+		}
+		switch p.ResourceType {
+		case "role":
+			if roleIDs[p.ResourceID] {
+				return true, nil
+			}
+		case "person":
+			if p.ResourceID == personID && (p.OrganizationID == orgID || p.OrganizationID == uuid.Nil) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (r *permissionRepository) HasPermissions(ctx context.Context, personID, orgID uuid.UUID, checks []repository.PermissionCheck) ([]bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	roleIDs := make(map[uuid.UUID]bool)
+	for _, a := range r.store.roleAssignments {
+		if a.PersonID == personID && (a.OrganizationID == orgID || a.OrganizationID == uuid.Nil) {
+			roleIDs[a.RoleID] = true
+		}
+	}
+
+	var grants []repository.PermissionGrant
+	for _, p := range r.store.permissions {
+		if !p.Allowed {
+			continue
+		}
+		if (p.ResourceType == "role" && roleIDs[p.ResourceID]) ||
+			(p.ResourceType == "person" && p.ResourceID == personID && (p.OrganizationID == orgID || p.OrganizationID == uuid.Nil)) {
+			grants = append(grants, repository.PermissionGrant{
+				ResourceName:     p.ResourceName,
+				Activity:         p.Activity,
+				TargetResourceID: p.TargetResourceID,
+			})
+		}
+	}
+
+	allowed := make([]bool, len(checks))
+	for i, check := range checks {
+		allowed[i] = check.Allowed(grants)
+	}
+	return allowed, nil
+}
@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type personRepository struct {
+	store *Store
+}
+
+// NewPersonRepository creates a new in-memory PersonRepository.
+func NewPersonRepository(store *Store) repository.PersonRepository {
+	return &personRepository{store: store}
+}
+
+func (r *personRepository) Create(ctx context.Context, person *models.Person) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, p := range r.store.persons {
+		if p.Email == person.Email {
+			return fmt.Errorf("creating person: %w", ErrDuplicate)
+		}
+	}
+	stamp(&person.ID, &person.CreatedAt, &person.UpdatedAt)
+	r.store.persons[person.ID] = *person
+	return nil
+}
+
+func (r *personRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Person, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	person, ok := r.store.persons[id]
+	if !ok {
+		return nil, fmt.Errorf("person not found: %w", ErrNotFound)
+	}
+	return &person, nil
+}
+
+func (r *personRepository) GetByEmail(ctx context.Context, email string) (*models.Person, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, person := range r.store.persons {
+		if person.Email == email {
+			return &person, nil
+		}
+	}
+	return nil, fmt.Errorf("person not found by email: %w", ErrNotFound)
+}
+
+func (r *personRepository) List(ctx context.Context, filters repository.PersonFilters, pagination repository.Pagination) ([]*models.Person, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	persons := collect(r.store.persons, func(p models.Person) bool {
+		if filters.Email != nil && p.Email != *filters.Email {
+			return false
+		}
+		if filters.Anonymized != nil && p.Anonymized != *filters.Anonymized {
+			return false
+		}
+		if filters.OrganizationID != nil && !r.store.isMember(p.ID, *filters.OrganizationID, false) {
+			return false
+		}
+		return true
+	})
+	persons, total := paginate(persons, func(p *models.Person) time.Time { return p.CreatedAt }, pagination)
+	return persons, total, nil
+}
+
+func (r *personRepository) Update(ctx context.Context, person *models.Person) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	person.UpdatedAt = time.Now()
+	r.store.persons[person.ID] = *person
+	return nil
+}
+
+func (r *personRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.persons[id]; !ok {
+		return fmt.Errorf("person not found: %w", ErrNotFound)
+	}
+	delete(r.store.persons, id)
+	return nil
+}
+
+func (r *personRepository) Anonymize(ctx context.Context, id uuid.UUID) error {
+	person, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	person.FirstName = "Anonymized"
+	person.LastName = "Anonymized"
+	person.Email = fmt.Sprintf("anonymized-%s@example.com", id.String())
+	person.Anonymized = true
+	person.AnonymizedAt = &now
+
+	if err := r.Update(ctx, person); err != nil {
+		return fmt.Errorf("anonymizing person: %w", err)
+	}
+
+	return nil
+}
+
+func (r *personRepository) GetOrganizations(ctx context.Context, personID uuid.UUID) ([]*models.Organization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.organizations, func(o models.Organization) bool {
+		return r.store.isMember(personID, o.ID, false)
+	}), nil
+}
+
+func (r *personRepository) GetActiveOrganizations(ctx context.Context, personID uuid.UUID) ([]*models.Organization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.organizations, func(o models.Organization) bool {
+		return r.store.isMember(personID, o.ID, true)
+	}), nil
+}
+
+// isMember reports whether the person has a profile in the organization. The
+// caller must hold mu.
+func (s *Store) isMember(personID, orgID uuid.UUID, activeOnly bool) bool {
+	for _, p := range s.profiles {
+		if p.PersonID == personID && p.OrganizationID == orgID && (!activeOnly || p.IsActive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type personEmailRepository struct {
+	store *Store
+}
+
+// NewPersonEmailRepository creates a new in-memory PersonEmailRepository.
+func NewPersonEmailRepository(store *Store) repository.PersonEmailRepository {
+	return &personEmailRepository{store: store}
+}
+
+func (r *personEmailRepository) Create(ctx context.Context, email *models.PersonEmail) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, e := range r.store.personEmails {
+		if e.Email == email.Email {
+			return fmt.Errorf("creating person email: %w", ErrDuplicate)
+		}
+	}
+	stamp(&email.ID, &email.CreatedAt, &email.UpdatedAt)
+	r.store.personEmails[email.ID] = *email
+	return nil
+}
+
+func (r *personEmailRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PersonEmail, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if e, ok := r.store.personEmails[id]; ok {
+		return &e, nil
+	}
+	return nil, fmt.Errorf("email not found: %w", ErrNotFound)
+}
+
+func (r *personEmailRepository) GetByEmail(ctx context.Context, email string) (*models.PersonEmail, error) {
+	email = strings.ToLower(email)
+	return r.find(func(e models.PersonEmail) bool { return e.Email == email })
+}
+
+func (r *personEmailRepository) GetByVerificationTokenHash(ctx context.Context, tokenHash string) (*models.PersonEmail, error) {
+	return r.find(func(e models.PersonEmail) bool {
+		return e.VerificationTokenHash != "" && e.VerificationTokenHash == tokenHash
+	})
+}
+
+func (r *personEmailRepository) find(match func(models.PersonEmail) bool) (*models.PersonEmail, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, e := range r.store.personEmails {
+		if match(e) {
+			return &e, nil
+		}
+	}
+	return nil, fmt.Errorf("email not found: %w", ErrNotFound)
+}
+
+func (r *personEmailRepository) ListByPerson(ctx context.Context, personID uuid.UUID) ([]*models.PersonEmail, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	emails := collect(r.store.personEmails, func(e models.PersonEmail) bool {
+		return e.PersonID == personID
+	})
+	sort.Slice(emails, func(i, j int) bool {
+		return emails[i].CreatedAt.Before(emails[j].CreatedAt)
+	})
+	return emails, nil
+}
+
+func (r *personEmailRepository) Update(ctx context.Context, email *models.PersonEmail) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.personEmails[email.ID]; !ok {
+		return fmt.Errorf("updating person email: %w", ErrNotFound)
+	}
+	email.UpdatedAt = time.Now()
+	r.store.personEmails[email.ID] = *email
+	return nil
+}
+
+func (r *personEmailRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.personEmails, id)
+	return nil
+}
+
+func (r *personEmailRepository) DeleteByPerson(ctx context.Context, personID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, e := range r.store.personEmails {
+		if e.PersonID == personID {
+			delete(r.store.personEmails, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type profileRepository struct {
+	store *Store
+}
+
+// NewPersonOrganizationProfileRepository creates a new in-memory PersonOrganizationProfileRepository.
+func NewPersonOrganizationProfileRepository(store *Store) repository.PersonOrganizationProfileRepository {
+	return &profileRepository{store: store}
+}
+
+func (r *profileRepository) Create(ctx context.Context, profile *models.PersonOrganizationProfile) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, p := range r.store.profiles {
+		if p.PersonID == profile.PersonID && p.OrganizationID == profile.OrganizationID {
+			return fmt.Errorf("creating profile: %w", ErrDuplicate)
+		}
+	}
+	stamp(&profile.ID, &profile.CreatedAt, &profile.UpdatedAt)
+	r.store.profiles[profile.ID] = profileRow(*profile)
+	return nil
+}
+
+func (r *profileRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PersonOrganizationProfile, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	profile, ok := r.store.profiles[id]
+	if !ok {
+		return nil, fmt.Errorf("profile not found: %w", ErrNotFound)
+	}
+	return &profile, nil
+}
+
+func (r *profileRepository) GetByPersonAndOrg(ctx context.Context, personID, orgID uuid.UUID) (*models.PersonOrganizationProfile, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, profile := range r.store.profiles {
+		if profile.PersonID == personID && profile.OrganizationID == orgID {
+			return &profile, nil
+		}
+	}
+	return nil, fmt.Errorf("profile not found: %w", ErrNotFound)
+}
+
+func (r *profileRepository) GetByPerson(ctx context.Context, personID uuid.UUID) ([]*models.PersonOrganizationProfile, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return collect(r.store.profiles, func(p models.PersonOrganizationProfile) bool {
+		return p.PersonID == personID
+	}), nil
+}
+
+func (r *profileRepository) GetByOrganization(ctx context.Context, orgID uuid.UUID, activeOnly bool) ([]*models.PersonOrganizationProfile, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	profiles := collect(r.store.profiles, func(p models.PersonOrganizationProfile) bool {
+		return p.OrganizationID == orgID && (!activeOnly || p.IsActive)
+	})
+	// Mirror Preload("Person")
+	for _, p := range profiles {
+		p.Person = r.store.persons[p.PersonID]
+	}
+	return profiles, nil
+}
+
+func (r *profileRepository) Update(ctx context.Context, profile *models.PersonOrganizationProfile) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	profile.UpdatedAt = time.Now()
+	r.store.profiles[profile.ID] = profileRow(*profile)
+	return nil
+}
+
+func (r *profileRepository) UpdateWage(ctx context.Context, personID, orgID uuid.UUID, wage float64) error {
+	now := time.Now()
+	r.updateWhere(personID, orgID, func(p *models.PersonOrganizationProfile) {
+		p.HourlyWage = &wage
+		p.WageBandID = nil
+		p.WageUpdatedAt = &now
+	})
+	return nil
+}
+
+func (r *profileRepository) SetMeetingApprover(ctx context.Context, personID, orgID uuid.UUID, approver bool) error {
+	r.updateWhere(personID, orgID, func(p *models.PersonOrganizationProfile) {
+		p.MeetingApprover = approver
+	})
+	return nil
+}
+
+func (r *profileRepository) SetWageBand(ctx context.Context, personID, orgID uuid.UUID, bandID *uuid.UUID) error {
+	now := time.Now()
+	r.updateWhere(personID, orgID, func(p *models.PersonOrganizationProfile) {
+		p.WageBandID = bandID
+		p.HourlyWage = nil
+		p.WageUpdatedAt = &now
+	})
+	return nil
+}
+
+func (r *profileRepository) ClearWages(ctx context.Context, orgID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	for id, p := range r.store.profiles {
+		if p.OrganizationID == orgID {
+			p.HourlyWage = nil
+			p.WageBandID = nil
+			p.WageUpdatedAt = &now
+			p.UpdatedAt = now
+			r.store.profiles[id] = p
+		}
+	}
+	return nil
+}
+
+func (r *profileRepository) Activate(ctx context.Context, personID, orgID uuid.UUID) error {
+	now := time.Now()
+	r.updateWhere(personID, orgID, func(p *models.PersonOrganizationProfile) {
+		p.IsActive = true
+		p.LeftAt = nil
+		p.JoinedAt = now
+	})
+	return nil
+}
+
+func (r *profileRepository) Deactivate(ctx context.Context, personID, orgID uuid.UUID) error {
+	now := time.Now()
+	r.updateWhere(personID, orgID, func(p *models.PersonOrganizationProfile) {
+		p.IsActive = false
+		p.LeftAt = &now
+	})
+	return nil
+}
+
+func (r *profileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.profiles[id]; !ok {
+		return fmt.Errorf("profile not found: %w", ErrNotFound)
+	}
+	delete(r.store.profiles, id)
+	return nil
+}
+
+func (r *profileRepository) updateWhere(personID, orgID uuid.UUID, update func(p *models.PersonOrganizationProfile)) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, p := range r.store.profiles {
+		if p.PersonID == personID && p.OrganizationID == orgID {
+			update(&p)
+			p.UpdatedAt = time.Now()
+			r.store.profiles[id] = p
+		}
+	}
+}
+
+// profileRow drops preloaded associations so they are not stored with the
+// profile.
+func profileRow(p models.PersonOrganizationProfile) models.PersonOrganizationProfile {
+	p.Person = models.Person{}
+	p.Organization = models.Organization{}
+	return p
+}
@@ -0,0 +1,20 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type purgeRepository struct{}
+
+// NewPurgeRepository creates a new in-memory PurgeRepository. The memory
+// store deletes rows outright, so there is never anything to purge.
+func NewPurgeRepository(store *Store) repository.PurgeRepository {
+	return &purgeRepository{}
+}
+
+func (r *purgeRepository) PurgeDeleted(ctx context.Context, before time.Time) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
@@ -0,0 +1,289 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type reportRepository struct {
+	store *Store
+}
+
+// NewReportRepository creates a new in-memory ReportRepository.
+func NewReportRepository(store *Store) repository.ReportRepository {
+	return &reportRepository{store: store}
+}
+
+func (r *reportRepository) Summary(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*repository.MeetingSummary, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var summary repository.MeetingSummary
+	attendees := 0
+	for _, m := range r.store.meetings {
+		if m.OrganizationID != orgID || m.StartedAt == nil || m.StartedAt.Before(from) || !m.StartedAt.Before(to) {
+			continue
+		}
+		summary.MeetingCount++
+		summary.TotalCost += m.TotalCost
+		summary.TotalSeconds += int64(m.TotalDuration)
+		attendees += m.MaxAttendees
+		summary.AttendeeSeconds += m.AttendeeSeconds
+	}
+	for _, a := range r.store.meetingArchives {
+		if a.OrganizationID != orgID || a.StartedAt == nil || a.StartedAt.Before(from) || !a.StartedAt.Before(to) {
+			continue
+		}
+		summary.MeetingCount++
+		summary.TotalCost += a.TotalCost
+		summary.TotalSeconds += int64(a.TotalDuration)
+		attendees += a.MaxAttendees
+		summary.AttendeeSeconds += a.AttendeeSeconds
+	}
+	if summary.MeetingCount > 0 {
+		summary.AvgAttendees = float64(attendees) / float64(summary.MeetingCount)
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) Trend(ctx context.Context, orgID uuid.UUID, from, to time.Time, interval string, loc *time.Location) ([]*repository.TrendBucket, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byStart := make(map[time.Time]*repository.TrendBucket)
+	for _, inc := range r.store.increments {
+		m, ok := r.store.meetings[inc.MeetingID]
+		if !ok || m.OrganizationID != orgID || inc.StartTime.Before(from) || !inc.StartTime.Before(to) {
+			continue
+		}
+		start := repository.TruncateInterval(inc.StartTime, interval, loc)
+		b, ok := byStart[start]
+		if !ok {
+			b = &repository.TrendBucket{Start: start}
+			byStart[start] = b
+		}
+		b.Cost += inc.Cost
+		b.Seconds += int64(inc.ElapsedTime)
+	}
+	// Archived meetings count by UTC day, as in the daily aggregates
+	for meetingID, days := range r.store.meetingArchiveDays {
+		if r.store.meetingArchives[meetingID].OrganizationID != orgID {
+			continue
+		}
+		for _, d := range days {
+			if d.Day.Before(from) || !d.Day.Before(to) {
+				continue
+			}
+			start := repository.TruncateInterval(d.Day, interval, loc)
+			b, ok := byStart[start]
+			if !ok {
+				b = &repository.TrendBucket{Start: start}
+				byStart[start] = b
+			}
+			b.Cost += d.Cost
+			b.Seconds += d.Seconds
+		}
+	}
+
+	buckets := make([]*repository.TrendBucket, 0, len(byStart))
+	for _, b := range byStart {
+		buckets = append(buckets, b)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Start.Before(buckets[j].Start) })
+	return buckets, nil
+}
+
+func (r *reportRepository) TopMeetings(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*repository.MeetingCost, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	meetings := make([]*repository.MeetingCost, 0)
+	for _, m := range r.store.meetings {
+		if m.OrganizationID != orgID || m.StartedAt == nil || m.StartedAt.Before(from) || !m.StartedAt.Before(to) {
+			continue
+		}
+		organizer := r.store.persons[m.CreatedByID]
+		meetings = append(meetings, &repository.MeetingCost{
+			MeetingID:          m.ID,
+			Purpose:            m.Purpose,
+			StartedAt:          *m.StartedAt,
+			Seconds:            int64(m.TotalDuration),
+			MaxAttendees:       m.MaxAttendees,
+			AttendeeSeconds:    m.AttendeeSeconds,
+			TotalCost:          m.TotalCost,
+			OrganizerID:        m.CreatedByID,
+			OrganizerFirstName: organizer.FirstName,
+			OrganizerLastName:  organizer.LastName,
+		})
+	}
+	sort.Slice(meetings, func(i, j int) bool { return meetings[i].TotalCost > meetings[j].TotalCost })
+	return meetings[:min(len(meetings), limit)], nil
+}
+
+func (r *reportRepository) TopSeries(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*repository.SeriesCost, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byPurpose := make(map[string]*repository.SeriesCost)
+	attendees := make(map[string]int)
+	for _, m := range r.store.meetings {
+		purpose := strings.TrimSpace(m.Purpose)
+		if m.OrganizationID != orgID || purpose == "" || m.StartedAt == nil || m.StartedAt.Before(from) || !m.StartedAt.Before(to) {
+			continue
+		}
+		key := strings.ToLower(purpose)
+		s, ok := byPurpose[key]
+		if !ok {
+			s = &repository.SeriesCost{Purpose: purpose}
+			byPurpose[key] = s
+		}
+		s.MeetingCount++
+		s.TotalCost += m.TotalCost
+		s.TotalSeconds += int64(m.TotalDuration)
+		attendees[key] += m.MaxAttendees
+		s.AttendeeSeconds += m.AttendeeSeconds
+		if m.StartedAt.After(s.LastStartedAt) {
+			s.LastStartedAt = *m.StartedAt
+		}
+	}
+
+	series := make([]*repository.SeriesCost, 0)
+	for key, s := range byPurpose {
+		if s.MeetingCount < 2 {
+			continue
+		}
+		s.AvgAttendees = float64(attendees[key]) / float64(s.MeetingCount)
+		series = append(series, s)
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].TotalCost > series[j].TotalCost })
+	return series[:min(len(series), limit)], nil
+}
+
+func (r *reportRepository) LateStarts(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*repository.LateStartSummary, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var summary repository.LateStartSummary
+	for _, m := range r.store.meetings {
+		if m.OrganizationID != orgID || m.ScheduledStart == nil || m.StartedAt == nil || m.StartedAt.Before(from) || !m.StartedAt.Before(to) {
+			continue
+		}
+		summary.ScheduledCount++
+		if m.LateStartSeconds > 0 {
+			summary.LateCount++
+			summary.LateSeconds += int64(m.LateStartSeconds)
+			summary.LateCost += m.LateStartCost
+		}
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) Overruns(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*repository.OverrunSummary, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var summary repository.OverrunSummary
+	for _, m := range r.store.meetings {
+		if m.OrganizationID != orgID || m.ScheduledEnd == nil || m.StartedAt == nil || m.StartedAt.Before(from) || !m.StartedAt.Before(to) {
+			continue
+		}
+		summary.ScheduledCount++
+		if m.OverrunSeconds > 0 {
+			summary.OverrunCount++
+			summary.OverrunSeconds += int64(m.OverrunSeconds)
+			summary.OverrunCost += m.OverrunCost
+		}
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) OffHours(ctx context.Context, orgID uuid.UUID, from, to time.Time, hours *repository.WorkingHours) (*repository.OffHoursSummary, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var summary repository.OffHoursSummary
+	for _, m := range r.store.meetings {
+		if m.OrganizationID != orgID || m.StartedAt == nil || m.StartedAt.Before(from) || !m.StartedAt.Before(to) {
+			continue
+		}
+		switch hours.Classify(*m.StartedAt) {
+		case repository.OffHours:
+			summary.OffHoursCount++
+			summary.OffHoursSeconds += int64(m.TotalDuration)
+			summary.OffHoursCost += m.TotalCost
+		case repository.Holiday:
+			summary.HolidayCount++
+			summary.HolidaySeconds += int64(m.TotalDuration)
+			summary.HolidayCost += m.TotalCost
+		}
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) RatingSummary(ctx context.Context, orgID uuid.UUID, from, to time.Time, poorRating float64) (*repository.RatingSummary, error) {
+	meetings, err := r.RatedMeetings(ctx, orgID, from, to, math.MaxInt)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary repository.RatingSummary
+	for _, m := range meetings {
+		summary.MeetingCount++
+		summary.Responses += m.Responses
+		summary.RatingTotal += int64(math.Round(m.AvgRating * float64(m.Responses)))
+		summary.TotalCost += m.TotalCost
+		if m.AvgRating <= poorRating {
+			summary.PoorlyRatedCount++
+			summary.PoorlyRatedCost += m.TotalCost
+		}
+	}
+	return &summary, nil
+}
+
+func (r *reportRepository) RatedMeetings(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*repository.RatedMeeting, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byMeeting := make(map[uuid.UUID]*repository.RatedMeeting)
+	totals := make(map[uuid.UUID]int)
+	for _, rating := range r.store.meetingRatings {
+		m, ok := r.store.meetings[rating.MeetingID]
+		if !ok || m.OrganizationID != orgID || m.StartedAt == nil || m.StartedAt.Before(from) || !m.StartedAt.Before(to) {
+			continue
+		}
+		rm, ok := byMeeting[m.ID]
+		if !ok {
+			rm = &repository.RatedMeeting{
+				MeetingID:    m.ID,
+				Purpose:      m.Purpose,
+				StartedAt:    *m.StartedAt,
+				Seconds:      int64(m.TotalDuration),
+				MaxAttendees: m.MaxAttendees,
+				TotalCost:    m.TotalCost,
+			}
+			byMeeting[m.ID] = rm
+		}
+		rm.Responses++
+		totals[m.ID] += rating.Rating
+	}
+
+	meetings := make([]*repository.RatedMeeting, 0, len(byMeeting))
+	for id, rm := range byMeeting {
+		rm.AvgRating = float64(totals[id]) / float64(rm.Responses)
+		meetings = append(meetings, rm)
+	}
+	sort.Slice(meetings, func(i, j int) bool { return meetings[i].TotalCost > meetings[j].TotalCost })
+	return meetings[:min(len(meetings), limit)], nil
+}
+
+// RefreshRollups has nothing to do: the memory store reports from the
+// meetings directly.
+func (r *reportRepository) RefreshRollups(ctx context.Context, now time.Time) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type reportExportRepository struct {
+	store *Store
+}
+
+// NewReportExportRepository creates a new in-memory ReportExportRepository.
+func NewReportExportRepository(store *Store) repository.ReportExportRepository {
+	return &reportExportRepository{store: store}
+}
+
+func (r *reportExportRepository) Create(ctx context.Context, export *models.ReportExport) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&export.ID, &export.CreatedAt, &export.UpdatedAt)
+	r.store.reportExports[export.ID] = *export
+	return nil
+}
+
+func (r *reportExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ReportExport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	export, ok := r.store.reportExports[id]
+	if !ok {
+		return nil, fmt.Errorf("report export not found: %w", ErrNotFound)
+	}
+	return &export, nil
+}
+
+func (r *reportExportRepository) Update(ctx context.Context, export *models.ReportExport) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.reportExports[export.ID]; !ok {
+		return fmt.Errorf("updating report export: %w", ErrNotFound)
+	}
+	export.UpdatedAt = time.Now()
+	r.store.reportExports[export.ID] = *export
+	return nil
+}
+
+func (r *reportExportRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var n int64
+	for id, export := range r.store.reportExports {
+		if export.ExpiresAt.Before(before) {
+			delete(r.store.reportExports, id)
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,204 @@
+// Package memory provides in-memory implementations of the repository
+// interfaces, so the service layer can run in unit tests and in the
+// zero-dependency demo mode without Postgres or Redis.
+//
+// Deletes are hard deletes, and List sorting only honors created_at.
+package memory
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+var (
+	// ErrNotFound is wrapped by lookups that match no record.
+	ErrNotFound = errors.New("record not found")
+	// ErrDuplicate is wrapped by writes that violate a unique constraint.
+	ErrDuplicate = errors.New("duplicate key value violates unique constraint")
+)
+
+// Store holds every table behind a single lock so that repositories can
+// answer queries spanning tables. Records are stored by value and copied on
+// the way in and out, so callers never share memory with the store.
+type Store struct {
+	mu sync.RWMutex
+
+	persons         map[uuid.UUID]models.Person
+	organizations   map[uuid.UUID]models.Organization
+	profiles        map[uuid.UUID]models.PersonOrganizationProfile
+	meetings        map[uuid.UUID]models.Meeting
+	increments      map[uuid.UUID]models.Increment
+	participants    map[uuid.UUID]models.MeetingParticipant
+	meetingRatings  map[uuid.UUID]models.MeetingRating
+	roles           map[uuid.UUID]models.Role
+	roleAssignments map[uuid.UUID]models.RoleAssignment
+	permissions     map[uuid.UUID]models.Permission
+	authMethods     map[uuid.UUID]models.AuthMethod
+	sessions        map[uuid.UUID]models.Session
+	consents        map[uuid.UUID]models.CookieConsent
+	// consentCategories are keyed by key
+	consentCategories map[string]models.ConsentCategory
+	auditLogs         []models.AuditLog
+
+	webhookEndpoints  map[uuid.UUID]models.WebhookEndpoint
+	webhookDeliveries map[uuid.UUID]models.WebhookDelivery
+	emailDeliveries   map[uuid.UUID]models.EmailDelivery
+	integrations      map[uuid.UUID]models.Integration
+	warehouseExports  map[uuid.UUID]models.WarehouseExport
+	dpaAcceptances    map[uuid.UUID]models.DPAAcceptance
+
+	notificationPreferences map[uuid.UUID]models.NotificationPreference
+	pushSubscriptions       map[uuid.UUID]models.PushSubscription
+
+	costAlerts        map[uuid.UUID]models.CostAlert
+	costAlertTriggers map[uuid.UUID]models.CostAlertTrigger
+	costMilestones    map[uuid.UUID]models.CostMilestone
+
+	wageBands map[uuid.UUID]models.WageBand
+	holidays  map[uuid.UUID]models.Holiday
+
+	subscriptions map[uuid.UUID]models.Subscription
+	payments      map[uuid.UUID]models.Payment
+	invoices      map[uuid.UUID]models.Invoice
+	usageRecords  map[uuid.UUID]models.UsageRecord
+	apiUsage      map[uuid.UUID]models.APIUsage
+
+	reportExports map[uuid.UUID]models.ReportExport
+
+	accountDeletions map[uuid.UUID]models.AccountDeletion
+	personEmails     map[uuid.UUID]models.PersonEmail
+	extensionTokens  map[uuid.UUID]models.ExtensionToken
+	syncOperations   map[uuid.UUID]models.SyncOperation
+
+	// meetingArchives and meetingArchiveDays are keyed by meeting ID
+	meetingArchives    map[uuid.UUID]models.MeetingArchive
+	meetingArchiveDays map[uuid.UUID][]models.MeetingArchiveDay
+
+	// meetingLocks serializes CycleIncrement per meeting without holding mu
+	// while the cycle callback runs.
+	meetingLocksMu sync.Mutex
+	meetingLocks   map[uuid.UUID]*sync.Mutex
+}
+
+// NewStore creates an empty Store.
+func NewStore() *Store {
+	return &Store{
+		persons:           make(map[uuid.UUID]models.Person),
+		organizations:     make(map[uuid.UUID]models.Organization),
+		profiles:          make(map[uuid.UUID]models.PersonOrganizationProfile),
+		meetings:          make(map[uuid.UUID]models.Meeting),
+		increments:        make(map[uuid.UUID]models.Increment),
+		participants:      make(map[uuid.UUID]models.MeetingParticipant),
+		meetingRatings:    make(map[uuid.UUID]models.MeetingRating),
+		roles:             make(map[uuid.UUID]models.Role),
+		roleAssignments:   make(map[uuid.UUID]models.RoleAssignment),
+		permissions:       make(map[uuid.UUID]models.Permission),
+		authMethods:       make(map[uuid.UUID]models.AuthMethod),
+		sessions:          make(map[uuid.UUID]models.Session),
+		consents:          make(map[uuid.UUID]models.CookieConsent),
+		consentCategories: make(map[string]models.ConsentCategory),
+		meetingLocks:      make(map[uuid.UUID]*sync.Mutex),
+
+		webhookEndpoints:  make(map[uuid.UUID]models.WebhookEndpoint),
+		webhookDeliveries: make(map[uuid.UUID]models.WebhookDelivery),
+		emailDeliveries:   make(map[uuid.UUID]models.EmailDelivery),
+		integrations:      make(map[uuid.UUID]models.Integration),
+		warehouseExports:  make(map[uuid.UUID]models.WarehouseExport),
+		dpaAcceptances:    make(map[uuid.UUID]models.DPAAcceptance),
+
+		notificationPreferences: make(map[uuid.UUID]models.NotificationPreference),
+		pushSubscriptions:       make(map[uuid.UUID]models.PushSubscription),
+
+		costAlerts:        make(map[uuid.UUID]models.CostAlert),
+		costAlertTriggers: make(map[uuid.UUID]models.CostAlertTrigger),
+		costMilestones:    make(map[uuid.UUID]models.CostMilestone),
+
+		wageBands: make(map[uuid.UUID]models.WageBand),
+		holidays:  make(map[uuid.UUID]models.Holiday),
+
+		subscriptions: make(map[uuid.UUID]models.Subscription),
+		payments:      make(map[uuid.UUID]models.Payment),
+		invoices:      make(map[uuid.UUID]models.Invoice),
+		usageRecords:  make(map[uuid.UUID]models.UsageRecord),
+		apiUsage:      make(map[uuid.UUID]models.APIUsage),
+
+		reportExports: make(map[uuid.UUID]models.ReportExport),
+
+		accountDeletions: make(map[uuid.UUID]models.AccountDeletion),
+		personEmails:     make(map[uuid.UUID]models.PersonEmail),
+		extensionTokens:  make(map[uuid.UUID]models.ExtensionToken),
+		syncOperations:   make(map[uuid.UUID]models.SyncOperation),
+
+		meetingArchives:    make(map[uuid.UUID]models.MeetingArchive),
+		meetingArchiveDays: make(map[uuid.UUID][]models.MeetingArchiveDay),
+	}
+}
+
+// AuditLogs returns a copy of every audit log written so far, oldest first.
+func (s *Store) AuditLogs() []models.AuditLog {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]models.AuditLog(nil), s.auditLogs...)
+}
+
+func (s *Store) meetingLock(id uuid.UUID) *sync.Mutex {
+	s.meetingLocksMu.Lock()
+	defer s.meetingLocksMu.Unlock()
+	l, ok := s.meetingLocks[id]
+	if !ok {
+		l = &sync.Mutex{}
+		s.meetingLocks[id] = l
+	}
+	return l
+}
+
+// stamp fills the ID and timestamps the way GORM does on create.
+func stamp(id *uuid.UUID, createdAt, updatedAt *time.Time) {
+	if *id == uuid.Nil {
+		*id = uuid.Must(uuid.NewRandom())
+	}
+	now := time.Now()
+	if createdAt.IsZero() {
+		*createdAt = now
+	}
+	if updatedAt.IsZero() {
+		*updatedAt = now
+	}
+}
+
+// collect returns pointers to copies of the rows matching keep.
+func collect[T any](rows map[uuid.UUID]T, keep func(T) bool) []*T {
+	result := make([]*T, 0)
+	for _, row := range rows {
+		if keep(row) {
+			result = append(result, &row)
+		}
+	}
+	return result
+}
+
+// paginate orders rows by created_at (descending unless SortDir is "asc")
+// and applies the page window, returning the page and the total count.
+func paginate[T any](rows []*T, createdAt func(*T) time.Time, pagination repository.Pagination) ([]*T, int64) {
+	desc := pagination.SortBy == "" || pagination.SortDir == "desc"
+	sort.SliceStable(rows, func(i, j int) bool {
+		if desc {
+			return createdAt(rows[i]).After(createdAt(rows[j]))
+		}
+		return createdAt(rows[i]).Before(createdAt(rows[j]))
+	})
+
+	total := int64(len(rows))
+	if pagination.PageSize > 0 {
+		start := min(pagination.Offset(), len(rows))
+		end := min(start+pagination.Limit(), len(rows))
+		rows = rows[start:end]
+	}
+	return rows, total
+}
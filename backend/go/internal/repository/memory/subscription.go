@@ -0,0 +1,171 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type subscriptionRepository struct {
+	store *Store
+}
+
+// NewSubscriptionRepository creates a new in-memory SubscriptionRepository.
+func NewSubscriptionRepository(store *Store) repository.SubscriptionRepository {
+	return &subscriptionRepository{store: store}
+}
+
+func (r *subscriptionRepository) Create(ctx context.Context, sub *models.Subscription) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&sub.ID, &sub.CreatedAt, &sub.UpdatedAt)
+	r.store.subscriptions[sub.ID] = detachSubscription(sub)
+	return nil
+}
+
+func (r *subscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Subscription, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	sub, ok := r.store.subscriptions[id]
+	if !ok {
+		return nil, fmt.Errorf("subscription not found: %w", ErrNotFound)
+	}
+	return &sub, nil
+}
+
+func (r *subscriptionRepository) GetByOrganization(ctx context.Context, orgID uuid.UUID) (*models.Subscription, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var latest *models.Subscription
+	for _, sub := range r.store.subscriptions {
+		if sub.OrganizationID == orgID && (latest == nil || sub.CreatedAt.After(latest.CreatedAt)) {
+			latest = &sub
+		}
+	}
+	return latest, nil
+}
+
+func (r *subscriptionRepository) GetByStripeSubscriptionID(ctx context.Context, stripeID string) (*models.Subscription, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, sub := range r.store.subscriptions {
+		if sub.StripeSubscriptionID == stripeID {
+			return &sub, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *subscriptionRepository) Update(ctx context.Context, sub *models.Subscription) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.subscriptions[sub.ID]; !ok {
+		return fmt.Errorf("updating subscription: %w", ErrNotFound)
+	}
+	sub.UpdatedAt = time.Now()
+	r.store.subscriptions[sub.ID] = detachSubscription(sub)
+	return nil
+}
+
+func (r *subscriptionRepository) ListLocalTrials(ctx context.Context) ([]*models.Subscription, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	subs := collect(r.store.subscriptions, func(s models.Subscription) bool {
+		return s.Status == models.SubscriptionTrialing && s.StripeSubscriptionID == ""
+	})
+	sort.Slice(subs, func(i, j int) bool { return subs[i].CurrentPeriodEnd.Before(subs[j].CurrentPeriodEnd) })
+	return subs, nil
+}
+
+func (r *subscriptionRepository) SavePayment(ctx context.Context, payment *models.Payment) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, existing := range r.store.payments {
+		if existing.StripePaymentIntentID == payment.StripePaymentIntentID {
+			if existing.Status == models.PaymentSucceeded {
+				*payment = existing
+				return nil
+			}
+			existing.Amount = payment.Amount
+			existing.Currency = payment.Currency
+			existing.Status = payment.Status
+			existing.PaidAt = payment.PaidAt
+			existing.ReceiptURL = payment.ReceiptURL
+			existing.UpdatedAt = time.Now()
+			r.store.payments[id] = existing
+			*payment = existing
+			return nil
+		}
+	}
+	stamp(&payment.ID, &payment.CreatedAt, &payment.UpdatedAt)
+	row := *payment
+	row.Subscription = models.Subscription{}
+	r.store.payments[row.ID] = row
+	return nil
+}
+
+func (r *subscriptionRepository) ListPayments(ctx context.Context, subscriptionID uuid.UUID, pagination repository.Pagination) ([]*models.Payment, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	payments := collect(r.store.payments, func(p models.Payment) bool {
+		return p.SubscriptionID == subscriptionID
+	})
+	pagination.SortBy = ""
+	payments, total := paginate(payments, func(p *models.Payment) time.Time { return p.CreatedAt }, pagination)
+	return payments, total, nil
+}
+
+func (r *subscriptionRepository) SaveInvoice(ctx context.Context, invoice *models.Invoice) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, existing := range r.store.invoices {
+		if existing.StripeInvoiceID == invoice.StripeInvoiceID {
+			if existing.Status == models.InvoicePaid || existing.Status == models.InvoiceVoid {
+				*invoice = existing
+				return nil
+			}
+			invoice.ID = existing.ID
+			invoice.CreatedAt = existing.CreatedAt
+			invoice.UpdatedAt = time.Now()
+			r.store.invoices[id] = *invoice
+			return nil
+		}
+	}
+	stamp(&invoice.ID, &invoice.CreatedAt, &invoice.UpdatedAt)
+	r.store.invoices[invoice.ID] = *invoice
+	return nil
+}
+
+func (r *subscriptionRepository) ListInvoices(ctx context.Context, orgID uuid.UUID, pagination repository.Pagination) ([]*models.Invoice, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	invoices := collect(r.store.invoices, func(i models.Invoice) bool {
+		return i.OrganizationID == orgID
+	})
+	pagination.SortBy = ""
+	invoices, total := paginate(invoices, func(i *models.Invoice) time.Time { return i.IssuedAt }, pagination)
+	return invoices, total, nil
+}
+
+// detachSubscription copies sub without its associations.
+func detachSubscription(sub *models.Subscription) models.Subscription {
+	row := *sub
+	row.Organization = models.Organization{}
+	row.Payments = nil
+	return row
+}
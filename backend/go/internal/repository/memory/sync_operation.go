@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type syncOperationRepository struct {
+	store *Store
+}
+
+// NewSyncOperationRepository creates a new in-memory
+// SyncOperationRepository.
+func NewSyncOperationRepository(store *Store) repository.SyncOperationRepository {
+	return &syncOperationRepository{store: store}
+}
+
+func (r *syncOperationRepository) Claim(ctx context.Context, op *models.SyncOperation) (*models.SyncOperation, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, o := range r.store.syncOperations {
+		if o.PersonID == op.PersonID && o.OperationID == op.OperationID {
+			return &o, nil
+		}
+	}
+	stamp(&op.ID, &op.CreatedAt, &op.UpdatedAt)
+	r.store.syncOperations[op.ID] = *op
+	return nil, nil
+}
+
+func (r *syncOperationRepository) Update(ctx context.Context, op *models.SyncOperation) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.syncOperations[op.ID]; !ok {
+		return fmt.Errorf("sync operation not found: %w", ErrNotFound)
+	}
+	op.UpdatedAt = time.Now()
+	r.store.syncOperations[op.ID] = *op
+	return nil
+}
+
+func (r *syncOperationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.syncOperations, id)
+	return nil
+}
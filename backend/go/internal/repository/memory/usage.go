@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type usageRepository struct {
+	store *Store
+}
+
+// NewUsageRepository creates a new in-memory UsageRepository.
+func NewUsageRepository(store *Store) repository.UsageRepository {
+	return &usageRepository{store: store}
+}
+
+func (r *usageRepository) AddUsage(ctx context.Context, orgID uuid.UUID, period time.Time, seconds int64, activeMembers int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, record := range r.store.usageRecords {
+		if record.OrganizationID == orgID && record.Period.Equal(period) {
+			record.MeetingSeconds += seconds
+			record.ActiveMembers = max(record.ActiveMembers, activeMembers)
+			record.UpdatedAt = time.Now()
+			r.store.usageRecords[id] = record
+			return nil
+		}
+	}
+
+	record := models.UsageRecord{
+		OrganizationID: orgID,
+		Period:         period,
+		MeetingSeconds: seconds,
+		ActiveMembers:  activeMembers,
+	}
+	stamp(&record.ID, &record.CreatedAt, &record.UpdatedAt)
+	r.store.usageRecords[record.ID] = record
+	return nil
+}
+
+func (r *usageRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, since time.Time) ([]*models.UsageRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	records := collect(r.store.usageRecords, func(u models.UsageRecord) bool {
+		return u.OrganizationID == orgID && !u.Period.Before(since)
+	})
+	sort.Slice(records, func(i, j int) bool { return records[i].Period.After(records[j].Period) })
+	return records, nil
+}
+
+func (r *usageRepository) ListUnreported(ctx context.Context, since time.Time) ([]*models.UsageRecord, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	records := collect(r.store.usageRecords, func(u models.UsageRecord) bool {
+		return !u.Period.Before(since) &&
+			(u.MeetingSeconds/60 > u.ReportedMeetingMinutes || u.ActiveMembers != u.ReportedActiveMembers)
+	})
+	sort.Slice(records, func(i, j int) bool { return records[i].Period.Before(records[j].Period) })
+	return records, nil
+}
+
+func (r *usageRepository) MarkReported(ctx context.Context, id uuid.UUID, minutes int64, activeMembers int, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.usageRecords[id]
+	if !ok {
+		return fmt.Errorf("marking usage reported: %w", ErrNotFound)
+	}
+	record.ReportedMeetingMinutes = minutes
+	record.ReportedActiveMembers = activeMembers
+	record.ReportedAt = &at
+	r.store.usageRecords[id] = record
+	return nil
+}
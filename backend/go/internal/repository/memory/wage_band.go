@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type wageBandRepository struct {
+	store *Store
+}
+
+// NewWageBandRepository creates a new in-memory WageBandRepository.
+func NewWageBandRepository(store *Store) repository.WageBandRepository {
+	return &wageBandRepository{store: store}
+}
+
+func (r *wageBandRepository) Create(ctx context.Context, band *models.WageBand) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, b := range r.store.wageBands {
+		if b.OrganizationID == band.OrganizationID && b.Name == band.Name {
+			return fmt.Errorf("creating wage band: duplicate name %q", band.Name)
+		}
+	}
+	stamp(&band.ID, &band.CreatedAt, &band.UpdatedAt)
+	r.store.wageBands[band.ID] = *band
+	return nil
+}
+
+func (r *wageBandRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.WageBand, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	band, ok := r.store.wageBands[id]
+	if !ok {
+		return nil, fmt.Errorf("wage band not found: %w", ErrNotFound)
+	}
+	return &band, nil
+}
+
+func (r *wageBandRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.WageBand, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	bands := collect(r.store.wageBands, func(b models.WageBand) bool { return b.OrganizationID == orgID })
+	sort.Slice(bands, func(i, j int) bool {
+		if bands[i].MinWage != bands[j].MinWage {
+			return bands[i].MinWage < bands[j].MinWage
+		}
+		return bands[i].MaxWage < bands[j].MaxWage
+	})
+	return bands, nil
+}
+
+func (r *wageBandRepository) Update(ctx context.Context, band *models.WageBand) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.wageBands[band.ID]; !ok {
+		return fmt.Errorf("updating wage band: %w", ErrNotFound)
+	}
+	band.UpdatedAt = time.Now()
+	r.store.wageBands[band.ID] = *band
+	return nil
+}
+
+func (r *wageBandRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.wageBands, id)
+	for profileID, p := range r.store.profiles {
+		if p.WageBandID != nil && *p.WageBandID == id {
+			p.WageBandID = nil
+			r.store.profiles[profileID] = p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type warehouseExportRepository struct {
+	store *Store
+}
+
+// NewWarehouseExportRepository creates a new in-memory WarehouseExportRepository.
+func NewWarehouseExportRepository(store *Store) repository.WarehouseExportRepository {
+	return &warehouseExportRepository{store: store}
+}
+
+func (r *warehouseExportRepository) Create(ctx context.Context, export *models.WarehouseExport) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, e := range r.store.warehouseExports {
+		if e.OrganizationID == export.OrganizationID && e.Provider == export.Provider {
+			return fmt.Errorf("creating warehouse export: %w", ErrDuplicate)
+		}
+	}
+	stamp(&export.ID, &export.CreatedAt, &export.UpdatedAt)
+	r.store.warehouseExports[export.ID] = *export
+	return nil
+}
+
+func (r *warehouseExportRepository) GetByProvider(ctx context.Context, orgID uuid.UUID, provider string) (*models.WarehouseExport, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, e := range r.store.warehouseExports {
+		if e.OrganizationID == orgID && e.Provider == provider {
+			return &e, nil
+		}
+	}
+	return nil, fmt.Errorf("warehouse export not found: %w", ErrNotFound)
+}
+
+func (r *warehouseExportRepository) Update(ctx context.Context, export *models.WarehouseExport) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.warehouseExports[export.ID]; !ok {
+		return fmt.Errorf("updating warehouse export: %w", ErrNotFound)
+	}
+	export.UpdatedAt = time.Now()
+	r.store.warehouseExports[export.ID] = *export
+	return nil
+}
+
+func (r *warehouseExportRepository) Claim(ctx context.Context, id uuid.UUID, now, until time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	export, ok := r.store.warehouseExports[id]
+	if !ok {
+		return false, fmt.Errorf("claiming warehouse export: %w", ErrNotFound)
+	}
+	if export.RunningUntil != nil && export.RunningUntil.After(now) {
+		return false, nil
+	}
+	export.RunningUntil = &until
+	r.store.warehouseExports[id] = export
+	return true, nil
+}
+
+// afterWatermark reports whether a change at (at, id) comes after w and no
+// later than until.
+func afterWatermark(w repository.Watermark, until time.Time, at time.Time, id uuid.UUID) bool {
+	if at.After(until) {
+		return false
+	}
+	if w.At.IsZero() {
+		return true
+	}
+	return at.After(w.At) || at.Equal(w.At) && id.String() > w.ID.String()
+}
+
+// sortChanges orders changes by time, then ID.
+func sortChanges(at func(i int) time.Time, id func(i int) uuid.UUID) func(i, j int) bool {
+	return func(i, j int) bool {
+		if !at(i).Equal(at(j)) {
+			return at(i).Before(at(j))
+		}
+		return id(i).String() < id(j).String()
+	}
+}
+
+func (r *warehouseExportRepository) ChangedMeetings(ctx context.Context, orgID uuid.UUID, after repository.Watermark, until time.Time, limit int) ([]*repository.MeetingChange, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var changes []*repository.MeetingChange
+	for _, m := range r.store.meetings {
+		at := repository.MeetingChangedAt(&m)
+		if m.OrganizationID != orgID || !afterWatermark(after, until, at, m.ID) {
+			continue
+		}
+		changes = append(changes, &repository.MeetingChange{Meeting: &m, ChangedAt: at})
+	}
+	sort.Slice(changes, sortChanges(
+		func(i int) time.Time { return changes[i].ChangedAt },
+		func(i int) uuid.UUID { return changes[i].Meeting.ID }))
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+
+	for _, c := range changes {
+		if c.Meeting.DeletedAt.Valid {
+			continue
+		}
+		increments := collect(r.store.increments, func(i models.Increment) bool { return i.MeetingID == c.Meeting.ID })
+		sort.Slice(increments, func(i, j int) bool { return increments[i].StartTime.Before(increments[j].StartTime) })
+		for _, inc := range increments {
+			c.Meeting.Increments = append(c.Meeting.Increments, *inc)
+		}
+	}
+	return changes, nil
+}
+
+func (r *warehouseExportRepository) ChangedMemberships(ctx context.Context, orgID uuid.UUID, after repository.Watermark, until time.Time, limit int) ([]*repository.MembershipChange, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var changes []*repository.MembershipChange
+	for _, p := range r.store.profiles {
+		if p.OrganizationID != orgID {
+			continue
+		}
+		person := r.store.persons[p.PersonID]
+		at := p.UpdatedAt
+		if person.UpdatedAt.After(at) {
+			at = person.UpdatedAt
+		}
+		if p.DeletedAt.Valid && p.DeletedAt.Time.After(at) {
+			at = p.DeletedAt.Time
+		}
+		if !afterWatermark(after, until, at, p.ID) {
+			continue
+		}
+		change := &repository.MembershipChange{
+			ProfileID:       p.ID,
+			PersonID:        p.PersonID,
+			Email:           person.Email,
+			FirstName:       person.FirstName,
+			LastName:        person.LastName,
+			IsActive:        p.IsActive,
+			JoinedAt:        p.JoinedAt,
+			LeftAt:          p.LeftAt,
+			MeetingApprover: p.MeetingApprover,
+			CreatedAt:       p.CreatedAt,
+			ChangedAt:       at,
+		}
+		if p.DeletedAt.Valid {
+			change.DeletedAt = &p.DeletedAt.Time
+		}
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, sortChanges(
+		func(i int) time.Time { return changes[i].ChangedAt },
+		func(i int) uuid.UUID { return changes[i].ProfileID }))
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return changes, nil
+}
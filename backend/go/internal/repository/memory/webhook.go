@@ -0,0 +1,126 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type webhookRepository struct {
+	store *Store
+}
+
+// NewWebhookRepository creates a new in-memory WebhookRepository.
+func NewWebhookRepository(store *Store) repository.WebhookRepository {
+	return &webhookRepository{store: store}
+}
+
+func (r *webhookRepository) CreateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&endpoint.ID, &endpoint.CreatedAt, &endpoint.UpdatedAt)
+	row := *endpoint
+	row.Organization = models.Organization{}
+	r.store.webhookEndpoints[row.ID] = row
+	return nil
+}
+
+func (r *webhookRepository) GetEndpoint(ctx context.Context, id uuid.UUID) (*models.WebhookEndpoint, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	endpoint, ok := r.store.webhookEndpoints[id]
+	if !ok {
+		return nil, fmt.Errorf("webhook endpoint not found: %w", ErrNotFound)
+	}
+	return &endpoint, nil
+}
+
+func (r *webhookRepository) ListEndpoints(ctx context.Context, orgID uuid.UUID) ([]*models.WebhookEndpoint, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	endpoints := collect(r.store.webhookEndpoints, func(e models.WebhookEndpoint) bool {
+		return e.OrganizationID == orgID
+	})
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].CreatedAt.Before(endpoints[j].CreatedAt) })
+	return endpoints, nil
+}
+
+func (r *webhookRepository) UpdateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.webhookEndpoints[endpoint.ID]; !ok {
+		return fmt.Errorf("updating webhook endpoint: %w", ErrNotFound)
+	}
+	endpoint.UpdatedAt = time.Now()
+	row := *endpoint
+	row.Organization = models.Organization{}
+	r.store.webhookEndpoints[row.ID] = row
+	return nil
+}
+
+func (r *webhookRepository) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.webhookEndpoints, id)
+	return nil
+}
+
+func (r *webhookRepository) CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stamp(&delivery.ID, &delivery.CreatedAt, &delivery.UpdatedAt)
+	row := *delivery
+	row.Endpoint = models.WebhookEndpoint{}
+	r.store.webhookDeliveries[row.ID] = row
+	return nil
+}
+
+func (r *webhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	delivery, ok := r.store.webhookDeliveries[id]
+	if !ok {
+		return nil, fmt.Errorf("webhook delivery not found: %w", ErrNotFound)
+	}
+	// Left zero when the endpoint has been deleted
+	delivery.Endpoint = r.store.webhookEndpoints[delivery.EndpointID]
+	return &delivery, nil
+}
+
+func (r *webhookRepository) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.webhookDeliveries[delivery.ID]; !ok {
+		return fmt.Errorf("updating webhook delivery: %w", ErrNotFound)
+	}
+	delivery.UpdatedAt = time.Now()
+	row := *delivery
+	row.Endpoint = models.WebhookEndpoint{}
+	r.store.webhookDeliveries[row.ID] = row
+	return nil
+}
+
+func (r *webhookRepository) ListDeliveries(ctx context.Context, endpointID uuid.UUID, pagination repository.Pagination) ([]*models.WebhookDelivery, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	deliveries := collect(r.store.webhookDeliveries, func(d models.WebhookDelivery) bool {
+		return d.EndpointID == endpointID
+	})
+	pagination.SortBy = ""
+	deliveries, total := paginate(deliveries, func(d *models.WebhookDelivery) time.Time { return d.CreatedAt }, pagination)
+	return deliveries, total, nil
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// NotificationRepository handles notification preferences and Web Push
+// subscriptions.
+type NotificationRepository interface {
+	// Preferences
+	ListPreferences(ctx context.Context, personID uuid.UUID) ([]*models.NotificationPreference, error)
+	// SavePreferences inserts or updates each preference by person, event
+	// and channel.
+	SavePreferences(ctx context.Context, prefs []*models.NotificationPreference) error
+
+	// Push subscriptions
+	// SavePushSubscription inserts the subscription or, when its endpoint
+	// is already registered, takes it over.
+	SavePushSubscription(ctx context.Context, sub *models.PushSubscription) error
+	ListPushSubscriptions(ctx context.Context, personID uuid.UUID) ([]*models.PushSubscription, error)
+	// DeletePushSubscription deletes one of the person's subscriptions.
+	DeletePushSubscription(ctx context.Context, personID, id uuid.UUID) error
+}
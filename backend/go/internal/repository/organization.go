@@ -37,5 +37,10 @@ type OrgFilters struct {
 	Slug     *string
 	Name     *string
 	MemberID *uuid.UUID // Filter by member
+
+	// Discoverable keeps the organizations people can find without an
+	// invitation: public ones, and those discoverable by one of Domains
+	Discoverable bool
+	Domains      []string
 }
 
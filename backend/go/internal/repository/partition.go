@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// PartitionRepository keeps the monthly partitions of the increments table.
+// Both methods do nothing while the table isn't partitioned, as when it was
+// created by AutoMigrate.
+type PartitionRepository interface {
+	// CreateIncrementPartitions creates the missing partitions for the
+	// months from the month of from through the month of through, and
+	// returns their names. Increments of those months waiting in the
+	// default partition move into them.
+	CreateIncrementPartitions(ctx context.Context, from, through time.Time) ([]string, error)
+	// DropIncrementPartitions drops the partitions of months that ended by
+	// before, with their increments, and returns their names.
+	DropIncrementPartitions(ctx context.Context, before time.Time) ([]string, error)
+}
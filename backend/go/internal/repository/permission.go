@@ -29,8 +29,43 @@ type PermissionRepository interface {
 	AssignRole(ctx context.Context, assignment *models.RoleAssignment) error
 	UnassignRole(ctx context.Context, roleID, personID, orgID uuid.UUID) error
 	GetRolesByPerson(ctx context.Context, personID, orgID uuid.UUID) ([]*models.Role, error)
+	GetRoleHolders(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) // IDs of the people assigned the role
 
 	// Permission checking
 	HasPermission(ctx context.Context, personID, orgID uuid.UUID, resourceName string, resourceID *uuid.UUID, activity string) (bool, error)
+	// HasPermissions answers each of checks for the person in the
+	// organization, in order, as HasPermission would, from one query of
+	// everything they are granted there.
+	HasPermissions(ctx context.Context, personID, orgID uuid.UUID, checks []PermissionCheck) ([]bool, error)
 }
 
+// PermissionCheck asks whether a person may perform Activity on the
+// resource named ResourceName: ResourceID for a specific one, or nil for
+// all of them.
+type PermissionCheck struct {
+	ResourceName string
+	ResourceID   *uuid.UUID
+	Activity     string
+}
+
+// PermissionGrant is a permission a person holds, through a role or
+// directly. A nil TargetResourceID grants it on every resource named
+// ResourceName.
+type PermissionGrant struct {
+	ResourceName     string
+	Activity         string
+	TargetResourceID *uuid.UUID
+}
+
+// Allowed reports whether any of grants allows c.
+func (c PermissionCheck) Allowed(grants []PermissionGrant) bool {
+	for _, g := range grants {
+		if g.ResourceName != c.ResourceName || g.Activity != c.Activity {
+			continue
+		}
+		if g.TargetResourceID == nil || (c.ResourceID != nil && *g.TargetResourceID == *c.ResourceID) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// PersonEmailRepository handles people's secondary email addresses.
+type PersonEmailRepository interface {
+	Create(ctx context.Context, email *models.PersonEmail) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.PersonEmail, error)
+	// GetByEmail matches the address case-insensitively, verified or not.
+	GetByEmail(ctx context.Context, email string) (*models.PersonEmail, error)
+	GetByVerificationTokenHash(ctx context.Context, tokenHash string) (*models.PersonEmail, error)
+	ListByPerson(ctx context.Context, personID uuid.UUID) ([]*models.PersonEmail, error)
+	Update(ctx context.Context, email *models.PersonEmail) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	DeleteByPerson(ctx context.Context, personID uuid.UUID) error
+}
@@ -0,0 +1,217 @@
+// Package pgx provides repository implementations on pgx and sqlc-generated
+// queries, for deployments that prefer raw SQL over GORM reflection on hot
+// paths. Regenerate the sqlc package with `sqlc generate` from backend/go.
+package pgx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository/pgx/sqlc"
+)
+
+type incrementRepository struct {
+	q     *sqlc.Queries
+	cache cache.Cache
+	ttls  cache.TTLs
+}
+
+// NewIncrementRepository creates a new pgx-based IncrementRepository.
+func NewIncrementRepository(pool *pgxpool.Pool, cache cache.Cache, ttls cache.TTLs) repository.IncrementRepository {
+	return &incrementRepository{
+		q:     sqlc.New(pool),
+		cache: cache,
+		ttls:  ttls,
+	}
+}
+
+func (r *incrementRepository) Create(ctx context.Context, increment *models.Increment) error {
+	prepareIncrement(increment)
+	if err := r.q.CreateIncrement(ctx, sqlc.CreateIncrementParams{
+		ID:            increment.ID,
+		CreatedAt:     increment.CreatedAt,
+		UpdatedAt:     increment.UpdatedAt,
+		MeetingID:     increment.MeetingID,
+		StartTime:     increment.StartTime,
+		StopTime:      increment.StopTime,
+		AttendeeCount: int64(increment.AttendeeCount),
+		AverageWage:   increment.AverageWage,
+		ElapsedTime:   int64(increment.ElapsedTime),
+		Cost:          increment.Cost,
+		TotalCost:     increment.TotalCost,
+		Purpose:       pgtype.Text{String: increment.Purpose, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("creating increment: %w", err)
+	}
+	// Invalidate increments list for meeting
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(increment.MeetingID))
+	return nil
+}
+
+func (r *incrementRepository) CreateBatch(ctx context.Context, increments []*models.Increment) error {
+	if len(increments) == 0 {
+		return nil
+	}
+	rows := make([]sqlc.CreateIncrementsParams, len(increments))
+	for i, increment := range increments {
+		prepareIncrement(increment)
+		rows[i] = sqlc.CreateIncrementsParams{
+			ID:            increment.ID,
+			CreatedAt:     increment.CreatedAt,
+			UpdatedAt:     increment.UpdatedAt,
+			MeetingID:     increment.MeetingID,
+			StartTime:     increment.StartTime,
+			StopTime:      increment.StopTime,
+			AttendeeCount: int64(increment.AttendeeCount),
+			AverageWage:   increment.AverageWage,
+			ElapsedTime:   int64(increment.ElapsedTime),
+			Cost:          increment.Cost,
+			TotalCost:     increment.TotalCost,
+			Purpose:       pgtype.Text{String: increment.Purpose, Valid: true},
+		}
+	}
+	if _, err := r.q.CreateIncrements(ctx, rows); err != nil {
+		return fmt.Errorf("batch creating increments: %w", err)
+	}
+	// Invalidate increments list for meeting
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(increments[0].MeetingID))
+	return nil
+}
+
+func (r *incrementRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Increment, error) {
+	increment, err := cache.LoadThrough(ctx, r.cache, cache.KeyIncrement(id), r.ttls.Increment, func(ctx context.Context) (models.Increment, error) {
+		row, err := r.q.GetIncrement(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.Increment{}, fmt.Errorf("increment not found: %w", err)
+			}
+			return models.Increment{}, fmt.Errorf("getting increment by id: %w", err)
+		}
+		return toIncrement(sqlc.ListIncrementsByMeetingRow(row)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &increment, nil
+}
+
+func (r *incrementRepository) GetByMeeting(ctx context.Context, meetingID uuid.UUID) ([]*models.Increment, error) {
+	increments, err := cache.LoadThrough(ctx, r.cache, cache.KeyMeetingIncrements(meetingID), r.ttls.IncrementList, func(ctx context.Context) ([]models.Increment, error) {
+		rows, err := r.q.ListIncrementsByMeeting(ctx, meetingID)
+		if err != nil {
+			return nil, fmt.Errorf("getting increments by meeting: %w", err)
+		}
+		increments := make([]models.Increment, len(rows))
+		for i, row := range rows {
+			increments[i] = toIncrement(row)
+		}
+		return increments, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Hand each caller its own copies, since the loaded slice may be shared
+	result := make([]*models.Increment, len(increments))
+	for i := range increments {
+		inc := increments[i]
+		result[i] = &inc
+	}
+	return result, nil
+}
+
+func (r *incrementRepository) Update(ctx context.Context, increment *models.Increment) error {
+	increment.UpdatedAt = time.Now()
+	n, err := r.q.UpdateIncrement(ctx, sqlc.UpdateIncrementParams{
+		ID:            increment.ID,
+		UpdatedAt:     increment.UpdatedAt,
+		MeetingID:     increment.MeetingID,
+		StartTime:     increment.StartTime,
+		StopTime:      increment.StopTime,
+		AttendeeCount: int64(increment.AttendeeCount),
+		AverageWage:   increment.AverageWage,
+		ElapsedTime:   int64(increment.ElapsedTime),
+		Cost:          increment.Cost,
+		TotalCost:     increment.TotalCost,
+		Purpose:       pgtype.Text{String: increment.Purpose, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("updating increment: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("increment not found: %w", pgx.ErrNoRows)
+	}
+
+	// Invalidate cache
+	_ = r.cache.Delete(ctx, cache.KeyIncrement(increment.ID))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(increment.MeetingID))
+
+	return nil
+}
+
+func (r *incrementRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	meetingID, err := r.q.SoftDeleteIncrement(ctx, sqlc.SoftDeleteIncrementParams{ID: id, DeletedAt: time.Now()})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("increment not found: %w", err)
+		}
+		return fmt.Errorf("deleting increment: %w", err)
+	}
+
+	// Invalidate cache
+	_ = r.cache.Delete(ctx, cache.KeyIncrement(id))
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(meetingID))
+
+	return nil
+}
+
+func (r *incrementRepository) DeleteByMeeting(ctx context.Context, meetingID uuid.UUID) error {
+	if err := r.q.SoftDeleteIncrementsByMeeting(ctx, sqlc.SoftDeleteIncrementsByMeetingParams{MeetingID: meetingID, DeletedAt: time.Now()}); err != nil {
+		return fmt.Errorf("deleting increments by meeting: %w", err)
+	}
+
+	// Invalidate cache
+	_ = r.cache.Delete(ctx, cache.KeyMeetingIncrements(meetingID))
+	return nil
+}
+
+// prepareIncrement fills the fields GORM would set on create.
+func prepareIncrement(increment *models.Increment) {
+	if increment.ID == uuid.Nil {
+		increment.ID = uuid.Must(uuid.NewRandom())
+	}
+	now := time.Now()
+	if increment.CreatedAt.IsZero() {
+		increment.CreatedAt = now
+	}
+	if increment.UpdatedAt.IsZero() {
+		increment.UpdatedAt = now
+	}
+}
+
+func toIncrement(row sqlc.ListIncrementsByMeetingRow) models.Increment {
+	return models.Increment{
+		ID:            row.ID,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+		MeetingID:     row.MeetingID,
+		StartTime:     row.StartTime,
+		StopTime:      row.StopTime,
+		AttendeeCount: int(row.AttendeeCount),
+		AverageWage:   row.AverageWage,
+		ElapsedTime:   int(row.ElapsedTime),
+		Cost:          row.Cost,
+		TotalCost:     row.TotalCost,
+		Purpose:       row.Purpose.String,
+	}
+}
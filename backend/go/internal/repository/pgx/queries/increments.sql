@@ -0,0 +1,53 @@
+-- name: CreateIncrement :exec
+INSERT INTO increments (
+    id, created_at, updated_at, meeting_id, start_time, stop_time,
+    attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+);
+
+-- name: CreateIncrements :copyfrom
+INSERT INTO increments (
+    id, created_at, updated_at, meeting_id, start_time, stop_time,
+    attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+);
+
+-- name: GetIncrement :one
+SELECT id, created_at, updated_at, meeting_id, start_time, stop_time,
+       attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+FROM increments
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListIncrementsByMeeting :many
+SELECT id, created_at, updated_at, meeting_id, start_time, stop_time,
+       attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+FROM increments
+WHERE meeting_id = $1 AND deleted_at IS NULL
+ORDER BY start_time ASC;
+
+-- name: UpdateIncrement :execrows
+UPDATE increments
+SET updated_at = $2,
+    meeting_id = $3,
+    start_time = $4,
+    stop_time = $5,
+    attendee_count = $6,
+    average_wage = $7,
+    elapsed_time = $8,
+    cost = $9,
+    total_cost = $10,
+    purpose = $11
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: SoftDeleteIncrement :one
+UPDATE increments
+SET deleted_at = $2
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING meeting_id;
+
+-- name: SoftDeleteIncrementsByMeeting :exec
+UPDATE increments
+SET deleted_at = $2
+WHERE meeting_id = $1 AND deleted_at IS NULL;
@@ -0,0 +1,18 @@
+-- Tables used by the pgx repositories, as created by the GORM models. Only
+-- read by sqlc for query checking; migrations own the real schema.
+
+CREATE TABLE increments (
+    id             uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at     timestamptz,
+    updated_at     timestamptz,
+    deleted_at     timestamptz NULL,
+    meeting_id     uuid NOT NULL,
+    start_time     timestamptz NOT NULL,
+    stop_time      timestamptz NOT NULL,
+    attendee_count bigint NOT NULL,
+    average_wage   numeric(10,2) NOT NULL,
+    elapsed_time   bigint NOT NULL,
+    cost           numeric(12,2) NOT NULL,
+    total_cost     numeric(12,2) NOT NULL,
+    purpose        text
+);
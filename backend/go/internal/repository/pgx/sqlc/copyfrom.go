@@ -0,0 +1,53 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: copyfrom.go
+
+package sqlc
+
+import (
+	"context"
+)
+
+// iteratorForCreateIncrements implements pgx.CopyFromSource.
+type iteratorForCreateIncrements struct {
+	rows                 []CreateIncrementsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateIncrements) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateIncrements) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].CreatedAt,
+		r.rows[0].UpdatedAt,
+		r.rows[0].MeetingID,
+		r.rows[0].StartTime,
+		r.rows[0].StopTime,
+		r.rows[0].AttendeeCount,
+		r.rows[0].AverageWage,
+		r.rows[0].ElapsedTime,
+		r.rows[0].Cost,
+		r.rows[0].TotalCost,
+		r.rows[0].Purpose,
+	}, nil
+}
+
+func (r iteratorForCreateIncrements) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateIncrements(ctx context.Context, arg []CreateIncrementsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"increments"}, []string{"id", "created_at", "updated_at", "meeting_id", "start_time", "stop_time", "attendee_count", "average_wage", "elapsed_time", "cost", "total_cost", "purpose"}, &iteratorForCreateIncrements{rows: arg})
+}
@@ -0,0 +1,253 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: increments.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createIncrement = `-- name: CreateIncrement :exec
+INSERT INTO increments (
+    id, created_at, updated_at, meeting_id, start_time, stop_time,
+    attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+)
+`
+
+type CreateIncrementParams struct {
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	MeetingID     uuid.UUID
+	StartTime     time.Time
+	StopTime      time.Time
+	AttendeeCount int64
+	AverageWage   float64
+	ElapsedTime   int64
+	Cost          float64
+	TotalCost     float64
+	Purpose       pgtype.Text
+}
+
+func (q *Queries) CreateIncrement(ctx context.Context, arg CreateIncrementParams) error {
+	_, err := q.db.Exec(ctx, createIncrement,
+		arg.ID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.MeetingID,
+		arg.StartTime,
+		arg.StopTime,
+		arg.AttendeeCount,
+		arg.AverageWage,
+		arg.ElapsedTime,
+		arg.Cost,
+		arg.TotalCost,
+		arg.Purpose,
+	)
+	return err
+}
+
+type CreateIncrementsParams struct {
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	MeetingID     uuid.UUID
+	StartTime     time.Time
+	StopTime      time.Time
+	AttendeeCount int64
+	AverageWage   float64
+	ElapsedTime   int64
+	Cost          float64
+	TotalCost     float64
+	Purpose       pgtype.Text
+}
+
+const getIncrement = `-- name: GetIncrement :one
+SELECT id, created_at, updated_at, meeting_id, start_time, stop_time,
+       attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+FROM increments
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type GetIncrementRow struct {
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	MeetingID     uuid.UUID
+	StartTime     time.Time
+	StopTime      time.Time
+	AttendeeCount int64
+	AverageWage   float64
+	ElapsedTime   int64
+	Cost          float64
+	TotalCost     float64
+	Purpose       pgtype.Text
+}
+
+func (q *Queries) GetIncrement(ctx context.Context, id uuid.UUID) (GetIncrementRow, error) {
+	row := q.db.QueryRow(ctx, getIncrement, id)
+	var i GetIncrementRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MeetingID,
+		&i.StartTime,
+		&i.StopTime,
+		&i.AttendeeCount,
+		&i.AverageWage,
+		&i.ElapsedTime,
+		&i.Cost,
+		&i.TotalCost,
+		&i.Purpose,
+	)
+	return i, err
+}
+
+const listIncrementsByMeeting = `-- name: ListIncrementsByMeeting :many
+SELECT id, created_at, updated_at, meeting_id, start_time, stop_time,
+       attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+FROM increments
+WHERE meeting_id = $1 AND deleted_at IS NULL
+ORDER BY start_time ASC
+`
+
+type ListIncrementsByMeetingRow struct {
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	MeetingID     uuid.UUID
+	StartTime     time.Time
+	StopTime      time.Time
+	AttendeeCount int64
+	AverageWage   float64
+	ElapsedTime   int64
+	Cost          float64
+	TotalCost     float64
+	Purpose       pgtype.Text
+}
+
+func (q *Queries) ListIncrementsByMeeting(ctx context.Context, meetingID uuid.UUID) ([]ListIncrementsByMeetingRow, error) {
+	rows, err := q.db.Query(ctx, listIncrementsByMeeting, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListIncrementsByMeetingRow
+	for rows.Next() {
+		var i ListIncrementsByMeetingRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MeetingID,
+			&i.StartTime,
+			&i.StopTime,
+			&i.AttendeeCount,
+			&i.AverageWage,
+			&i.ElapsedTime,
+			&i.Cost,
+			&i.TotalCost,
+			&i.Purpose,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDeleteIncrement = `-- name: SoftDeleteIncrement :one
+UPDATE increments
+SET deleted_at = $2
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING meeting_id
+`
+
+type SoftDeleteIncrementParams struct {
+	ID        uuid.UUID
+	DeletedAt time.Time
+}
+
+func (q *Queries) SoftDeleteIncrement(ctx context.Context, arg SoftDeleteIncrementParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, softDeleteIncrement, arg.ID, arg.DeletedAt)
+	var meeting_id uuid.UUID
+	err := row.Scan(&meeting_id)
+	return meeting_id, err
+}
+
+const softDeleteIncrementsByMeeting = `-- name: SoftDeleteIncrementsByMeeting :exec
+UPDATE increments
+SET deleted_at = $2
+WHERE meeting_id = $1 AND deleted_at IS NULL
+`
+
+type SoftDeleteIncrementsByMeetingParams struct {
+	MeetingID uuid.UUID
+	DeletedAt time.Time
+}
+
+func (q *Queries) SoftDeleteIncrementsByMeeting(ctx context.Context, arg SoftDeleteIncrementsByMeetingParams) error {
+	_, err := q.db.Exec(ctx, softDeleteIncrementsByMeeting, arg.MeetingID, arg.DeletedAt)
+	return err
+}
+
+const updateIncrement = `-- name: UpdateIncrement :execrows
+UPDATE increments
+SET updated_at = $2,
+    meeting_id = $3,
+    start_time = $4,
+    stop_time = $5,
+    attendee_count = $6,
+    average_wage = $7,
+    elapsed_time = $8,
+    cost = $9,
+    total_cost = $10,
+    purpose = $11
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type UpdateIncrementParams struct {
+	ID            uuid.UUID
+	UpdatedAt     time.Time
+	MeetingID     uuid.UUID
+	StartTime     time.Time
+	StopTime      time.Time
+	AttendeeCount int64
+	AverageWage   float64
+	ElapsedTime   int64
+	Cost          float64
+	TotalCost     float64
+	Purpose       pgtype.Text
+}
+
+func (q *Queries) UpdateIncrement(ctx context.Context, arg UpdateIncrementParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateIncrement,
+		arg.ID,
+		arg.UpdatedAt,
+		arg.MeetingID,
+		arg.StartTime,
+		arg.StopTime,
+		arg.AttendeeCount,
+		arg.AverageWage,
+		arg.ElapsedTime,
+		arg.Cost,
+		arg.TotalCost,
+		arg.Purpose,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -21,6 +21,9 @@ type PersonOrganizationProfileRepository interface {
 	// Update
 	Update(ctx context.Context, profile *models.PersonOrganizationProfile) error
 	UpdateWage(ctx context.Context, personID, orgID uuid.UUID, wage float64) error
+	SetMeetingApprover(ctx context.Context, personID, orgID uuid.UUID, approver bool) error
+	SetWageBand(ctx context.Context, personID, orgID uuid.UUID, bandID *uuid.UUID) error // Clears the exact wage
+	ClearWages(ctx context.Context, orgID uuid.UUID) error                               // Clears every member's wage and wage band
 
 	// Membership
 	Activate(ctx context.Context, personID, orgID uuid.UUID) error
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeRepository permanently removes soft-deleted rows.
+type PurgeRepository interface {
+	// PurgeDeleted hard-deletes rows soft-deleted before the cutoff and
+	// returns the number of rows removed per table.
+	PurgeDeleted(ctx context.Context, before time.Time) (map[string]int64, error)
+}
@@ -0,0 +1,178 @@
+// Package redis provides an AuthRepository that keeps sessions in Redis
+// alone, expiring with their TTL, instead of writing them to the database.
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type authRepository struct {
+	repository.AuthRepository
+	cache    cache.Cache
+	client   *goredis.Client
+	fallback bool
+}
+
+// NewAuthRepository wraps next so that sessions are stored in Redis under
+// their token hash until they expire, and every request's LastActivity
+// update stays out of the database. Auth methods still go to next. With
+// fallback, sessions missing from Redis are looked up in next, such as
+// those from before the switch, and sessions Redis can't take are written
+// to next; deleting a session deletes it from both.
+func NewAuthRepository(next repository.AuthRepository, c cache.Cache, fallback bool) repository.AuthRepository {
+	return &authRepository{AuthRepository: next, cache: c, client: c.GetClient(), fallback: fallback}
+}
+
+func (r *authRepository) CreateSession(ctx context.Context, session *models.Session) error {
+	if session.ID == uuid.Nil {
+		session.ID = uuid.Must(uuid.NewRandom())
+	}
+	now := time.Now()
+	session.CreatedAt, session.UpdatedAt = now, now
+	if err := r.store(ctx, session); err != nil {
+		if r.fallback {
+			return r.AuthRepository.CreateSession(ctx, session)
+		}
+		return fmt.Errorf("creating session: %w", err)
+	}
+	return nil
+}
+
+func (r *authRepository) GetSessionByTokenHash(ctx context.Context, tokenHash string) (*models.Session, error) {
+	var session models.Session
+	err := r.cache.Get(ctx, cache.KeySession(tokenHash), &session)
+	if err == nil {
+		return &session, nil
+	}
+	if r.fallback {
+		return r.AuthRepository.GetSessionByTokenHash(ctx, tokenHash)
+	}
+	return nil, fmt.Errorf("session not found: %w", err)
+}
+
+func (r *authRepository) GetSessionsByPerson(ctx context.Context, personID uuid.UUID) ([]*models.Session, error) {
+	hashes, err := r.client.SMembers(ctx, cache.KeyPersonSessions(personID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions by person: %w", err)
+	}
+
+	var sessions []*models.Session
+	seen := make(map[uuid.UUID]bool, len(hashes))
+	for _, hash := range hashes {
+		var session models.Session
+		if err := r.cache.Get(ctx, cache.KeySession(hash), &session); err != nil {
+			// Expired; forget it
+			r.client.SRem(ctx, cache.KeyPersonSessions(personID), hash)
+			continue
+		}
+		sessions = append(sessions, &session)
+		seen[session.ID] = true
+	}
+
+	if r.fallback {
+		stored, err := r.AuthRepository.GetSessionsByPerson(ctx, personID)
+		if err != nil {
+			return nil, err
+		}
+		for _, session := range stored {
+			if !seen[session.ID] {
+				sessions = append(sessions, session)
+			}
+		}
+	}
+	return sessions, nil
+}
+
+func (r *authRepository) UpdateSession(ctx context.Context, session *models.Session) error {
+	session.UpdatedAt = time.Now()
+	if err := r.store(ctx, session); err != nil {
+		if r.fallback {
+			return r.AuthRepository.UpdateSession(ctx, session)
+		}
+		return fmt.Errorf("updating session: %w", err)
+	}
+	return nil
+}
+
+func (r *authRepository) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	var hash string
+	if err := r.cache.Get(ctx, cache.KeySessionByID(id), &hash); err == nil {
+		var session models.Session
+		if err := r.cache.Get(ctx, cache.KeySession(hash), &session); err == nil {
+			r.client.SRem(ctx, cache.KeyPersonSessions(session.PersonID), hash)
+		}
+		if err := r.cache.Delete(ctx, cache.KeySession(hash)); err != nil {
+			return fmt.Errorf("deleting session: %w", err)
+		}
+		_ = r.cache.Delete(ctx, cache.KeySessionByID(id))
+	}
+	if r.fallback {
+		return r.AuthRepository.DeleteSession(ctx, id)
+	}
+	return nil
+}
+
+// DeleteExpiredSessions leaves sessions in Redis to their TTL and deletes
+// only those in the database, when falling back to it.
+func (r *authRepository) DeleteExpiredSessions(ctx context.Context) (int64, error) {
+	if r.fallback {
+		return r.AuthRepository.DeleteExpiredSessions(ctx)
+	}
+	return 0, nil
+}
+
+func (r *authRepository) DeleteSessionsByPerson(ctx context.Context, personID uuid.UUID) error {
+	key := cache.KeyPersonSessions(personID)
+	hashes, err := r.client.SMembers(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("deleting sessions by person: %w", err)
+	}
+	for _, hash := range hashes {
+		var session models.Session
+		if err := r.cache.Get(ctx, cache.KeySession(hash), &session); err == nil {
+			_ = r.cache.Delete(ctx, cache.KeySessionByID(session.ID))
+		}
+		if err := r.cache.Delete(ctx, cache.KeySession(hash)); err != nil {
+			return fmt.Errorf("deleting sessions by person: %w", err)
+		}
+	}
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("deleting sessions by person: %w", err)
+	}
+	if r.fallback {
+		return r.AuthRepository.DeleteSessionsByPerson(ctx, personID)
+	}
+	return nil
+}
+
+// store writes the session under its token hash and ID, and adds it to its
+// person's sessions, all expiring with it.
+func (r *authRepository) store(ctx context.Context, session *models.Session) error {
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return fmt.Errorf("session expired at %s", session.ExpiresAt.Format(time.RFC3339))
+	}
+	if err := r.cache.Set(ctx, cache.KeySession(session.TokenHash), session, ttl); err != nil {
+		return err
+	}
+	if err := r.cache.Set(ctx, cache.KeySessionByID(session.ID), session.TokenHash, ttl); err != nil {
+		return err
+	}
+
+	// The set lives as long as the person's newest session
+	key := cache.KeyPersonSessions(session.PersonID)
+	pipe := r.client.TxPipeline()
+	pipe.SAdd(ctx, key, session.TokenHash)
+	pipe.ExpireGT(ctx, key, ttl)
+	pipe.ExpireNX(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportRepository aggregates meeting data for reports in the database.
+// Summary and Trend may read whole days from daily aggregates, which lag
+// behind the meetings until RefreshRollups next runs. The aggregates are
+// of UTC days, so only UTC trends read them.
+type ReportRepository interface {
+	// Summary totals the organization's meetings started in [from, to).
+	Summary(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*MeetingSummary, error)
+	// Trend totals the organization's meeting time in [from, to) by
+	// interval in loc, for the buckets that have any, oldest first.
+	Trend(ctx context.Context, orgID uuid.UUID, from, to time.Time, interval string, loc *time.Location) ([]*TrendBucket, error)
+	// TopMeetings returns the limit most expensive of the organization's
+	// meetings started in [from, to), most expensive first.
+	TopMeetings(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*MeetingCost, error)
+	// TopSeries groups the organization's meetings started in [from, to)
+	// into series by purpose, ignoring case and surrounding space, and
+	// returns the limit most expensive series of two or more meetings.
+	TopSeries(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*SeriesCost, error)
+	// RatingSummary totals the organization's rated meetings started in
+	// [from, to). Meetings averaging poorRating or less count as poorly
+	// rated.
+	RatingSummary(ctx context.Context, orgID uuid.UUID, from, to time.Time, poorRating float64) (*RatingSummary, error)
+	// RatedMeetings returns the limit most expensive of the organization's
+	// rated meetings started in [from, to), most expensive first.
+	RatedMeetings(ctx context.Context, orgID uuid.UUID, from, to time.Time, limit int) ([]*RatedMeeting, error)
+	// LateStarts totals the late starts of the organization's scheduled
+	// meetings started in [from, to).
+	LateStarts(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*LateStartSummary, error)
+	// Overruns totals the overruns of the organization's meetings with a
+	// scheduled end started in [from, to).
+	Overruns(ctx context.Context, orgID uuid.UUID, from, to time.Time) (*OverrunSummary, error)
+	// OffHours totals the organization's meetings started in [from, to)
+	// outside hours, by whether they started on a holiday or otherwise
+	// outside working time.
+	OffHours(ctx context.Context, orgID uuid.UUID, from, to time.Time, hours *WorkingHours) (*OffHoursSummary, error)
+	// RefreshRollups recomputes the daily aggregates of every
+	// organization and day whose meetings or increments changed since the
+	// last refresh, as of now, and returns how many days it refreshed.
+	RefreshRollups(ctx context.Context, now time.Time) (int64, error)
+}
+
+// Trend intervals, named as Postgres date_trunc fields.
+const (
+	IntervalDay   = "day"
+	IntervalWeek  = "week"
+	IntervalMonth = "month"
+)
+
+// TruncateInterval returns the start of the day, ISO week or month in loc
+// containing t, as date_trunc does.
+func TruncateInterval(t time.Time, interval string, loc *time.Location) time.Time {
+	t = t.In(loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	switch interval {
+	case IntervalWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case IntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	}
+	return day
+}
+
+// NextInterval returns the start of the bucket after the one starting at
+// start.
+func NextInterval(start time.Time, interval string) time.Time {
+	switch interval {
+	case IntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case IntervalMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// When a meeting started against an organization's working hours.
+const (
+	InHours  = ""
+	OffHours = "off_hours" // On a working day but outside its hours, or on a day not worked
+	Holiday  = "holiday"
+)
+
+// WorkingHours is when an organization works: from Start to End, as
+// "15:04", on Days, in Location, other than on Holidays.
+type WorkingHours struct {
+	Days       []time.Weekday
+	Start, End string
+	// Holidays are the dates not worked, as "2006-01-02"
+	Holidays []string
+	Location *time.Location
+}
+
+// Classify returns whether t falls in working hours: InHours, OffHours
+// or Holiday.
+func (w *WorkingHours) Classify(t time.Time) string {
+	t = t.In(w.Location)
+	if slices.Contains(w.Holidays, t.Format(time.DateOnly)) {
+		return Holiday
+	}
+	clock := t.Format("15:04")
+	if !slices.Contains(w.Days, t.Weekday()) || clock < w.Start || clock >= w.End {
+		return OffHours
+	}
+	return InHours
+}
+
+// TrendBucket is the meeting time of one interval.
+type TrendBucket struct {
+	Start   time.Time
+	Cost    float64
+	Seconds int64
+}
+
+// MeetingSummary is the aggregate of a set of meetings.
+type MeetingSummary struct {
+	MeetingCount int64
+	TotalCost    float64
+	// TotalSeconds is the meetings' combined duration
+	TotalSeconds int64
+	// AvgAttendees averages each meeting's peak attendance
+	AvgAttendees float64
+	// AttendeeSeconds sums the time of every attendee of the meetings
+	AttendeeSeconds int64
+}
+
+// LateStartSummary is the aggregate of a set of scheduled meetings' starts.
+type LateStartSummary struct {
+	ScheduledCount int64
+	// LateCount counts the meetings that started late, LateSeconds adds up
+	// by how much and LateCost what the waiting cost
+	LateCount   int64
+	LateSeconds int64
+	LateCost    float64
+}
+
+// OverrunSummary is the aggregate of a set of scheduled meetings' ends.
+type OverrunSummary struct {
+	ScheduledCount int64
+	// OverrunCount counts the meetings that ran past their scheduled end,
+	// OverrunSeconds adds up by how much and OverrunCost what that time cost
+	OverrunCount   int64
+	OverrunSeconds int64
+	OverrunCost    float64
+}
+
+// OffHoursSummary is the aggregate of a set of meetings started outside
+// working hours, holidays apart from the rest.
+type OffHoursSummary struct {
+	OffHoursCount   int64
+	OffHoursSeconds int64
+	OffHoursCost    float64
+	HolidayCount    int64
+	HolidaySeconds  int64
+	HolidayCost     float64
+}
+
+// MeetingCost is one meeting and its organizer, for reports.
+type MeetingCost struct {
+	MeetingID          uuid.UUID
+	Purpose            string
+	StartedAt          time.Time
+	Seconds            int64
+	MaxAttendees       int
+	AttendeeSeconds    int64
+	TotalCost          float64
+	OrganizerID        uuid.UUID
+	OrganizerFirstName string
+	OrganizerLastName  string
+}
+
+// SeriesCost is the aggregate of meetings sharing a purpose.
+type SeriesCost struct {
+	// Purpose as written on one of the meetings
+	Purpose      string
+	MeetingCount int64
+	TotalCost    float64
+	TotalSeconds int64
+	AvgAttendees float64
+	// AttendeeSeconds sums the time of every attendee of the meetings
+	AttendeeSeconds int64
+	LastStartedAt   time.Time
+}
+
+// RatingSummary is the aggregate of a set of rated meetings.
+type RatingSummary struct {
+	MeetingCount int64
+	// Responses counts the ratings and RatingTotal adds them up
+	Responses   int64
+	RatingTotal int64
+	TotalCost   float64
+	// PoorlyRatedCount and PoorlyRatedCost cover the meetings whose
+	// average rating is poor
+	PoorlyRatedCount int64
+	PoorlyRatedCost  float64
+}
+
+// RatedMeeting is one meeting and its participants' ratings.
+type RatedMeeting struct {
+	MeetingID    uuid.UUID
+	Purpose      string
+	StartedAt    time.Time
+	Seconds      int64
+	MaxAttendees int
+	TotalCost    float64
+	Responses    int64
+	AvgRating    float64
+}
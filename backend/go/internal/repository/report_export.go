@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// ReportExportRepository stores report exports and their files.
+type ReportExportRepository interface {
+	Create(ctx context.Context, export *models.ReportExport) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ReportExport, error)
+	Update(ctx context.Context, export *models.ReportExport) error
+	// DeleteExpired deletes exports that expired before the cutoff and
+	// returns how many it removed.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
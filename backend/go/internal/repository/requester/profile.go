@@ -0,0 +1,105 @@
+package requester
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type profileRepository struct {
+	repository.PersonOrganizationProfileRepository
+}
+
+// NewProfileRepository wraps next so that lookups of the requester's own
+// memberships are answered from the request's Requester. Other people's
+// go to next, as does everything outside a request. Changes to memberships
+// make the Requester read them again.
+func NewProfileRepository(next repository.PersonOrganizationProfileRepository) repository.PersonOrganizationProfileRepository {
+	return &profileRepository{PersonOrganizationProfileRepository: next}
+}
+
+// requester returns the request's Requester if it is personID.
+func requester(ctx context.Context, personID uuid.UUID) *Requester {
+	if r := FromContext(ctx); r != nil && r.PersonID == personID {
+		return r
+	}
+	return nil
+}
+
+func (r *profileRepository) GetByPersonAndOrg(ctx context.Context, personID, orgID uuid.UUID) (*models.PersonOrganizationProfile, error) {
+	req := requester(ctx, personID)
+	if req == nil {
+		return r.PersonOrganizationProfileRepository.GetByPersonAndOrg(ctx, personID, orgID)
+	}
+	profile, err := req.Profile(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, fmt.Errorf("profile not found")
+	}
+	return profile, nil
+}
+
+func (r *profileRepository) GetByPerson(ctx context.Context, personID uuid.UUID) ([]*models.PersonOrganizationProfile, error) {
+	if req := requester(ctx, personID); req != nil {
+		return req.Profiles(ctx)
+	}
+	return r.PersonOrganizationProfileRepository.GetByPerson(ctx, personID)
+}
+
+// forget makes the request's Requester read memberships again after a
+// change to personID's, or to anyone's when personID is nil.
+func forget(ctx context.Context, personID *uuid.UUID) {
+	if req := FromContext(ctx); req != nil && (personID == nil || req.PersonID == *personID) {
+		req.Forget()
+	}
+}
+
+func (r *profileRepository) Create(ctx context.Context, profile *models.PersonOrganizationProfile) error {
+	defer forget(ctx, &profile.PersonID)
+	return r.PersonOrganizationProfileRepository.Create(ctx, profile)
+}
+
+func (r *profileRepository) Update(ctx context.Context, profile *models.PersonOrganizationProfile) error {
+	defer forget(ctx, &profile.PersonID)
+	return r.PersonOrganizationProfileRepository.Update(ctx, profile)
+}
+
+func (r *profileRepository) UpdateWage(ctx context.Context, personID, orgID uuid.UUID, wage float64) error {
+	defer forget(ctx, &personID)
+	return r.PersonOrganizationProfileRepository.UpdateWage(ctx, personID, orgID, wage)
+}
+
+func (r *profileRepository) SetMeetingApprover(ctx context.Context, personID, orgID uuid.UUID, approver bool) error {
+	defer forget(ctx, &personID)
+	return r.PersonOrganizationProfileRepository.SetMeetingApprover(ctx, personID, orgID, approver)
+}
+
+func (r *profileRepository) SetWageBand(ctx context.Context, personID, orgID uuid.UUID, bandID *uuid.UUID) error {
+	defer forget(ctx, &personID)
+	return r.PersonOrganizationProfileRepository.SetWageBand(ctx, personID, orgID, bandID)
+}
+
+func (r *profileRepository) ClearWages(ctx context.Context, orgID uuid.UUID) error {
+	defer forget(ctx, nil)
+	return r.PersonOrganizationProfileRepository.ClearWages(ctx, orgID)
+}
+
+func (r *profileRepository) Activate(ctx context.Context, personID, orgID uuid.UUID) error {
+	defer forget(ctx, &personID)
+	return r.PersonOrganizationProfileRepository.Activate(ctx, personID, orgID)
+}
+
+func (r *profileRepository) Deactivate(ctx context.Context, personID, orgID uuid.UUID) error {
+	defer forget(ctx, &personID)
+	return r.PersonOrganizationProfileRepository.Deactivate(ctx, personID, orgID)
+}
+
+func (r *profileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	defer forget(ctx, nil)
+	return r.PersonOrganizationProfileRepository.Delete(ctx, id)
+}
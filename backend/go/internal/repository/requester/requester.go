@@ -0,0 +1,145 @@
+// Package requester keeps the signed-in person's organization memberships
+// and role names in the request context, loaded once when first needed,
+// and provides a PersonOrganizationProfileRepository that answers the
+// person's own membership lookups from them before asking the repository
+// it wraps.
+package requester
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+type contextKey string
+
+// ContextKeyRequester is the context key the requester middleware stores
+// the request's *Requester under.
+const ContextKeyRequester contextKey = "requester"
+
+// FromContext returns the requester of the request ctx belongs to, or nil
+// outside an authenticated request.
+func FromContext(ctx context.Context) *Requester {
+	r, _ := ctx.Value(ContextKeyRequester).(*Requester)
+	return r
+}
+
+// Loader creates each request's Requester, reading memberships from
+// repositories that must not themselves consult the request's Requester.
+type Loader struct {
+	profileRepo    repository.PersonOrganizationProfileRepository
+	permissionRepo repository.PermissionRepository
+}
+
+// NewLoader creates a Loader that reads from profileRepo and
+// permissionRepo.
+func NewLoader(profileRepo repository.PersonOrganizationProfileRepository, permissionRepo repository.PermissionRepository) *Loader {
+	return &Loader{profileRepo: profileRepo, permissionRepo: permissionRepo}
+}
+
+// New returns a Requester for personID that loads nothing until asked.
+func (l *Loader) New(personID uuid.UUID) *Requester {
+	return &Requester{PersonID: personID, loader: l}
+}
+
+// Requester is the signed-in person's memberships, active or not, and the
+// names of their roles in the organizations they are active in. Nothing is
+// read until first asked for, so requests that don't need them cost no
+// queries.
+type Requester struct {
+	PersonID uuid.UUID
+
+	loader *Loader
+
+	mu       sync.Mutex
+	profiles map[uuid.UUID]*models.PersonOrganizationProfile // nil until loaded
+	roles    map[uuid.UUID][]string                          // By organization, as read
+}
+
+// load reads the memberships if they aren't loaded. The caller must hold
+// mu.
+func (r *Requester) load(ctx context.Context) error {
+	if r.profiles != nil {
+		return nil
+	}
+
+	profiles, err := r.loader.profileRepo.GetByPerson(ctx, r.PersonID)
+	if err != nil {
+		return err
+	}
+	r.profiles = make(map[uuid.UUID]*models.PersonOrganizationProfile, len(profiles))
+	for _, p := range profiles {
+		r.profiles[p.OrganizationID] = p
+	}
+	r.roles = make(map[uuid.UUID][]string)
+	return nil
+}
+
+// Profile returns a copy of the person's membership of orgID, or nil when
+// they have none.
+func (r *Requester) Profile(ctx context.Context, orgID uuid.UUID) (*models.PersonOrganizationProfile, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+	p, ok := r.profiles[orgID]
+	if !ok {
+		return nil, nil
+	}
+	profile := *p
+	return &profile, nil
+}
+
+// Profiles returns copies of all the person's memberships.
+func (r *Requester) Profiles(ctx context.Context) ([]*models.PersonOrganizationProfile, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+	profiles := make([]*models.PersonOrganizationProfile, 0, len(r.profiles))
+	for _, p := range r.profiles {
+		profile := *p
+		profiles = append(profiles, &profile)
+	}
+	return profiles, nil
+}
+
+// Roles returns the names of the person's roles in orgID, or none when
+// they aren't an active member. Each organization's are read once.
+func (r *Requester) Roles(ctx context.Context, orgID uuid.UUID) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+	if p, ok := r.profiles[orgID]; !ok || !p.IsActive {
+		return nil, nil
+	}
+	if names, ok := r.roles[orgID]; ok {
+		return names, nil
+	}
+
+	roles, err := r.loader.permissionRepo.GetRolesByPerson(ctx, r.PersonID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("getting roles: %w", err)
+	}
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = role.Name
+	}
+	r.roles[orgID] = names
+	return names, nil
+}
+
+// Forget drops what was loaded, so it is read again when next needed.
+func (r *Requester) Forget() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.profiles, r.roles = nil, nil
+}
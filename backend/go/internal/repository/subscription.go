@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// SubscriptionRepository handles organizations' subscriptions and their
+// invoices and payments.
+type SubscriptionRepository interface {
+	Create(ctx context.Context, sub *models.Subscription) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Subscription, error)
+	// GetByOrganization returns the organization's most recent
+	// subscription, or nil if it never subscribed.
+	GetByOrganization(ctx context.Context, orgID uuid.UUID) (*models.Subscription, error)
+	// GetByStripeSubscriptionID returns the subscription linked to a Stripe
+	// subscription, or nil if none is.
+	GetByStripeSubscriptionID(ctx context.Context, stripeID string) (*models.Subscription, error)
+	Update(ctx context.Context, sub *models.Subscription) error
+	// ListLocalTrials returns the trialing subscriptions not billed through
+	// Stripe, which runs its own trials.
+	ListLocalTrials(ctx context.Context) ([]*models.Subscription, error)
+
+	// SavePayment inserts the payment or updates the one with the same
+	// Stripe payment ID. A payment that succeeded is left as it is, so a
+	// late failure event cannot overwrite it.
+	SavePayment(ctx context.Context, payment *models.Payment) error
+	// ListPayments returns a subscription's payments, newest first.
+	ListPayments(ctx context.Context, subscriptionID uuid.UUID, pagination Pagination) ([]*models.Payment, int64, error)
+
+	// SaveInvoice inserts the invoice or updates the one with the same
+	// Stripe invoice ID. A paid or void invoice is final and left as it is,
+	// so an event arriving late cannot reopen it.
+	SaveInvoice(ctx context.Context, invoice *models.Invoice) error
+	// ListInvoices returns an organization's invoices, most recently issued
+	// first.
+	ListInvoices(ctx context.Context, orgID uuid.UUID, pagination Pagination) ([]*models.Invoice, int64, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// SyncOperationRepository records the outcomes of operations clients sync.
+type SyncOperationRepository interface {
+	// Claim records op as pending, returning nil, unless its person has
+	// already synced an operation with its OperationID, whose record it
+	// returns instead.
+	Claim(ctx context.Context, op *models.SyncOperation) (*models.SyncOperation, error)
+	// Update saves the outcome of a claimed operation.
+	Update(ctx context.Context, op *models.SyncOperation) error
+	// Delete releases a claim, so the operation can be synced again.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
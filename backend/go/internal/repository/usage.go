@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// UsageRepository handles organizations' monthly billable usage.
+type UsageRepository interface {
+	// AddUsage adds seconds of meeting time to the organization's record
+	// for period, creating it if needed, and raises its active members to
+	// activeMembers if that is higher.
+	AddUsage(ctx context.Context, orgID uuid.UUID, period time.Time, seconds int64, activeMembers int) error
+	// ListByOrganization returns the organization's records from period
+	// since onwards, newest first.
+	ListByOrganization(ctx context.Context, orgID uuid.UUID, since time.Time) ([]*models.UsageRecord, error)
+	// ListUnreported returns the records from period since onwards with
+	// usage not yet reported to Stripe, oldest first.
+	ListUnreported(ctx context.Context, since time.Time) ([]*models.UsageRecord, error)
+	// MarkReported records the usage reported to Stripe for a record.
+	MarkReported(ctx context.Context, id uuid.UUID, minutes int64, activeMembers int, at time.Time) error
+}
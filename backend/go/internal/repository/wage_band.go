@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// WageBandRepository handles organizations' wage bands.
+type WageBandRepository interface {
+	Create(ctx context.Context, band *models.WageBand) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.WageBand, error)
+	// ListByOrganization returns the organization's bands, lowest first.
+	ListByOrganization(ctx context.Context, orgID uuid.UUID) ([]*models.WageBand, error)
+	Update(ctx context.Context, band *models.WageBand) error
+	// Delete removes the band; members assigned it are left without one.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
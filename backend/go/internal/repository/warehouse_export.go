@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// WarehouseExportRepository handles organizations' data warehouse exports
+// and reads the changes they push.
+type WarehouseExportRepository interface {
+	Create(ctx context.Context, export *models.WarehouseExport) error
+	// GetByProvider returns the organization's export to provider.
+	GetByProvider(ctx context.Context, orgID uuid.UUID, provider string) (*models.WarehouseExport, error)
+	Update(ctx context.Context, export *models.WarehouseExport) error
+	// Claim sets the export's RunningUntil to until unless a run holds it
+	// past now, and reports whether it did.
+	Claim(ctx context.Context, id uuid.UUID, now, until time.Time) (bool, error)
+
+	// ChangedMeetings returns up to limit of the organization's meetings
+	// changed after the watermark and no later than until, deleted ones
+	// included, in order of change. Meetings that aren't deleted come
+	// with their increments.
+	ChangedMeetings(ctx context.Context, orgID uuid.UUID, after Watermark, until time.Time, limit int) ([]*MeetingChange, error)
+	// ChangedMemberships returns up to limit of the organization's
+	// memberships whose profile or person changed after the watermark and
+	// no later than until, deleted ones included, in order of change.
+	ChangedMemberships(ctx context.Context, orgID uuid.UUID, after Watermark, until time.Time, limit int) ([]*MembershipChange, error)
+}
+
+// Watermark is the change time and ID of the last row an export pushed;
+// changes are read in (time, ID) order. The zero Watermark reads from the
+// start.
+type Watermark struct {
+	At time.Time
+	ID uuid.UUID
+}
+
+// MeetingChange is a meeting as it is now, with its increments.
+type MeetingChange struct {
+	Meeting   *models.Meeting
+	ChangedAt time.Time
+}
+
+// MeetingChangedAt is when the meeting last changed: its last update,
+// increment compaction or deletion.
+func MeetingChangedAt(m *models.Meeting) time.Time {
+	at := m.UpdatedAt
+	if m.IncrementsCompactedAt != nil && m.IncrementsCompactedAt.After(at) {
+		at = *m.IncrementsCompactedAt
+	}
+	if m.DeletedAt.Valid && m.DeletedAt.Time.After(at) {
+		at = m.DeletedAt.Time
+	}
+	return at
+}
+
+// MembershipChange is a member of an organization as they are now. It
+// carries no wages.
+type MembershipChange struct {
+	ProfileID       uuid.UUID
+	PersonID        uuid.UUID
+	Email           string
+	FirstName       string
+	LastName        string
+	IsActive        bool
+	JoinedAt        time.Time
+	LeftAt          *time.Time
+	MeetingApprover bool
+	CreatedAt       time.Time
+	DeletedAt       *time.Time
+	ChangedAt       time.Time
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// WebhookRepository handles webhook endpoints and their delivery history.
+type WebhookRepository interface {
+	// Endpoints
+	CreateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error
+	GetEndpoint(ctx context.Context, id uuid.UUID) (*models.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context, orgID uuid.UUID) ([]*models.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error
+	DeleteEndpoint(ctx context.Context, id uuid.UUID) error
+
+	// Deliveries
+	CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// GetDelivery loads the delivery with its Endpoint.
+	GetDelivery(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// ListDeliveries returns an endpoint's deliveries, newest first, and
+	// the total count.
+	ListDeliveries(ctx context.Context, endpointID uuid.UUID, pagination Pagination) ([]*models.WebhookDelivery, int64, error)
+}
@@ -0,0 +1,228 @@
+// Package rls scopes database connections to a tenant for the PostgreSQL
+// row-level security policies on meetings and their rows.
+//
+// A request's scope is carried in its context as a *Conn. GORM statements
+// made with that context run on the connection it holds, which is set up
+// with the scope when first used; statements made without one run
+// unscoped, as background jobs and unauthenticated routes do, and the
+// policies let them see every row.
+package rls
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Tables are those the row-level security policies cover.
+var Tables = []string{"meetings", "increments", "meeting_participants", "meeting_ratings", "meeting_archives", "meeting_archive_days"}
+
+// Scope is the tenant a connection is limited to.
+type Scope struct {
+	PersonID        uuid.UUID
+	OrganizationIDs []uuid.UUID
+}
+
+type contextKey string
+
+// ContextKeyConn is the context key a request's *Conn is stored under.
+const ContextKeyConn contextKey = "rls_conn"
+
+// ErrReleased is returned for statements made with a context whose scoped
+// connection has been released, such as from a goroutine outliving its
+// request.
+var ErrReleased = errors.New("scoped connection released")
+
+// Conn is a connection scoped to a tenant. It is taken from the pool on
+// first use and held until Release.
+type Conn struct {
+	db    *sql.DB
+	scope Scope
+
+	mu       sync.Mutex
+	conn     *sql.Conn
+	released bool
+}
+
+// NewConn returns a connection from db's pool scoped to scope.
+func NewConn(db *sql.DB, scope Scope) *Conn {
+	return &Conn{db: db, scope: scope}
+}
+
+// get returns the scoped connection, taking and setting it up on first use.
+func (c *Conn) get(ctx context.Context) (*sql.Conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.released {
+		return nil, ErrReleased
+	}
+	if c.conn != nil {
+		return c.conn, nil
+	}
+
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orgIDs := make([]string, len(c.scope.OrganizationIDs))
+	for i, id := range c.scope.OrganizationIDs {
+		orgIDs[i] = id.String()
+	}
+	if _, err := conn.ExecContext(ctx,
+		"SELECT set_config('app.tenant_scoped', 'on', false), set_config('app.organization_ids', $1, false), set_config('app.person_id', $2, false)",
+		strings.Join(orgIDs, ","), c.scope.PersonID.String(),
+	); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("scoping connection: %w", err)
+	}
+	c.conn = conn
+	return conn, nil
+}
+
+// Release clears the scope and returns the connection to the pool. A
+// connection that can't be cleared is discarded, so no scope outlives its
+// request.
+func (c *Conn) Release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.released = true
+	if c.conn == nil {
+		return
+	}
+	if _, err := c.conn.ExecContext(context.Background(),
+		"SELECT set_config('app.tenant_scoped', '', false), set_config('app.organization_ids', '', false), set_config('app.person_id', '', false)",
+	); err != nil {
+		_ = c.conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	}
+	_ = c.conn.Close()
+	c.conn = nil
+}
+
+func connFrom(ctx context.Context) *Conn {
+	if ctx == nil {
+		return nil
+	}
+	conn, _ := ctx.Value(ContextKeyConn).(*Conn)
+	return conn
+}
+
+// connPool sends GORM statements to the scoped connection of their
+// context, or to the pool when they have none.
+type connPool struct {
+	db *sql.DB
+}
+
+// Install routes db's statements through the scoped connection of their
+// context.
+func Install(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	pool := &connPool{db: sqlDB}
+	db.ConnPool = pool
+	db.Statement.ConnPool = pool
+	return nil
+}
+
+func (p *connPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	if c := connFrom(ctx); c != nil {
+		conn, err := c.get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return conn.PrepareContext(ctx, query)
+	}
+	return p.db.PrepareContext(ctx, query)
+}
+
+func (p *connPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if c := connFrom(ctx); c != nil {
+		conn, err := c.get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return conn.ExecContext(ctx, query, args...)
+	}
+	return p.db.ExecContext(ctx, query, args...)
+}
+
+func (p *connPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if c := connFrom(ctx); c != nil {
+		conn, err := c.get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return conn.QueryContext(ctx, query, args...)
+	}
+	return p.db.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext can't return an error, so a failure to scope the
+// connection surfaces from the row's Scan as a cancelled query.
+func (p *connPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if c := connFrom(ctx); c != nil {
+		conn, err := c.get(ctx)
+		if err != nil {
+			cancelled, cancel := context.WithCancel(ctx)
+			cancel()
+			return p.db.QueryRowContext(cancelled, query, args...)
+		}
+		return conn.QueryRowContext(ctx, query, args...)
+	}
+	return p.db.QueryRowContext(ctx, query, args...)
+}
+
+func (p *connPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	if c := connFrom(ctx); c != nil {
+		conn, err := c.get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return conn.BeginTx(ctx, opts)
+	}
+	return p.db.BeginTx(ctx, opts)
+}
+
+// GetDBConn lets gorm.DB.DB find the pool.
+func (p *connPool) GetDBConn() (*sql.DB, error) {
+	return p.db, nil
+}
+
+// Verify checks the policies bind the role db connects as: each table has
+// row-level security enabled, and either belongs to another role or forces
+// it on its owner, and the role can't bypass it.
+func Verify(ctx context.Context, db *sql.DB) error {
+	var bypass bool
+	if err := db.QueryRowContext(ctx, "SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user").Scan(&bypass); err != nil {
+		return fmt.Errorf("checking database role: %w", err)
+	}
+	if bypass {
+		return errors.New("row-level security: the database role is a superuser or bypasses row-level security")
+	}
+
+	for _, table := range Tables {
+		var enforced bool
+		err := db.QueryRowContext(ctx, `
+			SELECT c.relrowsecurity AND (c.relforcerowsecurity OR pg_get_userbyid(c.relowner) <> current_user)
+			FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+			WHERE n.nspname = current_schema() AND c.relname = $1`, table).Scan(&enforced)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("row-level security: table %s doesn't exist; run the migrations", table)
+		}
+		if err != nil {
+			return fmt.Errorf("checking row-level security on %s: %w", table, err)
+		}
+		if !enforced {
+			return fmt.Errorf("row-level security isn't enforced on %s: run the migrations, and connect as a role that doesn't own it or set FORCE ROW LEVEL SECURITY", table)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Package seed loads a demo organization with users, roles and a few weeks of
+// finished meetings, so a fresh database or demo instance has realistic data.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/container"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// Password is shared by every seeded user.
+const Password = "demo-password"
+
+// OrganizationName names the seeded organization.
+const OrganizationName = "Acme Demo"
+
+// AdminEmail signs in as the organization's Admin; the other users are
+// Members.
+const AdminEmail = "admin@demo.meetingcost.local"
+
+var users = []struct {
+	email     string
+	firstName string
+	lastName  string
+	wage      float64
+}{
+	{AdminEmail, "Ada", "Admin", 95},
+	{"alice@demo.meetingcost.local", "Alice", "Anders", 70},
+	{"bob@demo.meetingcost.local", "Bob", "Brown", 55},
+	{"carol@demo.meetingcost.local", "Carol", "Chen", 85},
+	{"dave@demo.meetingcost.local", "Dave", "Diaz", 62},
+	{"erin@demo.meetingcost.local", "Erin", "Evans", 110},
+}
+
+var purposes = []string{
+	"Daily standup",
+	"Sprint planning",
+	"Quarterly roadmap review",
+	"Design critique",
+	"Incident retrospective",
+	"1:1 sync",
+	"All-hands",
+	"Customer escalation",
+}
+
+// Result summarizes what Run created.
+type Result struct {
+	OrganizationID uuid.UUID
+	People         int
+	Meetings       int
+	Skipped        bool
+}
+
+// Run seeds the demo data through ctn. It is idempotent: if the admin user
+// already exists nothing is written and Result.Skipped is set. Meetings are
+// generated from a fixed random seed so every run produces the same shape,
+// ending at now.
+func Run(ctx context.Context, ctn *container.Container, now time.Time) (*Result, error) {
+	if existing, _ := ctn.PersonRepo.GetByEmail(ctx, AdminEmail); existing != nil {
+		return &Result{Skipped: true}, nil
+	}
+
+	// 1. Users with known credentials
+	people := make([]uuid.UUID, len(users))
+	for i, u := range users {
+		resp, err := ctn.AuthService.Register(ctx, service.RegisterRequest{
+			Email:     u.email,
+			Password:  Password,
+			FirstName: u.firstName,
+			LastName:  u.lastName,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("registering %s: %w", u.email, err)
+		}
+		people[i] = resp.User.ID
+	}
+	adminID := people[0]
+
+	// 2. Organization; creating it seeds the Admin and Member roles
+	org, err := ctn.OrgService.CreateOrganization(ctx, adminID, service.CreateOrganizationRequest{
+		Name:        OrganizationName,
+		Description: "Seeded demo organization",
+		DefaultWage: 75,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating organization: %w", err)
+	}
+	if err := ctn.OrgService.UpdateMemberWage(ctx, org.ID, adminID, users[0].wage, adminID, "", ""); err != nil {
+		return nil, fmt.Errorf("setting admin wage: %w", err)
+	}
+	// A paid plan rather than the signup trial, so the demo is not held to
+	// the free plan's member limit once the trial would have lapsed
+	sub, err := ctn.SubscriptionRepo.GetByOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting subscription: %w", err)
+	}
+	if sub == nil {
+		sub = &models.Subscription{OrganizationID: org.ID}
+	}
+	sub.PlanType = models.PlanPremium
+	sub.Status = models.SubscriptionActive
+	sub.CurrentPeriodStart = now
+	sub.CurrentPeriodEnd = now.AddDate(0, 1, 0)
+	if sub.ID == uuid.Nil {
+		err = ctn.SubscriptionRepo.Create(ctx, sub)
+	} else {
+		err = ctn.SubscriptionRepo.Update(ctx, sub)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("saving subscription: %w", err)
+	}
+	for i, u := range users[1:] {
+		wage := u.wage
+		if err := ctn.OrgService.AddMember(ctx, org.ID, adminID, service.AddMemberRequest{
+			PersonID: people[i+1],
+			Wage:     &wage,
+		}); err != nil {
+			return nil, fmt.Errorf("adding %s: %w", u.email, err)
+		}
+	}
+
+	// 3. Historical meetings
+	rng := rand.New(rand.NewSource(1))
+	const meetings = 12
+	for i := 0; i < meetings; i++ {
+		day := now.AddDate(0, 0, i-meetings)
+		start := time.Date(day.Year(), day.Month(), day.Day(), 9+rng.Intn(8), 0, 0, 0, now.Location())
+		if err := seedMeeting(ctx, ctn, org.ID, people, rng, purposes[rng.Intn(len(purposes))], start); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Result{OrganizationID: org.ID, People: len(people), Meetings: meetings}, nil
+}
+
+// seedMeeting writes a finished meeting of two to four increments, as if its
+// attendee count changed while it ran, then recalculates its totals.
+func seedMeeting(ctx context.Context, ctn *container.Container, orgID uuid.UUID, people []uuid.UUID, rng *rand.Rand, purpose string, start time.Time) error {
+	attendees := people[:2+rng.Intn(len(people)-1)]
+	avgWage := 0.0
+	for i := range attendees {
+		avgWage += users[i].wage
+	}
+	avgWage /= float64(len(attendees))
+
+	meeting := &models.Meeting{
+		CreatedAt:      start,
+		OrganizationID: orgID,
+		Purpose:        purpose,
+		CreatedByID:    attendees[rng.Intn(len(attendees))],
+		StartedAt:      &start,
+	}
+	if err := ctn.MeetingRepo.Create(ctx, meeting); err != nil {
+		return fmt.Errorf("creating meeting: %w", err)
+	}
+
+	increments := make([]*models.Increment, 2+rng.Intn(3))
+	at := start
+	for i := range increments {
+		elapsed := (5 + rng.Intn(20)) * 60
+		count := len(attendees) - rng.Intn(2)
+		increments[i] = &models.Increment{
+			MeetingID:     meeting.ID,
+			StartTime:     at,
+			StopTime:      at.Add(time.Duration(elapsed) * time.Second),
+			AttendeeCount: count,
+			AverageWage:   avgWage,
+			ElapsedTime:   elapsed,
+			Cost:          (float64(elapsed) / 3600.0) * float64(count) * avgWage,
+			Purpose:       purpose,
+		}
+		at = increments[i].StopTime
+	}
+	if err := ctn.IncrementRepo.CreateBatch(ctx, increments); err != nil {
+		return fmt.Errorf("creating increments: %w", err)
+	}
+
+	for _, id := range attendees {
+		joined, left := start, at
+		if err := ctn.MeetingRepo.AddParticipant(ctx, &models.MeetingParticipant{
+			MeetingID: meeting.ID,
+			PersonID:  id,
+			JoinedAt:  &joined,
+			LeftAt:    &left,
+			Duration:  int(at.Sub(start).Seconds()),
+		}); err != nil {
+			return fmt.Errorf("adding participant: %w", err)
+		}
+	}
+
+	meeting.StoppedAt = &at
+	if err := ctn.MeetingRepo.Update(ctx, meeting); err != nil {
+		return fmt.Errorf("stopping meeting: %w", err)
+	}
+	if err := ctn.MeetingRepo.RecalculateTotals(ctx, meeting.ID); err != nil {
+		return fmt.Errorf("recalculating totals: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountDeletionService exercises a person's right to erasure: they ask to
+// delete their account, confirm with a token emailed to them, and are
+// anonymized once a grace period has passed unless they cancel first.
+type AccountDeletionService interface {
+	// RequestDeletion emails the person a confirmation token, replacing
+	// any earlier one not yet confirmed.
+	RequestDeletion(ctx context.Context, personID uuid.UUID, ipAddress, userAgent string) (*AccountDeletionDTO, error)
+	// ConfirmDeletion schedules the deletion for the end of the grace
+	// period.
+	ConfirmDeletion(ctx context.Context, personID uuid.UUID, req ConfirmAccountDeletionRequest) (*AccountDeletionDTO, error)
+	GetDeletion(ctx context.Context, personID uuid.UUID) (*AccountDeletionDTO, error)
+	CancelDeletion(ctx context.Context, personID uuid.UUID, ipAddress, userAgent string) error
+
+	// ProcessDue anonymizes the people whose deletions are due: their
+	// sign-in methods and sessions are deleted, their memberships
+	// deactivated and their wages cleared. It runs in the worker and
+	// returns how many people it anonymized.
+	ProcessDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// AccountDeletionTokenTTL is how long a deletion confirmation token works.
+const AccountDeletionTokenTTL = 24 * time.Hour
+
+// Account deletion statuses.
+const (
+	AccountDeletionAwaitingConfirmation = "awaiting_confirmation"
+	AccountDeletionScheduled            = "scheduled"
+)
+
+type ConfirmAccountDeletionRequest struct {
+	Token     string `json:"token" validate:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type AccountDeletionDTO struct {
+	Status      string    `json:"status"`
+	RequestedAt time.Time `json:"requested_at"`
+	// ConfirmBy is when the confirmation token expires, while the
+	// deletion awaits confirmation
+	ConfirmBy *time.Time `json:"confirm_by,omitempty"`
+	// ScheduledFor is when the person is anonymized, once confirmed
+	ScheduledFor *time.Time `json:"scheduled_for,omitempty"`
+}
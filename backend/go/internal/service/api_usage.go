@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIUsageService counts the API requests made for each organization, by
+// the credential they carried, so admins can see which integrations call
+// the API most and how close they are to their plan's daily limit.
+type APIUsageService interface {
+	// Record counts a request made for the organization at at, with the
+	// extension token tokenID or uuid.Nil for a session, and answered with
+	// status. Counts are held in memory and written every flush interval,
+	// so recording never waits on the database.
+	Record(orgID, tokenID uuid.UUID, status int, at time.Time)
+	// GetAPIUsage returns the organization's usage over the last days
+	// calendar days, UTC, today included. Counts not yet written are left
+	// out.
+	GetAPIUsage(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, days int) (*APIUsageDTO, error)
+	// PurgeExpired deletes counts older than the retention and returns
+	// how many it deleted.
+	PurgeExpired(ctx context.Context, now time.Time) (int64, error)
+	// Close writes the counts held in memory.
+	Close(ctx context.Context) error
+}
+
+// API usage credential types.
+const (
+	APIUsageSession        = "session"
+	APIUsageExtensionToken = "extension_token"
+)
+
+type APIUsageDTO struct {
+	// Since is the first day counted, as YYYY-MM-DD
+	Since    string `json:"since"`
+	Requests int64  `json:"requests"`
+	Errors   int64  `json:"errors"`
+	// DailyLimit is the requests a day the plan allows; 0 is unlimited
+	DailyLimit int `json:"daily_limit"`
+	// Days are newest first, including days without any requests
+	Days []*APIUsageDayDTO `json:"days"`
+	// Credentials are those requests were made with, most requests first
+	Credentials []*APIUsageCredentialDTO `json:"credentials"`
+}
+
+type APIUsageDayDTO struct {
+	// Date is YYYY-MM-DD
+	Date      string `json:"date"`
+	Requests  int64  `json:"requests"`
+	Errors    int64  `json:"errors"`
+	OverLimit bool   `json:"over_limit"`
+}
+
+// APIUsageCredentialDTO counts the requests made with one extension token,
+// or with every member's sessions together.
+type APIUsageCredentialDTO struct {
+	// Type is APIUsageSession or APIUsageExtensionToken
+	Type string `json:"type"`
+	// The extension token, and the person it acts for
+	TokenID   *uuid.UUID `json:"token_id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	PersonID  *uuid.UUID `json:"person_id,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+	Requests      int64     `json:"requests"`
+	Errors        int64     `json:"errors"`
+	LastRequestAt time.Time `json:"last_request_at"`
+}
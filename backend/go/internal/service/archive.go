@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArchiveService moves old meetings out of the live tables into compressed
+// archives, keeping what the daily report totals need, and restores them
+// for the rare look at their details.
+type ArchiveService interface {
+	// ArchiveMeetings archives the meetings that stopped more than age ago.
+	ArchiveMeetings(ctx context.Context, age time.Duration) (*ArchiveResult, error)
+	// ListArchivedMeetings returns the organization's archived meetings,
+	// most recently started first. Only organization admins can see them.
+	ListArchivedMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, pagination Pagination) ([]*ArchivedMeetingDTO, int64, error)
+	// RestoreMeeting moves an archived meeting back into the live tables,
+	// where it stays until it has been back as long as the archive age.
+	RestoreMeeting(ctx context.Context, orgID uuid.UUID, meetingID uuid.UUID, requesterID uuid.UUID) (*MeetingDTO, error)
+}
+
+// ArchiveResult reports what an archive run archived.
+type ArchiveResult struct {
+	Cutoff   time.Time `json:"cutoff"`
+	Archived int       `json:"archived"`
+}
+
+// ArchivedMeetingDTO summarizes an archived meeting.
+type ArchivedMeetingDTO struct {
+	MeetingID       uuid.UUID  `json:"meeting_id"`
+	Purpose         string     `json:"purpose"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	StoppedAt       *time.Time `json:"stopped_at,omitempty"`
+	TotalCost       float64    `json:"total_cost"`
+	TotalDuration   int        `json:"total_duration"`
+	MaxAttendees    int        `json:"max_attendees"`
+	AttendeeSeconds int64      `json:"attendee_seconds"`
+	IncrementCount  int        `json:"increment_count"`
+	ArchivedAt      time.Time  `json:"archived_at"`
+	// Size is the compressed size of the archived rows in bytes
+	Size int `json:"size"`
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -9,6 +10,23 @@ import (
 // AuditLogService handles creating audit logs.
 type AuditLogService interface {
 	Log(ctx context.Context, params LogParams) error
+	// MeetingDeleters returns who deleted each of the organization's
+	// meetings deleted since since, by meeting ID, from the delete_meeting
+	// and bulk_delete_meetings entries.
+	MeetingDeleters(ctx context.Context, orgID uuid.UUID, since time.Time) (map[uuid.UUID]uuid.UUID, error)
+	// Close writes the buffered entries, waiting until ctx is done at
+	// most. Entries logged afterwards are written as they are logged.
+	Close(ctx context.Context) error
+}
+
+// AuditBuffer configures how an AuditLogService buffers entries.
+type AuditBuffer struct {
+	// Size is how many entries may wait to be written; 0 writes each as
+	// it is logged.
+	Size int
+	// FlushInterval is the longest an entry waits before its batch is
+	// written.
+	FlushInterval time.Duration
 }
 
 // LogParams contains data for creating an audit log.
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/auth"
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 )
 
@@ -17,7 +18,7 @@ type AuthService interface {
 	// Authentication
 	Login(ctx context.Context, req LoginRequest) (*LoginResponse, error)
 	Logout(ctx context.Context, token string, ipAddress, userAgent string) error
-	RefreshToken(ctx context.Context, refreshToken string) (*TokenResponse, error)
+	RefreshToken(ctx context.Context, refreshToken string, ipAddress, userAgent string) (*TokenResponse, error)
 
 	// OAuth
 	OAuthLogin(ctx context.Context, provider string, code string) (*LoginResponse, error)
@@ -31,9 +32,21 @@ type AuthService interface {
 
 	// Session management
 	ValidateSession(ctx context.Context, token string) (*SessionInfo, error)
-	GetSessions(ctx context.Context, personID uuid.UUID) ([]*models.Session, error)
-	RevokeSession(ctx context.Context, personID, sessionID uuid.UUID) error
-	RevokeAllSessions(ctx context.Context, personID uuid.UUID) error
+	// GetSessions lists the person's live sessions, most recently active
+	// first, flagging currentSessionID as current
+	GetSessions(ctx context.Context, personID, currentSessionID uuid.UUID) ([]*SessionDTO, error)
+	// RevokeSession signs out one of the person's sessions
+	RevokeSession(ctx context.Context, personID, sessionID uuid.UUID, ipAddress, userAgent string) error
+	// RevokeAllSessions signs the person out everywhere. Refresh tokens
+	// already issued stay valid until they expire
+	RevokeAllSessions(ctx context.Context, personID uuid.UUID, ipAddress, userAgent string) error
+	// SetActiveOrganization switches the organization the session works
+	// in, and that the person's sessions start in from then on
+	SetActiveOrganization(ctx context.Context, personID, sessionID, orgID uuid.UUID, ipAddress, userAgent string) (*ActiveOrganizationDTO, error)
+
+	// IntrospectToken describes an access token for other services,
+	// without counting as activity on its session
+	IntrospectToken(ctx context.Context, token string) (*IntrospectionResponse, error)
 }
 
 type RegisterRequest struct {
@@ -41,6 +54,9 @@ type RegisterRequest struct {
 	Password  string `json:"password" validate:"required,min=8"`
 	FirstName string `json:"firstName" validate:"required"`
 	LastName  string `json:"lastName"`
+	// Timezone is the person's IANA zone, which reports fall back to when
+	// their organization has none; empty is UTC
+	Timezone  string `json:"timezone"`
 	IPAddress string `json:"-"`
 	UserAgent string `json:"-"`
 }
@@ -71,8 +87,57 @@ type TokenResponse struct {
 }
 
 type SessionInfo struct {
+	SessionID    uuid.UUID
 	PersonID     uuid.UUID
 	Email        string
 	ExpiresAt    time.Time
 	LastActivity time.Time
+	// Memberships are the access token's membership claims, when it
+	// carries them and they have not been revoked
+	Memberships []auth.Membership
+	// ActiveOrganizationID is the organization the session works in, if
+	// any
+	ActiveOrganizationID *uuid.UUID
+}
+
+// SessionDTO is one of a person's sessions, for telling their devices
+// apart.
+type SessionDTO struct {
+	ID           uuid.UUID `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	LastActivity time.Time `json:"last_activity"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	UserAgent    string    `json:"user_agent,omitempty"`
+	IPAddress    string    `json:"ip_address,omitempty"`
+	// Current marks the session of the request's own token
+	Current bool `json:"current"`
+}
+
+// IntrospectionResponse describes a token, after RFC 7662. An inactive
+// token is described by Active alone.
+type IntrospectionResponse struct {
+	Active    bool   `json:"active"`
+	TokenType string `json:"token_type,omitempty"`
+	// Scope lists the token's grants, space-separated, each as
+	// "<organization ID>:<resource>:<activity>"
+	Scope     string `json:"scope,omitempty"`
+	Subject   string `json:"sub,omitempty"`
+	Username  string `json:"username,omitempty"`
+	Issuer    string `json:"iss,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	// Memberships are the person's active organization memberships as
+	// they stand now, whether or not the token carries them
+	Memberships []auth.Membership `json:"orgs,omitempty"`
+	// ActiveOrganizationID is the organization the token's session works
+	// in, if any
+	ActiveOrganizationID *uuid.UUID `json:"active_org,omitempty"`
+}
+
+type SetActiveOrganizationRequest struct {
+	OrganizationID uuid.UUID `json:"organization_id" validate:"required"`
+}
+
+type ActiveOrganizationDTO struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
 }
@@ -0,0 +1,33 @@
+package service
+
+// CalculatorService prices meetings that haven't happened, with the math
+// tracked meetings are priced by, for the marketing site's calculator.
+// Nothing it is given is stored.
+type CalculatorService interface {
+	Calculate(req CalculateRequest) (*CalculationDTO, error)
+}
+
+// Calculator bounds: a meeting of up to 10,000 attendees at up to $10,000
+// an hour each, lasting up to a day.
+const (
+	MaxCalculatorAttendees = 10000
+	MaxCalculatorWage      = 10000
+	MaxCalculatorMinutes   = 24 * 60
+)
+
+// CalculateRequest describes a meeting: how many attend, their average
+// hourly wage and how many minutes it lasts.
+type CalculateRequest struct {
+	Attendees       int     `json:"attendees" validate:"min=1"`
+	AverageWage     float64 `json:"average_wage" validate:"min=0"`
+	DurationMinutes int     `json:"duration_minutes" validate:"min=1"`
+}
+
+// CalculationDTO is what a meeting would cost: its total and rates, as a
+// tracked meeting's cost reports them, and what each attendee and hour of
+// attendee time comes to.
+type CalculationDTO struct {
+	MeetingCostDTO
+	CostPerAttendee float64 `json:"cost_per_attendee"`
+	AttendeeHours   float64 `json:"attendee_hours"`
+}
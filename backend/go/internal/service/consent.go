@@ -13,6 +13,13 @@ type ConsentService interface {
 	GetConsent(ctx context.Context, sessionID string) (*ConsentDTO, error)
 	UpdateConsent(ctx context.Context, req UpdateConsentRequest) (*ConsentDTO, error)
 	WithdrawConsent(ctx context.Context, sessionID string, cookieTypes []string) error
+	// SetDoNotSell records a session's CCPA/CPRA choice to opt out of, or
+	// back into, the sale or sharing of personal information.
+	SetDoNotSell(ctx context.Context, req SetDoNotSellRequest) (*ConsentDTO, error)
+	// DoNotSell reports whether the person has opted out of the sale or
+	// sharing of personal information. Integrations that pass personal
+	// information to third parties for marketing must check it first.
+	DoNotSell(ctx context.Context, personID uuid.UUID) (bool, error)
 
 	// Cookie enforcement
 	CheckCookieAllowed(ctx context.Context, sessionID string, cookieCategory string) (bool, error)
@@ -24,19 +31,56 @@ type ConsentService interface {
 
 	// Policy management
 	GetCurrentPolicyVersion(ctx context.Context) (string, error)
+	// GetBanner returns what the consent banner shows. Given a session, it
+	// also says whether the session needs to be asked again.
+	GetBanner(ctx context.Context, sessionID string) (*ConsentBannerDTO, error)
+	// ListCategories returns the built-in and configured consent
+	// categories, in banner order.
+	ListCategories(ctx context.Context) ([]*ConsentCategoryDTO, error)
+	// SaveCategory creates or updates the category with key. Saving a
+	// built-in category's key changes its name, description and position.
+	SaveCategory(ctx context.Context, key string, req SaveConsentCategoryRequest) (*ConsentCategoryDTO, error)
+	// DeleteCategory removes a configured category; built-in ones can't be
+	// deleted. Consent records keep what was granted for it.
+	DeleteCategory(ctx context.Context, key string) error
 
 	// Syncing
 	SyncConsent(ctx context.Context, sessionID string, personID uuid.UUID) error
 }
 
+// ConsentPolicy is the deployment's consent policy and banner copy.
+type ConsentPolicy struct {
+	// Version is recorded on every consent; consent to an older version is
+	// asked for again
+	Version          string
+	BannerTitle      string
+	BannerText       string
+	PrivacyPolicyURL string
+	CookiePolicyURL  string
+}
+
 type UpdateConsentRequest struct {
 	SessionID         string     `json:"session_id" validate:"required"`
 	PersonID          *uuid.UUID `json:"person_id"`
 	AnalyticsCookies  bool       `json:"analytics_cookies"`
 	MarketingCookies  bool       `json:"marketing_cookies"`
 	FunctionalCookies bool       `json:"functional_cookies"`
-	IPAddress         string     `json:"-"` // Set from request context
-	UserAgent         string     `json:"-"` // Set from request context
+	// Categories grants or declines categories by key, and takes
+	// precedence over the boolean fields above, which older clients send
+	Categories map[string]bool `json:"categories"`
+	// DoNotSell sets the CCPA/CPRA opt-out; omitted keeps the session's
+	// current choice
+	DoNotSell *bool  `json:"do_not_sell"`
+	IPAddress string `json:"-"` // Set from request context
+	UserAgent string `json:"-"` // Set from request context
+}
+
+type SetDoNotSellRequest struct {
+	SessionID string     `json:"session_id" validate:"required"`
+	PersonID  *uuid.UUID `json:"-"` // Set from the signed-in person, if any
+	DoNotSell bool       `json:"do_not_sell"`
+	IPAddress string     `json:"-"` // Set from request context
+	UserAgent string     `json:"-"` // Set from request context
 }
 
 type ConsentDTO struct {
@@ -47,13 +91,51 @@ type ConsentDTO struct {
 	AnalyticsCookies  bool       `json:"analytics_cookies"`
 	MarketingCookies  bool       `json:"marketing_cookies"`
 	FunctionalCookies bool       `json:"functional_cookies"`
-	ConsentVersion    string     `json:"consent_version"`
-	ConsentDate       time.Time  `json:"consent_date"`
-	PreviousConsentID *uuid.UUID `json:"previous_consent_id,omitempty"`
+	// Categories says whether each consent category was granted, by key
+	Categories        map[string]bool `json:"categories"`
+	DoNotSell         bool            `json:"do_not_sell"`
+	ConsentVersion    string          `json:"consent_version"`
+	ConsentDate       time.Time       `json:"consent_date"`
+	PreviousConsentID *uuid.UUID      `json:"previous_consent_id,omitempty"`
 }
 
 type ConsentExportDTO struct {
-	PersonID   uuid.UUID    `json:"person_id"`
+	PersonID uuid.UUID `json:"person_id"`
+	// DoNotSell is the person's current CCPA/CPRA opt-out
+	DoNotSell  bool         `json:"do_not_sell"`
 	Consents   []ConsentDTO `json:"consents"`
 	ExportDate time.Time    `json:"export_date"`
 }
+
+type ConsentCategoryDTO struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+	Position    int    `json:"position"`
+	BuiltIn     bool   `json:"built_in"`
+}
+
+type SaveConsentCategoryRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"` // Ignored for built-in categories
+	Position    int    `json:"position"`
+}
+
+type ConsentBannerDTO struct {
+	PolicyVersion string                `json:"policy_version"`
+	Title         string                `json:"title"`
+	Text          string                `json:"text"`
+	Links         []ConsentBannerLink   `json:"links"`
+	Categories    []*ConsentCategoryDTO `json:"categories"`
+	// NeedsConsent is set when a session is given: true when it has no
+	// consent, consent to an older policy version, or categories were
+	// added since
+	NeedsConsent *bool `json:"needs_consent,omitempty"`
+}
+
+type ConsentBannerLink struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
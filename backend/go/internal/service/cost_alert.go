@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CostAlertService manages cost threshold alerts and fires them as meetings
+// accrue cost.
+type CostAlertService interface {
+	CreateAlert(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req CreateCostAlertRequest) (*CostAlertDTO, error)
+	// ListAlerts returns the requester's alerts in the organization.
+	ListAlerts(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*CostAlertDTO, error)
+	DeleteAlert(ctx context.Context, orgID, alertID uuid.UUID, requesterID uuid.UUID) error
+
+	// Evaluate fires every alert watching the meeting whose threshold cost
+	// has reached and that has not fired for it yet: it notifies the
+	// alert's owner and broadcasts EventCostThreshold to the meeting. It
+	// also announces a CostMilestones amount the meeting has newly passed
+	// in the organization's Slack channel, when it has them turned on.
+	Evaluate(ctx context.Context, orgID, meetingID uuid.UUID, cost float64) error
+	// CheckActiveMeetings evaluates every active meeting at its current
+	// cost, so alerts fire while nobody is changing or watching a meeting.
+	// It runs in the worker and returns how many meetings it checked.
+	CheckActiveMeetings(ctx context.Context) (int, error)
+}
+
+// CostMilestones are the round amounts, in dollars, announced in an
+// organization's Slack channel as each meeting's cost passes them.
+var CostMilestones = []float64{100, 500, 1000}
+
+// Slack integration settings for milestone announcements.
+const (
+	// SlackSettingMilestones turns the announcements on when true
+	SlackSettingMilestones = "milestones"
+	// SlackSettingMilestoneChannel is the channel they are posted to, by
+	// ID or as #name
+	SlackSettingMilestoneChannel = "milestone_channel"
+)
+
+// MilestonePolicy controls milestone announcements.
+type MilestonePolicy struct {
+	// Throttle is the least time between an organization's announcements;
+	// milestones passed sooner are recorded but not announced
+	Throttle time.Duration
+	// Timeout bounds each request to Slack
+	Timeout time.Duration
+}
+
+type CreateCostAlertRequest struct {
+	// MeetingID limits the alert to one meeting; empty watches every
+	// meeting of the organization
+	MeetingID *uuid.UUID `json:"meeting_id"`
+	Threshold float64    `json:"threshold" validate:"required,gt=0"`
+}
+
+type CostAlertDTO struct {
+	ID              uuid.UUID  `json:"id"`
+	MeetingID       *uuid.UUID `json:"meeting_id"`
+	Threshold       float64    `json:"threshold"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// CostThresholdEvent is the payload of EventCostThreshold.
+type CostThresholdEvent struct {
+	AlertID   uuid.UUID `json:"alert_id"`
+	PersonID  uuid.UUID `json:"person_id"`
+	Threshold float64   `json:"threshold"`
+	Cost      float64   `json:"cost"`
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DigestService emails periodic meeting cost summaries.
+type DigestService interface {
+	// QueueWeeklyDigests queues a digest for every organization covering the
+	// week before end, and returns how many were queued.
+	QueueWeeklyDigests(ctx context.Context, end time.Time) (int, error)
+	// SendWeeklyDigest emails an organization's digest for the week before
+	// end to each active member who hasn't opted out, and returns how many
+	// were sent. Organizations with no meetings that week are skipped.
+	SendWeeklyDigest(ctx context.Context, orgID uuid.UUID, end time.Time) (int, error)
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DPAService records organizations accepting the data processing agreement
+// and lists the platform's sub-processors, for enterprise procurement.
+type DPAService interface {
+	// GetDPA returns the current agreement and the organization's
+	// acceptances of it and earlier versions.
+	GetDPA(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) (*DPAStatusDTO, error)
+	// AcceptDPA records the requester accepting the current agreement on
+	// the organization's behalf. Accepting it again returns the first
+	// acceptance.
+	AcceptDPA(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req AcceptDPARequest) (*DPAAcceptanceDTO, error)
+	// ListSubProcessors returns the third parties that process personal
+	// data for the platform.
+	ListSubProcessors(ctx context.Context) []SubProcessor
+}
+
+// DPAPolicy is the data processing agreement organizations accept.
+type DPAPolicy struct {
+	Version string
+	URL     string
+}
+
+// SubProcessor is a third party that processes personal data for the
+// platform.
+type SubProcessor struct {
+	Name     string `json:"name"`
+	Purpose  string `json:"purpose"`
+	Location string `json:"location,omitempty"`
+	URL      string `json:"url,omitempty"`
+	// Condition says when it is used, if not always
+	Condition string `json:"condition,omitempty"`
+}
+
+type AcceptDPARequest struct {
+	// Version must be the current version, so nobody accepts an agreement
+	// they haven't seen
+	Version   string `json:"version" validate:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type DPAAcceptanceDTO struct {
+	ID           uuid.UUID `json:"id"`
+	Version      string    `json:"version"`
+	AcceptedByID uuid.UUID `json:"accepted_by_id"`
+	AcceptedAt   time.Time `json:"accepted_at"`
+}
+
+type DPAStatusDTO struct {
+	CurrentVersion string `json:"current_version"`
+	URL            string `json:"url,omitempty"`
+	// Accepted is whether the current version has been accepted
+	Accepted    bool                `json:"accepted"`
+	Acceptances []*DPAAcceptanceDTO `json:"acceptances"`
+}
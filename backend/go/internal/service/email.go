@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// EmailService renders transactional emails and queues them for the worker
+// to send.
+type EmailService interface {
+	// Send renders template (one of the email.Template constants) with data,
+	// its matching email.*Data type, and queues it for to. It returns the
+	// delivery log ID.
+	Send(ctx context.Context, to string, template string, data interface{}) (uuid.UUID, error)
+	// Deliver sends a queued email. It runs in the worker; an error leaves
+	// the task to the queue's retries.
+	Deliver(ctx context.Context, payload SendEmailPayload) error
+}
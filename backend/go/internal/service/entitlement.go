@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// Unlimited is the value of an Entitlements limit that does not apply.
+const Unlimited = 0
+
+// Entitlements are what an organization's plan allows.
+type Entitlements struct {
+	PlanType string `json:"plan_type"`
+	// Active members, counting everyone already in the organization
+	MaxMembers int `json:"max_members"`
+	// Meetings running at the same time
+	MaxActiveMeetings int `json:"max_active_meetings"`
+	// How far back reports reach, in days
+	ReportRetentionDays int `json:"report_retention_days"`
+	// Webhook endpoints and other integrations
+	IntegrationsEnabled bool `json:"integrations_enabled"`
+	// API requests a day, as the API usage analytics count them; days
+	// over it are flagged there, not refused
+	APIRequestsPerDay int `json:"api_requests_per_day"`
+}
+
+// PlanEntitlements maps each plan type to its limits.
+var PlanEntitlements = map[string]Entitlements{
+	models.PlanFree: {
+		PlanType:            models.PlanFree,
+		MaxMembers:          5,
+		MaxActiveMeetings:   1,
+		ReportRetentionDays: 30,
+		APIRequestsPerDay:   10000,
+	},
+	models.PlanBasic: {
+		PlanType:            models.PlanBasic,
+		MaxMembers:          25,
+		MaxActiveMeetings:   5,
+		ReportRetentionDays: 180,
+		IntegrationsEnabled: true,
+		APIRequestsPerDay:   100000,
+	},
+	models.PlanPremium: {
+		PlanType:            models.PlanPremium,
+		MaxMembers:          100,
+		MaxActiveMeetings:   25,
+		ReportRetentionDays: 730,
+		IntegrationsEnabled: true,
+		APIRequestsPerDay:   1000000,
+	},
+	models.PlanEnterprise: {
+		PlanType:            models.PlanEnterprise,
+		MaxMembers:          Unlimited,
+		MaxActiveMeetings:   Unlimited,
+		ReportRetentionDays: Unlimited,
+		IntegrationsEnabled: true,
+		APIRequestsPerDay:   Unlimited,
+	},
+}
+
+// EffectivePlan returns the plan sub entitles its organization to at now.
+// No subscription, or a canceled subscription or trial past its period, is
+// the free plan.
+func EffectivePlan(sub *models.Subscription, now time.Time) string {
+	if sub == nil {
+		return models.PlanFree
+	}
+	lapsable := sub.Status == models.SubscriptionCanceled || sub.Status == models.SubscriptionTrialing
+	if lapsable && !sub.CurrentPeriodEnd.After(now) {
+		return models.PlanFree
+	}
+	if _, ok := PlanEntitlements[sub.PlanType]; !ok {
+		return models.PlanFree
+	}
+	return sub.PlanType
+}
+
+// EntitlementService enforces plan limits. The Check methods return a
+// LIMIT_EXCEEDED DomainError when the organization may not grow further.
+type EntitlementService interface {
+	// GetEntitlements returns the limits of the organization's current
+	// plan.
+	GetEntitlements(ctx context.Context, orgID uuid.UUID) (*Entitlements, error)
+	// CheckMemberLimit checks that one more member may join.
+	CheckMemberLimit(ctx context.Context, orgID uuid.UUID) error
+	// CheckActiveMeetingLimit checks that one more meeting may start.
+	CheckActiveMeetingLimit(ctx context.Context, orgID uuid.UUID) error
+	// CheckIntegrations checks that the plan allows integrations.
+	CheckIntegrations(ctx context.Context, orgID uuid.UUID) error
+}
@@ -14,6 +14,13 @@ const (
 	EventAverageWage        EventType = "meeting:average_wage"
 	EventMeetingCost        EventType = "meeting:cost"
 	EventMeetingParticipant EventType = "meeting:participant"
+	EventCostThreshold      EventType = "meeting:cost_threshold"
+	EventMeetingSurvey      EventType = "meeting:survey"
+	EventMeetingOverrun     EventType = "meeting:overrun"
+	EventIncrementCorrected EventType = "meeting:increment_corrected"
+	EventMeetingUndo        EventType = "meeting:undo"
+	EventMeetingFinalized   EventType = "meeting:finalized"
+	EventCostTick           EventType = "meeting:cost_tick"
 )
 
 // MeetingEvent represents a message broadcasted via websocket.
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExtensionService backs the browser extension. People create tokens for
+// it in their account, each acting for them in one organization, and the
+// extension tracks the calls it detects in their tabs with a token.
+type ExtensionService interface {
+	// CreateToken returns a new token, the only time it is shown.
+	CreateToken(ctx context.Context, personID uuid.UUID, req CreateExtensionTokenRequest) (*ExtensionTokenDTO, error)
+	ListTokens(ctx context.Context, personID uuid.UUID) ([]*ExtensionTokenDTO, error)
+	RevokeToken(ctx context.Context, personID, tokenID uuid.UUID, ipAddress, userAgent string) error
+	// Authenticate returns who a token acts for. Revoked tokens, and those
+	// of people no longer active members of their organization, are
+	// refused.
+	Authenticate(ctx context.Context, token string) (*ExtensionIdentity, error)
+
+	// Start tracks a call: the organization's running meeting for it when
+	// there is one, such as another attendee's extension started, or a new
+	// meeting started now otherwise.
+	Start(ctx context.Context, id ExtensionIdentity, req ExtensionStartRequest) (*ExtensionMeetingDTO, error)
+	Stop(ctx context.Context, id ExtensionIdentity, meetingID uuid.UUID) (*ExtensionMeetingDTO, error)
+	// Tick reports the attendees the extension sees and returns the cost
+	// so far. A changed count starts a new increment.
+	Tick(ctx context.Context, id ExtensionIdentity, meetingID uuid.UUID, req ExtensionTickRequest) (*ExtensionMeetingDTO, error)
+	Cost(ctx context.Context, id ExtensionIdentity, meetingID uuid.UUID) (*ExtensionMeetingDTO, error)
+}
+
+// MaxExtensionTokens is how many unrevoked extension tokens a person can
+// have.
+const MaxExtensionTokens = 10
+
+// Call platforms the extension detects, mapped to the external type of the
+// meetings it tracks for them.
+var ExtensionPlatforms = map[string]string{
+	"meet": "google",
+	"zoom": "zoom",
+}
+
+// ExtensionIdentity is who an extension token acts for.
+type ExtensionIdentity struct {
+	TokenID        uuid.UUID
+	PersonID       uuid.UUID
+	OrganizationID uuid.UUID
+}
+
+type CreateExtensionTokenRequest struct {
+	OrganizationID uuid.UUID `json:"organization_id" validate:"required"`
+	Name           string    `json:"name"`
+	IPAddress      string    `json:"-"`
+	UserAgent      string    `json:"-"`
+}
+
+type ExtensionTokenDTO struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	// Token is set only in the response creating it
+	Token      string     `json:"token,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ExtensionStartRequest names the call in a tab: its platform, a key of
+// ExtensionPlatforms, and its ID there, such as a Meet code.
+type ExtensionStartRequest struct {
+	Platform  string `json:"platform" validate:"required"`
+	CallID    string `json:"call_id" validate:"required"`
+	Title     string `json:"title"`
+	Attendees int    `json:"attendees" validate:"min=0"`
+}
+
+type ExtensionTickRequest struct {
+	// Attendees is left out when the extension can't count them
+	Attendees *int `json:"attendees" validate:"omitempty,min=0"`
+}
+
+// ExtensionMeetingDTO is a meeting in the compact form the extension
+// shows.
+type ExtensionMeetingDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Running   bool      `json:"running"`
+	Attendees int       `json:"attendees"`
+	Cost      float64   `json:"cost"`
+	Seconds   int       `json:"seconds"`
+	// PerSecond is what each second costs at the current attendance, so
+	// the extension can count up between ticks
+	PerSecond float64 `json:"per_second"`
+}
@@ -0,0 +1,285 @@
+package impl
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/email"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type accountDeletionService struct {
+	deletionRepo    repository.AccountDeletionRepository
+	personRepo      repository.PersonRepository
+	emailRepo       repository.PersonEmailRepository
+	extensionRepo   repository.ExtensionTokenRepository
+	authRepo        repository.AuthRepository
+	profileRepo     repository.PersonOrganizationProfileRepository
+	permissionRepo  repository.PermissionRepository
+	emailService    service.EmailService
+	auditLogService service.AuditLogService
+	grace           time.Duration
+	logger          logger.Logger
+}
+
+// NewAccountDeletionService creates a new AccountDeletionService that
+// anonymizes people grace after they confirm deleting their account.
+func NewAccountDeletionService(
+	deletionRepo repository.AccountDeletionRepository,
+	personRepo repository.PersonRepository,
+	emailRepo repository.PersonEmailRepository,
+	extensionRepo repository.ExtensionTokenRepository,
+	authRepo repository.AuthRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	permissionRepo repository.PermissionRepository,
+	emailService service.EmailService,
+	auditLogService service.AuditLogService,
+	grace time.Duration,
+	logger logger.Logger,
+) service.AccountDeletionService {
+	return &accountDeletionService{
+		deletionRepo:    deletionRepo,
+		personRepo:      personRepo,
+		emailRepo:       emailRepo,
+		extensionRepo:   extensionRepo,
+		authRepo:        authRepo,
+		profileRepo:     profileRepo,
+		permissionRepo:  permissionRepo,
+		emailService:    emailService,
+		auditLogService: auditLogService,
+		grace:           grace,
+		logger:          logger,
+	}
+}
+
+func (s *accountDeletionService) RequestDeletion(ctx context.Context, personID uuid.UUID, ipAddress, userAgent string) (*service.AccountDeletionDTO, error) {
+	person, err := s.personRepo.GetByID(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion, err := s.deletionRepo.GetByPerson(ctx, personID)
+	if err != nil {
+		deletion = &models.AccountDeletion{PersonID: personID}
+	} else if deletion.ConfirmedAt != nil {
+		return nil, fmt.Errorf("invalid request: the account's deletion is already scheduled")
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("generating confirmation token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	deletion.TokenHash = hashDeletionToken(token)
+	deletion.TokenExpiresAt = time.Now().Add(service.AccountDeletionTokenTTL)
+	if deletion.ID == uuid.Nil {
+		err = s.deletionRepo.Create(ctx, deletion)
+	} else {
+		err = s.deletionRepo.Update(ctx, deletion)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.emailService.Send(ctx, person.Email, email.TemplateAccountDeletion, email.AccountDeletionData{
+		Name:      person.FirstName,
+		Token:     token,
+		ExpiresIn: service.AccountDeletionTokenTTL,
+		GraceDays: int(s.grace.Hours() / 24),
+	}); err != nil {
+		return nil, fmt.Errorf("sending confirmation email: %w", err)
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     &personID,
+		Action:       "request_account_deletion",
+		ResourceType: "person",
+		ResourceID:   personID,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+	})
+
+	return toAccountDeletionDTO(deletion), nil
+}
+
+func (s *accountDeletionService) ConfirmDeletion(ctx context.Context, personID uuid.UUID, req service.ConfirmAccountDeletionRequest) (*service.AccountDeletionDTO, error) {
+	deletion, err := s.deletionRepo.GetByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion.ConfirmedAt != nil {
+		return nil, fmt.Errorf("invalid request: the account's deletion is already scheduled")
+	}
+	if time.Now().After(deletion.TokenExpiresAt) ||
+		subtle.ConstantTimeCompare([]byte(hashDeletionToken(req.Token)), []byte(deletion.TokenHash)) != 1 {
+		return nil, fmt.Errorf("invalid token: request the deletion again for a new one")
+	}
+
+	now := time.Now()
+	scheduledFor := now.Add(s.grace)
+	deletion.ConfirmedAt = &now
+	deletion.ScheduledFor = &scheduledFor
+	if err := s.deletionRepo.Update(ctx, deletion); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     &personID,
+		Action:       "confirm_account_deletion",
+		ResourceType: "person",
+		ResourceID:   personID,
+		Details: map[string]interface{}{
+			"scheduled_for": scheduledFor,
+		},
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+	})
+
+	return toAccountDeletionDTO(deletion), nil
+}
+
+func (s *accountDeletionService) GetDeletion(ctx context.Context, personID uuid.UUID) (*service.AccountDeletionDTO, error) {
+	deletion, err := s.deletionRepo.GetByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	return toAccountDeletionDTO(deletion), nil
+}
+
+func (s *accountDeletionService) CancelDeletion(ctx context.Context, personID uuid.UUID, ipAddress, userAgent string) error {
+	deletion, err := s.deletionRepo.GetByPerson(ctx, personID)
+	if err != nil {
+		return err
+	}
+	if err := s.deletionRepo.Delete(ctx, deletion.ID); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     &personID,
+		Action:       "cancel_account_deletion",
+		ResourceType: "person",
+		ResourceID:   personID,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+	})
+	return nil
+}
+
+func (s *accountDeletionService) ProcessDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.deletionRepo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	var errs []error
+	for _, deletion := range due {
+		if err := s.anonymize(ctx, deletion.PersonID); err != nil {
+			errs = append(errs, fmt.Errorf("anonymizing %s: %w", deletion.PersonID, err))
+			continue
+		}
+		completed := time.Now()
+		deletion.CompletedAt = &completed
+		deletion.TokenHash = ""
+		if err := s.deletionRepo.Update(ctx, deletion); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			Action:       "anonymize_person",
+			ResourceType: "person",
+			ResourceID:   deletion.PersonID,
+		})
+		n++
+	}
+
+	err = errors.Join(errs...)
+	if err != nil {
+		s.logger.Error("failed to process account deletions", "anonymized", n, "error", err)
+	} else if n > 0 {
+		s.logger.Info("processed account deletions", "anonymized", n)
+	}
+	return n, err
+}
+
+// anonymize erases the person: they can no longer sign in, use the
+// extension or be matched by their secondary emails, leave their
+// organizations without roles or wages, and keep only an anonymized name
+// on the meetings they took part in.
+func (s *accountDeletionService) anonymize(ctx context.Context, personID uuid.UUID) error {
+	methods, err := s.authRepo.GetAuthMethodsByPerson(ctx, personID)
+	if err != nil {
+		return err
+	}
+	for _, m := range methods {
+		if err := s.authRepo.DeleteAuthMethod(ctx, m.ID); err != nil {
+			return err
+		}
+	}
+	if err := s.authRepo.DeleteSessionsByPerson(ctx, personID); err != nil {
+		return err
+	}
+	if err := s.emailRepo.DeleteByPerson(ctx, personID); err != nil {
+		return err
+	}
+	if err := s.extensionRepo.DeleteByPerson(ctx, personID); err != nil {
+		return err
+	}
+
+	profiles, err := s.profileRepo.GetByPerson(ctx, personID)
+	if err != nil {
+		return err
+	}
+	for _, p := range profiles {
+		roles, err := s.permissionRepo.GetRolesByPerson(ctx, personID, p.OrganizationID)
+		if err != nil {
+			return err
+		}
+		for _, role := range roles {
+			if err := s.permissionRepo.UnassignRole(ctx, role.ID, personID, p.OrganizationID); err != nil {
+				return err
+			}
+		}
+		if err := s.profileRepo.SetWageBand(ctx, personID, p.OrganizationID, nil); err != nil {
+			return err
+		}
+		if p.IsActive {
+			if err := s.profileRepo.Deactivate(ctx, personID, p.OrganizationID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.personRepo.Anonymize(ctx, personID)
+}
+
+func toAccountDeletionDTO(d *models.AccountDeletion) *service.AccountDeletionDTO {
+	dto := &service.AccountDeletionDTO{RequestedAt: d.CreatedAt}
+	if d.ConfirmedAt == nil {
+		dto.Status = service.AccountDeletionAwaitingConfirmation
+		dto.ConfirmBy = &d.TokenExpiresAt
+	} else {
+		dto.Status = service.AccountDeletionScheduled
+		dto.ScheduledFor = d.ScheduledFor
+	}
+	return dto
+}
+
+// hashDeletionToken returns the stored form of a confirmation token.
+func hashDeletionToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
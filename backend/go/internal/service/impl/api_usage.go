@@ -0,0 +1,263 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// apiUsageWriteTimeout bounds each write of the counts held, which outlive
+// the requests that made them.
+const apiUsageWriteTimeout = 10 * time.Second
+
+// apiUsageKey is what requests are counted by.
+type apiUsageKey struct {
+	orgID   uuid.UUID
+	tokenID uuid.UUID
+	hour    time.Time
+}
+
+type apiUsageService struct {
+	usageRepo          repository.APIUsageRepository
+	tokenRepo          repository.ExtensionTokenRepository
+	permissionRepo     repository.PermissionRepository
+	entitlementService service.EntitlementService
+	// retention is how long counts are kept
+	retention time.Duration
+	logger    logger.Logger
+
+	// mu guards counts and closed, so nothing is counted once the last
+	// write has started
+	mu     sync.Mutex
+	counts map[apiUsageKey]*models.APIUsage
+	closed bool
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// NewAPIUsageService creates a new APIUsageService implementation. Counts
+// are held in memory and written by a background writer every
+// flushInterval.
+func NewAPIUsageService(
+	usageRepo repository.APIUsageRepository,
+	tokenRepo repository.ExtensionTokenRepository,
+	permissionRepo repository.PermissionRepository,
+	entitlementService service.EntitlementService,
+	flushInterval time.Duration,
+	retention time.Duration,
+	logger logger.Logger,
+) service.APIUsageService {
+	s := &apiUsageService{
+		usageRepo:          usageRepo,
+		tokenRepo:          tokenRepo,
+		permissionRepo:     permissionRepo,
+		entitlementService: entitlementService,
+		retention:          retention,
+		logger:             logger,
+		counts:             make(map[apiUsageKey]*models.APIUsage),
+		stop:               make(chan struct{}),
+		done:               make(chan struct{}),
+	}
+	go s.run(flushInterval)
+	return s
+}
+
+func (s *apiUsageService) Record(orgID, tokenID uuid.UUID, status int, at time.Time) {
+	at = at.UTC()
+	key := apiUsageKey{orgID: orgID, tokenID: tokenID, hour: at.Truncate(time.Hour)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	count, ok := s.counts[key]
+	if !ok {
+		count = &models.APIUsage{OrganizationID: orgID, TokenID: tokenID, Hour: key.hour}
+		s.counts[key] = count
+	}
+	count.Requests++
+	if status >= 400 {
+		count.Errors++
+	}
+	if at.After(count.LastRequestAt) {
+		count.LastRequestAt = at
+	}
+}
+
+// run writes the counts every interval until stopped, and once more then.
+func (s *apiUsageService) run(interval time.Duration) {
+	defer close(s.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			s.write()
+			return
+		case <-ticker.C:
+			s.write()
+		}
+	}
+}
+
+// write writes the counts held and starts counting afresh. Counts that
+// fail to write are held again for the next write.
+func (s *apiUsageService) write() {
+	s.mu.Lock()
+	held := s.counts
+	s.counts = make(map[apiUsageKey]*models.APIUsage)
+	s.mu.Unlock()
+	if len(held) == 0 {
+		return
+	}
+
+	batch := make([]*models.APIUsage, 0, len(held))
+	for _, count := range held {
+		batch = append(batch, count)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), apiUsageWriteTimeout)
+	defer cancel()
+	err := s.usageRepo.Add(ctx, batch)
+	if err == nil {
+		return
+	}
+	s.logger.Error("failed to write api usage", "count", len(batch), "error", err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, count := range held {
+		if newer, ok := s.counts[key]; ok {
+			count.Requests += newer.Requests
+			count.Errors += newer.Errors
+			if newer.LastRequestAt.After(count.LastRequestAt) {
+				count.LastRequestAt = newer.LastRequestAt
+			}
+		}
+		s.counts[key] = count
+	}
+}
+
+func (s *apiUsageService) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.stop)
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("writing api usage: %w", ctx.Err())
+	}
+}
+
+func (s *apiUsageService) GetAPIUsage(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, days int) (*service.APIUsageDTO, error) {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return nil, fmt.Errorf("forbidden")
+	}
+	if days < 1 {
+		return nil, fmt.Errorf("invalid days: must be at least 1")
+	}
+
+	ent, err := s.entitlementService.GetEntitlements(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	since := today.AddDate(0, 0, 1-days)
+	counts, err := s.usageRepo.ListByOrganization(ctx, orgID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &service.APIUsageDTO{
+		Since:       since.Format(time.DateOnly),
+		DailyLimit:  ent.APIRequestsPerDay,
+		Days:        make([]*service.APIUsageDayDTO, 0, days),
+		Credentials: make([]*service.APIUsageCredentialDTO, 0),
+	}
+	byDay := make(map[string]*service.APIUsageDayDTO, days)
+	for day := today; !day.Before(since); day = day.AddDate(0, 0, -1) {
+		dto := &service.APIUsageDayDTO{Date: day.Format(time.DateOnly)}
+		byDay[dto.Date] = dto
+		res.Days = append(res.Days, dto)
+	}
+	byToken := make(map[uuid.UUID]*service.APIUsageCredentialDTO)
+	for _, c := range counts {
+		res.Requests += c.Requests
+		res.Errors += c.Errors
+		if day, ok := byDay[c.Hour.UTC().Format(time.DateOnly)]; ok {
+			day.Requests += c.Requests
+			day.Errors += c.Errors
+		}
+
+		cred, ok := byToken[c.TokenID]
+		if !ok {
+			cred = s.credential(ctx, orgID, c.TokenID)
+			byToken[c.TokenID] = cred
+			res.Credentials = append(res.Credentials, cred)
+		}
+		cred.Requests += c.Requests
+		cred.Errors += c.Errors
+		if c.LastRequestAt.After(cred.LastRequestAt) {
+			cred.LastRequestAt = c.LastRequestAt
+		}
+	}
+	if res.DailyLimit != service.Unlimited {
+		for _, day := range res.Days {
+			day.OverLimit = day.Requests > int64(res.DailyLimit)
+		}
+	}
+	sort.SliceStable(res.Credentials, func(i, j int) bool {
+		return res.Credentials[i].Requests > res.Credentials[j].Requests
+	})
+	return res, nil
+}
+
+// credential describes the credential tokenID, which names no token for
+// sessions. A token since deleted, with its person's account, is described
+// by its ID alone.
+func (s *apiUsageService) credential(ctx context.Context, orgID, tokenID uuid.UUID) *service.APIUsageCredentialDTO {
+	if tokenID == uuid.Nil {
+		return &service.APIUsageCredentialDTO{Type: service.APIUsageSession}
+	}
+	cred := &service.APIUsageCredentialDTO{Type: service.APIUsageExtensionToken, TokenID: &tokenID}
+	token, err := s.tokenRepo.GetByID(ctx, tokenID)
+	if err != nil || token.OrganizationID != orgID {
+		return cred
+	}
+	cred.Name = token.Name
+	cred.PersonID = &token.PersonID
+	cred.RevokedAt = token.RevokedAt
+	return cred
+}
+
+func (s *apiUsageService) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+	n, err := s.usageRepo.DeleteBefore(ctx, now.Add(-s.retention))
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		s.logger.Info("purged api usage", "count", n)
+	}
+	return n, nil
+}
@@ -0,0 +1,148 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// archiveBatch bounds how many meetings one ArchiveMeetings call to the
+// repository archives.
+const archiveBatch = 100
+
+type archiveService struct {
+	archiveRepo     repository.ArchiveRepository
+	permissionRepo  repository.PermissionRepository
+	meetingService  service.MeetingService
+	auditLogService service.AuditLogService
+	logger          logger.Logger
+}
+
+// NewArchiveService creates a new ArchiveService implementation.
+func NewArchiveService(
+	archiveRepo repository.ArchiveRepository,
+	permissionRepo repository.PermissionRepository,
+	meetingService service.MeetingService,
+	auditLogService service.AuditLogService,
+	logger logger.Logger,
+) service.ArchiveService {
+	return &archiveService{
+		archiveRepo:     archiveRepo,
+		permissionRepo:  permissionRepo,
+		meetingService:  meetingService,
+		auditLogService: auditLogService,
+		logger:          logger,
+	}
+}
+
+func (s *archiveService) ArchiveMeetings(ctx context.Context, age time.Duration) (*service.ArchiveResult, error) {
+	if age <= 0 {
+		return nil, fmt.Errorf("age must be positive")
+	}
+
+	result := &service.ArchiveResult{Cutoff: time.Now().Add(-age)}
+	for {
+		n, err := s.archiveRepo.ArchiveMeetings(ctx, result.Cutoff, archiveBatch)
+		result.Archived += n
+		if err != nil {
+			s.logger.Error("archive failed", "cutoff", result.Cutoff, "archived", result.Archived, "error", err)
+			return nil, fmt.Errorf("archiving meetings: %w", err)
+		}
+		if n < archiveBatch {
+			break
+		}
+	}
+
+	if result.Archived > 0 {
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			Action:       "archive_meetings",
+			ResourceType: "system",
+			ResourceID:   uuid.Nil,
+			Details:      map[string]interface{}{"cutoff": result.Cutoff, "archived": result.Archived},
+		})
+		s.logger.Info("archived meetings", "cutoff", result.Cutoff, "archived", result.Archived)
+	}
+	return result, nil
+}
+
+// authorize checks that requester administers the organization.
+func (s *archiveService) authorize(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil {
+		return err
+	}
+	if !hasPerm {
+		return fmt.Errorf("forbidden: only organization admins can manage archived meetings")
+	}
+	return nil
+}
+
+func (s *archiveService) ListArchivedMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, pagination service.Pagination) ([]*service.ArchivedMeetingDTO, int64, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, 0, err
+	}
+
+	archives, total, err := s.archiveRepo.ListByOrganization(ctx, orgID, repository.Pagination{
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("listing archived meetings: %w", err)
+	}
+
+	dtos := make([]*service.ArchivedMeetingDTO, len(archives))
+	for i, a := range archives {
+		dtos[i] = mapMeetingArchiveToDTO(a)
+	}
+	return dtos, total, nil
+}
+
+func (s *archiveService) RestoreMeeting(ctx context.Context, orgID uuid.UUID, meetingID uuid.UUID, requesterID uuid.UUID) (*service.MeetingDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	archive, err := s.archiveRepo.GetByMeeting(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if archive.OrganizationID != orgID {
+		return nil, fmt.Errorf("archived meeting not found")
+	}
+	if err := s.archiveRepo.Restore(ctx, meetingID, time.Now()); err != nil {
+		return nil, fmt.Errorf("restoring meeting: %w", err)
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "restore_archived_meeting",
+		ResourceType:   "meeting",
+		ResourceID:     meetingID,
+		Details:        map[string]interface{}{"archived_at": archive.CreatedAt},
+	})
+
+	return s.meetingService.GetMeeting(ctx, meetingID, requesterID, service.MeetingExpand{})
+}
+
+func mapMeetingArchiveToDTO(a *models.MeetingArchive) *service.ArchivedMeetingDTO {
+	return &service.ArchivedMeetingDTO{
+		MeetingID:       a.MeetingID,
+		Purpose:         a.Purpose,
+		StartedAt:       a.StartedAt,
+		StoppedAt:       a.StoppedAt,
+		TotalCost:       a.TotalCost,
+		TotalDuration:   a.TotalDuration,
+		MaxAttendees:    a.MaxAttendees,
+		AttendeeSeconds: a.AttendeeSeconds,
+		IncrementCount:  a.IncrementCount,
+		ArchivedAt:      a.CreatedAt,
+		Size:            a.Size,
+	}
+}
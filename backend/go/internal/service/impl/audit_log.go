@@ -3,22 +3,51 @@ package impl
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
 	"github.com/yourorg/meeting-cost/backend/go/internal/service"
 	"gorm.io/datatypes"
 )
 
+// auditBatch bounds how many buffered entries one insert writes.
+const auditBatch = 100
+
+// auditWriteTimeout bounds each write of buffered entries, which outlive
+// the requests that logged them.
+const auditWriteTimeout = 10 * time.Second
+
 type auditLogService struct {
 	auditLogRepo repository.AuditLogRepository
+	logger       logger.Logger
+
+	// mu guards closed, so pending isn't sent on once closed
+	mu      sync.RWMutex
+	closed  bool
+	pending chan *models.AuditLog // nil when entries aren't buffered
+	done    chan struct{}
 }
 
-// NewAuditLogService creates a new AuditLogService implementation.
-func NewAuditLogService(auditLogRepo repository.AuditLogRepository) service.AuditLogService {
-	return &auditLogService{
+// NewAuditLogService creates a new AuditLogService implementation. With a
+// positive buffer.Size, entries are queued and written in batches by a
+// background writer, so logging doesn't wait on the database. An entry
+// logged while the buffer is full is written straight away instead.
+func NewAuditLogService(auditLogRepo repository.AuditLogRepository, buffer service.AuditBuffer, logger logger.Logger) service.AuditLogService {
+	s := &auditLogService{
 		auditLogRepo: auditLogRepo,
+		logger:       logger,
+	}
+	if buffer.Size > 0 {
+		s.pending = make(chan *models.AuditLog, buffer.Size)
+		s.done = make(chan struct{})
+		go s.run(buffer.FlushInterval)
 	}
+	return s
 }
 
 func (s *auditLogService) Log(ctx context.Context, params service.LogParams) error {
@@ -39,7 +68,103 @@ func (s *auditLogService) Log(ctx context.Context, params service.LogParams) err
 		Details:        details,
 		IPAddress:      params.IPAddress,
 		UserAgent:      params.UserAgent,
+		// Buffered entries are stamped when logged, not when written
+		CreatedAt: time.Now(),
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pending != nil && !s.closed {
+		select {
+		case s.pending <- auditLog:
+			return nil
+		default:
+		}
+	}
 	return s.auditLogRepo.Create(ctx, auditLog)
 }
+
+// run writes pending entries a batch at a time, when a batch fills or
+// every interval, until pending is closed.
+func (s *auditLogService) run(interval time.Duration) {
+	defer close(s.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	batch := make([]*models.AuditLog, 0, auditBatch)
+	for {
+		select {
+		case auditLog, ok := <-s.pending:
+			if !ok {
+				s.write(batch)
+				return
+			}
+			batch = append(batch, auditLog)
+			if len(batch) == auditBatch {
+				s.write(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			s.write(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (s *auditLogService) write(batch []*models.AuditLog) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), auditWriteTimeout)
+	defer cancel()
+	if err := s.auditLogRepo.CreateBatch(ctx, batch); err != nil {
+		s.logger.Error("failed to write audit logs", "count", len(batch), "error", err)
+	}
+}
+
+func (s *auditLogService) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if s.pending == nil || s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.pending)
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("flushing audit logs: %w", ctx.Err())
+	}
+}
+
+func (s *auditLogService) MeetingDeleters(ctx context.Context, orgID uuid.UUID, since time.Time) (map[uuid.UUID]uuid.UUID, error) {
+	logs, err := s.auditLogRepo.ListByOrganization(ctx, orgID, []string{"delete_meeting", "bulk_delete_meetings"}, since)
+	if err != nil {
+		return nil, err
+	}
+
+	// Later entries win
+	deleters := make(map[uuid.UUID]uuid.UUID)
+	for _, l := range logs {
+		if l.PersonID == nil {
+			continue
+		}
+		if l.Action == "delete_meeting" {
+			deleters[l.ResourceID] = *l.PersonID
+			continue
+		}
+		var details struct {
+			MeetingIDs []uuid.UUID `json:"meeting_ids"`
+		}
+		if err := json.Unmarshal(l.Details, &details); err != nil {
+			continue
+		}
+		for _, id := range details.MeetingIDs {
+			deleters[id] = *l.PersonID
+		}
+	}
+	return deleters, nil
+}
@@ -6,6 +6,9 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -29,7 +32,10 @@ have ai look at the rest of them for consistency
 type authService struct {
 	personRepo      repository.PersonRepository
 	authRepo        repository.AuthRepository
+	profileRepo     repository.PersonOrganizationProfileRepository
+	permissionRepo  repository.PermissionRepository
 	tokenManager    *auth.TokenManager
+	revocations     *auth.MembershipRevocations
 	auditLogService service.AuditLogService
 	logger          logger.Logger
 }
@@ -38,14 +44,20 @@ type authService struct {
 func NewAuthService(
 	personRepo repository.PersonRepository,
 	authRepo repository.AuthRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	permissionRepo repository.PermissionRepository,
 	tokenManager *auth.TokenManager,
+	revocations *auth.MembershipRevocations,
 	auditLogService service.AuditLogService,
 	logger logger.Logger,
 ) service.AuthService {
 	return &authService{
 		personRepo:      personRepo,
 		authRepo:        authRepo,
+		profileRepo:     profileRepo,
+		permissionRepo:  permissionRepo,
 		tokenManager:    tokenManager,
+		revocations:     revocations,
 		auditLogService: auditLogService,
 		logger:          logger,
 	}
@@ -58,6 +70,13 @@ func (s *authService) Register(ctx context.Context, req service.RegisterRequest)
 		return nil, fmt.Errorf("email already registered")
 	}
 
+	if req.Timezone == "" {
+		req.Timezone = "UTC"
+	}
+	if _, err := service.LoadTimezone(req.Timezone); err != nil {
+		return nil, err
+	}
+
 	// 2. Hash password
 	hashedPassword, err := auth.HashPassword(req.Password)
 	if err != nil {
@@ -69,6 +88,7 @@ func (s *authService) Register(ctx context.Context, req service.RegisterRequest)
 		Email:     req.Email,
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
+		Timezone:  req.Timezone,
 	}
 	if err := s.personRepo.Create(ctx, person); err != nil {
 		return nil, fmt.Errorf("creating person: %w", err)
@@ -87,16 +107,19 @@ func (s *authService) Register(ctx context.Context, req service.RegisterRequest)
 	}
 
 	// 5. Generate Initial Token Pair
-	tokens, err := s.tokenManager.GenerateTokenPair(person.ID, person.Email)
+	tokens, err := s.tokenManager.GenerateTokenPair(person.ID, person.Email, s.memberships(ctx, person.ID), nil)
 	if err != nil {
 		return nil, fmt.Errorf("generating tokens: %w", err)
 	}
 
 	// 6. Create Session
 	session := &models.Session{
-		PersonID:  person.ID,
-		TokenHash: s.hashToken(tokens.AccessToken),
-		ExpiresAt: time.Now().Add(7 * 24 * time.Hour), // Match refresh token expiry
+		PersonID:     person.ID,
+		TokenHash:    s.hashToken(tokens.AccessToken),
+		ExpiresAt:    time.Now().Add(7 * 24 * time.Hour), // Match refresh token expiry
+		LastActivity: time.Now(),
+		UserAgent:    req.UserAgent,
+		IPAddress:    req.IPAddress,
 	}
 	if err := s.authRepo.CreateSession(ctx, session); err != nil {
 		s.logger.Error("failed to create session after registration", "error", err)
@@ -151,16 +174,22 @@ func (s *authService) Login(ctx context.Context, req service.LoginRequest) (*ser
 	}
 
 	// 3. Generate tokens
-	tokens, err := s.tokenManager.GenerateTokenPair(person.ID, person.Email)
+	activeOrgID := s.activeOrganization(ctx, person)
+	tokens, err := s.tokenManager.GenerateTokenPair(person.ID, person.Email, s.memberships(ctx, person.ID), activeOrgID)
 	if err != nil {
 		return nil, fmt.Errorf("generating tokens: %w", err)
 	}
 
 	// 4. Create session
 	session := &models.Session{
-		PersonID:  person.ID,
-		TokenHash: s.hashToken(tokens.AccessToken),
-		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+		PersonID:     person.ID,
+		TokenHash:    s.hashToken(tokens.AccessToken),
+		ExpiresAt:    time.Now().Add(7 * 24 * time.Hour),
+		LastActivity: time.Now(),
+		UserAgent:    req.UserAgent,
+		IPAddress:    req.IPAddress,
+
+		ActiveOrganizationID: activeOrgID,
 	}
 	if err := s.authRepo.CreateSession(ctx, session); err != nil {
 		return nil, fmt.Errorf("creating session: %w", err)
@@ -206,7 +235,7 @@ func (s *authService) Logout(ctx context.Context, token string, ipAddress, userA
 	return err
 }
 
-func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (*service.TokenResponse, error) {
+func (s *authService) RefreshToken(ctx context.Context, refreshToken string, ipAddress, userAgent string) (*service.TokenResponse, error) {
 	personID, err := s.tokenManager.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return nil, fmt.Errorf("invalid refresh token: %w", err)
@@ -217,16 +246,22 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (*s
 		return nil, fmt.Errorf("person not found: %w", err)
 	}
 
-	tokens, err := s.tokenManager.GenerateTokenPair(person.ID, person.Email)
+	activeOrgID := s.activeOrganization(ctx, person)
+	tokens, err := s.tokenManager.GenerateTokenPair(person.ID, person.Email, s.memberships(ctx, person.ID), activeOrgID)
 	if err != nil {
 		return nil, fmt.Errorf("generating tokens: %w", err)
 	}
 
 	// Create new session for the new access token
 	session := &models.Session{
-		PersonID:  person.ID,
-		TokenHash: s.hashToken(tokens.AccessToken),
-		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+		PersonID:     person.ID,
+		TokenHash:    s.hashToken(tokens.AccessToken),
+		ExpiresAt:    time.Now().Add(7 * 24 * time.Hour),
+		LastActivity: time.Now(),
+		UserAgent:    userAgent,
+		IPAddress:    ipAddress,
+
+		ActiveOrganizationID: activeOrgID,
 	}
 	if err := s.authRepo.CreateSession(ctx, session); err != nil {
 		return nil, fmt.Errorf("creating session: %w", err)
@@ -295,24 +330,254 @@ func (s *authService) ValidateSession(ctx context.Context, token string) (*servi
 	session.LastActivity = time.Now()
 	_ = s.authRepo.UpdateSession(ctx, session)
 
-	return &service.SessionInfo{
-		PersonID:     claims.PersonID,
-		Email:        claims.Email,
-		ExpiresAt:    session.ExpiresAt,
-		LastActivity: session.LastActivity,
-	}, nil
+	info := &service.SessionInfo{
+		SessionID:            session.ID,
+		PersonID:             claims.PersonID,
+		Email:                claims.Email,
+		ExpiresAt:            session.ExpiresAt,
+		LastActivity:         session.LastActivity,
+		ActiveOrganizationID: session.ActiveOrganizationID,
+	}
+	if claims.Memberships != nil && claims.IssuedAt != nil &&
+		!s.revocations.Revoked(ctx, claims.PersonID, claims.IssuedAt.Time) {
+		info.Memberships = claims.Memberships
+	}
+	return info, nil
+}
+
+// activeOrganization returns the organization a new session of the person
+// starts in: the one they last switched to while they are still a member of
+// it, or else their only organization.
+func (s *authService) activeOrganization(ctx context.Context, person *models.Person) *uuid.UUID {
+	profiles, err := s.profileRepo.GetByPerson(ctx, person.ID)
+	if err != nil {
+		return nil
+	}
+	var orgIDs []uuid.UUID
+	for _, p := range profiles {
+		if !p.IsActive {
+			continue
+		}
+		if person.ActiveOrganizationID != nil && p.OrganizationID == *person.ActiveOrganizationID {
+			return person.ActiveOrganizationID
+		}
+		orgIDs = append(orgIDs, p.OrganizationID)
+	}
+	if len(orgIDs) == 1 {
+		return &orgIDs[0]
+	}
+	return nil
+}
+
+func (s *authService) SetActiveOrganization(ctx context.Context, personID, sessionID, orgID uuid.UUID, ipAddress, userAgent string) (*service.ActiveOrganizationDTO, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, personID, orgID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of the organization")
+	}
+
+	person, err := s.personRepo.GetByID(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	person.ActiveOrganizationID = &orgID
+	if err := s.personRepo.Update(ctx, person); err != nil {
+		return nil, fmt.Errorf("updating person: %w", err)
+	}
+
+	sessions, err := s.authRepo.GetSessionsByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.ID == sessionID {
+			session.ActiveOrganizationID = &orgID
+			if err := s.authRepo.UpdateSession(ctx, session); err != nil {
+				return nil, fmt.Errorf("updating session: %w", err)
+			}
+		}
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &personID,
+		OrganizationID: &orgID,
+		Action:         "switch_organization",
+		ResourceType:   "organization",
+		ResourceID:     orgID,
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+	})
+
+	return &service.ActiveOrganizationDTO{OrganizationID: orgID}, nil
+}
+
+// memberships returns the person's active memberships, with the role names
+// and organization-wide grants of each, for their access token. It returns
+// nil when tokens carry no membership claims or the memberships can't be
+// read, leaving permission checks to the repositories.
+func (s *authService) memberships(ctx context.Context, personID uuid.UUID) []auth.Membership {
+	if !s.tokenManager.MembershipClaims() {
+		return nil
+	}
+	memberships, err := s.readMemberships(ctx, personID)
+	if err != nil {
+		s.logger.Error("failed to read memberships for token claims", "person_id", personID, "error", err)
+		return nil
+	}
+	return memberships
 }
 
-func (s *authService) GetSessions(ctx context.Context, personID uuid.UUID) ([]*models.Session, error) {
-	return s.authRepo.GetSessionsByPerson(ctx, personID)
+// readMemberships returns the person's active memberships, with the role
+// names and organization-wide grants of each.
+func (s *authService) readMemberships(ctx context.Context, personID uuid.UUID) ([]auth.Membership, error) {
+	profiles, err := s.profileRepo.GetByPerson(ctx, personID)
+	if err != nil {
+		return nil, fmt.Errorf("reading memberships: %w", err)
+	}
+
+	memberships := []auth.Membership{}
+	for _, p := range profiles {
+		if !p.IsActive {
+			continue
+		}
+		roles, err := s.permissionRepo.GetRolesByPerson(ctx, personID, p.OrganizationID)
+		if err != nil {
+			return nil, fmt.Errorf("reading roles: %w", err)
+		}
+		m := auth.Membership{OrganizationID: p.OrganizationID, Roles: []string{}}
+		for _, role := range roles {
+			m.Roles = append(m.Roles, role.Name)
+			perms, err := s.permissionRepo.GetPermissionsByRole(ctx, role.ID)
+			if err != nil {
+				return nil, fmt.Errorf("reading permissions of role %s: %w", role.ID, err)
+			}
+			for _, perm := range perms {
+				grant := auth.Grant(perm.ResourceName, perm.Activity)
+				if perm.Allowed && perm.TargetResourceID == nil && !slices.Contains(m.Grants, grant) {
+					m.Grants = append(m.Grants, grant)
+				}
+			}
+		}
+		memberships = append(memberships, m)
+	}
+	return memberships, nil
 }
 
-func (s *authService) RevokeSession(ctx context.Context, personID, sessionID uuid.UUID) error {
-	return s.authRepo.DeleteSession(ctx, sessionID)
+// IntrospectToken reports an access token active while it is valid and its
+// session is live. Refresh tokens, which have no session, are inactive.
+func (s *authService) IntrospectToken(ctx context.Context, token string) (*service.IntrospectionResponse, error) {
+	inactive := &service.IntrospectionResponse{}
+	claims, err := s.tokenManager.ValidateAccessToken(token)
+	if err != nil || claims.PersonID == uuid.Nil {
+		return inactive, nil
+	}
+	session, err := s.authRepo.GetSessionByTokenHash(ctx, s.hashToken(token))
+	if err != nil || time.Now().After(session.ExpiresAt) {
+		return inactive, nil
+	}
+
+	memberships, err := s.readMemberships(ctx, claims.PersonID)
+	if err != nil {
+		return nil, err
+	}
+	var scope []string
+	for _, m := range memberships {
+		for _, grant := range m.Grants {
+			scope = append(scope, m.OrganizationID.String()+":"+grant)
+		}
+	}
+
+	res := &service.IntrospectionResponse{
+		Active:      true,
+		TokenType:   "Bearer",
+		Scope:       strings.Join(scope, " "),
+		Subject:     claims.PersonID.String(),
+		Username:    claims.Email,
+		Issuer:      claims.Issuer,
+		Memberships: memberships,
+
+		ActiveOrganizationID: session.ActiveOrganizationID,
+	}
+	if claims.IssuedAt != nil {
+		res.IssuedAt = claims.IssuedAt.Unix()
+	}
+	if claims.ExpiresAt != nil {
+		res.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+	return res, nil
 }
 
-func (s *authService) RevokeAllSessions(ctx context.Context, personID uuid.UUID) error {
-	return s.authRepo.DeleteSessionsByPerson(ctx, personID)
+func (s *authService) GetSessions(ctx context.Context, personID, currentSessionID uuid.UUID) ([]*service.SessionDTO, error) {
+	sessions, err := s.authRepo.GetSessionsByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dtos := make([]*service.SessionDTO, 0, len(sessions))
+	for _, session := range sessions {
+		if now.After(session.ExpiresAt) {
+			continue
+		}
+		dtos = append(dtos, &service.SessionDTO{
+			ID:           session.ID,
+			CreatedAt:    session.CreatedAt,
+			LastActivity: session.LastActivity,
+			ExpiresAt:    session.ExpiresAt,
+			UserAgent:    session.UserAgent,
+			IPAddress:    session.IPAddress,
+			Current:      session.ID == currentSessionID,
+		})
+	}
+	sort.Slice(dtos, func(i, j int) bool {
+		return dtos[i].LastActivity.After(dtos[j].LastActivity)
+	})
+	return dtos, nil
+}
+
+func (s *authService) RevokeSession(ctx context.Context, personID, sessionID uuid.UUID, ipAddress, userAgent string) error {
+	sessions, err := s.authRepo.GetSessionsByPerson(ctx, personID)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(sessions, func(session *models.Session) bool { return session.ID == sessionID }) {
+		return fmt.Errorf("session not found")
+	}
+	if err := s.authRepo.DeleteSession(ctx, sessionID); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     &personID,
+		Action:       "revoke_session",
+		ResourceType: "session",
+		ResourceID:   sessionID,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+	})
+	return nil
+}
+
+func (s *authService) RevokeAllSessions(ctx context.Context, personID uuid.UUID, ipAddress, userAgent string) error {
+	sessions, err := s.authRepo.GetSessionsByPerson(ctx, personID)
+	if err != nil {
+		return err
+	}
+	if err := s.authRepo.DeleteSessionsByPerson(ctx, personID); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     &personID,
+		Action:       "logout_all",
+		ResourceType: "person",
+		ResourceID:   personID,
+		Details: map[string]interface{}{
+			"sessions_revoked": len(sessions),
+		},
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	})
+	return nil
 }
 
 // Helper: Hash token for session storage
@@ -0,0 +1,42 @@
+package impl
+
+import (
+	"fmt"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type calculatorService struct{}
+
+// NewCalculatorService creates a new CalculatorService implementation.
+func NewCalculatorService() service.CalculatorService {
+	return &calculatorService{}
+}
+
+func (s *calculatorService) Calculate(req service.CalculateRequest) (*service.CalculationDTO, error) {
+	switch {
+	case req.Attendees < 1 || req.Attendees > service.MaxCalculatorAttendees:
+		return nil, fmt.Errorf("invalid attendees: must be from 1 to %d", service.MaxCalculatorAttendees)
+	case req.AverageWage < 0 || req.AverageWage > service.MaxCalculatorWage:
+		return nil, fmt.Errorf("invalid average_wage: must be from 0 to %d", service.MaxCalculatorWage)
+	case req.DurationMinutes < 1 || req.DurationMinutes > service.MaxCalculatorMinutes:
+		return nil, fmt.Errorf("invalid duration_minutes: must be from 1 to %d", service.MaxCalculatorMinutes)
+	}
+
+	// Priced as a stopped meeting of one increment, whose cost is kept to
+	// the cent
+	seconds := req.DurationMinutes * 60
+	cost := roundCents(incrementCost(seconds, req.Attendees, req.AverageWage))
+	res := &service.CalculationDTO{
+		MeetingCostDTO: service.MeetingCostDTO{
+			TotalCost:     cost,
+			TotalDuration: seconds,
+			CostPerSecond: cost / float64(seconds),
+		},
+		CostPerAttendee: roundCents(cost / float64(req.Attendees)),
+		AttendeeHours:   roundCents(float64(seconds) / 3600 * float64(req.Attendees)),
+	}
+	res.CostPerMinute = res.CostPerSecond * 60
+	res.CostPerHour = res.CostPerSecond * 3600
+	return res, nil
+}
@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,13 +13,17 @@ import (
 
 type consentService struct {
 	repo            repository.ConsentRepository
+	categoryRepo    repository.ConsentCategoryRepository
 	auditLogService service.AuditLogService
+	policy          service.ConsentPolicy
 }
 
-func NewConsentService(repo repository.ConsentRepository, auditLogService service.AuditLogService) service.ConsentService {
+func NewConsentService(repo repository.ConsentRepository, categoryRepo repository.ConsentCategoryRepository, auditLogService service.AuditLogService, policy service.ConsentPolicy) service.ConsentService {
 	return &consentService{
 		repo:            repo,
+		categoryRepo:    categoryRepo,
 		auditLogService: auditLogService,
+		policy:          policy,
 	}
 }
 
@@ -31,25 +36,51 @@ func (s *consentService) GetConsent(ctx context.Context, sessionID string) (*ser
 }
 
 func (s *consentService) UpdateConsent(ctx context.Context, req service.UpdateConsentRequest) (*service.ConsentDTO, error) {
+	categories, err := s.categories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Older clients send only the built-in categories' fields
+	grants := map[string]bool{
+		models.ConsentAnalytics:  req.AnalyticsCookies,
+		models.ConsentMarketing:  req.MarketingCookies,
+		models.ConsentFunctional: req.FunctionalCookies,
+	}
+	required := make(map[string]bool, len(categories))
+	for _, c := range categories {
+		required[c.Key] = c.Required
+		if _, ok := grants[c.Key]; !ok {
+			grants[c.Key] = c.Required
+		}
+	}
+	for key, granted := range req.Categories {
+		isRequired, ok := required[key]
+		if !ok {
+			return nil, fmt.Errorf("invalid consent category %q", key)
+		}
+		grants[key] = granted || isRequired
+	}
+
 	previous, _ := s.repo.GetCurrentBySession(ctx, req.SessionID)
 
 	consent := &models.CookieConsent{
-		SessionID:         req.SessionID,
-		PersonID:          req.PersonID,
-		NecessaryCookies:  true,
-		AnalyticsCookies:  req.AnalyticsCookies,
-		MarketingCookies:  req.MarketingCookies,
-		FunctionalCookies: req.FunctionalCookies,
-		ConsentVersion:    "1.0.0", // Hardcoded for now
-		ConsentDate:       time.Now(),
-		IPAddress:         req.IPAddress,
-		UserAgent:         req.UserAgent,
-		ConsentSource:     "update",
+		SessionID:      req.SessionID,
+		PersonID:       req.PersonID,
+		ConsentVersion: s.policy.Version,
+		ConsentDate:    time.Now(),
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+		ConsentSource:  "update",
 	}
-
 	if previous != nil {
 		consent.PreviousConsentID = &previous.ID
+		consent.DoNotSell = previous.DoNotSell
 	}
+	if req.DoNotSell != nil {
+		consent.DoNotSell = *req.DoNotSell
+	}
+	setConsentGrants(consent, grants)
 
 	if err := s.repo.Create(ctx, consent); err != nil {
 		return nil, err
@@ -64,10 +95,12 @@ func (s *consentService) UpdateConsent(ctx context.Context, req service.UpdateCo
 		IPAddress:    consent.IPAddress,
 		UserAgent:    consent.UserAgent,
 		Details: map[string]interface{}{
-			"analytics":  consent.AnalyticsCookies,
-			"marketing":  consent.MarketingCookies,
-			"functional": consent.FunctionalCookies,
-			"version":    consent.ConsentVersion,
+			"analytics":   consent.AnalyticsCookies,
+			"marketing":   consent.MarketingCookies,
+			"functional":  consent.FunctionalCookies,
+			"categories":  grants,
+			"do_not_sell": consent.DoNotSell,
+			"version":     consent.ConsentVersion,
 		},
 	})
 
@@ -83,26 +116,21 @@ func (s *consentService) WithdrawConsent(ctx context.Context, sessionID string,
 	consent := &models.CookieConsent{
 		SessionID:         sessionID,
 		PersonID:          previous.PersonID,
-		NecessaryCookies:  true,
-		AnalyticsCookies:  previous.AnalyticsCookies,
-		MarketingCookies:  previous.MarketingCookies,
-		FunctionalCookies: previous.FunctionalCookies,
 		ConsentVersion:    previous.ConsentVersion,
 		ConsentDate:       time.Now(),
 		ConsentSource:     "withdrawal",
 		PreviousConsentID: &previous.ID,
+		DoNotSell:         previous.DoNotSell,
 	}
 
+	// Necessary consent can't be withdrawn
+	grants := consentGrants(previous)
 	for _, ct := range cookieTypes {
-		switch ct {
-		case "analytics":
-			consent.AnalyticsCookies = false
-		case "marketing":
-			consent.MarketingCookies = false
-		case "functional":
-			consent.FunctionalCookies = false
+		if _, ok := grants[ct]; ok {
+			grants[ct] = false
 		}
 	}
+	setConsentGrants(consent, grants)
 
 	if err := s.repo.Create(ctx, consent); err != nil {
 		return err
@@ -124,6 +152,68 @@ func (s *consentService) WithdrawConsent(ctx context.Context, sessionID string,
 	return nil
 }
 
+func (s *consentService) SetDoNotSell(ctx context.Context, req service.SetDoNotSellRequest) (*service.ConsentDTO, error) {
+	if req.SessionID == "" {
+		return nil, fmt.Errorf("invalid request: session_id is required")
+	}
+
+	consent := &models.CookieConsent{
+		SessionID:      req.SessionID,
+		PersonID:       req.PersonID,
+		ConsentVersion: s.policy.Version,
+		ConsentDate:    time.Now(),
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+		ConsentSource:  "do_not_sell",
+		DoNotSell:      req.DoNotSell,
+	}
+
+	// Keep the session's other choices; without any, only required
+	// categories are granted
+	grants := make(map[string]bool)
+	if previous, err := s.repo.GetCurrentBySession(ctx, req.SessionID); err == nil {
+		grants = consentGrants(previous)
+		consent.PreviousConsentID = &previous.ID
+		consent.ConsentVersion = previous.ConsentVersion
+		if consent.PersonID == nil {
+			consent.PersonID = previous.PersonID
+		}
+	} else {
+		categories, err := s.categories(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range categories {
+			grants[c.Key] = c.Required
+		}
+	}
+	setConsentGrants(consent, grants)
+
+	if err := s.repo.Create(ctx, consent); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     consent.PersonID,
+		Action:       "set_do_not_sell",
+		ResourceType: "cookie_consent",
+		ResourceID:   consent.ID,
+		IPAddress:    consent.IPAddress,
+		UserAgent:    consent.UserAgent,
+		Details:      map[string]interface{}{"do_not_sell": consent.DoNotSell},
+	})
+
+	return s.mapToDTO(consent), nil
+}
+
+func (s *consentService) DoNotSell(ctx context.Context, personID uuid.UUID) (bool, error) {
+	consent, err := s.repo.GetCurrentByPerson(ctx, personID)
+	if err != nil {
+		return false, nil // No consent recorded, so no opt-out
+	}
+	return consent.DoNotSell, nil
+}
+
 func (s *consentService) CheckCookieAllowed(ctx context.Context, sessionID string, cookieCategory string) (bool, error) {
 	if cookieCategory == "necessary" {
 		return true, nil
@@ -134,16 +224,8 @@ func (s *consentService) CheckCookieAllowed(ctx context.Context, sessionID strin
 		return false, nil // Default to false if no consent found
 	}
 
-	switch cookieCategory {
-	case "analytics":
-		return consent.AnalyticsCookies, nil
-	case "marketing":
-		return consent.MarketingCookies, nil
-	case "functional":
-		return consent.FunctionalCookies, nil
-	default:
-		return false, nil
-	}
+	// Categories added since count as declined until consent is updated
+	return consentGrants(consent)[cookieCategory], nil
 }
 
 func (s *consentService) ClassifyCookie(cookieName string) string {
@@ -194,15 +276,56 @@ func (s *consentService) ExportConsentData(ctx context.Context, personID uuid.UU
 		consents[i] = *s.mapToDTO(m)
 	}
 
+	// History is newest first
+	doNotSell := len(history) > 0 && history[0].DoNotSell
+
 	return &service.ConsentExportDTO{
 		PersonID:   personID,
+		DoNotSell:  doNotSell,
 		Consents:   consents,
 		ExportDate: time.Now(),
 	}, nil
 }
 
 func (s *consentService) GetCurrentPolicyVersion(ctx context.Context) (string, error) {
-	return "1.0.0", nil
+	return s.policy.Version, nil
+}
+
+func (s *consentService) GetBanner(ctx context.Context, sessionID string) (*service.ConsentBannerDTO, error) {
+	categories, err := s.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	banner := &service.ConsentBannerDTO{
+		PolicyVersion: s.policy.Version,
+		Title:         s.policy.BannerTitle,
+		Text:          s.policy.BannerText,
+		Links:         []service.ConsentBannerLink{},
+		Categories:    categories,
+	}
+	if s.policy.PrivacyPolicyURL != "" {
+		banner.Links = append(banner.Links, service.ConsentBannerLink{Label: "Privacy policy", URL: s.policy.PrivacyPolicyURL})
+	}
+	if s.policy.CookiePolicyURL != "" {
+		banner.Links = append(banner.Links, service.ConsentBannerLink{Label: "Cookie policy", URL: s.policy.CookiePolicyURL})
+	}
+
+	if sessionID != "" {
+		needs := true
+		if consent, err := s.repo.GetCurrentBySession(ctx, sessionID); err == nil && consent.ConsentVersion == s.policy.Version {
+			grants := consentGrants(consent)
+			needs = false
+			for _, c := range categories {
+				if _, ok := grants[c.Key]; !ok {
+					needs = true
+					break
+				}
+			}
+		}
+		banner.NeedsConsent = &needs
+	}
+	return banner, nil
 }
 
 func (s *consentService) SyncConsent(ctx context.Context, sessionID string, personID uuid.UUID) error {
@@ -220,15 +343,13 @@ func (s *consentService) SyncConsent(ctx context.Context, sessionID string, pers
 	newConsent := &models.CookieConsent{
 		SessionID:         sessionID,
 		PersonID:          &personID,
-		NecessaryCookies:  true,
-		AnalyticsCookies:  consent.AnalyticsCookies,
-		MarketingCookies:  consent.MarketingCookies,
-		FunctionalCookies: consent.FunctionalCookies,
 		ConsentVersion:    consent.ConsentVersion,
 		ConsentDate:       time.Now(),
 		ConsentSource:     "sync",
 		PreviousConsentID: &consent.ID,
+		DoNotSell:         consent.DoNotSell,
 	}
+	setConsentGrants(newConsent, consentGrants(consent))
 
 	if err := s.repo.Create(ctx, newConsent); err != nil {
 		return err
@@ -257,6 +378,8 @@ func (s *consentService) mapToDTO(m *models.CookieConsent) *service.ConsentDTO {
 		AnalyticsCookies:  m.AnalyticsCookies,
 		MarketingCookies:  m.MarketingCookies,
 		FunctionalCookies: m.FunctionalCookies,
+		Categories:        consentGrants(m),
+		DoNotSell:         m.DoNotSell,
 		ConsentVersion:    m.ConsentVersion,
 		ConsentDate:       m.ConsentDate,
 		PreviousConsentID: m.PreviousConsentID,
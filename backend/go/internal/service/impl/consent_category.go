@@ -0,0 +1,168 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// consentCategoryKey is the form of a category key, e.g. ai_processing.
+var consentCategoryKey = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+func (s *consentService) ListCategories(ctx context.Context) ([]*service.ConsentCategoryDTO, error) {
+	categories, err := s.categories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*service.ConsentCategoryDTO, len(categories))
+	for i, c := range categories {
+		dtos[i] = mapConsentCategoryToDTO(c)
+	}
+	return dtos, nil
+}
+
+func (s *consentService) SaveCategory(ctx context.Context, key string, req service.SaveConsentCategoryRequest) (*service.ConsentCategoryDTO, error) {
+	if !consentCategoryKey.MatchString(key) {
+		return nil, fmt.Errorf("invalid key: use lowercase letters, digits and underscores, starting with a letter")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, fmt.Errorf("invalid name: must not be empty")
+	}
+
+	category := &models.ConsentCategory{
+		Key:         key,
+		Name:        req.Name,
+		Description: req.Description,
+		Required:    req.Required,
+		Position:    req.Position,
+	}
+	// Built-in categories keep whether they are required
+	if builtIn := builtInConsentCategory(key); builtIn != nil {
+		category.Required = builtIn.Required
+	}
+	if err := s.categoryRepo.Save(ctx, category); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		Action:       "save_consent_category",
+		ResourceType: "consent_category",
+		ResourceID:   category.ID,
+		Details: map[string]interface{}{
+			"key":      key,
+			"name":     category.Name,
+			"required": category.Required,
+		},
+	})
+
+	return mapConsentCategoryToDTO(category), nil
+}
+
+func (s *consentService) DeleteCategory(ctx context.Context, key string) error {
+	if builtInConsentCategory(key) != nil {
+		return fmt.Errorf("invalid key: built-in categories can't be deleted")
+	}
+	category, err := s.categoryRepo.GetByKey(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := s.categoryRepo.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		Action:       "delete_consent_category",
+		ResourceType: "consent_category",
+		ResourceID:   category.ID,
+		Details:      map[string]interface{}{"key": key},
+	})
+	return nil
+}
+
+// categories returns the built-in categories, as configured if they are,
+// and the configured ones, in banner order.
+func (s *consentService) categories(ctx context.Context) ([]*models.ConsentCategory, error) {
+	configured, err := s.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]*models.ConsentCategory, len(configured))
+	for _, c := range configured {
+		byKey[c.Key] = c
+	}
+	categories := configured
+	for _, c := range models.DefaultConsentCategories() {
+		if _, ok := byKey[c.Key]; !ok {
+			categories = append(categories, c)
+		}
+	}
+	sort.SliceStable(categories, func(i, j int) bool {
+		if categories[i].Position != categories[j].Position {
+			return categories[i].Position < categories[j].Position
+		}
+		return categories[i].Key < categories[j].Key
+	})
+	return categories, nil
+}
+
+// builtInConsentCategory returns the built-in category with key, or nil.
+func builtInConsentCategory(key string) *models.ConsentCategory {
+	for _, c := range models.DefaultConsentCategories() {
+		if c.Key == key {
+			return c
+		}
+	}
+	return nil
+}
+
+// consentGrants returns what a consent record granted, by category key.
+// Records from before categories were configurable only have the built-in
+// columns.
+func consentGrants(m *models.CookieConsent) map[string]bool {
+	grants := make(map[string]bool)
+	if len(m.Categories) > 0 {
+		_ = json.Unmarshal(m.Categories, &grants)
+	}
+	if len(grants) == 0 {
+		grants[models.ConsentNecessary] = m.NecessaryCookies
+		grants[models.ConsentAnalytics] = m.AnalyticsCookies
+		grants[models.ConsentMarketing] = m.MarketingCookies
+		grants[models.ConsentFunctional] = m.FunctionalCookies
+	}
+	return grants
+}
+
+// setConsentGrants stores grants on a consent record, keeping the built-in
+// columns in step for older clients. Marketing is declined while the
+// record opts out of the sale or sharing of personal information.
+func setConsentGrants(m *models.CookieConsent, grants map[string]bool) {
+	grants[models.ConsentNecessary] = true
+	if m.DoNotSell {
+		grants[models.ConsentMarketing] = false
+	}
+	m.NecessaryCookies = true
+	m.AnalyticsCookies = grants[models.ConsentAnalytics]
+	m.MarketingCookies = grants[models.ConsentMarketing]
+	m.FunctionalCookies = grants[models.ConsentFunctional]
+	m.Categories, _ = json.Marshal(grants)
+}
+
+func mapConsentCategoryToDTO(c *models.ConsentCategory) *service.ConsentCategoryDTO {
+	return &service.ConsentCategoryDTO{
+		Key:         c.Key,
+		Name:        c.Name,
+		Description: c.Description,
+		Required:    c.Required,
+		Position:    c.Position,
+		BuiltIn:     builtInConsentCategory(c.Key) != nil,
+	}
+}
@@ -0,0 +1,229 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/pubsub"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type costAlertService struct {
+	alertRepo           repository.CostAlertRepository
+	meetingRepo         repository.MeetingRepository
+	profileRepo         repository.PersonOrganizationProfileRepository
+	permissionRepo      repository.PermissionRepository
+	integrationRepo     repository.IntegrationRepository
+	notificationService service.NotificationService
+	pubsub              pubsub.PubSub
+	milestones          service.MilestonePolicy
+	logger              logger.Logger
+}
+
+// NewCostAlertService creates a new CostAlertService.
+func NewCostAlertService(
+	alertRepo repository.CostAlertRepository,
+	meetingRepo repository.MeetingRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	permissionRepo repository.PermissionRepository,
+	integrationRepo repository.IntegrationRepository,
+	notificationService service.NotificationService,
+	ps pubsub.PubSub,
+	milestones service.MilestonePolicy,
+	logger logger.Logger,
+) service.CostAlertService {
+	return &costAlertService{
+		alertRepo:           alertRepo,
+		meetingRepo:         meetingRepo,
+		profileRepo:         profileRepo,
+		permissionRepo:      permissionRepo,
+		integrationRepo:     integrationRepo,
+		notificationService: notificationService,
+		pubsub:              ps,
+		milestones:          milestones,
+		logger:              logger,
+	}
+}
+
+// authorize checks that requester is an active member of the organization.
+func (s *costAlertService) authorize(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return fmt.Errorf("forbidden: not a member of this organization")
+	}
+	return nil
+}
+
+func (s *costAlertService) CreateAlert(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.CreateCostAlertRequest) (*service.CostAlertDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if req.Threshold <= 0 {
+		return nil, fmt.Errorf("invalid threshold: must be greater than zero")
+	}
+
+	if req.MeetingID != nil {
+		meeting, err := s.meetingRepo.GetByID(ctx, *req.MeetingID)
+		if err != nil || meeting.OrganizationID != orgID {
+			return nil, fmt.Errorf("meeting not found")
+		}
+		hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "meeting", req.MeetingID, "read")
+		if err != nil {
+			return nil, err
+		}
+		if !hasPerm {
+			return nil, fmt.Errorf("forbidden")
+		}
+	}
+
+	alert := &models.CostAlert{
+		OrganizationID: orgID,
+		PersonID:       requesterID,
+		MeetingID:      req.MeetingID,
+		Threshold:      req.Threshold,
+	}
+	if err := s.alertRepo.Create(ctx, alert); err != nil {
+		return nil, err
+	}
+	return toCostAlertDTO(alert), nil
+}
+
+func (s *costAlertService) ListAlerts(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*service.CostAlertDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	alerts, err := s.alertRepo.ListByPerson(ctx, orgID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*service.CostAlertDTO, len(alerts))
+	for i, a := range alerts {
+		dtos[i] = toCostAlertDTO(a)
+	}
+	return dtos, nil
+}
+
+func (s *costAlertService) DeleteAlert(ctx context.Context, orgID, alertID uuid.UUID, requesterID uuid.UUID) error {
+	// Alerts are private to their owner, so someone else's is reported as
+	// missing
+	alert, err := s.alertRepo.GetByID(ctx, alertID)
+	if err != nil || alert.OrganizationID != orgID || alert.PersonID != requesterID {
+		return fmt.Errorf("cost alert not found")
+	}
+	return s.alertRepo.Delete(ctx, alertID)
+}
+
+func (s *costAlertService) Evaluate(ctx context.Context, orgID, meetingID uuid.UUID, cost float64) error {
+	return errors.Join(
+		s.fireAlerts(ctx, orgID, meetingID, cost),
+		s.announceMilestone(ctx, orgID, meetingID, cost),
+	)
+}
+
+// fireAlerts fires the alerts due for the meeting at cost.
+func (s *costAlertService) fireAlerts(ctx context.Context, orgID, meetingID uuid.UUID, cost float64) error {
+	alerts, err := s.alertRepo.ListDue(ctx, orgID, meetingID, cost)
+	if err != nil || len(alerts) == 0 {
+		return err
+	}
+
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return err
+	}
+	title := meeting.Purpose
+	if title == "" {
+		title = "Untitled meeting"
+	}
+
+	var errs []error
+	for _, alert := range alerts {
+		// Recording the trigger first makes concurrent evaluations of the
+		// same meeting fire each alert once
+		fired, err := s.alertRepo.RecordTrigger(ctx, &models.CostAlertTrigger{
+			AlertID:   alert.ID,
+			MeetingID: meetingID,
+			Cost:      cost,
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !fired {
+			continue
+		}
+
+		now := time.Now()
+		alert.LastTriggeredAt = &now
+		if err := s.alertRepo.Update(ctx, alert); err != nil {
+			s.logger.Error("failed to update cost alert", "alert_id", alert.ID, "error", err)
+		}
+
+		event := service.MeetingEvent{
+			Type:      service.EventCostThreshold,
+			MeetingID: meetingID,
+			Payload: service.CostThresholdEvent{
+				AlertID:   alert.ID,
+				PersonID:  alert.PersonID,
+				Threshold: alert.Threshold,
+				Cost:      cost,
+			},
+		}
+		if err := s.pubsub.Publish(ctx, cache.ChannelMeetingEvents(meetingID), event); err != nil {
+			s.logger.Error("failed to broadcast cost threshold event", "meeting_id", meetingID, "alert_id", alert.ID, "error", err)
+		}
+
+		// Skip owners who have since left the organization
+		if profile, err := s.profileRepo.GetByPersonAndOrg(ctx, alert.PersonID, orgID); err != nil || !profile.IsActive {
+			continue
+		}
+		if err := s.notificationService.Notify(ctx, alert.PersonID, service.NotificationCostThreshold, service.Notification{
+			Title: fmt.Sprintf("%s passed $%.2f", title, alert.Threshold),
+			Body:  fmt.Sprintf("%q has cost $%.2f so far, passing your $%.2f alert.", title, cost, alert.Threshold),
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("notifying alert %s: %w", alert.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *costAlertService) CheckActiveMeetings(ctx context.Context) (int, error) {
+	active := true
+	meetings, _, err := s.meetingRepo.List(ctx, repository.MeetingFilters{IsActive: &active}, repository.Pagination{})
+	if err != nil {
+		return 0, fmt.Errorf("listing active meetings: %w", err)
+	}
+
+	// One meeting's failure is logged so the rest are still checked
+	now := time.Now()
+	for _, m := range meetings {
+		increments, err := s.meetingRepo.GetIncrements(ctx, m.ID)
+		if err != nil {
+			s.logger.Error("failed to load increments for cost alerts", "meeting_id", m.ID, "error", err)
+			continue
+		}
+		cost, _ := accruedCost(increments, true, now)
+		if err := s.Evaluate(ctx, m.OrganizationID, m.ID, cost); err != nil {
+			s.logger.Error("failed to evaluate cost alerts", "meeting_id", m.ID, "error", err)
+		}
+	}
+	return len(meetings), nil
+}
+
+func toCostAlertDTO(a *models.CostAlert) *service.CostAlertDTO {
+	return &service.CostAlertDTO{
+		ID:              a.ID,
+		MeetingID:       a.MeetingID,
+		Threshold:       a.Threshold,
+		LastTriggeredAt: a.LastTriggeredAt,
+		CreatedAt:       a.CreatedAt,
+	}
+}
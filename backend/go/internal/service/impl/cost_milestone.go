@@ -0,0 +1,96 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/notify"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// announceMilestone posts to the organization's Slack channel when the
+// meeting's cost has passed a milestone it hadn't yet. Only the highest
+// milestone passed is announced, so a meeting first seen past several
+// announces one, and none is while the organization announced another
+// within the throttle.
+func (s *costAlertService) announceMilestone(ctx context.Context, orgID, meetingID uuid.UUID, cost float64) error {
+	var milestone float64
+	for _, m := range service.CostMilestones {
+		if cost >= m {
+			milestone = m
+		}
+	}
+	if milestone == 0 {
+		return nil
+	}
+
+	integration, err := s.integrationRepo.GetByProvider(ctx, orgID, models.IntegrationSlack)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return err
+	}
+	channel, ok := milestoneChannel(integration)
+	if !ok {
+		return nil
+	}
+
+	// Recording the milestone first makes concurrent evaluations of the
+	// same meeting announce it once
+	record := &models.CostMilestone{
+		OrganizationID: orgID,
+		MeetingID:      meetingID,
+		Milestone:      milestone,
+		Cost:           cost,
+	}
+	passed, err := s.alertRepo.RecordMilestone(ctx, record)
+	if err != nil || !passed {
+		return err
+	}
+
+	now := time.Now()
+	last, err := s.alertRepo.LastMilestonePostedAt(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if last != nil && now.Sub(*last) < s.milestones.Throttle {
+		s.logger.Info("cost milestone throttled", "organization_id", orgID, "meeting_id", meetingID, "milestone", milestone)
+		return nil
+	}
+
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return err
+	}
+	title := meeting.Purpose
+	if title == "" {
+		title = "Untitled meeting"
+	}
+	text := fmt.Sprintf("%q has passed $%.0f and cost $%.2f so far.", title, milestone, cost)
+	if err := notify.NewSlack(integration.AccessToken, s.milestones.Timeout).PostMessage(ctx, channel, text); err != nil {
+		return fmt.Errorf("announcing cost milestone: %w", err)
+	}
+	return s.alertRepo.MarkMilestonePosted(ctx, record.ID, now)
+}
+
+// milestoneChannel returns the channel the organization's Slack integration
+// announces milestones in, and whether it does.
+func milestoneChannel(integration *models.Integration) (string, bool) {
+	if integration.Status != models.IntegrationConnected || integration.AccessToken == "" || len(integration.Settings) == 0 {
+		return "", false
+	}
+	var settings map[string]interface{}
+	if err := json.Unmarshal(integration.Settings, &settings); err != nil {
+		return "", false
+	}
+	on, _ := settings[service.SlackSettingMilestones].(bool)
+	channel, _ := settings[service.SlackSettingMilestoneChannel].(string)
+	channel = strings.TrimSpace(channel)
+	return channel, on && channel != ""
+}
@@ -0,0 +1,160 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/email"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// digestTopMeetings is how many of the most expensive meetings a digest
+// lists.
+const digestTopMeetings = 5
+
+type digestService struct {
+	orgRepo             repository.OrganizationRepository
+	meetingRepo         repository.MeetingRepository
+	profileRepo         repository.PersonOrganizationProfileRepository
+	personRepo          repository.PersonRepository
+	emailService        service.EmailService
+	notificationService service.NotificationService
+	queue               *queue.Client
+	logger              logger.Logger
+}
+
+// NewDigestService creates a new DigestService.
+func NewDigestService(
+	orgRepo repository.OrganizationRepository,
+	meetingRepo repository.MeetingRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	personRepo repository.PersonRepository,
+	emailService service.EmailService,
+	notificationService service.NotificationService,
+	queue *queue.Client,
+	logger logger.Logger,
+) service.DigestService {
+	return &digestService{
+		orgRepo:             orgRepo,
+		meetingRepo:         meetingRepo,
+		profileRepo:         profileRepo,
+		personRepo:          personRepo,
+		emailService:        emailService,
+		notificationService: notificationService,
+		queue:               queue,
+		logger:              logger,
+	}
+}
+
+func (s *digestService) QueueWeeklyDigests(ctx context.Context, end time.Time) (int, error) {
+	orgs, _, err := s.orgRepo.List(ctx, repository.OrgFilters{}, repository.Pagination{})
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	queued := 0
+	for _, org := range orgs {
+		if _, err := s.queue.Enqueue(ctx, service.TaskWeeklyDigest, service.WeeklyDigestPayload{
+			OrganizationID: org.ID,
+			PeriodEnd:      end,
+		}); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		queued++
+	}
+	return queued, errors.Join(errs...)
+}
+
+func (s *digestService) SendWeeklyDigest(ctx context.Context, orgID uuid.UUID, end time.Time) (int, error) {
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return 0, err
+	}
+
+	start := end.AddDate(0, 0, -7)
+	meetings, _, err := s.meetingRepo.List(ctx, repository.MeetingFilters{
+		OrganizationID: &orgID,
+		StartedAfter:   &start,
+		StartedBefore:  &end,
+	}, repository.Pagination{})
+	if err != nil {
+		return 0, fmt.Errorf("listing meetings: %w", err)
+	}
+	if len(meetings) == 0 {
+		return 0, nil
+	}
+
+	data := email.DigestData{
+		OrganizationName: org.Name,
+		PeriodStart:      start,
+		PeriodEnd:        end,
+		MeetingCount:     len(meetings),
+	}
+	seconds := 0
+	for _, m := range meetings {
+		data.TotalCost += m.TotalCost
+		seconds += m.TotalDuration
+	}
+	data.MeetingHours = float64(seconds) / 3600
+
+	sort.Slice(meetings, func(i, j int) bool { return meetings[i].TotalCost > meetings[j].TotalCost })
+	for _, m := range meetings[:min(len(meetings), digestTopMeetings)] {
+		title := m.Purpose
+		if title == "" {
+			title = "Untitled meeting"
+		}
+		data.TopMeetings = append(data.TopMeetings, email.DigestMeeting{
+			Title:    title,
+			Cost:     m.TotalCost,
+			Duration: time.Duration(m.TotalDuration) * time.Second,
+		})
+	}
+
+	members, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return 0, fmt.Errorf("listing members: %w", err)
+	}
+
+	// Emails are queued as they go, so a failure for one member is logged
+	// rather than failing the task and mailing everyone again on retry
+	sent := 0
+	for _, member := range members {
+		if err := s.sendTo(ctx, member.PersonID, data); err != nil {
+			s.logger.Error("failed to send weekly digest", "organization_id", orgID, "person_id", member.PersonID, "error", err)
+			continue
+		}
+		sent++
+	}
+
+	s.logger.Info("weekly digest sent", "organization_id", orgID, "recipients", sent, "meetings", len(meetings))
+	return sent, nil
+}
+
+// sendTo queues the digest for one person unless they opted out.
+func (s *digestService) sendTo(ctx context.Context, personID uuid.UUID, data email.DigestData) error {
+	person, err := s.personRepo.GetByID(ctx, personID)
+	if err != nil {
+		return err
+	}
+	if person.Anonymized {
+		return nil
+	}
+	enabled, err := s.notificationService.Enabled(ctx, personID, service.NotificationWeeklyDigest, service.ChannelEmail)
+	if err != nil || !enabled {
+		return err
+	}
+
+	data.Name = person.FirstName
+	data.UnsubscribeURL = s.notificationService.UnsubscribeURL(personID, service.NotificationWeeklyDigest)
+	_, err = s.emailService.Send(ctx, person.Email, email.TemplateDigest, data)
+	return err
+}
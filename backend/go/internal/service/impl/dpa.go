@@ -0,0 +1,125 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type dpaService struct {
+	dpaRepo         repository.DPARepository
+	permissionRepo  repository.PermissionRepository
+	auditLogService service.AuditLogService
+	policy          service.DPAPolicy
+	subProcessors   []service.SubProcessor
+}
+
+// NewDPAService creates a new DPAService implementation.
+func NewDPAService(
+	dpaRepo repository.DPARepository,
+	permissionRepo repository.PermissionRepository,
+	auditLogService service.AuditLogService,
+	policy service.DPAPolicy,
+	subProcessors []service.SubProcessor,
+) service.DPAService {
+	return &dpaService{
+		dpaRepo:         dpaRepo,
+		permissionRepo:  permissionRepo,
+		auditLogService: auditLogService,
+		policy:          policy,
+		subProcessors:   subProcessors,
+	}
+}
+
+// authorize checks that requester may act for the organization in legal
+// matters.
+func (s *dpaService) authorize(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil {
+		return err
+	}
+	if !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+	return nil
+}
+
+func (s *dpaService) GetDPA(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) (*service.DPAStatusDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	acceptances, err := s.dpaRepo.ListByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &service.DPAStatusDTO{
+		CurrentVersion: s.policy.Version,
+		URL:            s.policy.URL,
+		Acceptances:    make([]*service.DPAAcceptanceDTO, len(acceptances)),
+	}
+	for i, a := range acceptances {
+		status.Acceptances[i] = mapDPAAcceptanceToDTO(a)
+		if a.Version == s.policy.Version {
+			status.Accepted = true
+		}
+	}
+	return status, nil
+}
+
+func (s *dpaService) AcceptDPA(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.AcceptDPARequest) (*service.DPAAcceptanceDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if req.Version != s.policy.Version {
+		return nil, fmt.Errorf("invalid version: the current agreement is version %s", s.policy.Version)
+	}
+
+	if existing, err := s.dpaRepo.GetByVersion(ctx, orgID, req.Version); err == nil {
+		return mapDPAAcceptanceToDTO(existing), nil
+	}
+
+	acceptance := &models.DPAAcceptance{
+		OrganizationID: orgID,
+		Version:        req.Version,
+		AcceptedByID:   requesterID,
+		AcceptedAt:     time.Now(),
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	}
+	if err := s.dpaRepo.Create(ctx, acceptance); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "accept_dpa",
+		ResourceType:   "dpa_acceptance",
+		ResourceID:     acceptance.ID,
+		Details:        map[string]interface{}{"version": acceptance.Version},
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	return mapDPAAcceptanceToDTO(acceptance), nil
+}
+
+func (s *dpaService) ListSubProcessors(ctx context.Context) []service.SubProcessor {
+	return s.subProcessors
+}
+
+func mapDPAAcceptanceToDTO(a *models.DPAAcceptance) *service.DPAAcceptanceDTO {
+	return &service.DPAAcceptanceDTO{
+		ID:           a.ID,
+		Version:      a.Version,
+		AcceptedByID: a.AcceptedByID,
+		AcceptedAt:   a.AcceptedAt,
+	}
+}
@@ -0,0 +1,101 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/email"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type emailService struct {
+	deliveryRepo repository.EmailDeliveryRepository
+	mailer       email.Mailer
+	from         string
+	queue        *queue.Client
+	logger       logger.Logger
+}
+
+// NewEmailService creates a new EmailService sending from the given
+// address through mailer.
+func NewEmailService(
+	deliveryRepo repository.EmailDeliveryRepository,
+	mailer email.Mailer,
+	from string,
+	queue *queue.Client,
+	logger logger.Logger,
+) service.EmailService {
+	return &emailService{
+		deliveryRepo: deliveryRepo,
+		mailer:       mailer,
+		from:         from,
+		queue:        queue,
+		logger:       logger,
+	}
+}
+
+func (s *emailService) Send(ctx context.Context, to string, template string, data interface{}) (uuid.UUID, error) {
+	msg, err := email.Render(template, data)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	msg.From = s.from
+	msg.To = to
+
+	delivery := &models.EmailDelivery{
+		Recipient: to,
+		Template:  template,
+		Subject:   msg.Subject,
+		Driver:    s.mailer.Name(),
+		Status:    models.EmailQueued,
+	}
+	if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err := s.queue.Enqueue(ctx, service.TaskSendEmail, service.SendEmailPayload{
+		DeliveryID: delivery.ID,
+		Message:    *msg,
+	}); err != nil {
+		return uuid.Nil, fmt.Errorf("queueing email: %w", err)
+	}
+	return delivery.ID, nil
+}
+
+func (s *emailService) Deliver(ctx context.Context, payload service.SendEmailPayload) error {
+	delivery, err := s.deliveryRepo.GetByID(ctx, payload.DeliveryID)
+	if err != nil {
+		return err
+	}
+	// Tasks are delivered at least once; don't send an email twice
+	if delivery.Status == models.EmailSent {
+		return nil
+	}
+
+	providerID, sendErr := s.mailer.Send(ctx, &payload.Message)
+
+	delivery.Attempts++
+	if sendErr != nil {
+		delivery.Status = models.EmailFailed
+		delivery.Error = sendErr.Error()
+		s.logger.Warn("email send failed", "delivery_id", delivery.ID, "template", delivery.Template, "driver", delivery.Driver, "attempts", delivery.Attempts, "error", sendErr)
+	} else {
+		now := time.Now()
+		delivery.Status = models.EmailSent
+		delivery.Error = ""
+		delivery.ProviderMessageID = providerID
+		delivery.SentAt = &now
+		s.logger.Info("email sent", "delivery_id", delivery.ID, "template", delivery.Template, "driver", delivery.Driver, "provider_message_id", providerID)
+	}
+
+	if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+		return err
+	}
+	return sendErr
+}
@@ -0,0 +1,93 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	apperrors "github.com/yourorg/meeting-cost/backend/go/internal/errors"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type entitlementService struct {
+	subscriptionRepo repository.SubscriptionRepository
+	profileRepo      repository.PersonOrganizationProfileRepository
+	meetingRepo      repository.MeetingRepository
+}
+
+// NewEntitlementService creates a new EntitlementService.
+func NewEntitlementService(
+	subscriptionRepo repository.SubscriptionRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	meetingRepo repository.MeetingRepository,
+) service.EntitlementService {
+	return &entitlementService{
+		subscriptionRepo: subscriptionRepo,
+		profileRepo:      profileRepo,
+		meetingRepo:      meetingRepo,
+	}
+}
+
+func (s *entitlementService) GetEntitlements(ctx context.Context, orgID uuid.UUID) (*service.Entitlements, error) {
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("getting subscription: %w", err)
+	}
+	ent := service.PlanEntitlements[service.EffectivePlan(sub, time.Now())]
+	return &ent, nil
+}
+
+func (s *entitlementService) CheckMemberLimit(ctx context.Context, orgID uuid.UUID) error {
+	ent, err := s.GetEntitlements(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if ent.MaxMembers == service.Unlimited {
+		return nil
+	}
+
+	members, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return fmt.Errorf("counting members: %w", err)
+	}
+	if len(members) >= ent.MaxMembers {
+		return apperrors.ErrLimitExceeded("members", ent.MaxMembers, ent.PlanType)
+	}
+	return nil
+}
+
+func (s *entitlementService) CheckActiveMeetingLimit(ctx context.Context, orgID uuid.UUID) error {
+	ent, err := s.GetEntitlements(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if ent.MaxActiveMeetings == service.Unlimited {
+		return nil
+	}
+
+	active := true
+	_, total, err := s.meetingRepo.List(ctx, repository.MeetingFilters{
+		OrganizationID: &orgID,
+		IsActive:       &active,
+	}, repository.Pagination{Page: 1, PageSize: 1})
+	if err != nil {
+		return fmt.Errorf("counting active meetings: %w", err)
+	}
+	if total >= int64(ent.MaxActiveMeetings) {
+		return apperrors.ErrLimitExceeded("active meetings", ent.MaxActiveMeetings, ent.PlanType)
+	}
+	return nil
+}
+
+func (s *entitlementService) CheckIntegrations(ctx context.Context, orgID uuid.UUID) error {
+	ent, err := s.GetEntitlements(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !ent.IntegrationsEnabled {
+		return apperrors.ErrLimitExceeded("integrations", 0, ent.PlanType)
+	}
+	return nil
+}
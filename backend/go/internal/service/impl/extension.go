@@ -0,0 +1,315 @@
+package impl
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// extensionTokenPrefix starts every extension token, so leaked ones are
+// easy to recognize.
+const extensionTokenPrefix = "mcx_"
+
+// extensionUseResolution is how stale a token's last use may get before a
+// request records it again, so ticks don't write on every call.
+const extensionUseResolution = time.Minute
+
+type extensionService struct {
+	tokenRepo       repository.ExtensionTokenRepository
+	profileRepo     repository.PersonOrganizationProfileRepository
+	meetingRepo     repository.MeetingRepository
+	meetingService  service.MeetingService
+	auditLogService service.AuditLogService
+	logger          logger.Logger
+}
+
+// NewExtensionService creates a new ExtensionService implementation. It
+// tracks calls through meetingService, so the extension is held to the
+// same permissions as the app.
+func NewExtensionService(
+	tokenRepo repository.ExtensionTokenRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	meetingRepo repository.MeetingRepository,
+	meetingService service.MeetingService,
+	auditLogService service.AuditLogService,
+	logger logger.Logger,
+) service.ExtensionService {
+	return &extensionService{
+		tokenRepo:       tokenRepo,
+		profileRepo:     profileRepo,
+		meetingRepo:     meetingRepo,
+		meetingService:  meetingService,
+		auditLogService: auditLogService,
+		logger:          logger,
+	}
+}
+
+// activeMember reports whether the person is an active member of the
+// organization.
+func (s *extensionService) activeMember(ctx context.Context, personID, orgID uuid.UUID) bool {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, personID, orgID)
+	return err == nil && profile.IsActive
+}
+
+func (s *extensionService) CreateToken(ctx context.Context, personID uuid.UUID, req service.CreateExtensionTokenRequest) (*service.ExtensionTokenDTO, error) {
+	if !s.activeMember(ctx, personID, req.OrganizationID) {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+	name := strings.TrimSpace(req.Name)
+	if len(name) > 100 {
+		return nil, fmt.Errorf("invalid name: at most 100 characters")
+	}
+
+	tokens, err := s.tokenRepo.ListByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	unrevoked := 0
+	for _, t := range tokens {
+		if t.RevokedAt == nil {
+			unrevoked++
+		}
+	}
+	if unrevoked >= service.MaxExtensionTokens {
+		return nil, fmt.Errorf("invalid token: an account can have at most %d extension tokens; revoke one first", service.MaxExtensionTokens)
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("generating extension token: %w", err)
+	}
+	secret := extensionTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	token := &models.ExtensionToken{
+		PersonID:       personID,
+		OrganizationID: req.OrganizationID,
+		Name:           name,
+		TokenHash:      hashExtensionToken(secret),
+	}
+	if err := s.tokenRepo.Create(ctx, token); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &personID,
+		OrganizationID: &req.OrganizationID,
+		Action:         "create_extension_token",
+		ResourceType:   "extension_token",
+		ResourceID:     token.ID,
+		Details:        map[string]interface{}{"name": name},
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	dto := toExtensionTokenDTO(token)
+	dto.Token = secret
+	return dto, nil
+}
+
+func (s *extensionService) ListTokens(ctx context.Context, personID uuid.UUID) ([]*service.ExtensionTokenDTO, error) {
+	tokens, err := s.tokenRepo.ListByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*service.ExtensionTokenDTO, len(tokens))
+	for i, t := range tokens {
+		dtos[i] = toExtensionTokenDTO(t)
+	}
+	return dtos, nil
+}
+
+func (s *extensionService) RevokeToken(ctx context.Context, personID, tokenID uuid.UUID, ipAddress, userAgent string) error {
+	token, err := s.tokenRepo.GetByID(ctx, tokenID)
+	if err != nil || token.PersonID != personID {
+		return fmt.Errorf("extension token not found")
+	}
+	if token.RevokedAt != nil {
+		return nil
+	}
+	if err := s.tokenRepo.Revoke(ctx, tokenID, time.Now()); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &personID,
+		OrganizationID: &token.OrganizationID,
+		Action:         "revoke_extension_token",
+		ResourceType:   "extension_token",
+		ResourceID:     tokenID,
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+	})
+	return nil
+}
+
+func (s *extensionService) Authenticate(ctx context.Context, secret string) (*service.ExtensionIdentity, error) {
+	if !strings.HasPrefix(secret, extensionTokenPrefix) {
+		return nil, fmt.Errorf("invalid extension token")
+	}
+	token, err := s.tokenRepo.GetByTokenHash(ctx, hashExtensionToken(secret))
+	if err != nil || token.RevokedAt != nil {
+		return nil, fmt.Errorf("invalid extension token")
+	}
+	if !s.activeMember(ctx, token.PersonID, token.OrganizationID) {
+		return nil, fmt.Errorf("invalid extension token: no longer a member of its organization")
+	}
+
+	now := time.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= extensionUseResolution {
+		if err := s.tokenRepo.MarkUsed(ctx, token.ID, now); err != nil {
+			s.logger.Error("failed to record extension token use", "token_id", token.ID, "error", err)
+		}
+	}
+	return &service.ExtensionIdentity{
+		TokenID:        token.ID,
+		PersonID:       token.PersonID,
+		OrganizationID: token.OrganizationID,
+	}, nil
+}
+
+func (s *extensionService) Start(ctx context.Context, id service.ExtensionIdentity, req service.ExtensionStartRequest) (*service.ExtensionMeetingDTO, error) {
+	externalType, ok := service.ExtensionPlatforms[req.Platform]
+	if !ok {
+		return nil, fmt.Errorf("invalid platform %q", req.Platform)
+	}
+	callID := strings.TrimSpace(req.CallID)
+	if callID == "" {
+		return nil, fmt.Errorf("invalid call_id: required")
+	}
+	if req.Attendees < 0 {
+		return nil, fmt.Errorf("invalid attendees: must not be negative")
+	}
+
+	// Join the call's meeting if someone is already tracking it
+	active := true
+	running, _, err := s.meetingRepo.List(ctx, repository.MeetingFilters{
+		OrganizationID: &id.OrganizationID,
+		IsActive:       &active,
+		ExternalType:   &externalType,
+		ExternalID:     &callID,
+	}, repository.Pagination{Page: 1, PageSize: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(running) > 0 {
+		return s.Cost(ctx, id, running[0].ID)
+	}
+
+	purpose := strings.TrimSpace(req.Title)
+	meeting, err := s.meetingService.CreateMeeting(ctx, id.OrganizationID, id.PersonID, service.CreateMeetingRequest{
+		OrganizationID: id.OrganizationID,
+		Purpose:        purpose,
+		ExternalType:   externalType,
+		ExternalID:     callID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.meetingService.StartMeeting(ctx, meeting.ID, id.PersonID); err != nil {
+		return nil, err
+	}
+	if req.Attendees > 0 {
+		if err := s.meetingService.UpdateAttendeeCount(ctx, meeting.ID, req.Attendees, id.PersonID, "", ""); err != nil {
+			return nil, err
+		}
+	}
+	return s.Cost(ctx, id, meeting.ID)
+}
+
+func (s *extensionService) Stop(ctx context.Context, id service.ExtensionIdentity, meetingID uuid.UUID) (*service.ExtensionMeetingDTO, error) {
+	if _, err := s.meeting(ctx, id, meetingID); err != nil {
+		return nil, err
+	}
+	if err := s.meetingService.StopMeeting(ctx, meetingID, id.PersonID); err != nil {
+		return nil, err
+	}
+	return s.Cost(ctx, id, meetingID)
+}
+
+func (s *extensionService) Tick(ctx context.Context, id service.ExtensionIdentity, meetingID uuid.UUID, req service.ExtensionTickRequest) (*service.ExtensionMeetingDTO, error) {
+	meeting, err := s.meeting(ctx, id, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Attendees != nil && *req.Attendees < 0 {
+		return nil, fmt.Errorf("invalid attendees: must not be negative")
+	}
+
+	if req.Attendees != nil && meeting.IsActive {
+		open, err := s.meetingRepo.GetOpenIncrement(ctx, meetingID)
+		if err != nil {
+			return nil, err
+		}
+		if open == nil || open.AttendeeCount != *req.Attendees {
+			if err := s.meetingService.UpdateAttendeeCount(ctx, meetingID, *req.Attendees, id.PersonID, "", ""); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s.Cost(ctx, id, meetingID)
+}
+
+func (s *extensionService) Cost(ctx context.Context, id service.ExtensionIdentity, meetingID uuid.UUID) (*service.ExtensionMeetingDTO, error) {
+	meeting, err := s.meeting(ctx, id, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := s.meetingService.GetMeetingCost(ctx, meetingID, id.PersonID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &service.ExtensionMeetingDTO{
+		ID:      meetingID,
+		Running: meeting.IsActive,
+		Cost:    cost.TotalCost,
+		Seconds: cost.TotalDuration,
+	}
+	if meeting.IsActive {
+		open, err := s.meetingRepo.GetOpenIncrement(ctx, meetingID)
+		if err != nil {
+			return nil, err
+		}
+		if open != nil {
+			dto.Attendees = open.AttendeeCount
+			dto.PerSecond = float64(open.AttendeeCount) * open.AverageWage / 3600
+		}
+	}
+	return dto, nil
+}
+
+// meeting returns the meeting if it belongs to the token's organization.
+func (s *extensionService) meeting(ctx context.Context, id service.ExtensionIdentity, meetingID uuid.UUID) (*models.Meeting, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil || meeting.OrganizationID != id.OrganizationID {
+		return nil, fmt.Errorf("meeting not found")
+	}
+	return meeting, nil
+}
+
+// hashExtensionToken returns the stored form of an extension token.
+func hashExtensionToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func toExtensionTokenDTO(t *models.ExtensionToken) *service.ExtensionTokenDTO {
+	return &service.ExtensionTokenDTO{
+		ID:             t.ID,
+		OrganizationID: t.OrganizationID,
+		Name:           t.Name,
+		LastUsedAt:     t.LastUsedAt,
+		RevokedAt:      t.RevokedAt,
+		CreatedAt:      t.CreatedAt,
+	}
+}
@@ -0,0 +1,284 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+	"gorm.io/datatypes"
+)
+
+type integrationService struct {
+	integrationRepo repository.IntegrationRepository
+	permissionRepo  repository.PermissionRepository
+	auditLogService service.AuditLogService
+	entitlements    service.EntitlementService
+}
+
+// NewIntegrationService creates a new IntegrationService implementation.
+func NewIntegrationService(
+	integrationRepo repository.IntegrationRepository,
+	permissionRepo repository.PermissionRepository,
+	auditLogService service.AuditLogService,
+	entitlements service.EntitlementService,
+) service.IntegrationService {
+	return &integrationService{
+		integrationRepo: integrationRepo,
+		permissionRepo:  permissionRepo,
+		auditLogService: auditLogService,
+		entitlements:    entitlements,
+	}
+}
+
+// authorize checks that requester may manage the organization's
+// integrations.
+func (s *integrationService) authorize(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil {
+		return err
+	}
+	if !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+	return nil
+}
+
+// integrationProvider looks up a provider by ID.
+func integrationProvider(id string) (service.IntegrationProvider, error) {
+	for _, p := range service.Integrations {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return service.IntegrationProvider{}, fmt.Errorf("integration %q not found", id)
+}
+
+// integration returns the organization's integration with provider, or a
+// new disconnected one if it has none yet.
+func (s *integrationService) integration(ctx context.Context, orgID uuid.UUID, provider string) (*models.Integration, error) {
+	integration, err := s.integrationRepo.GetByProvider(ctx, orgID, provider)
+	if err == nil {
+		return integration, nil
+	}
+	if !strings.Contains(err.Error(), "not found") {
+		return nil, err
+	}
+	return &models.Integration{
+		OrganizationID: orgID,
+		Provider:       provider,
+		Status:         models.IntegrationDisconnected,
+	}, nil
+}
+
+// save creates the integration on its first change and updates it after.
+func (s *integrationService) save(ctx context.Context, integration *models.Integration) error {
+	if integration.ID == uuid.Nil {
+		return s.integrationRepo.Create(ctx, integration)
+	}
+	return s.integrationRepo.Update(ctx, integration)
+}
+
+func (s *integrationService) ListIntegrations(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*service.IntegrationDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	integrations, err := s.integrationRepo.ListByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	byProvider := make(map[string]*models.Integration, len(integrations))
+	for _, i := range integrations {
+		byProvider[i.Provider] = i
+	}
+
+	dtos := make([]*service.IntegrationDTO, len(service.Integrations))
+	for i, p := range service.Integrations {
+		integration, ok := byProvider[p.ID]
+		if !ok {
+			integration = &models.Integration{Provider: p.ID, Status: models.IntegrationDisconnected}
+		}
+		dtos[i] = toIntegrationDTO(p, integration)
+	}
+	return dtos, nil
+}
+
+func (s *integrationService) GetIntegration(ctx context.Context, orgID uuid.UUID, providerID string, requesterID uuid.UUID) (*service.IntegrationDTO, error) {
+	p, err := integrationProvider(providerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	integration, err := s.integration(ctx, orgID, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toIntegrationDTO(p, integration), nil
+}
+
+func (s *integrationService) ConnectIntegration(ctx context.Context, orgID uuid.UUID, providerID string, requesterID uuid.UUID, req service.ConnectIntegrationRequest) (*service.IntegrationDTO, error) {
+	p, err := integrationProvider(providerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := s.entitlements.CheckIntegrations(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.AccessToken) == "" {
+		return nil, fmt.Errorf("invalid access_token: required")
+	}
+
+	integration, err := s.integration(ctx, orgID, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	integration.Status = models.IntegrationConnected
+	integration.AccessToken = req.AccessToken
+	integration.RefreshToken = req.RefreshToken
+	integration.TokenExpiresAt = req.ExpiresAt
+	integration.ExternalAccount = strings.TrimSpace(req.ExternalAccount)
+	integration.ConnectedByID = &requesterID
+	integration.ConnectedAt = &now
+	integration.DisconnectedAt = nil
+	if req.Settings != nil {
+		if integration.Settings, err = encodeIntegrationSettings(req.Settings); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.save(ctx, integration); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "connect_integration",
+		ResourceType:   "integration",
+		ResourceID:     integration.ID,
+		Details:        map[string]interface{}{"provider": p.ID, "external_account": integration.ExternalAccount},
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	return toIntegrationDTO(p, integration), nil
+}
+
+func (s *integrationService) DisconnectIntegration(ctx context.Context, orgID uuid.UUID, providerID string, requesterID uuid.UUID, ipAddress, userAgent string) (*service.IntegrationDTO, error) {
+	p, err := integrationProvider(providerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	integration, err := s.integration(ctx, orgID, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	if integration.Status != models.IntegrationConnected {
+		return toIntegrationDTO(p, integration), nil
+	}
+
+	now := time.Now()
+	integration.Status = models.IntegrationDisconnected
+	integration.AccessToken = ""
+	integration.RefreshToken = ""
+	integration.TokenExpiresAt = nil
+	integration.DisconnectedAt = &now
+	if err := s.save(ctx, integration); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "disconnect_integration",
+		ResourceType:   "integration",
+		ResourceID:     integration.ID,
+		Details:        map[string]interface{}{"provider": p.ID},
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+	})
+
+	return toIntegrationDTO(p, integration), nil
+}
+
+func (s *integrationService) UpdateIntegrationSettings(ctx context.Context, orgID uuid.UUID, providerID string, requesterID uuid.UUID, req service.UpdateIntegrationSettingsRequest) (*service.IntegrationDTO, error) {
+	p, err := integrationProvider(providerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if req.Settings == nil {
+		return nil, fmt.Errorf("invalid settings: must be an object")
+	}
+
+	integration, err := s.integration(ctx, orgID, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	if integration.Settings, err = encodeIntegrationSettings(req.Settings); err != nil {
+		return nil, err
+	}
+	if err := s.save(ctx, integration); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "update_integration_settings",
+		ResourceType:   "integration",
+		ResourceID:     integration.ID,
+		Details:        map[string]interface{}{"provider": p.ID, "settings": req.Settings},
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	return toIntegrationDTO(p, integration), nil
+}
+
+func encodeIntegrationSettings(settings map[string]interface{}) (datatypes.JSON, error) {
+	b, err := json.Marshal(settings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+	return datatypes.JSON(b), nil
+}
+
+func toIntegrationDTO(p service.IntegrationProvider, i *models.Integration) *service.IntegrationDTO {
+	dto := &service.IntegrationDTO{
+		Provider:        p.ID,
+		Name:            p.Name,
+		Status:          i.Status,
+		ExternalAccount: i.ExternalAccount,
+		Settings:        map[string]interface{}{},
+		ConnectedByID:   i.ConnectedByID,
+		ConnectedAt:     i.ConnectedAt,
+		DisconnectedAt:  i.DisconnectedAt,
+	}
+	if len(i.Settings) > 0 {
+		_ = json.Unmarshal(i.Settings, &dto.Settings)
+	}
+	if !i.UpdatedAt.IsZero() {
+		updated := i.UpdatedAt
+		dto.UpdatedAt = &updated
+	}
+	return dto
+}
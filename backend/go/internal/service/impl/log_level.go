@@ -0,0 +1,86 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/pubsub"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type logLevelService struct {
+	level           *logger.Level
+	pubsub          pubsub.PubSub
+	auditLogService service.AuditLogService
+	logger          logger.Logger
+}
+
+// NewLogLevelService creates a new LogLevelService implementation that
+// changes level, applying changes published by other processes until ctx
+// is cancelled.
+func NewLogLevelService(
+	ctx context.Context,
+	level *logger.Level,
+	ps pubsub.PubSub,
+	auditLogService service.AuditLogService,
+	logger logger.Logger,
+) service.LogLevelService {
+	s := &logLevelService{
+		level:           level,
+		pubsub:          ps,
+		auditLogService: auditLogService,
+		logger:          logger,
+	}
+	s.listen(ctx)
+	return s
+}
+
+func (s *logLevelService) listen(ctx context.Context) {
+	messages := s.pubsub.Subscribe(ctx, cache.ChannelLogLevel)
+	go func() {
+		for payload := range messages {
+			var msg service.LogLevelDTO
+			if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+				continue
+			}
+			if msg.Level == s.level.String() {
+				continue
+			}
+			if err := s.level.Set(msg.Level); err != nil {
+				s.logger.Error("ignoring published log level", "level", msg.Level, "error", err)
+				continue
+			}
+			s.logger.Warn("log level changed", "level", msg.Level)
+		}
+	}()
+}
+
+func (s *logLevelService) GetLevel() *service.LogLevelDTO {
+	return &service.LogLevelDTO{Level: s.level.String()}
+}
+
+func (s *logLevelService) SetLevel(ctx context.Context, req service.SetLogLevelRequest) (*service.LogLevelDTO, error) {
+	from := s.level.String()
+	if err := s.level.Set(req.Level); err != nil {
+		return nil, err
+	}
+	s.logger.Warn("log level changed", "from", from, "level", req.Level)
+
+	// Other processes follow; one that misses it keeps its level until
+	// the change is repeated
+	res := &service.LogLevelDTO{Level: req.Level}
+	if err := s.pubsub.Publish(ctx, cache.ChannelLogLevel, res); err != nil {
+		s.logger.Error("failed to publish log level", "level", req.Level, "error", err)
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		Action:       "set_log_level",
+		ResourceType: "system",
+		ResourceID:   uuid.Nil,
+		Details:      map[string]interface{}{"from": from, "to": req.Level},
+	})
+	return res, nil
+}
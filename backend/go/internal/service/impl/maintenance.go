@@ -0,0 +1,187 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/encryption"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type maintenanceService struct {
+	purgeRepo       repository.PurgeRepository
+	partitionRepo   repository.PartitionRepository
+	meetingRepo     repository.MeetingRepository
+	encryptionRepo  repository.EncryptionRepository
+	authRepo        repository.AuthRepository
+	auditLogService service.AuditLogService
+	queue           *queue.Client
+	logger          logger.Logger
+}
+
+// NewMaintenanceService creates a new MaintenanceService.
+func NewMaintenanceService(purgeRepo repository.PurgeRepository, partitionRepo repository.PartitionRepository, meetingRepo repository.MeetingRepository, encryptionRepo repository.EncryptionRepository, authRepo repository.AuthRepository, auditLogService service.AuditLogService, queue *queue.Client, logger logger.Logger) service.MaintenanceService {
+	return &maintenanceService{
+		purgeRepo:       purgeRepo,
+		partitionRepo:   partitionRepo,
+		meetingRepo:     meetingRepo,
+		encryptionRepo:  encryptionRepo,
+		authRepo:        authRepo,
+		auditLogService: auditLogService,
+		queue:           queue,
+		logger:          logger,
+	}
+}
+
+func (s *maintenanceService) PurgeDeleted(ctx context.Context, retention time.Duration) (*service.PurgeResult, error) {
+	if retention <= 0 {
+		return nil, fmt.Errorf("retention must be positive")
+	}
+
+	cutoff := time.Now().Add(-retention)
+	purged, err := s.purgeRepo.PurgeDeleted(ctx, cutoff)
+	if err != nil {
+		s.logger.Error("purge failed", "cutoff", cutoff, "purged", purged, "error", err)
+		return nil, fmt.Errorf("purging soft-deleted rows: %w", err)
+	}
+
+	details := make(map[string]interface{}, len(purged)+1)
+	details["cutoff"] = cutoff
+	for table, n := range purged {
+		details[table] = n
+	}
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		Action:       "purge",
+		ResourceType: "system",
+		ResourceID:   uuid.Nil,
+		Details:      details,
+	})
+	s.logger.Info("purged soft-deleted rows", "cutoff", cutoff, "purged", purged)
+
+	return &service.PurgeResult{Cutoff: cutoff, Purged: purged}, nil
+}
+
+func (s *maintenanceService) EnqueuePurgeDeleted(ctx context.Context, retention time.Duration) (string, error) {
+	if retention <= 0 {
+		return "", fmt.Errorf("retention must be positive")
+	}
+	return s.queue.Enqueue(ctx, service.TaskPurgeDeleted, service.PurgeDeletedPayload{Retention: retention})
+}
+
+func (s *maintenanceService) CleanupSessions(ctx context.Context) (int64, error) {
+	n, err := s.authRepo.DeleteExpiredSessions(ctx)
+	if err != nil {
+		s.logger.Error("session cleanup failed", "deleted", n, "error", err)
+		return n, fmt.Errorf("cleaning up sessions: %w", err)
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		Action:       "cleanup_sessions",
+		ResourceType: "system",
+		ResourceID:   uuid.Nil,
+		Details:      map[string]interface{}{"deleted": n},
+	})
+	s.logger.Info("deleted expired sessions", "deleted", n)
+
+	return n, nil
+}
+
+func (s *maintenanceService) MaintainPartitions(ctx context.Context, ahead int, retention time.Duration) (*service.PartitionResult, error) {
+	if ahead < 0 || retention < 0 {
+		return nil, fmt.Errorf("ahead and retention must not be negative")
+	}
+
+	now := time.Now()
+	result := &service.PartitionResult{Created: []string{}, Dropped: []string{}}
+	created, err := s.partitionRepo.CreateIncrementPartitions(ctx, now, now.AddDate(0, ahead, 0))
+	result.Created = append(result.Created, created...)
+	if err != nil {
+		s.logger.Error("partition creation failed", "created", created, "error", err)
+		return nil, fmt.Errorf("creating partitions: %w", err)
+	}
+	if retention > 0 {
+		dropped, err := s.partitionRepo.DropIncrementPartitions(ctx, now.Add(-retention))
+		result.Dropped = append(result.Dropped, dropped...)
+		if err != nil {
+			s.logger.Error("partition drop failed", "dropped", dropped, "error", err)
+			return nil, fmt.Errorf("dropping partitions: %w", err)
+		}
+	}
+
+	if len(result.Created) > 0 || len(result.Dropped) > 0 {
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			Action:       "maintain_partitions",
+			ResourceType: "system",
+			ResourceID:   uuid.Nil,
+			Details:      map[string]interface{}{"created": result.Created, "dropped": result.Dropped},
+		})
+		s.logger.Info("maintained increment partitions", "created", result.Created, "dropped", result.Dropped)
+	}
+
+	return result, nil
+}
+
+// compactBatch bounds how many meetings CompactIncrements lists at a time.
+const compactBatch = 100
+
+func (s *maintenanceService) CompactIncrements(ctx context.Context) (*service.CompactResult, error) {
+	result := &service.CompactResult{}
+	for {
+		ids, err := s.meetingRepo.ListUncompacted(ctx, compactBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			removed, err := s.meetingRepo.CompactIncrements(ctx, id, time.Now())
+			if err != nil {
+				s.logger.Error("failed to compact increments", "meeting_id", id, "error", err)
+				result.Failed++
+				continue
+			}
+			result.Meetings++
+			result.Removed += removed
+		}
+		// Failed meetings would be listed again, so the next run retries them
+		if len(ids) < compactBatch || result.Failed > 0 {
+			break
+		}
+	}
+
+	if result.Removed > 0 || result.Failed > 0 {
+		s.logger.Info("compacted increments", "meetings", result.Meetings, "removed", result.Removed, "failed", result.Failed)
+	}
+	return result, nil
+}
+
+func (s *maintenanceService) Reencrypt(ctx context.Context) (*service.ReencryptResult, error) {
+	keyring := encryption.Active()
+	if keyring == nil {
+		return nil, fmt.Errorf("encryption is not configured; set ENCRYPTION_KEYS")
+	}
+
+	rewritten, err := s.encryptionRepo.Reencrypt(ctx, keyring)
+	if err != nil {
+		s.logger.Error("re-encryption failed", "primary_key", keyring.Primary(), "rewritten", rewritten, "error", err)
+		return nil, fmt.Errorf("re-encrypting: %w", err)
+	}
+
+	details := make(map[string]interface{}, len(rewritten)+1)
+	details["primary_key"] = keyring.Primary()
+	for table, n := range rewritten {
+		details[table] = n
+	}
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		Action:       "reencrypt",
+		ResourceType: "system",
+		ResourceID:   uuid.Nil,
+		Details:      details,
+	})
+	s.logger.Info("re-encrypted columns", "primary_key", keyring.Primary(), "rewritten", rewritten)
+
+	return &service.ReencryptResult{PrimaryKey: keyring.Primary(), Rewritten: rewritten}, nil
+}
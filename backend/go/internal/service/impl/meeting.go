@@ -3,11 +3,14 @@ package impl
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
 	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/metrics"
 	"github.com/yourorg/meeting-cost/backend/go/internal/models"
 	"github.com/yourorg/meeting-cost/backend/go/internal/pubsub"
 	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
@@ -20,33 +23,75 @@ type meetingService struct {
 	orgRepo         repository.OrganizationRepository
 	profileRepo     repository.PersonOrganizationProfileRepository
 	permissionRepo  repository.PermissionRepository
+	syncRepo        repository.SyncOperationRepository
+	holidayRepo     repository.HolidayRepository
 	auditLogService service.AuditLogService
+	webhookService  service.WebhookService
+	alertService    service.CostAlertService
+	surveyService   service.SurveyService
+	notifyService   service.NotificationService
+	entitlements    service.EntitlementService
+	usageService    service.UsageService
 	cache           cache.Cache
 	pubsub          pubsub.PubSub
+	publicURL       string
+	shareSecret     []byte
+	started         *prometheus.CounterVec
 	logger          logger.Logger
 }
 
-// NewMeetingService creates a new MeetingService implementation.
+// NewMeetingService creates a new MeetingService implementation. Check-in
+// links point at publicURL and their share tokens are signed with
+// shareSecret. Meeting starts are counted on reg.
 func NewMeetingService(
 	meetingRepo repository.MeetingRepository,
 	incrementRepo repository.IncrementRepository,
 	orgRepo repository.OrganizationRepository,
 	profileRepo repository.PersonOrganizationProfileRepository,
 	permissionRepo repository.PermissionRepository,
+	syncRepo repository.SyncOperationRepository,
+	holidayRepo repository.HolidayRepository,
 	auditLogService service.AuditLogService,
+	webhookService service.WebhookService,
+	alertService service.CostAlertService,
+	surveyService service.SurveyService,
+	notifyService service.NotificationService,
+	entitlements service.EntitlementService,
+	usageService service.UsageService,
 	cache cache.Cache,
 	ps pubsub.PubSub,
+	publicURL string,
+	shareSecret string,
+	reg prometheus.Registerer,
 	logger logger.Logger,
 ) service.MeetingService {
+	started := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "meetings_started_total",
+		Help:      "Meetings started, restarts included, by organization.",
+	}, []string{"organization_id"})
+	reg.MustRegister(started)
+
 	return &meetingService{
 		meetingRepo:     meetingRepo,
 		incrementRepo:   incrementRepo,
 		orgRepo:         orgRepo,
 		profileRepo:     profileRepo,
 		permissionRepo:  permissionRepo,
+		syncRepo:        syncRepo,
+		holidayRepo:     holidayRepo,
 		auditLogService: auditLogService,
+		webhookService:  webhookService,
+		alertService:    alertService,
+		surveyService:   surveyService,
+		notifyService:   notifyService,
+		entitlements:    entitlements,
+		usageService:    usageService,
 		cache:           cache,
 		pubsub:          ps,
+		publicURL:       strings.TrimSuffix(publicURL, "/"),
+		shareSecret:     []byte(shareSecret),
+		started:         started,
 		logger:          logger,
 	}
 }
@@ -64,6 +109,41 @@ func (s *meetingService) broadcastEvent(ctx context.Context, meetingID uuid.UUID
 	}
 }
 
+// dispatchWebhook sends the meeting's current state to the organization's
+// webhook endpoints subscribed to event.
+func (s *meetingService) dispatchWebhook(ctx context.Context, meetingID uuid.UUID, event string) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err == nil {
+		err = s.webhookService.Dispatch(ctx, meeting.OrganizationID, event, s.toMeetingDTO(meeting))
+	}
+	if err != nil {
+		s.logger.Error("failed to dispatch meeting webhook", "meeting_id", meetingID, "event", event, "error", err)
+	}
+}
+
+// recordUsage meters the meeting time of a closed increment.
+func (s *meetingService) recordUsage(ctx context.Context, meetingID uuid.UUID, inc *models.Increment) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err == nil {
+		err = s.usageService.RecordMeetingTime(ctx, meeting.OrganizationID, inc.ElapsedTime, inc.StopTime)
+	}
+	if err != nil {
+		s.logger.Error("failed to record usage", "meeting_id", meetingID, "error", err)
+	}
+}
+
+// checkCostAlerts fires the cost alerts the meeting's recorded total has
+// reached.
+func (s *meetingService) checkCostAlerts(ctx context.Context, meetingID uuid.UUID) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err == nil {
+		err = s.alertService.Evaluate(ctx, meeting.OrganizationID, meetingID, meeting.TotalCost)
+	}
+	if err != nil {
+		s.logger.Error("failed to evaluate cost alerts", "meeting_id", meetingID, "error", err)
+	}
+}
+
 func (s *meetingService) CreateMeeting(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.CreateMeetingRequest) (*service.MeetingDTO, error) {
 	// 1. Authorization check
 	hasPermission, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "meeting", nil, "create")
@@ -75,9 +155,16 @@ func (s *meetingService) CreateMeeting(ctx context.Context, orgID uuid.UUID, req
 	}
 
 	// 2. Business validation (e.g. org exists and is active)
-	if _, err := s.orgRepo.GetByID(ctx, orgID); err != nil {
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
 		return nil, fmt.Errorf("getting organization: %w", err)
 	}
+	if err := validateSchedule(req.ScheduledStart, req.ScheduledEnd); err != nil {
+		return nil, err
+	}
+	if req.ExpectedAttendees < 0 {
+		return nil, fmt.Errorf("invalid expected_attendees: must not be negative")
+	}
 
 	// 3. Create model
 	meeting := &models.Meeting{
@@ -86,8 +173,13 @@ func (s *meetingService) CreateMeeting(ctx context.Context, orgID uuid.UUID, req
 		Purpose:        req.Purpose,
 		ExternalType:   req.ExternalType,
 		ExternalID:     req.ExternalID,
+		ScheduledStart: utc(req.ScheduledStart),
+		ScheduledEnd:   utc(req.ScheduledEnd),
 		IsActive:       false,
+
+		ExpectedAttendees: req.ExpectedAttendees,
 	}
+	pending := projectApproval(meeting, org)
 
 	// 4. Repository call
 	if err := s.meetingRepo.Create(ctx, meeting); err != nil {
@@ -104,12 +196,96 @@ func (s *meetingService) CreateMeeting(ctx context.Context, orgID uuid.UUID, req
 		IPAddress:      req.IPAddress,
 		UserAgent:      req.UserAgent,
 	})
+	if pending {
+		s.requestApproval(ctx, meeting, org)
+	}
 
 	// 5. Return DTO
+	dto := s.toMeetingDTO(meeting)
+	dto.Warnings = s.warnOutsideHours(ctx, org, meeting.ScheduledStart, meeting.ScheduledEnd)
+	return dto, nil
+}
+
+func (s *meetingService) CreateCompletedMeeting(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.CreateCompletedMeetingRequest) (*service.MeetingDTO, error) {
+	hasPermission, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "meeting", nil, "create")
+	if err != nil {
+		return nil, fmt.Errorf("checking permission: %w", err)
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("forbidden: insufficient permissions to create meeting")
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("getting organization: %w", err)
+	}
+	wage := org.DefaultWage
+	if req.AverageWage != nil {
+		wage = *req.AverageWage
+	}
+	switch {
+	case req.StartedAt.IsZero() || !req.StoppedAt.After(req.StartedAt):
+		return nil, fmt.Errorf("invalid stopped_at: must be after started_at")
+	case req.StoppedAt.After(time.Now()):
+		return nil, fmt.Errorf("invalid stopped_at: must not be in the future")
+	case req.StoppedAt.Sub(req.StartedAt) > service.MaxCompletedMeeting:
+		return nil, fmt.Errorf("invalid stopped_at: a meeting can last at most %s", service.MaxCompletedMeeting)
+	case req.AttendeeCount < 1:
+		return nil, fmt.Errorf("invalid attendee_count: must be at least 1")
+	case wage < 0:
+		return nil, fmt.Errorf("invalid average_wage: must not be negative")
+	}
+
+	startedAt, stoppedAt := req.StartedAt.UTC(), req.StoppedAt.UTC()
+	meeting := &models.Meeting{
+		OrganizationID: orgID,
+		CreatedByID:    requesterID,
+		Purpose:        req.Purpose,
+		StartedAt:      &startedAt,
+		StoppedAt:      &stoppedAt,
+		IsActive:       false,
+	}
+	inc := &models.Increment{
+		StartTime:     startedAt,
+		StopTime:      stoppedAt,
+		ElapsedTime:   int(stoppedAt.Sub(startedAt).Seconds()),
+		AttendeeCount: req.AttendeeCount,
+		AverageWage:   wage,
+		Purpose:       req.Purpose,
+	}
+	inc.Cost = incrementCost(inc.ElapsedTime, inc.AttendeeCount, inc.AverageWage)
+
+	if err := s.meetingRepo.CreateWithIncrements(ctx, meeting, []*models.Increment{inc}); err != nil {
+		return nil, err
+	}
+	if err := s.updateMeetingTotals(ctx, meeting.ID); err != nil {
+		s.logger.Error("failed to update meeting totals on create", "meeting_id", meeting.ID, "error", err)
+	}
+	s.recordUsage(ctx, meeting.ID, inc)
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "create_completed_meeting",
+		ResourceType:   "meeting",
+		ResourceID:     meeting.ID,
+		Details: map[string]interface{}{
+			"started_at":     startedAt,
+			"stopped_at":     stoppedAt,
+			"attendee_count": req.AttendeeCount,
+			"average_wage":   wage,
+		},
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+	})
+
+	if m, err := s.meetingRepo.GetByID(ctx, meeting.ID); err == nil {
+		meeting = m
+	}
 	return s.toMeetingDTO(meeting), nil
 }
 
-func (s *meetingService) GetMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) (*service.MeetingDTO, error) {
+func (s *meetingService) GetMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, expand service.MeetingExpand) (*service.MeetingDTO, error) {
 	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
 	if err != nil {
 		return nil, err
@@ -124,7 +300,39 @@ func (s *meetingService) GetMeeting(ctx context.Context, meetingID uuid.UUID, re
 		return nil, fmt.Errorf("forbidden")
 	}
 
-	return s.toMeetingDTO(meeting), nil
+	if !expand.Increments && !expand.Participants {
+		return s.toMeetingDTO(meeting), nil
+	}
+
+	meeting, err = s.meetingRepo.GetByIDWithPreloads(ctx, meetingID, repository.MeetingPreloads{
+		Increments:   expand.Increments,
+		Participants: expand.Participants,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dto := s.toMeetingDTO(meeting)
+	if expand.Increments {
+		dto.Increments = make([]service.IncrementDTO, len(meeting.Increments))
+		for i, inc := range meeting.Increments {
+			dto.Increments[i] = toIncrementDTO(&inc)
+		}
+	}
+	if expand.Participants {
+		dto.Participants = make([]service.ParticipantDTO, len(meeting.Participants))
+		for i, p := range meeting.Participants {
+			dto.Participants[i] = service.ParticipantDTO{
+				PersonID: p.PersonID,
+				Email:    p.Person.Email,
+				Name:     strings.TrimSpace(p.Person.FirstName + " " + p.Person.LastName),
+				JoinedAt: p.JoinedAt,
+				LeftAt:   p.LeftAt,
+			}
+		}
+	}
+
+	return dto, nil
 }
 
 func (s *meetingService) UpdateMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, req service.UpdateMeetingRequest) (*service.MeetingDTO, error) {
@@ -141,16 +349,69 @@ func (s *meetingService) UpdateMeeting(ctx context.Context, meetingID uuid.UUID,
 	if !hasPermission {
 		return nil, fmt.Errorf("forbidden")
 	}
+	if err := notFinalized(meeting); err != nil {
+		return nil, err
+	}
 
 	if req.Purpose != nil {
 		meeting.Purpose = *req.Purpose
 	}
+	if req.ScheduledStart != nil {
+		if meeting.StartedAt != nil {
+			return nil, fmt.Errorf("invalid scheduled_start: the meeting has already started")
+		}
+		meeting.ScheduledStart = utc(req.ScheduledStart)
+	}
+	if req.ScheduledEnd != nil {
+		meeting.ScheduledEnd = utc(req.ScheduledEnd)
+		meeting.OverrunAt = nil
+	}
+	if req.ExpectedAttendees != nil {
+		if meeting.StartedAt != nil {
+			return nil, fmt.Errorf("invalid expected_attendees: the meeting has already started")
+		}
+		if *req.ExpectedAttendees < 0 {
+			return nil, fmt.Errorf("invalid expected_attendees: must not be negative")
+		}
+		meeting.ExpectedAttendees = *req.ExpectedAttendees
+	}
+	if err := validateSchedule(meeting.ScheduledStart, meeting.ScheduledEnd); err != nil {
+		return nil, err
+	}
+
+	// Until it starts, a meeting's projection follows its plans
+	var org *models.Organization
+	pending := false
+	if meeting.StartedAt == nil && (req.ScheduledStart != nil || req.ScheduledEnd != nil || req.ExpectedAttendees != nil) {
+		if org, err = s.orgRepo.GetByID(ctx, meeting.OrganizationID); err != nil {
+			return nil, fmt.Errorf("getting organization: %w", err)
+		}
+		pending = projectApproval(meeting, org)
+	}
 
 	if err := s.meetingRepo.Update(ctx, meeting); err != nil {
 		return nil, err
 	}
+	if pending {
+		s.requestApproval(ctx, meeting, org)
+	}
 
-	return s.toMeetingDTO(meeting), nil
+	dto := s.toMeetingDTO(meeting)
+	if org != nil && (req.ScheduledStart != nil || req.ScheduledEnd != nil) {
+		dto.Warnings = s.warnOutsideHours(ctx, org, meeting.ScheduledStart, meeting.ScheduledEnd)
+	}
+	return dto, nil
+}
+
+// warnOutsideHours warns when a meeting is booked outside the
+// organization's working hours. Failing to check only logs, as the
+// meeting is booked either way.
+func (s *meetingService) warnOutsideHours(ctx context.Context, org *models.Organization, start, end *time.Time) []string {
+	warnings, err := scheduleWarnings(ctx, s.holidayRepo, org, start, end)
+	if err != nil {
+		s.logger.Error("failed to check working hours", "organization_id", org.ID, "error", err)
+	}
+	return warnings
 }
 
 func (s *meetingService) DeleteMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error {
@@ -167,6 +428,9 @@ func (s *meetingService) DeleteMeeting(ctx context.Context, meetingID uuid.UUID,
 	if !hasPermission {
 		return fmt.Errorf("forbidden")
 	}
+	if err := notFinalized(meeting); err != nil {
+		return err
+	}
 
 	err = s.meetingRepo.Delete(ctx, meetingID)
 	if err == nil {
@@ -201,29 +465,78 @@ func (s *meetingService) StartMeeting(ctx context.Context, meetingID uuid.UUID,
 	if meeting.IsActive {
 		return fmt.Errorf("meeting is already active")
 	}
+	if err := notFinalized(meeting); err != nil {
+		return err
+	}
+	if err := approvedToStart(meeting); err != nil {
+		return err
+	}
+	if err := s.entitlements.CheckActiveMeetingLimit(ctx, meeting.OrganizationID); err != nil {
+		return err
+	}
 
-	if err := s.meetingRepo.Start(ctx, meetingID); err != nil {
+	org, err := s.orgRepo.GetByID(ctx, meeting.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("getting organization: %w", err)
+	}
+
+	// Everyone who checked in before the start is counted
+	participants, err := s.meetingRepo.GetParticipants(ctx, meetingID)
+	if err != nil {
 		return err
 	}
+	waiting := checkedIn(participants)
 
-	// Create first increment
-	org, _ := s.orgRepo.GetByID(ctx, meeting.OrganizationID)
+	// Start the meeting and open its first increment atomically
 	firstInc := &models.Increment{
 		MeetingID:     meetingID,
 		StartTime:     time.Now(),
 		AverageWage:   org.DefaultWage,
-		AttendeeCount: 0, // Should probably be based on current participants if any
+		AttendeeCount: len(waiting),
 		Purpose:       meeting.Purpose,
 	}
 
-	if err := s.meetingRepo.AddIncrement(ctx, firstInc); err != nil {
+	if err := s.meetingRepo.StartWithIncrement(ctx, meetingID, firstInc); err != nil {
 		return err
 	}
+	s.started.WithLabelValues(meeting.OrganizationID.String()).Inc()
+
+	// A scheduled meeting's first start records how late it was
+	if meeting.StartedAt == nil && meeting.ScheduledStart != nil {
+		if seconds, cost := lateStart(*meeting.ScheduledStart, firstInc.StartTime, waiting, org.DefaultWage); seconds > 0 {
+			if err := s.meetingRepo.RecordLateStart(ctx, meetingID, seconds, cost); err != nil {
+				s.logger.Error("failed to record late start", "meeting_id", meetingID, "error", err)
+			}
+		}
+	}
 
 	s.broadcastEvent(ctx, meetingID, service.EventMeetingStarted, firstInc)
+	s.dispatchWebhook(ctx, meetingID, service.WebhookEventMeetingStarted)
 	return nil
 }
 
+// lateStart returns how many seconds after scheduled a meeting started at
+// started, and what the attendees waiting for it cost at wage an hour:
+// each from when they checked in, or the scheduled time if later. Starts
+// within service.LateStartGrace are on time.
+func lateStart(scheduled, started time.Time, waiting []*models.MeetingParticipant, wage float64) (int, float64) {
+	late := started.Sub(scheduled)
+	if late < service.LateStartGrace {
+		return 0, 0
+	}
+	var waited time.Duration
+	for _, p := range waiting {
+		from := scheduled
+		if p.JoinedAt.After(from) {
+			from = *p.JoinedAt
+		}
+		if started.After(from) {
+			waited += started.Sub(from)
+		}
+	}
+	return int(late.Seconds()), roundCents(waited.Hours() * wage)
+}
+
 func (s *meetingService) StopMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) error {
 	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
 	if err != nil {
@@ -243,30 +556,47 @@ func (s *meetingService) StopMeeting(ctx context.Context, meetingID uuid.UUID, r
 		return fmt.Errorf("meeting is not active")
 	}
 
-	if err := s.meetingRepo.Stop(ctx, meetingID); err != nil {
+	// Finalize current increment as the meeting stops
+	now := time.Now()
+	closed, err := s.meetingRepo.Stop(ctx, meetingID, now, func(inc *models.Increment) error {
+		inc.StopTime = now
+		inc.ElapsedTime = int(now.Sub(inc.StartTime).Seconds())
+		inc.Cost = incrementCost(inc.ElapsedTime, inc.AttendeeCount, inc.AverageWage)
+		return nil
+	})
+	if err != nil {
 		return err
 	}
-
-	// Finalize current increment
-	increments, _ := s.meetingRepo.GetIncrements(ctx, meetingID)
-	now := time.Now()
-	for _, inc := range increments {
-		if inc.StopTime.IsZero() {
-			inc.StopTime = now
-			inc.ElapsedTime = int(now.Sub(inc.StartTime).Seconds())
-			inc.Cost = (float64(inc.ElapsedTime) / 3600.0) * float64(inc.AttendeeCount) * inc.AverageWage
-			_ = s.incrementRepo.Update(ctx, inc)
-			break
-		}
+	if closed != nil {
+		s.recordUsage(ctx, meetingID, closed)
 	}
 
+	s.stopped(ctx, meeting)
+	return nil
+}
+
+// stopped follows up a meeting's stop once its last increment has closed.
+func (s *meetingService) stopped(ctx context.Context, meeting *models.Meeting) {
+	meetingID := meeting.ID
+
 	// Update meeting totals
 	if err := s.updateMeetingTotals(ctx, meetingID); err != nil {
 		s.logger.Error("failed to update meeting totals on stop", "meeting_id", meetingID, "error", err)
 	}
+	if meeting.ScheduledEnd != nil {
+		s.recordOverrun(ctx, meetingID, *meeting.ScheduledEnd)
+	}
+	// A failure leaves them to the compaction job
+	if _, err := s.meetingRepo.CompactIncrements(ctx, meetingID, time.Now()); err != nil {
+		s.logger.Error("failed to compact increments on stop", "meeting_id", meetingID, "error", err)
+	}
 
 	s.broadcastEvent(ctx, meetingID, service.EventMeetingStopped, nil)
-	return nil
+	s.dispatchWebhook(ctx, meetingID, service.WebhookEventMeetingStopped)
+	s.checkCostAlerts(ctx, meetingID)
+	if err := s.surveyService.OpenSurvey(ctx, meetingID); err != nil {
+		s.logger.Error("failed to open meeting survey", "meeting_id", meetingID, "error", err)
+	}
 }
 
 func (s *meetingService) ResetMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) error {
@@ -311,7 +641,11 @@ func (s *meetingService) UpdateAttendeeCount(ctx context.Context, meetingID uuid
 	return err
 }
 
-func (s *meetingService) UpdateAverageWage(ctx context.Context, meetingID uuid.UUID, wage float64, requesterID uuid.UUID) error {
+func (s *meetingService) UpdateAverageWage(ctx context.Context, meetingID uuid.UUID, wage float64, requesterID uuid.UUID, ipAddress, userAgent string) error {
+	if wage < 0 {
+		return fmt.Errorf("invalid average_wage: must not be negative")
+	}
+
 	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
 	if err != nil {
 		return err
@@ -326,12 +660,32 @@ func (s *meetingService) UpdateAverageWage(ctx context.Context, meetingID uuid.U
 		return nil
 	}
 
-	return s.cycleIncrement(ctx, meetingID, func(inc *models.Increment) {
+	err = s.cycleIncrement(ctx, meetingID, func(inc *models.Increment) {
 		inc.AverageWage = wage
 	})
+
+	if err == nil {
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			PersonID:       &requesterID,
+			OrganizationID: &meeting.OrganizationID,
+			Action:         "update_average_wage",
+			ResourceType:   "meeting",
+			ResourceID:     meetingID,
+			Details:        map[string]interface{}{"average_wage": wage},
+			IPAddress:      ipAddress,
+			UserAgent:      userAgent,
+		})
+	}
+
+	return err
 }
 
-func (s *meetingService) UpdatePurpose(ctx context.Context, meetingID uuid.UUID, purpose string, requesterID uuid.UUID) error {
+func (s *meetingService) UpdatePurpose(ctx context.Context, meetingID uuid.UUID, purpose string, requesterID uuid.UUID, ipAddress, userAgent string) error {
+	purpose = strings.TrimSpace(purpose)
+	if purpose == "" {
+		return fmt.Errorf("invalid purpose: must not be empty")
+	}
+
 	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
 	if err != nil {
 		return err
@@ -343,70 +697,158 @@ func (s *meetingService) UpdatePurpose(ctx context.Context, meetingID uuid.UUID,
 	}
 
 	if !meeting.IsActive {
+		if err := notFinalized(meeting); err != nil {
+			return err
+		}
 		meeting.Purpose = purpose
-		return s.meetingRepo.Update(ctx, meeting)
+		err = s.meetingRepo.Update(ctx, meeting)
+	} else {
+		err = s.cycleIncrement(ctx, meetingID, func(inc *models.Increment) {
+			inc.Purpose = purpose
+		})
 	}
 
-	return s.cycleIncrement(ctx, meetingID, func(inc *models.Increment) {
-		inc.Purpose = purpose
-	})
+	if err == nil {
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			PersonID:       &requesterID,
+			OrganizationID: &meeting.OrganizationID,
+			Action:         "update_purpose",
+			ResourceType:   "meeting",
+			ResourceID:     meetingID,
+			Details:        map[string]interface{}{"purpose": purpose},
+			IPAddress:      ipAddress,
+			UserAgent:      userAgent,
+		})
+	}
+
+	return err
 }
 
-// cycleIncrement stops the current increment and starts a new one with modifications
-func (s *meetingService) cycleIncrement(ctx context.Context, meetingID uuid.UUID, modify func(*models.Increment)) error {
-	increments, err := s.meetingRepo.GetIncrements(ctx, meetingID)
-	if err != nil {
-		return err
+func (s *meetingService) UpdateIncrement(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, req service.UpdateIncrementRequest) error {
+	details := map[string]interface{}{}
+	if req.AttendeeCount != nil {
+		if *req.AttendeeCount < 0 {
+			return fmt.Errorf("invalid attendee_count: must not be negative")
+		}
+		details["attendee_count"] = *req.AttendeeCount
 	}
-
-	now := time.Now()
-	var lastInc *models.Increment
-	for _, inc := range increments {
-		if inc.StopTime.IsZero() {
-			lastInc = inc
-			break
+	if req.AverageWage != nil {
+		if *req.AverageWage < 0 {
+			return fmt.Errorf("invalid average_wage: must not be negative")
 		}
+		details["average_wage"] = *req.AverageWage
+	}
+	if req.Purpose != nil {
+		purpose := strings.TrimSpace(*req.Purpose)
+		if purpose == "" {
+			return fmt.Errorf("invalid purpose: must not be empty")
+		}
+		req.Purpose = &purpose
+		details["purpose"] = purpose
+	}
+	if len(details) == 0 {
+		return fmt.Errorf("invalid request: set attendee_count, average_wage or purpose")
 	}
 
-	newInc := &models.Increment{
-		MeetingID: meetingID,
-		StartTime: now,
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return err
 	}
 
-	if lastInc != nil {
-		lastInc.StopTime = now
-		lastInc.ElapsedTime = int(now.Sub(lastInc.StartTime).Seconds())
-		// Basic cost calculation: (elapsed / 3600) * count * average_wage
-		lastInc.Cost = (float64(lastInc.ElapsedTime) / 3600.0) * float64(lastInc.AttendeeCount) * lastInc.AverageWage
+	hasPerm, _ := s.permissionRepo.HasPermission(ctx, requesterID, meeting.OrganizationID, "meeting", &meetingID, "update")
+	if !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
 
-		if err := s.incrementRepo.Update(ctx, lastInc); err != nil {
+	if !meeting.IsActive {
+		// As with the single updates, only the purpose outlasts a stop
+		if req.Purpose == nil {
+			return nil
+		}
+		if err := notFinalized(meeting); err != nil {
 			return err
 		}
-
-		// Inherit values from last increment
-		newInc.AttendeeCount = lastInc.AttendeeCount
-		newInc.AverageWage = lastInc.AverageWage
-		newInc.Purpose = lastInc.Purpose
+		meeting.Purpose = *req.Purpose
+		err = s.meetingRepo.Update(ctx, meeting)
 	} else {
-		// No active increment? Fallback to meeting defaults or current state
-		meeting, _ := s.meetingRepo.GetByID(ctx, meetingID)
-		org, _ := s.orgRepo.GetByID(ctx, meeting.OrganizationID)
-		newInc.AverageWage = org.DefaultWage
-		newInc.Purpose = meeting.Purpose
+		err = s.cycleIncrement(ctx, meetingID, func(inc *models.Increment) {
+			if req.AttendeeCount != nil {
+				inc.AttendeeCount = *req.AttendeeCount
+			}
+			if req.AverageWage != nil {
+				inc.AverageWage = *req.AverageWage
+			}
+			if req.Purpose != nil {
+				inc.Purpose = *req.Purpose
+			}
+		})
+	}
+
+	if err == nil {
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			PersonID:       &requesterID,
+			OrganizationID: &meeting.OrganizationID,
+			Action:         "update_increment",
+			ResourceType:   "meeting",
+			ResourceID:     meetingID,
+			Details:        details,
+			IPAddress:      req.IPAddress,
+			UserAgent:      req.UserAgent,
+		})
 	}
 
-	modify(newInc)
+	return err
+}
+
+// cycleIncrement stops the current increment and starts a new one with modifications.
+// The repository serializes cycles per meeting, so the cycle time is taken only
+// once the lock is held to keep increment chains contiguous.
+func (s *meetingService) cycleIncrement(ctx context.Context, meetingID uuid.UUID, modify func(*models.Increment)) error {
+	var closed *models.Increment
+	newInc, err := s.meetingRepo.CycleIncrement(ctx, meetingID, func(lastInc *models.Increment) (*models.Increment, error) {
+		now := time.Now()
+		newInc := &models.Increment{
+			MeetingID: meetingID,
+			StartTime: now,
+		}
 
-	if err := s.meetingRepo.AddIncrement(ctx, newInc); err != nil {
+		if lastInc != nil {
+			lastInc.StopTime = now
+			lastInc.ElapsedTime = int(now.Sub(lastInc.StartTime).Seconds())
+			lastInc.Cost = incrementCost(lastInc.ElapsedTime, lastInc.AttendeeCount, lastInc.AverageWage)
+			closed = lastInc
+
+			// Inherit values from last increment
+			newInc.AttendeeCount = lastInc.AttendeeCount
+			newInc.AverageWage = lastInc.AverageWage
+			newInc.Purpose = lastInc.Purpose
+		} else {
+			// No active increment? Fallback to meeting defaults or current state
+			meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+			if err != nil {
+				return nil, err
+			}
+			org, err := s.orgRepo.GetByID(ctx, meeting.OrganizationID)
+			if err != nil {
+				return nil, err
+			}
+			newInc.AverageWage = org.DefaultWage
+			newInc.Purpose = meeting.Purpose
+		}
+
+		modify(newInc)
+		return newInc, nil
+	})
+	if err != nil {
 		return err
 	}
 
-	// Update meeting totals
-	if err := s.updateMeetingTotals(ctx, meetingID); err != nil {
-		s.logger.Error("failed to update meeting totals on cycle", "meeting_id", meetingID, "error", err)
+	// The cycle added the closed increment to the meeting's totals
+	if closed != nil {
+		s.recordUsage(ctx, meetingID, closed)
 	}
-
 	s.broadcastEvent(ctx, meetingID, service.EventMeetingCost, newInc)
+	s.checkCostAlerts(ctx, meetingID)
 	return nil
 }
 
@@ -458,24 +900,40 @@ func (s *meetingService) GetMeetingCost(ctx context.Context, meetingID uuid.UUID
 		return nil, err
 	}
 
-	increments, err := s.meetingRepo.GetIncrements(ctx, meetingID)
+	res, err := s.liveCost(ctx, meeting, time.Now())
 	if err != nil {
 		return nil, err
 	}
+	if meeting.IsActive {
+		if err := s.alertService.Evaluate(ctx, meeting.OrganizationID, meetingID, res.TotalCost); err != nil {
+			s.logger.Error("failed to evaluate cost alerts", "meeting_id", meetingID, "error", err)
+		}
+	}
+	return res, nil
+}
 
-	var totalCost float64
-	var totalDuration int
-	now := time.Now()
-
-	for _, inc := range increments {
-		if !inc.StopTime.IsZero() {
-			totalCost += inc.Cost
-			totalDuration += inc.ElapsedTime
-		} else if meeting.IsActive {
-			// Current active increment
-			elapsed := int(now.Sub(inc.StartTime).Seconds())
-			currentCost := (float64(elapsed) / 3600.0) * float64(inc.AttendeeCount) * inc.AverageWage
-			totalCost += currentCost
+// liveCost returns the meeting's cost as of now.
+func (s *meetingService) liveCost(ctx context.Context, meeting *models.Meeting, now time.Time) (*service.MeetingCostDTO, error) {
+	// The meeting's totals cover its closed increments, so only the open
+	// one is read
+	totalCost, totalDuration := meeting.TotalCost, meeting.TotalDuration
+	if meeting.IsActive {
+		open, err := s.meetingRepo.GetOpenIncrement(ctx, meeting.ID)
+		if err != nil {
+			return nil, err
+		}
+		if open == nil {
+			increments, err := s.meetingRepo.GetIncrements(ctx, meeting.ID)
+			if err != nil {
+				return nil, err
+			}
+			if open, err = s.repairOpenIncrement(ctx, meeting, increments); err != nil {
+				s.logger.Error("failed to repair open increment", "meeting_id", meeting.ID, "error", err)
+			}
+		}
+		if open != nil {
+			cost, elapsed := accruedCost([]*models.Increment{open}, true, now)
+			totalCost += cost
 			totalDuration += elapsed
 		}
 	}
@@ -503,7 +961,7 @@ func (s *meetingService) DeduplicateMeeting(ctx context.Context, meetingID uuid.
 
 // toMeetingDTO converts a meeting model to a DTO.
 func (s *meetingService) toMeetingDTO(m *models.Meeting) *service.MeetingDTO {
-	return &service.MeetingDTO{
+	dto := &service.MeetingDTO{
 		ID:             m.ID,
 		OrganizationID: m.OrganizationID,
 		Purpose:        m.Purpose,
@@ -514,53 +972,139 @@ func (s *meetingService) toMeetingDTO(m *models.Meeting) *service.MeetingDTO {
 		TotalDuration:  m.TotalDuration,
 		MaxAttendees:   m.MaxAttendees,
 		CreatedAt:      m.CreatedAt,
+
+		ScheduledStart:   m.ScheduledStart,
+		LateStartSeconds: m.LateStartSeconds,
+		LateStartCost:    m.LateStartCost,
+
+		ScheduledEnd:   m.ScheduledEnd,
+		OverrunSeconds: m.OverrunSeconds,
+		OverrunCost:    m.OverrunCost,
+
+		FinalizedAt: m.FinalizedAt,
+
+		ExpectedAttendees: m.ExpectedAttendees,
+		ProjectedCost:     m.ProjectedCost,
+		ApprovalStatus:    m.ApprovalStatus,
+		ApprovalDecidedAt: m.ApprovalDecidedAt,
+		ApprovalNote:      m.ApprovalNote,
+	}
+	if m.IsActive && m.ScheduledEnd != nil {
+		dto.OverrunSeconds = 0
+		if over := time.Since(*m.ScheduledEnd); over > service.OverrunGrace {
+			dto.OverrunSeconds = int(over.Seconds())
+		}
 	}
+	dto.Overrun = dto.OverrunSeconds > 0
+	return dto
 }
 
-// updateMeetingTotals recalculates and updates the meeting's cached total fields.
-func (s *meetingService) updateMeetingTotals(ctx context.Context, meetingID uuid.UUID) error {
-	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
-	if err != nil {
-		return fmt.Errorf("getting meeting: %w", err)
+// toIncrementDTO converts an increment model to a DTO.
+func toIncrementDTO(inc *models.Increment) service.IncrementDTO {
+	return service.IncrementDTO{
+		ID:            inc.ID,
+		StartTime:     inc.StartTime,
+		StopTime:      inc.StopTime,
+		ElapsedTime:   inc.ElapsedTime,
+		AttendeeCount: inc.AttendeeCount,
+		AverageWage:   inc.AverageWage,
+		Cost:          inc.Cost,
+		TotalCost:     inc.TotalCost,
+		Purpose:       inc.Purpose,
 	}
+}
 
-	increments, err := s.meetingRepo.GetIncrements(ctx, meetingID)
-	if err != nil {
-		return fmt.Errorf("getting increments: %w", err)
+// openIncrement returns the increment that has not been stopped yet, if any.
+func openIncrement(increments []*models.Increment) *models.Increment {
+	for _, inc := range increments {
+		if inc.StopTime.IsZero() {
+			return inc
+		}
 	}
+	return nil
+}
+
+// repairOpenIncrement restores the invariant that an active meeting always has
+// an open increment. A failure between starting a meeting and opening its first
+// increment used to leave it active without one, and GetMeetingCost would then
+// silently undercount. The repaired increment starts where the last one stopped (or when the meeting
+// started) and inherits its settings, so the gap is billed rather than lost.
+// utc returns t in UTC, which meeting times are stored in whatever offset
+// they were given with.
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
+
+func (s *meetingService) repairOpenIncrement(ctx context.Context, meeting *models.Meeting, increments []*models.Increment) (*models.Increment, error) {
+	s.logger.Warn("active meeting has no open increment, repairing", "meeting_id", meeting.ID)
+
+	return s.meetingRepo.CycleIncrement(ctx, meeting.ID, func(open *models.Increment) (*models.Increment, error) {
+		if open != nil {
+			// Repaired concurrently; nothing to do.
+			return nil, nil
+		}
+
+		repaired := &models.Increment{
+			MeetingID: meeting.ID,
+			StartTime: time.Now(),
+			Purpose:   meeting.Purpose,
+		}
+		if meeting.StartedAt != nil {
+			repaired.StartTime = *meeting.StartedAt
+		}
+
+		if n := len(increments); n > 0 {
+			last := increments[n-1]
+			repaired.StartTime = last.StopTime
+			repaired.AttendeeCount = last.AttendeeCount
+			repaired.AverageWage = last.AverageWage
+			repaired.Purpose = last.Purpose
+		} else {
+			org, err := s.orgRepo.GetByID(ctx, meeting.OrganizationID)
+			if err != nil {
+				return nil, fmt.Errorf("getting organization: %w", err)
+			}
+			repaired.AverageWage = org.DefaultWage
+		}
+
+		return repaired, nil
+	})
+}
+
+// incrementCost is what attendees cost over seconds at wage an hour each.
+// Every cost, tracked or calculated, comes from here.
+func incrementCost(seconds int, attendees int, wage float64) float64 {
+	return (float64(seconds) / 3600.0) * float64(attendees) * wage
+}
 
+// accruedCost sums the cost and duration of increments. The open increment
+// of an active meeting counts up to now.
+func accruedCost(increments []*models.Increment, active bool, now time.Time) (float64, int) {
 	var totalCost float64
 	var totalDuration int
-	var maxAttendees int
-
 	for _, inc := range increments {
 		if !inc.StopTime.IsZero() {
 			totalCost += inc.Cost
 			totalDuration += inc.ElapsedTime
-			if inc.AttendeeCount > maxAttendees {
-				maxAttendees = inc.AttendeeCount
-			}
-
-			// Update increment's running total cost if needed
-			if inc.TotalCost != totalCost {
-				inc.TotalCost = totalCost
-				_ = s.incrementRepo.Update(ctx, inc)
-			}
-		} else if meeting.IsActive {
-			// Current active increment - it should also contribute to max attendees
-			if inc.AttendeeCount > maxAttendees {
-				maxAttendees = inc.AttendeeCount
-			}
+		} else if active {
+			// Current active increment
+			elapsed := int(now.Sub(inc.StartTime).Seconds())
+			currentCost := incrementCost(elapsed, inc.AttendeeCount, inc.AverageWage)
+			totalCost += currentCost
+			totalDuration += elapsed
 		}
 	}
+	return totalCost, totalDuration
+}
 
-	meeting.TotalCost = totalCost
-	meeting.TotalDuration = totalDuration
-	meeting.MaxAttendees = maxAttendees
-
-	if err := s.meetingRepo.Update(ctx, meeting); err != nil {
-		return fmt.Errorf("updating meeting totals: %w", err)
+// updateMeetingTotals recalculates and updates the meeting's cached total fields.
+func (s *meetingService) updateMeetingTotals(ctx context.Context, meetingID uuid.UUID) error {
+	if err := s.meetingRepo.RecalculateTotals(ctx, meetingID); err != nil {
+		return fmt.Errorf("recalculating meeting totals: %w", err)
 	}
-
 	return nil
 }
@@ -0,0 +1,204 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *meetingService) ApproveMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, req service.ApprovalDecisionRequest) (*service.MeetingDTO, error) {
+	return s.decideApproval(ctx, meetingID, requesterID, models.ApprovalApproved, req)
+}
+
+func (s *meetingService) RejectMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, req service.ApprovalDecisionRequest) (*service.MeetingDTO, error) {
+	return s.decideApproval(ctx, meetingID, requesterID, models.ApprovalRejected, req)
+}
+
+// decideApproval records an approver's decision on a pending meeting and
+// tells its organizer.
+func (s *meetingService) decideApproval(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, status string, req service.ApprovalDecisionRequest) (*service.MeetingDTO, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	approver, err := s.isApprover(ctx, meeting.OrganizationID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !approver {
+		return nil, fmt.Errorf("forbidden: only meeting approvers and organization admins can decide on meetings")
+	}
+	if meeting.CreatedByID == requesterID {
+		return nil, fmt.Errorf("forbidden: approvers can't decide on meetings they organized")
+	}
+	if meeting.ApprovalStatus != models.ApprovalPending {
+		return nil, fmt.Errorf("invalid approval: the meeting is not awaiting approval")
+	}
+
+	now := time.Now()
+	meeting.ApprovalStatus = status
+	meeting.ApprovalDecidedAt = &now
+	meeting.ApprovalDecidedByID = &requesterID
+	meeting.ApprovalNote = req.Note
+	if err := s.meetingRepo.Update(ctx, meeting); err != nil {
+		return nil, err
+	}
+
+	action := "approve_meeting"
+	if status == models.ApprovalRejected {
+		action = "reject_meeting"
+	}
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &meeting.OrganizationID,
+		Action:         action,
+		ResourceType:   "meeting",
+		ResourceID:     meetingID,
+		Details: map[string]interface{}{
+			"projected_cost": meeting.ProjectedCost,
+			"note":           req.Note,
+		},
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+	})
+
+	body := fmt.Sprintf("%s, projected to cost $%.2f, was %s.", meetingName(meeting), meeting.ProjectedCost, status)
+	if status == models.ApprovalRejected {
+		body += " It can't start unless its plans change and it is approved."
+	}
+	if req.Note != "" {
+		body += " Note: " + req.Note
+	}
+	if err := s.notifyService.Notify(ctx, meeting.CreatedByID, service.NotificationApprovalDecided, service.Notification{
+		Title: fmt.Sprintf("Meeting %s: %s", status, meetingName(meeting)),
+		Body:  body,
+		URL:   s.meetingURL(meetingID),
+	}); err != nil {
+		s.logger.Error("failed to notify meeting organizer of approval", "meeting_id", meetingID, "error", err)
+	}
+
+	return s.toMeetingDTO(meeting), nil
+}
+
+// projectApproval projects the meeting's cost from its schedule and
+// expected attendees at the organization's default wage, and sets whether
+// it needs approval. An approved meeting stays approved unless its
+// projection rises. It reports whether approvers need asking: the meeting
+// now awaits approval and did not before, or not at this projection.
+func projectApproval(meeting *models.Meeting, org *models.Organization) bool {
+	status, before := meeting.ApprovalStatus, meeting.ProjectedCost
+
+	meeting.ProjectedCost = 0
+	if meeting.ScheduledStart != nil && meeting.ScheduledEnd != nil && meeting.ExpectedAttendees > 0 {
+		hours := meeting.ScheduledEnd.Sub(*meeting.ScheduledStart).Hours()
+		meeting.ProjectedCost = roundCents(hours * float64(meeting.ExpectedAttendees) * org.DefaultWage)
+	}
+
+	if org.ApprovalThreshold == nil || meeting.ProjectedCost <= *org.ApprovalThreshold {
+		meeting.ApprovalStatus = ""
+	} else if status != models.ApprovalApproved || meeting.ProjectedCost > before {
+		meeting.ApprovalStatus = models.ApprovalPending
+	}
+	if meeting.ApprovalStatus != models.ApprovalApproved {
+		meeting.ApprovalDecidedAt = nil
+		meeting.ApprovalDecidedByID = nil
+		meeting.ApprovalNote = ""
+	}
+	return meeting.ApprovalStatus == models.ApprovalPending &&
+		(status != models.ApprovalPending || meeting.ProjectedCost != before)
+}
+
+// approvedToStart refuses to start a meeting awaiting approval or rejected.
+func approvedToStart(meeting *models.Meeting) error {
+	switch meeting.ApprovalStatus {
+	case models.ApprovalPending:
+		return fmt.Errorf("invalid start: the meeting is awaiting approval")
+	case models.ApprovalRejected:
+		return fmt.Errorf("invalid start: the meeting's approval was rejected")
+	}
+	return nil
+}
+
+// requestApproval tells the organization's designated approvers, or its
+// admins when it has none, that the meeting awaits their decision.
+func (s *meetingService) requestApproval(ctx context.Context, meeting *models.Meeting, org *models.Organization) {
+	approvers, err := s.approvers(ctx, meeting.OrganizationID)
+	if err != nil {
+		s.logger.Error("failed to list meeting approvers", "meeting_id", meeting.ID, "error", err)
+		return
+	}
+
+	n := service.Notification{
+		Title: "Meeting awaiting approval: " + meetingName(meeting),
+		Body: fmt.Sprintf("%s is scheduled for %s with %d attendees, projected to cost $%.2f, over the approval threshold of $%.2f. Approve or reject it before it starts.",
+			meetingName(meeting), meeting.ScheduledStart.Format("Jan 2 15:04 MST"), meeting.ExpectedAttendees, meeting.ProjectedCost, *org.ApprovalThreshold),
+		URL: s.meetingURL(meeting.ID),
+	}
+	var errs []error
+	for _, personID := range approvers {
+		if personID == meeting.CreatedByID {
+			continue
+		}
+		if err := s.notifyService.Notify(ctx, personID, service.NotificationApprovalRequested, n); err != nil {
+			errs = append(errs, fmt.Errorf("notifying %s: %w", personID, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		s.logger.Error("failed to notify meeting approvers", "meeting_id", meeting.ID, "error", err)
+	}
+}
+
+// approvers returns the organization's active designated approvers, or its
+// admins when it has none.
+func (s *meetingService) approvers(ctx context.Context, orgID uuid.UUID) ([]uuid.UUID, error) {
+	members, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return nil, fmt.Errorf("listing members: %w", err)
+	}
+	var designated, admins []uuid.UUID
+	for _, m := range members {
+		if m.MeetingApprover {
+			designated = append(designated, m.PersonID)
+			continue
+		}
+		if admin, _ := s.permissionRepo.HasPermission(ctx, m.PersonID, orgID, "organization", nil, "update"); admin {
+			admins = append(admins, m.PersonID)
+		}
+	}
+	if len(designated) > 0 {
+		return designated, nil
+	}
+	return admins, nil
+}
+
+// isApprover reports whether the person decides on the organization's
+// meetings: a designated approver or an admin.
+func (s *meetingService) isApprover(ctx context.Context, orgID, personID uuid.UUID) (bool, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, personID, orgID)
+	if err != nil || !profile.IsActive {
+		return false, nil
+	}
+	if profile.MeetingApprover {
+		return true, nil
+	}
+	return s.permissionRepo.HasPermission(ctx, personID, orgID, "organization", nil, "update")
+}
+
+// meetingURL links to the meeting in the API.
+func (s *meetingService) meetingURL(meetingID uuid.UUID) string {
+	return s.publicURL + "/api/v2/meetings/" + meetingID.String()
+}
+
+// meetingName names the meeting in notifications.
+func meetingName(meeting *models.Meeting) string {
+	if meeting.Purpose != "" {
+		return meeting.Purpose
+	}
+	return "A meeting"
+}
@@ -0,0 +1,144 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *meetingService) BulkDeleteMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.BulkDeleteMeetingsRequest) (*service.BulkDeleteMeetingsDTO, error) {
+	if (len(req.MeetingIDs) == 0) == (req.Filter == nil) {
+		return nil, fmt.Errorf("invalid request: give either meeting_ids or a filter")
+	}
+
+	var meetings []*models.Meeting
+	var err error
+	skipped := 0
+	if req.Filter == nil {
+		meetings, err = s.listedMeetings(ctx, orgID, requesterID, req.MeetingIDs)
+	} else {
+		meetings, skipped, err = s.filteredMeetings(ctx, orgID, requesterID, *req.Filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &service.BulkDeleteMeetingsDTO{MeetingIDs: make([]uuid.UUID, len(meetings)), Skipped: skipped}
+	for i, m := range meetings {
+		res.MeetingIDs[i] = m.ID
+	}
+	if len(meetings) == 0 {
+		return res, nil
+	}
+	if err := s.meetingRepo.DeleteMany(ctx, res.MeetingIDs); err != nil {
+		return nil, err
+	}
+	res.Deleted = len(meetings)
+
+	details := map[string]interface{}{
+		"count":       res.Deleted,
+		"meeting_ids": res.MeetingIDs,
+	}
+	if req.Filter != nil {
+		details["filter"] = req.Filter
+		details["skipped"] = skipped
+	}
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "bulk_delete_meetings",
+		ResourceType:   "organization",
+		ResourceID:     orgID,
+		Details:        details,
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	return res, nil
+}
+
+// listedMeetings returns the organization's meetings by ID, failing unless
+// the requester can delete every one of them.
+func (s *meetingService) listedMeetings(ctx context.Context, orgID, requesterID uuid.UUID, ids []uuid.UUID) ([]*models.Meeting, error) {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	var meetings []*models.Meeting
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if len(seen) > service.MaxBulkDeleteMeetings {
+			return nil, fmt.Errorf("invalid meeting_ids: at most %d meetings at a time", service.MaxBulkDeleteMeetings)
+		}
+
+		meeting, err := s.meetingRepo.GetByID(ctx, id)
+		if err != nil || meeting.OrganizationID != orgID {
+			return nil, fmt.Errorf("meeting %s not found", id)
+		}
+		ok, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "meeting", &id, "delete")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("forbidden: can't delete meeting %s", id)
+		}
+		if meeting.FinalizedAt != nil {
+			return nil, fmt.Errorf("invalid meeting %s: it is finalized", id)
+		}
+		meetings = append(meetings, meeting)
+	}
+	return meetings, nil
+}
+
+// filteredMeetings returns the organization's stopped, unfinalized meetings
+// that match filter and that the requester can delete, and how many others
+// matched.
+func (s *meetingService) filteredMeetings(ctx context.Context, orgID, requesterID uuid.UUID, filter service.BulkDeleteFilter) ([]*models.Meeting, int, error) {
+	inactive := false
+	filters := repository.MeetingFilters{
+		OrganizationID: &orgID,
+		IsActive:       &inactive,
+		CreatedByID:    filter.CreatedByID,
+		CreatedBefore:  filter.CreatedBefore,
+		StartedBefore:  filter.StartedBefore,
+	}
+	if purpose := strings.TrimSpace(filter.Purpose); purpose != "" {
+		filters.Purpose = &purpose
+	}
+	if filters.Purpose == nil && filters.CreatedByID == nil && filters.CreatedBefore == nil && filters.StartedBefore == nil {
+		return nil, 0, fmt.Errorf("invalid filter: give at least one of purpose, created_before, started_before or created_by_id")
+	}
+
+	matched, total, err := s.meetingRepo.List(ctx, filters, repository.Pagination{Page: 1, PageSize: service.MaxBulkDeleteMeetings})
+	if err != nil {
+		return nil, 0, fmt.Errorf("listing meetings: %w", err)
+	}
+	if total > service.MaxBulkDeleteMeetings {
+		return nil, 0, fmt.Errorf("invalid filter: it matches %d meetings, more than %d at a time", total, service.MaxBulkDeleteMeetings)
+	}
+
+	checks := make([]repository.PermissionCheck, len(matched))
+	for i, meeting := range matched {
+		checks[i] = repository.PermissionCheck{ResourceName: "meeting", ResourceID: &meeting.ID, Activity: "delete"}
+	}
+	allowed, err := s.permissionRepo.HasPermissions(ctx, requesterID, orgID, checks)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var meetings []*models.Meeting
+	skipped := 0
+	for i, meeting := range matched {
+		if !allowed[i] || meeting.FinalizedAt != nil {
+			skipped++
+			continue
+		}
+		meetings = append(meetings, meeting)
+	}
+	return meetings, skipped, nil
+}
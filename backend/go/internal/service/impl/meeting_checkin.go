@@ -0,0 +1,242 @@
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+	"rsc.io/qr"
+)
+
+func (s *meetingService) ShareMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) (*service.MeetingShareDTO, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPerm, _ := s.permissionRepo.HasPermission(ctx, requesterID, meeting.OrganizationID, "meeting", &meetingID, "update")
+	if !hasPerm {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	expiresAt := time.Now().Add(service.MeetingShareTTL).Truncate(time.Second)
+	return &service.MeetingShareDTO{
+		Token:     s.shareToken(meetingID, expiresAt),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// checkInCodeScale is the pixels to a module of a check-in QR code.
+const checkInCodeScale = 8
+
+func (s *meetingService) CheckInCode(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) (*service.CheckInCode, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPerm, _ := s.permissionRepo.HasPermission(ctx, requesterID, meeting.OrganizationID, "meeting", &meetingID, "update")
+	if !hasPerm {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	expiresAt := time.Now().Add(service.CheckInCodeTTL).Truncate(time.Second)
+	url := s.publicURL + "/api/v2/meetings/" + meetingID.String() + "/checkin?token=" + s.shareToken(meetingID, expiresAt)
+	code, err := qr.Encode(url, qr.M)
+	if err != nil {
+		return nil, fmt.Errorf("rendering check-in code: %w", err)
+	}
+	code.Scale = checkInCodeScale
+	return &service.CheckInCode{URL: url, ExpiresAt: expiresAt, PNG: code.PNG()}, nil
+}
+
+func (s *meetingService) CheckIn(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, shareToken string) (*service.CheckInDTO, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canCheckIn(ctx, meeting, requesterID, shareToken); err != nil {
+		return nil, err
+	}
+
+	participant, err := s.participant(ctx, meetingID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if participant != nil && present(participant) {
+		return s.toCheckInDTO(ctx, meeting, participant)
+	}
+
+	now := time.Now()
+	if participant == nil {
+		participant = &models.MeetingParticipant{
+			MeetingID: meetingID,
+			PersonID:  requesterID,
+			JoinedAt:  &now,
+		}
+		err = s.meetingRepo.AddParticipant(ctx, participant)
+	} else {
+		participant.JoinedAt = &now
+		participant.LeftAt = nil
+		err = s.meetingRepo.UpdateParticipant(ctx, participant)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.attendanceChanged(ctx, meeting, participant, 1)
+}
+
+func (s *meetingService) CheckOut(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, shareToken string) (*service.CheckInDTO, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canCheckIn(ctx, meeting, requesterID, shareToken); err != nil {
+		return nil, err
+	}
+
+	participant, err := s.participant(ctx, meetingID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if participant == nil || !present(participant) {
+		return s.toCheckInDTO(ctx, meeting, participant)
+	}
+
+	now := time.Now()
+	participant.LeftAt = &now
+	participant.Duration += int(now.Sub(*participant.JoinedAt).Seconds())
+	if err := s.meetingRepo.UpdateParticipant(ctx, participant); err != nil {
+		return nil, err
+	}
+
+	return s.attendanceChanged(ctx, meeting, participant, -1)
+}
+
+// attendanceChanged moves a running meeting's attendee count by delta,
+// never below zero, and tells the meeting's clients who came or went.
+func (s *meetingService) attendanceChanged(ctx context.Context, meeting *models.Meeting, participant *models.MeetingParticipant, delta int) (*service.CheckInDTO, error) {
+	if meeting.IsActive {
+		if err := s.cycleIncrement(ctx, meeting.ID, func(inc *models.Increment) {
+			inc.AttendeeCount = max(inc.AttendeeCount+delta, 0)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	dto, err := s.toCheckInDTO(ctx, meeting, participant)
+	if err != nil {
+		return nil, err
+	}
+	s.broadcastEvent(ctx, meeting.ID, service.EventMeetingParticipant, dto)
+	return dto, nil
+}
+
+// canCheckIn allows members of the meeting's organization, and anyone
+// holding a current share token for the meeting.
+func (s *meetingService) canCheckIn(ctx context.Context, meeting *models.Meeting, requesterID uuid.UUID, shareToken string) error {
+	if shareToken != "" {
+		if !s.validShareToken(meeting.ID, shareToken, time.Now()) {
+			return fmt.Errorf("forbidden: invalid or expired share token")
+		}
+		return nil
+	}
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, meeting.OrganizationID)
+	if err != nil || !profile.IsActive {
+		return fmt.Errorf("forbidden: not a member of this organization")
+	}
+	return nil
+}
+
+// participant returns the person's participant record for the meeting, or
+// nil if they have never checked in.
+func (s *meetingService) participant(ctx context.Context, meetingID, personID uuid.UUID) (*models.MeetingParticipant, error) {
+	participants, err := s.meetingRepo.GetParticipants(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range participants {
+		if p.PersonID == personID {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// present reports whether the participant is checked in now.
+func present(p *models.MeetingParticipant) bool {
+	return p.JoinedAt != nil && p.LeftAt == nil
+}
+
+// checkedIn returns the participants checked in now.
+func checkedIn(participants []*models.MeetingParticipant) []*models.MeetingParticipant {
+	var here []*models.MeetingParticipant
+	for _, p := range participants {
+		if present(p) {
+			here = append(here, p)
+		}
+	}
+	return here
+}
+
+func (s *meetingService) toCheckInDTO(ctx context.Context, meeting *models.Meeting, participant *models.MeetingParticipant) (*service.CheckInDTO, error) {
+	dto := &service.CheckInDTO{MeetingID: meeting.ID}
+	if participant != nil {
+		dto.PersonID = participant.PersonID
+		dto.Present = present(participant)
+		dto.JoinedAt = participant.JoinedAt
+		dto.LeftAt = participant.LeftAt
+	}
+	if meeting.IsActive {
+		increments, err := s.meetingRepo.GetIncrements(ctx, meeting.ID)
+		if err != nil {
+			return nil, err
+		}
+		if inc := openIncrement(increments); inc != nil {
+			dto.AttendeeCount = inc.AttendeeCount
+		}
+	}
+	return dto, nil
+}
+
+// shareToken returns a token for checking in to the meeting until
+// expiresAt.
+func (s *meetingService) shareToken(meetingID uuid.UUID, expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(meetingID.String() + ":" + strconv.FormatInt(expiresAt.Unix(), 10)))
+	return payload + "." + s.signShare(payload)
+}
+
+// validShareToken reports whether token is a share token for the meeting
+// that has not expired at now.
+func (s *meetingService) validShareToken(meetingID uuid.UUID, token string, now time.Time) bool {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.signShare(payload))) {
+		return false
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return false
+	}
+	id, expires, _ := strings.Cut(string(raw), ":")
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return false
+	}
+	return id == meetingID.String() && now.Before(time.Unix(unix, 0))
+}
+
+// signShare returns the share token signature of payload.
+func (s *meetingService) signShare(payload string) string {
+	mac := hmac.New(sha256.New, s.shareSecret)
+	mac.Write([]byte("meeting-share:" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
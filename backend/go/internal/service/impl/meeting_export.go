@@ -0,0 +1,58 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/export"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *meetingService) ExportIncrements(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, format string) (*service.ReportFile, error) {
+	if format == "" {
+		format = export.FormatCSV
+	}
+	if format != export.FormatCSV {
+		return nil, fmt.Errorf("invalid format %q: must be %s", format, export.FormatCSV)
+	}
+
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPermission, err := s.permissionRepo.HasPermission(ctx, requesterID, meeting.OrganizationID, "meeting", &meetingID, "read")
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	increments, err := s.meetingRepo.GetIncrements(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A running meeting's open increment has no stop time or cost yet
+	t := export.Table{
+		Columns: []string{"Start", "Stop", "Elapsed Seconds", "Attendees", "Average Wage", "Cost", "Total Cost", "Purpose"},
+	}
+	for _, inc := range increments {
+		t.Rows = append(t.Rows, []any{
+			inc.StartTime, inc.StopTime, inc.ElapsedTime, inc.AttendeeCount,
+			inc.AverageWage, inc.Cost, inc.TotalCost, inc.Purpose,
+		})
+	}
+	data, err := export.CSV(t)
+	if err != nil {
+		return nil, fmt.Errorf("rendering increments: %w", err)
+	}
+
+	return &service.ReportFile{
+		Name:        fmt.Sprintf("meeting-%s-increments.%s", meetingID, format),
+		ContentType: export.ContentTypes[format],
+		Data:        data,
+	}, nil
+}
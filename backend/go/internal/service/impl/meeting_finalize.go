@@ -0,0 +1,79 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *meetingService) FinalizeMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) (*service.MeetingDTO, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, meeting.OrganizationID, "organization", nil, "update")
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return nil, fmt.Errorf("forbidden: only organization admins can finalize meetings")
+	}
+	if meeting.StartedAt == nil {
+		return nil, fmt.Errorf("invalid finalize: the meeting has not run")
+	}
+	if meeting.IsActive {
+		return nil, fmt.Errorf("invalid finalize: the meeting is running; stop it first")
+	}
+	if err := notFinalized(meeting); err != nil {
+		return nil, err
+	}
+
+	// The totals are settled before they are locked
+	if err := s.updateMeetingTotals(ctx, meetingID); err != nil {
+		return nil, err
+	}
+	ok, err := s.meetingRepo.Finalize(ctx, meetingID, requesterID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid finalize: the meeting is running or already finalized")
+	}
+	if meeting, err = s.meetingRepo.GetByID(ctx, meetingID); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &meeting.OrganizationID,
+		Action:         "finalize_meeting",
+		ResourceType:   "meeting",
+		ResourceID:     meetingID,
+		Details: map[string]interface{}{
+			"total_cost":     meeting.TotalCost,
+			"total_duration": meeting.TotalDuration,
+			"max_attendees":  meeting.MaxAttendees,
+		},
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	})
+
+	dto := s.toMeetingDTO(meeting)
+	s.broadcastEvent(ctx, meetingID, service.EventMeetingFinalized, dto)
+	s.dispatchWebhook(ctx, meetingID, service.WebhookEventMeetingFinalized)
+	return dto, nil
+}
+
+// notFinalized refuses changes to a finalized meeting, which only
+// corrections may change.
+func notFinalized(meeting *models.Meeting) error {
+	if meeting.FinalizedAt != nil {
+		return fmt.Errorf("invalid meeting: it is finalized; correct its increments instead")
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *meetingService) CorrectIncrement(ctx context.Context, meetingID, incrementID uuid.UUID, requesterID uuid.UUID, req service.CorrectIncrementRequest) (*service.IncrementDTO, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, meeting.OrganizationID, "organization", nil, "update")
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return nil, fmt.Errorf("forbidden: only organization admins can correct increments")
+	}
+
+	increments, err := s.meetingRepo.GetIncrements(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	var inc *models.Increment
+	for _, i := range increments {
+		if i.ID == incrementID {
+			inc = i
+		}
+	}
+	if inc == nil {
+		return nil, fmt.Errorf("increment not found")
+	}
+	if inc.StopTime.IsZero() {
+		return nil, fmt.Errorf("invalid increment: it is still open; change the meeting's attendees or wage instead")
+	}
+
+	before := incrementAudit(inc)
+	if req.StartTime != nil {
+		inc.StartTime = req.StartTime.UTC()
+	}
+	if req.StopTime != nil {
+		inc.StopTime = req.StopTime.UTC()
+	}
+	if req.AttendeeCount != nil {
+		inc.AttendeeCount = *req.AttendeeCount
+	}
+	if req.AverageWage != nil {
+		inc.AverageWage = *req.AverageWage
+	}
+	if req.Purpose != nil {
+		inc.Purpose = *req.Purpose
+	}
+	if err := validateIncrement(inc, increments, time.Now()); err != nil {
+		return nil, err
+	}
+
+	inc.ElapsedTime = int(inc.StopTime.Sub(inc.StartTime).Seconds())
+	inc.Cost = incrementCost(inc.ElapsedTime, inc.AttendeeCount, inc.AverageWage)
+	if err := s.incrementRepo.Update(ctx, inc); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateMeetingTotals(ctx, meetingID); err != nil {
+		s.logger.Error("failed to update meeting totals on correction", "meeting_id", meetingID, "error", err)
+	}
+	if !meeting.IsActive && meeting.ScheduledEnd != nil {
+		s.recordOverrun(ctx, meetingID, *meeting.ScheduledEnd)
+	}
+
+	// Reload for the running total the recalculation set
+	if corrected, err := s.incrementRepo.GetByID(ctx, incrementID); err == nil {
+		inc = corrected
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &meeting.OrganizationID,
+		Action:         "correct_increment",
+		ResourceType:   "meeting",
+		ResourceID:     meetingID,
+		Details: map[string]interface{}{
+			"increment_id": incrementID,
+			"before":       before,
+			"after":        incrementAudit(inc),
+			"finalized":    meeting.FinalizedAt != nil,
+		},
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+	})
+
+	dto := toIncrementDTO(inc)
+	s.broadcastEvent(ctx, meetingID, service.EventIncrementCorrected, dto)
+	s.checkCostAlerts(ctx, meetingID)
+	return &dto, nil
+}
+
+func (s *meetingService) UndoLastChange(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) (*service.UndoDTO, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPerm, _ := s.permissionRepo.HasPermission(ctx, requesterID, meeting.OrganizationID, "meeting", &meetingID, "update")
+	if !hasPerm {
+		return nil, fmt.Errorf("forbidden")
+	}
+	if !meeting.IsActive {
+		return nil, fmt.Errorf("invalid undo: the meeting is not running")
+	}
+
+	reopened, removed, err := s.meetingRepo.UndoIncrement(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if reopened == nil {
+		return nil, fmt.Errorf("invalid undo: nothing has changed since the meeting started")
+	}
+
+	// The reopened increment's time was metered when it closed; take it back
+	// so usage counts it once, when it closes for good
+	metered := int(removed.StartTime.Sub(reopened.StartTime).Seconds())
+	if err := s.usageService.RecordMeetingTime(ctx, meeting.OrganizationID, -metered, removed.StartTime); err != nil {
+		s.logger.Error("failed to reverse usage on undo", "meeting_id", meetingID, "error", err)
+	}
+
+	if err := s.updateMeetingTotals(ctx, meetingID); err != nil {
+		s.logger.Error("failed to update meeting totals on undo", "meeting_id", meetingID, "error", err)
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &meeting.OrganizationID,
+		Action:         "undo_meeting_change",
+		ResourceType:   "meeting",
+		ResourceID:     meetingID,
+		Details: map[string]interface{}{
+			"removed": incrementAudit(removed),
+			"restored": map[string]interface{}{
+				"increment_id":   reopened.ID,
+				"attendee_count": reopened.AttendeeCount,
+				"average_wage":   reopened.AverageWage,
+				"purpose":        reopened.Purpose,
+			},
+		},
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	})
+
+	dto := &service.UndoDTO{
+		RemovedIncrementID: removed.ID,
+		Increment:          toIncrementDTO(reopened),
+	}
+	s.broadcastEvent(ctx, meetingID, service.EventMeetingUndo, dto)
+	return dto, nil
+}
+
+// validateIncrement checks a corrected increment: a positive span that has
+// ended by now and doesn't overlap the meeting's other increments, the open
+// one running until now, and no negative attendees or wage.
+func validateIncrement(inc *models.Increment, increments []*models.Increment, now time.Time) error {
+	switch {
+	case !inc.StopTime.After(inc.StartTime):
+		return fmt.Errorf("invalid stop_time: must be after start_time")
+	case inc.StopTime.After(now):
+		return fmt.Errorf("invalid stop_time: must not be in the future")
+	case inc.AttendeeCount < 0:
+		return fmt.Errorf("invalid attendee_count: must not be negative")
+	case inc.AverageWage < 0:
+		return fmt.Errorf("invalid average_wage: must not be negative")
+	}
+	for _, other := range increments {
+		if other.ID == inc.ID {
+			continue
+		}
+		stop := other.StopTime
+		if stop.IsZero() {
+			stop = now
+		}
+		if inc.StartTime.Before(stop) && other.StartTime.Before(inc.StopTime) {
+			return fmt.Errorf("invalid increment: overlaps increment %s", other.ID)
+		}
+	}
+	return nil
+}
+
+// incrementAudit is the part of an increment an audit log entry records.
+func incrementAudit(inc *models.Increment) map[string]interface{} {
+	return map[string]interface{}{
+		"start_time":     inc.StartTime,
+		"stop_time":      inc.StopTime,
+		"attendee_count": inc.AttendeeCount,
+		"average_wage":   inc.AverageWage,
+		"cost":           inc.Cost,
+		"purpose":        inc.Purpose,
+	}
+}
@@ -0,0 +1,110 @@
+package impl_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/config"
+	"github.com/yourorg/meeting-cost/backend/go/internal/container"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// TestUndoMetersTimeOnce cycles a meeting's increment, undoes the change and
+// stops the meeting: the reopened increment was metered when the cycle
+// closed it, so usage must end up with the meeting's time counted once.
+func TestUndoMetersTimeOnce(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("DB_REPOSITORY_DRIVER", "memory")
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	level, err := logger.NewLevel("error")
+	if err != nil {
+		t.Fatalf("new level: %v", err)
+	}
+	l, err := logger.NewZapLogger("test", level)
+	if err != nil {
+		t.Fatalf("new logger: %v", err)
+	}
+	ctn, err := container.NewContainer(ctx, cfg, nil, cache.NewMemoryCache(cfg.Cache.LocalSize), l, level)
+	if err != nil {
+		t.Fatalf("new container: %v", err)
+	}
+	defer ctn.Close()
+
+	user, err := ctn.AuthService.Register(ctx, service.RegisterRequest{
+		Email:     "undo@example.com",
+		Password:  "undo-password",
+		FirstName: "Una",
+	})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	personID := user.User.ID
+	org, err := ctn.OrgService.CreateOrganization(ctx, personID, service.CreateOrganizationRequest{
+		Name:        "Undo",
+		DefaultWage: 60,
+	})
+	if err != nil {
+		t.Fatalf("create organization: %v", err)
+	}
+	meeting, err := ctn.MeetingService.CreateMeeting(ctx, org.ID, personID, service.CreateMeetingRequest{
+		OrganizationID: org.ID,
+		Purpose:        "Undo",
+	})
+	if err != nil {
+		t.Fatalf("create meeting: %v", err)
+	}
+	if err := ctn.MeetingService.StartMeeting(ctx, meeting.ID, personID); err != nil {
+		t.Fatalf("start meeting: %v", err)
+	}
+
+	// Backdate the first increment so the cycle closes ten minutes of it
+	increments, err := ctn.IncrementRepo.GetByMeeting(ctx, meeting.ID)
+	if err != nil || len(increments) != 1 {
+		t.Fatalf("get increments: %d, %v", len(increments), err)
+	}
+	increments[0].StartTime = increments[0].StartTime.Add(-10 * time.Minute)
+	if err := ctn.IncrementRepo.Update(ctx, increments[0]); err != nil {
+		t.Fatalf("backdate increment: %v", err)
+	}
+
+	if err := ctn.MeetingService.UpdateAttendeeCount(ctx, meeting.ID, 3, personID, "", ""); err != nil {
+		t.Fatalf("cycle increment: %v", err)
+	}
+	if _, err := ctn.MeetingService.UndoLastChange(ctx, meeting.ID, personID, "", ""); err != nil {
+		t.Fatalf("undo: %v", err)
+	}
+	if err := ctn.MeetingService.StopMeeting(ctx, meeting.ID, personID); err != nil {
+		t.Fatalf("stop meeting: %v", err)
+	}
+
+	increments, err = ctn.IncrementRepo.GetByMeeting(ctx, meeting.ID)
+	if err != nil {
+		t.Fatalf("get increments: %v", err)
+	}
+	var elapsed int64
+	for _, inc := range increments {
+		elapsed += int64(inc.ElapsedTime)
+	}
+	if elapsed < 600 {
+		t.Fatalf("meeting ran %ds, want at least 600s", elapsed)
+	}
+
+	records, err := ctn.UsageRepo.ListByOrganization(ctx, org.ID, models.UsagePeriod(time.Now()))
+	if err != nil {
+		t.Fatalf("list usage: %v", err)
+	}
+	var metered int64
+	for _, r := range records {
+		metered += r.MeetingSeconds
+	}
+	if metered != elapsed {
+		t.Errorf("metered %ds, want the meeting's %ds", metered, elapsed)
+	}
+}
@@ -0,0 +1,92 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *meetingService) CheckOverruns(ctx context.Context) (int, error) {
+	now := time.Now()
+	meetings, err := s.meetingRepo.ListOverrunning(ctx, now.Add(-service.OverrunGrace))
+	if err != nil {
+		return 0, err
+	}
+
+	// One meeting's failure is logged so the rest are still checked
+	overrunning := 0
+	for _, m := range meetings {
+		// Marking the meeting first makes concurrent checks publish once
+		marked, err := s.meetingRepo.MarkOverrun(ctx, m.ID, now)
+		if err != nil {
+			s.logger.Error("failed to mark meeting overrun", "meeting_id", m.ID, "error", err)
+			continue
+		}
+		if !marked {
+			continue
+		}
+		overrunning++
+
+		increments, err := s.meetingRepo.GetIncrements(ctx, m.ID)
+		if err != nil {
+			s.logger.Error("failed to load increments for overrun", "meeting_id", m.ID, "error", err)
+		}
+		cost, _ := accruedCost(increments, true, now)
+		s.broadcastEvent(ctx, m.ID, service.EventMeetingOverrun, service.OverrunEvent{
+			ScheduledEnd: *m.ScheduledEnd,
+			Cost:         roundCents(cost),
+		})
+	}
+	return overrunning, nil
+}
+
+// recordOverrun saves how much of a stopped meeting's time and cost came
+// after end.
+func (s *meetingService) recordOverrun(ctx context.Context, meetingID uuid.UUID, end time.Time) {
+	increments, err := s.meetingRepo.GetIncrements(ctx, meetingID)
+	if err == nil {
+		seconds, cost := overrun(end, increments)
+		err = s.meetingRepo.RecordOverrun(ctx, meetingID, seconds, cost)
+	}
+	if err != nil {
+		s.logger.Error("failed to record overrun", "meeting_id", meetingID, "error", err)
+	}
+}
+
+// overrun returns how many seconds of the closed increments fell after
+// end, and what they cost; an increment spanning end is split pro rata.
+// Overruns within service.OverrunGrace don't count.
+func overrun(end time.Time, increments []*models.Increment) (int, float64) {
+	var seconds, cost float64
+	for _, inc := range increments {
+		if inc.StopTime.IsZero() || !inc.StopTime.After(end) {
+			continue
+		}
+		from := inc.StartTime
+		if end.After(from) {
+			from = end
+		}
+		after := inc.StopTime.Sub(from).Seconds()
+		seconds += after
+		if total := inc.StopTime.Sub(inc.StartTime).Seconds(); total > 0 {
+			cost += inc.Cost * after / total
+		}
+	}
+	if seconds <= service.OverrunGrace.Seconds() {
+		return 0, 0
+	}
+	return int(seconds), roundCents(cost)
+}
+
+// validateSchedule checks that a scheduled end comes after the scheduled
+// start.
+func validateSchedule(start, end *time.Time) error {
+	if start != nil && end != nil && !end.After(*start) {
+		return fmt.Errorf("invalid scheduled_end: must be after scheduled_start")
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	apperrors "github.com/yourorg/meeting-cost/backend/go/internal/errors"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// syncConflict is why the server's state keeps a synced operation from
+// applying.
+type syncConflict struct {
+	reason string
+}
+
+func (e *syncConflict) Error() string {
+	return e.reason
+}
+
+func (s *meetingService) Sync(ctx context.Context, requesterID uuid.UUID, req service.SyncRequest) (*service.SyncResultDTO, error) {
+	if err := validateSync(req.Operations); err != nil {
+		return nil, err
+	}
+
+	// A meeting created and stopped offline never runs alongside the
+	// organization's others, so it doesn't count against their limit
+	stops := make(map[uuid.UUID]bool)
+	for _, op := range req.Operations {
+		if op.Type == service.SyncStop {
+			stops[op.MeetingID] = true
+		}
+	}
+
+	res := &service.SyncResultDTO{
+		Results:  make([]service.SyncOperationResult, len(req.Operations)),
+		Meetings: []*service.MeetingDTO{},
+	}
+	var meetingIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for i, op := range req.Operations {
+		result, err := s.syncOperation(ctx, requesterID, op, stops[op.MeetingID], req)
+		if err != nil {
+			return nil, fmt.Errorf("syncing operation %s: %w", op.ID, err)
+		}
+		res.Results[i] = *result
+		if !seen[op.MeetingID] {
+			seen[op.MeetingID] = true
+			meetingIDs = append(meetingIDs, op.MeetingID)
+		}
+	}
+
+	for _, id := range meetingIDs {
+		// Meetings the requester can't see, such as another's under a
+		// conflicting ID, are left out
+		if meeting, err := s.GetMeeting(ctx, id, requesterID, service.MeetingExpand{}); err == nil {
+			res.Meetings = append(res.Meetings, meeting)
+		}
+	}
+	return res, nil
+}
+
+// validateSync checks the operations are well formed, so none apply if
+// any is not.
+func validateSync(ops []service.SyncOperation) error {
+	if len(ops) == 0 {
+		return fmt.Errorf("invalid operations: give at least one")
+	}
+	if len(ops) > service.MaxSyncOperations {
+		return fmt.Errorf("invalid operations: at most %d at a time", service.MaxSyncOperations)
+	}
+	for i, op := range ops {
+		invalid := func(format string, args ...interface{}) error {
+			return fmt.Errorf("invalid operations[%d]: "+format, append([]interface{}{i}, args...)...)
+		}
+		switch {
+		case op.ID == uuid.Nil:
+			return invalid("id is required")
+		case op.MeetingID == uuid.Nil:
+			return invalid("meeting_id is required")
+		case op.At.IsZero():
+			return invalid("at is required")
+		case op.AttendeeCount != nil && *op.AttendeeCount < 0:
+			return invalid("attendee_count must not be negative")
+		case op.AverageWage != nil && *op.AverageWage < 0:
+			return invalid("average_wage must not be negative")
+		case op.Purpose != nil && strings.TrimSpace(*op.Purpose) == "":
+			return invalid("purpose must not be empty")
+		}
+		switch op.Type {
+		case service.SyncCreate:
+			if op.OrganizationID == nil {
+				return invalid("organization_id is required to create a meeting")
+			}
+		case service.SyncIncrement:
+			if op.AttendeeCount == nil && op.AverageWage == nil && op.Purpose == nil {
+				return invalid("set attendee_count, average_wage or purpose")
+			}
+		case service.SyncStop:
+		default:
+			return invalid("type must be create, increment or stop")
+		}
+	}
+	return nil
+}
+
+// syncOperation applies op unless it was synced before. An error leaves
+// it unsynced, to be retried.
+func (s *meetingService) syncOperation(ctx context.Context, requesterID uuid.UUID, op service.SyncOperation, stopped bool, req service.SyncRequest) (*service.SyncOperationResult, error) {
+	record := &models.SyncOperation{
+		PersonID:    requesterID,
+		OperationID: op.ID,
+		MeetingID:   op.MeetingID,
+		Type:        op.Type,
+		Status:      models.SyncPending,
+	}
+	earlier, err := s.syncRepo.Claim(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	if earlier != nil {
+		return &service.SyncOperationResult{ID: op.ID, Status: earlier.Status, Reason: earlier.Reason, Replayed: true}, nil
+	}
+
+	at := op.At.UTC()
+	if now := time.Now().UTC(); at.After(now) {
+		at = now
+	}
+	switch op.Type {
+	case service.SyncCreate:
+		err = s.syncCreate(ctx, requesterID, op, at, stopped)
+	case service.SyncIncrement:
+		err = s.syncIncrement(ctx, requesterID, op, at)
+	case service.SyncStop:
+		err = s.syncStop(ctx, requesterID, op, at)
+	}
+
+	var conflict *syncConflict
+	switch {
+	case err == nil:
+		record.Status = models.SyncApplied
+	case errors.As(err, &conflict):
+		record.Status = models.SyncConflict
+		record.Reason = conflict.reason
+	default:
+		if err := s.syncRepo.Delete(ctx, record.ID); err != nil {
+			s.logger.Error("failed to release sync operation", "operation_id", op.ID, "error", err)
+		}
+		return nil, err
+	}
+	if err := s.syncRepo.Update(ctx, record); err != nil {
+		s.logger.Error("failed to record sync operation", "operation_id", op.ID, "status", record.Status, "error", err)
+	}
+
+	if record.Status == models.SyncApplied {
+		details := map[string]interface{}{"operation_id": op.ID, "type": op.Type, "at": at}
+		if op.AttendeeCount != nil {
+			details["attendee_count"] = *op.AttendeeCount
+		}
+		if op.AverageWage != nil {
+			details["average_wage"] = *op.AverageWage
+		}
+		if op.Purpose != nil {
+			details["purpose"] = strings.TrimSpace(*op.Purpose)
+		}
+		if meeting, err := s.meetingRepo.GetByID(ctx, op.MeetingID); err == nil {
+			_ = s.auditLogService.Log(ctx, service.LogParams{
+				PersonID:       &requesterID,
+				OrganizationID: &meeting.OrganizationID,
+				Action:         "sync_meeting_operation",
+				ResourceType:   "meeting",
+				ResourceID:     op.MeetingID,
+				Details:        details,
+				IPAddress:      req.IPAddress,
+				UserAgent:      req.UserAgent,
+			})
+		}
+	}
+	return &service.SyncOperationResult{ID: op.ID, Status: record.Status, Reason: record.Reason}, nil
+}
+
+// syncCreate creates and starts a meeting at at, under the ID the client
+// gave it.
+func (s *meetingService) syncCreate(ctx context.Context, requesterID uuid.UUID, op service.SyncOperation, at time.Time, stopped bool) error {
+	orgID := *op.OrganizationID
+	hasPermission, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "meeting", nil, "create")
+	if err != nil {
+		return fmt.Errorf("checking permission: %w", err)
+	}
+	if !hasPermission {
+		return &syncConflict{"forbidden: insufficient permissions to create meeting"}
+	}
+
+	if _, err := s.meetingRepo.GetByID(ctx, op.MeetingID); err == nil {
+		return &syncConflict{"meeting already exists"}
+	} else if !strings.Contains(err.Error(), "not found") {
+		return err
+	}
+	if !stopped {
+		if err := s.entitlements.CheckActiveMeetingLimit(ctx, orgID); err != nil {
+			var domainErr *apperrors.DomainError
+			if errors.As(err, &domainErr) {
+				return &syncConflict{domainErr.Message}
+			}
+			return err
+		}
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("getting organization: %w", err)
+	}
+	first := &models.Increment{
+		StartTime:   at,
+		AverageWage: org.DefaultWage,
+	}
+	if op.AttendeeCount != nil {
+		first.AttendeeCount = *op.AttendeeCount
+	}
+	if op.AverageWage != nil {
+		first.AverageWage = *op.AverageWage
+	}
+	if op.Purpose != nil {
+		first.Purpose = strings.TrimSpace(*op.Purpose)
+	}
+	meeting := &models.Meeting{
+		ID:             op.MeetingID,
+		OrganizationID: orgID,
+		CreatedByID:    requesterID,
+		Purpose:        first.Purpose,
+		StartedAt:      &at,
+		IsActive:       true,
+		MaxAttendees:   first.AttendeeCount,
+	}
+	if err := s.meetingRepo.CreateWithIncrements(ctx, meeting, []*models.Increment{first}); err != nil {
+		return fmt.Errorf("creating meeting: %w", err)
+	}
+	s.started.WithLabelValues(orgID.String()).Inc()
+
+	s.broadcastEvent(ctx, meeting.ID, service.EventMeetingStarted, first)
+	s.dispatchWebhook(ctx, meeting.ID, service.WebhookEventMeetingStarted)
+	return nil
+}
+
+// syncMeeting returns the running meeting an operation changes, once the
+// requester is allowed to action it.
+func (s *meetingService) syncMeeting(ctx context.Context, requesterID uuid.UUID, meetingID uuid.UUID, action string) (*models.Meeting, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, &syncConflict{"meeting not found"}
+		}
+		return nil, err
+	}
+
+	hasPermission, err := s.permissionRepo.HasPermission(ctx, requesterID, meeting.OrganizationID, "meeting", &meetingID, action)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, &syncConflict{"forbidden"}
+	}
+	if !meeting.IsActive {
+		return nil, &syncConflict{"the meeting is not running"}
+	}
+	return meeting, nil
+}
+
+// closeAt closes a meeting's open increment at at, which must not come
+// before it opened: the meeting changed since the operation was recorded.
+func closeAt(open *models.Increment, at time.Time) error {
+	if open == nil {
+		return &syncConflict{"the meeting has no open increment"}
+	}
+	if at.Before(open.StartTime) {
+		return &syncConflict{fmt.Sprintf("the meeting changed at %s, after this operation", open.StartTime.Format(time.RFC3339))}
+	}
+	open.StopTime = at
+	open.ElapsedTime = int(at.Sub(open.StartTime).Seconds())
+	open.Cost = incrementCost(open.ElapsedTime, open.AttendeeCount, open.AverageWage)
+	return nil
+}
+
+// syncIncrement changes a running meeting from at, as cycleIncrement does
+// from now.
+func (s *meetingService) syncIncrement(ctx context.Context, requesterID uuid.UUID, op service.SyncOperation, at time.Time) error {
+	if _, err := s.syncMeeting(ctx, requesterID, op.MeetingID, "update"); err != nil {
+		return err
+	}
+
+	var closed *models.Increment
+	next, err := s.meetingRepo.CycleIncrement(ctx, op.MeetingID, func(open *models.Increment) (*models.Increment, error) {
+		if err := closeAt(open, at); err != nil {
+			return nil, err
+		}
+		closed = open
+
+		next := &models.Increment{
+			MeetingID:     op.MeetingID,
+			StartTime:     at,
+			AttendeeCount: open.AttendeeCount,
+			AverageWage:   open.AverageWage,
+			Purpose:       open.Purpose,
+		}
+		if op.AttendeeCount != nil {
+			next.AttendeeCount = *op.AttendeeCount
+		}
+		if op.AverageWage != nil {
+			next.AverageWage = *op.AverageWage
+		}
+		if op.Purpose != nil {
+			next.Purpose = strings.TrimSpace(*op.Purpose)
+		}
+		return next, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.recordUsage(ctx, op.MeetingID, closed)
+	s.broadcastEvent(ctx, op.MeetingID, service.EventMeetingCost, next)
+	s.checkCostAlerts(ctx, op.MeetingID)
+	return nil
+}
+
+// syncStop stops a running meeting at at.
+func (s *meetingService) syncStop(ctx context.Context, requesterID uuid.UUID, op service.SyncOperation, at time.Time) error {
+	meeting, err := s.syncMeeting(ctx, requesterID, op.MeetingID, "stop")
+	if err != nil {
+		return err
+	}
+
+	closed, err := s.meetingRepo.Stop(ctx, op.MeetingID, at, func(open *models.Increment) error {
+		return closeAt(open, at)
+	})
+	if err != nil {
+		return err
+	}
+
+	if closed != nil {
+		s.recordUsage(ctx, op.MeetingID, closed)
+	}
+	s.stopped(ctx, meeting)
+	return nil
+}
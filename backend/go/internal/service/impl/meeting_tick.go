@@ -0,0 +1,32 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *meetingService) BroadcastCosts(ctx context.Context) (int, error) {
+	active := true
+	meetings, _, err := s.meetingRepo.List(ctx, repository.MeetingFilters{IsActive: &active}, repository.Pagination{})
+	if err != nil {
+		return 0, fmt.Errorf("listing active meetings: %w", err)
+	}
+
+	// One meeting's failure is logged so the rest still tick
+	now := time.Now()
+	published := 0
+	for _, m := range meetings {
+		cost, err := s.liveCost(ctx, m, now)
+		if err != nil {
+			s.logger.Error("failed to compute live cost", "meeting_id", m.ID, "error", err)
+			continue
+		}
+		s.broadcastEvent(ctx, m.ID, service.EventCostTick, service.CostTickEvent{MeetingCostDTO: *cost, At: now})
+		published++
+	}
+	return published, nil
+}
@@ -0,0 +1,51 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *meetingService) ListDeletedMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, pagination service.Pagination) ([]*service.DeletedMeetingDTO, int64, error) {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil {
+		return nil, 0, err
+	}
+	if !hasPerm {
+		return nil, 0, fmt.Errorf("forbidden: only organization admins can view deleted meetings")
+	}
+
+	meetings, total, err := s.meetingRepo.ListDeleted(ctx, orgID, repository.Pagination{
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("listing deleted meetings: %w", err)
+	}
+
+	dtos := make([]*service.DeletedMeetingDTO, len(meetings))
+	if len(meetings) == 0 {
+		return dtos, total, nil
+	}
+
+	// Meetings come most recently deleted first, and each deletion is
+	// logged just after it happens
+	since := meetings[len(meetings)-1].DeletedAt.Time
+	deleters, err := s.auditLogService.MeetingDeleters(ctx, orgID, since)
+	if err != nil {
+		s.logger.Error("failed to look up meeting deleters", "organization_id", orgID, "error", err)
+	}
+	for i, m := range meetings {
+		dtos[i] = &service.DeletedMeetingDTO{
+			MeetingDTO: *s.toMeetingDTO(m),
+			DeletedAt:  m.DeletedAt.Time,
+		}
+		if deleter, ok := deleters[m.ID]; ok {
+			dtos[i].DeletedByID = &deleter
+		}
+	}
+	return dtos, total, nil
+}
@@ -0,0 +1,319 @@
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/email"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/notify"
+	"github.com/yourorg/meeting-cost/backend/go/internal/outbound"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+	personRepo       repository.PersonRepository
+	emailService     service.EmailService
+	slack            *notify.Slack
+	push             *notify.WebPush
+	queue            *queue.Client
+	publicURL        string
+	secret           []byte
+	logger           logger.Logger
+}
+
+// eventChannels restricts events to some channels; other events may use
+// every configured channel.
+var eventChannels = map[string][]string{
+	service.NotificationWeeklyDigest: {service.ChannelEmail},
+}
+
+// NewNotificationService creates a new NotificationService. slack and push
+// may be nil when those channels are not configured. Unsubscribe links point
+// at publicURL and are signed with secret.
+func NewNotificationService(
+	notificationRepo repository.NotificationRepository,
+	personRepo repository.PersonRepository,
+	emailService service.EmailService,
+	slack *notify.Slack,
+	push *notify.WebPush,
+	queue *queue.Client,
+	publicURL string,
+	secret string,
+	logger logger.Logger,
+) service.NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		personRepo:       personRepo,
+		emailService:     emailService,
+		slack:            slack,
+		push:             push,
+		queue:            queue,
+		publicURL:        strings.TrimSuffix(publicURL, "/"),
+		secret:           []byte(secret),
+		logger:           logger,
+	}
+}
+
+// channels returns the configured channels event may use.
+func (s *notificationService) channels(event string) []string {
+	if only, ok := eventChannels[event]; ok {
+		return only
+	}
+	channels := []string{service.ChannelEmail}
+	if s.slack != nil {
+		channels = append(channels, service.ChannelSlack)
+	}
+	if s.push != nil {
+		channels = append(channels, service.ChannelPush)
+	}
+	return channels
+}
+
+// preferences returns the person's effective setting for every event and
+// configured channel. Email is on until turned off; other channels are off
+// until turned on.
+func (s *notificationService) preferences(ctx context.Context, personID uuid.UUID) ([]service.NotificationPreferenceDTO, error) {
+	stored, err := s.notificationRepo.ListPreferences(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	enabled := make(map[[2]string]bool, len(stored))
+	for _, p := range stored {
+		enabled[[2]string{p.Event, p.Channel}] = p.Enabled
+	}
+
+	var prefs []service.NotificationPreferenceDTO
+	for _, event := range service.NotificationEvents {
+		for _, channel := range s.channels(event) {
+			on, ok := enabled[[2]string{event, channel}]
+			if !ok {
+				on = channel == service.ChannelEmail
+			}
+			prefs = append(prefs, service.NotificationPreferenceDTO{Event: event, Channel: channel, Enabled: on})
+		}
+	}
+	return prefs, nil
+}
+
+func (s *notificationService) Notify(ctx context.Context, personID uuid.UUID, event string, n service.Notification) error {
+	prefs, err := s.preferences(ctx, personID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, p := range prefs {
+		if p.Event != event || !p.Enabled {
+			continue
+		}
+		if _, err := s.queue.Enqueue(ctx, service.TaskNotify, service.SendNotificationPayload{
+			PersonID:     personID,
+			Event:        event,
+			Channel:      p.Channel,
+			Notification: n,
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("queueing %s notification: %w", p.Channel, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *notificationService) Enabled(ctx context.Context, personID uuid.UUID, event, channel string) (bool, error) {
+	prefs, err := s.preferences(ctx, personID)
+	if err != nil {
+		return false, err
+	}
+	for _, p := range prefs {
+		if p.Event == event && p.Channel == channel {
+			return p.Enabled, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *notificationService) UnsubscribeURL(personID uuid.UUID, event string) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(personID.String() + ":" + event))
+	return s.publicURL + "/api/v2/notifications/unsubscribe?token=" + payload + "." + s.sign(payload)
+}
+
+func (s *notificationService) Unsubscribe(ctx context.Context, token string) (string, error) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.sign(payload))) {
+		return "", fmt.Errorf("invalid unsubscribe token")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", fmt.Errorf("invalid unsubscribe token")
+	}
+	id, event, _ := strings.Cut(string(raw), ":")
+	personID, err := uuid.Parse(id)
+	if err != nil || !slices.Contains(service.NotificationEvents, event) {
+		return "", fmt.Errorf("invalid unsubscribe token")
+	}
+
+	err = s.notificationRepo.SavePreferences(ctx, []*models.NotificationPreference{{
+		PersonID: personID,
+		Event:    event,
+		Channel:  service.ChannelEmail,
+		Enabled:  false,
+	}})
+	if err != nil {
+		return "", err
+	}
+	return event, nil
+}
+
+// sign returns the unsubscribe token signature of payload.
+func (s *notificationService) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte("unsubscribe:" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *notificationService) Deliver(ctx context.Context, payload service.SendNotificationPayload) error {
+	person, err := s.personRepo.GetByID(ctx, payload.PersonID)
+	if err != nil {
+		return err
+	}
+	if person.Anonymized {
+		return nil
+	}
+	n := payload.Notification
+
+	switch payload.Channel {
+	case service.ChannelEmail:
+		_, err := s.emailService.Send(ctx, person.Email, email.TemplateNotification, email.NotificationData{
+			Name:  person.FirstName,
+			Title: n.Title,
+			Body:  n.Body,
+			URL:   n.URL,
+		})
+		return err
+
+	case service.ChannelSlack:
+		if s.slack == nil {
+			return nil
+		}
+		text := "*" + n.Title + "*\n" + n.Body
+		if n.URL != "" {
+			text += "\n" + n.URL
+		}
+		return s.slack.DirectMessage(ctx, person.Email, text)
+
+	case service.ChannelPush:
+		if s.push == nil {
+			return nil
+		}
+		return s.sendPush(ctx, person.ID, n)
+	}
+	return fmt.Errorf("unknown notification channel %q", payload.Channel)
+}
+
+// sendPush sends n to every browser the person subscribed, dropping
+// subscriptions the push service reports gone.
+func (s *notificationService) sendPush(ctx context.Context, personID uuid.UUID, n service.Notification) error {
+	subs, err := s.notificationRepo.ListPushSubscriptions(ctx, personID)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, sub := range subs {
+		err := s.push.Send(ctx, notify.PushSubscription{Endpoint: sub.Endpoint, P256dh: sub.P256dh, Auth: sub.Auth}, payload)
+		switch {
+		case errors.Is(err, notify.ErrSubscriptionGone):
+			_ = s.notificationRepo.DeletePushSubscription(ctx, personID, sub.ID)
+		case err != nil:
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *notificationService) GetPreferences(ctx context.Context, personID uuid.UUID) (*service.NotificationPreferencesDTO, error) {
+	prefs, err := s.preferences(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	return &service.NotificationPreferencesDTO{Channels: s.channels(""), Preferences: prefs}, nil
+}
+
+func (s *notificationService) UpdatePreferences(ctx context.Context, personID uuid.UUID, req service.UpdateNotificationPreferencesRequest) (*service.NotificationPreferencesDTO, error) {
+	rows := make([]*models.NotificationPreference, 0, len(req.Preferences))
+	for _, p := range req.Preferences {
+		if !slices.Contains(service.NotificationEvents, p.Event) {
+			return nil, fmt.Errorf("invalid event: %s", p.Event)
+		}
+		if !slices.Contains(s.channels(p.Event), p.Channel) {
+			return nil, fmt.Errorf("invalid channel: %s is not available", p.Channel)
+		}
+		rows = append(rows, &models.NotificationPreference{
+			PersonID: personID,
+			Event:    p.Event,
+			Channel:  p.Channel,
+			Enabled:  p.Enabled,
+		})
+	}
+
+	if err := s.notificationRepo.SavePreferences(ctx, rows); err != nil {
+		return nil, err
+	}
+	return s.GetPreferences(ctx, personID)
+}
+
+func (s *notificationService) PushPublicKey() string {
+	if s.push == nil {
+		return ""
+	}
+	return s.push.PublicKey()
+}
+
+func (s *notificationService) AddPushSubscription(ctx context.Context, personID uuid.UUID, req service.PushSubscriptionRequest) (*service.PushSubscriptionDTO, error) {
+	if s.push == nil {
+		return nil, fmt.Errorf("invalid channel: push is not available")
+	}
+	u, err := url.Parse(req.Endpoint)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint: must be an https URL")
+	}
+	if err := outbound.CheckHost(ctx, u.Hostname()); err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if req.Keys.P256dh == "" || req.Keys.Auth == "" {
+		return nil, fmt.Errorf("invalid subscription: keys.p256dh and keys.auth are required")
+	}
+
+	sub := &models.PushSubscription{
+		PersonID:  personID,
+		Endpoint:  req.Endpoint,
+		P256dh:    req.Keys.P256dh,
+		Auth:      req.Keys.Auth,
+		UserAgent: req.UserAgent,
+	}
+	if err := s.notificationRepo.SavePushSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	return &service.PushSubscriptionDTO{ID: sub.ID, Endpoint: sub.Endpoint}, nil
+}
+
+func (s *notificationService) RemovePushSubscription(ctx context.Context, personID, subscriptionID uuid.UUID) error {
+	return s.notificationRepo.DeletePushSubscription(ctx, personID, subscriptionID)
+}
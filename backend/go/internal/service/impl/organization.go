@@ -3,6 +3,7 @@ package impl
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -17,7 +18,12 @@ type organizationService struct {
 	profileRepo     repository.PersonOrganizationProfileRepository
 	permissionRepo  repository.PermissionRepository
 	personRepo      repository.PersonRepository
+	emailRepo       repository.PersonEmailRepository
+	wageBandRepo    repository.WageBandRepository
+	holidayRepo     repository.HolidayRepository
 	auditLogService service.AuditLogService
+	entitlements    service.EntitlementService
+	subscriptions   service.SubscriptionService
 	logger          logger.Logger
 }
 
@@ -27,7 +33,12 @@ func NewOrganizationService(
 	profileRepo repository.PersonOrganizationProfileRepository,
 	permissionRepo repository.PermissionRepository,
 	personRepo repository.PersonRepository,
+	emailRepo repository.PersonEmailRepository,
+	wageBandRepo repository.WageBandRepository,
+	holidayRepo repository.HolidayRepository,
 	auditLogService service.AuditLogService,
+	entitlements service.EntitlementService,
+	subscriptions service.SubscriptionService,
 	logger logger.Logger,
 ) service.OrganizationService {
 	return &organizationService{
@@ -35,7 +46,12 @@ func NewOrganizationService(
 		profileRepo:     profileRepo,
 		permissionRepo:  permissionRepo,
 		personRepo:      personRepo,
+		emailRepo:       emailRepo,
+		wageBandRepo:    wageBandRepo,
+		holidayRepo:     holidayRepo,
 		auditLogService: auditLogService,
+		entitlements:    entitlements,
+		subscriptions:   subscriptions,
 		logger:          logger,
 	}
 }
@@ -48,6 +64,10 @@ func (s *organizationService) CreateOrganization(ctx context.Context, creatorID
 		Slug:        slug,
 		Description: req.Description,
 		DefaultWage: req.DefaultWage,
+		SeatOverage: models.SeatOverageBlock,
+
+		WageVisibility: models.WageVisibilityManagersAndSelf,
+		Visibility:     models.OrgVisibilityInviteOnly,
 	}
 
 	// 2. Repository call
@@ -82,6 +102,11 @@ func (s *organizationService) CreateOrganization(ctx context.Context, creatorID
 		}
 	}
 
+	// 5. New organizations start on a trial of a paid plan
+	if err := s.subscriptions.StartTrial(ctx, org.ID); err != nil {
+		s.logger.Error("failed to start trial", "org_id", org.ID, "error", err)
+	}
+
 	// Audit Log
 	_ = s.auditLogService.Log(ctx, service.LogParams{
 		PersonID:       &creatorID,
@@ -198,6 +223,105 @@ func (s *organizationService) ListOrganizations(ctx context.Context, requesterID
 	return dtos, nil
 }
 
+func (s *organizationService) DiscoverOrganizations(ctx context.Context, requesterID uuid.UUID, query string) ([]*service.DiscoverableOrganizationDTO, error) {
+	domains, err := s.emailDomains(ctx, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	filters := repository.OrgFilters{Discoverable: true, Domains: domains}
+	if query = strings.TrimSpace(query); query != "" {
+		filters.Name = &query
+	}
+
+	orgs, _, err := s.orgRepo.List(ctx, filters, repository.Pagination{Page: 1, PageSize: 50, SortBy: "name"})
+	if err != nil {
+		return nil, fmt.Errorf("discovering organizations: %w", err)
+	}
+
+	dtos := make([]*service.DiscoverableOrganizationDTO, len(orgs))
+	for i, org := range orgs {
+		dtos[i] = &service.DiscoverableOrganizationDTO{
+			ID:          org.ID,
+			Name:        org.Name,
+			Slug:        org.Slug,
+			Description: org.Description,
+			Visibility:  org.Visibility,
+		}
+		if p, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, org.ID); err == nil && p.IsActive {
+			dtos[i].Member = true
+		}
+	}
+	return dtos, nil
+}
+
+func (s *organizationService) JoinOrganization(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error {
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("organization not found")
+	}
+
+	// Organizations the person can't discover don't exist for them
+	switch org.Visibility {
+	case models.OrgVisibilityPublic:
+	case models.OrgVisibilityDomain:
+		domains, err := s.emailDomains(ctx, requesterID)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(domains, org.Domain) {
+			return fmt.Errorf("organization not found")
+		}
+	default:
+		return fmt.Errorf("organization not found")
+	}
+
+	if _, err := s.admit(ctx, orgID, requesterID, requesterID, nil); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "join_organization",
+		ResourceType:   "person",
+		ResourceID:     requesterID,
+		Details: map[string]interface{}{
+			"visibility": org.Visibility,
+		},
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	})
+	return nil
+}
+
+// emailDomains returns the domains of the person's sign-in email and
+// verified secondary emails.
+func (s *organizationService) emailDomains(ctx context.Context, personID uuid.UUID) ([]string, error) {
+	person, err := s.personRepo.GetByID(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	addresses := []string{person.Email}
+	emails, err := s.emailRepo.ListByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range emails {
+		if e.VerifiedAt != nil {
+			addresses = append(addresses, e.Email)
+		}
+	}
+
+	var domains []string
+	for _, addr := range addresses {
+		_, domain, ok := strings.Cut(addr, "@")
+		if domain = strings.ToLower(domain); ok && domain != "" && !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+	return domains, nil
+}
+
 func (s *organizationService) UpdateOrganization(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.UpdateOrganizationRequest) (*service.OrganizationDTO, error) {
 	// Authorization check: must have 'update' permission
 	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
@@ -219,10 +343,128 @@ func (s *organizationService) UpdateOrganization(ctx context.Context, orgID uuid
 	if req.DefaultWage != nil {
 		org.DefaultWage = *req.DefaultWage
 	}
+	if req.SeatOverage != nil {
+		switch *req.SeatOverage {
+		case models.SeatOverageBlock, models.SeatOverageAddSeat:
+			org.SeatOverage = *req.SeatOverage
+		default:
+			return nil, fmt.Errorf("invalid seat_overage: must be %q or %q", models.SeatOverageBlock, models.SeatOverageAddSeat)
+		}
+	}
+	if req.WageVisibility != nil {
+		switch *req.WageVisibility {
+		case models.WageVisibilityManagersAndSelf, models.WageVisibilityAdmins, models.WageVisibilityAggregate:
+			org.WageVisibility = *req.WageVisibility
+		default:
+			return nil, fmt.Errorf("invalid wage_visibility: must be %q, %q or %q",
+				models.WageVisibilityManagersAndSelf, models.WageVisibilityAdmins, models.WageVisibilityAggregate)
+		}
+	}
+	// Turning aggregate-only on clears the wages already stored
+	clearWages := false
+	if req.AggregateOnly != nil {
+		clearWages = *req.AggregateOnly && !org.AggregateOnly
+		org.AggregateOnly = *req.AggregateOnly
+		if org.AggregateOnly {
+			org.UseBlendedWage = true
+		}
+	}
+	if req.MonthlyBudget != nil {
+		switch budget := *req.MonthlyBudget; {
+		case budget < 0:
+			return nil, fmt.Errorf("invalid monthly_budget: must not be negative")
+		case budget == 0:
+			org.MonthlyBudget = nil
+		default:
+			org.MonthlyBudget = &budget
+		}
+	}
+	if req.ApprovalThreshold != nil {
+		switch threshold := *req.ApprovalThreshold; {
+		case threshold < 0:
+			return nil, fmt.Errorf("invalid approval_threshold: must not be negative")
+		case threshold == 0:
+			org.ApprovalThreshold = nil
+		default:
+			org.ApprovalThreshold = &threshold
+		}
+	}
+	if req.TargetAttendeeHourCost != nil {
+		switch target := *req.TargetAttendeeHourCost; {
+		case target < 0:
+			return nil, fmt.Errorf("invalid target_attendee_hour_cost: must not be negative")
+		case target == 0:
+			org.TargetAttendeeHourCost = nil
+		default:
+			org.TargetAttendeeHourCost = &target
+		}
+	}
+	if req.TargetMeetingMinutes != nil {
+		switch target := *req.TargetMeetingMinutes; {
+		case target < 0:
+			return nil, fmt.Errorf("invalid target_meeting_minutes: must not be negative")
+		case target == 0:
+			org.TargetMeetingMinutes = nil
+		default:
+			org.TargetMeetingMinutes = &target
+		}
+	}
+	if req.MeetingSurveys != nil {
+		org.MeetingSurveys = *req.MeetingSurveys
+	}
+	if req.Timezone != nil {
+		if _, err := service.LoadTimezone(*req.Timezone); err != nil {
+			return nil, err
+		}
+		org.Timezone = *req.Timezone
+	}
+	if req.WorkingHours != nil {
+		if err := applyWorkingHours(org, req.WorkingHours); err != nil {
+			return nil, err
+		}
+	}
+	// Working hours are of the organization's own day
+	if org.WorkingDays != "" && org.Timezone == "" {
+		return nil, fmt.Errorf("invalid working_hours: set the organization's timezone first")
+	}
+	if req.Domain != nil {
+		domain := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(*req.Domain), "@"))
+		if domain != "" {
+			if strings.ContainsAny(domain, "@/ ") || !strings.Contains(domain, ".") {
+				return nil, fmt.Errorf("invalid domain: must be an email domain such as example.com")
+			}
+			// Nobody claims a domain they have no email at
+			domains, err := s.emailDomains(ctx, requesterID)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(domains, domain) {
+				return nil, fmt.Errorf("invalid domain: you need a verified email at %s", domain)
+			}
+		}
+		org.Domain = domain
+	}
+	if req.Visibility != nil {
+		switch *req.Visibility {
+		case models.OrgVisibilityInviteOnly, models.OrgVisibilityDomain, models.OrgVisibilityPublic:
+			org.Visibility = *req.Visibility
+		default:
+			return nil, fmt.Errorf("invalid visibility: must be %q, %q or %q",
+				models.OrgVisibilityInviteOnly, models.OrgVisibilityDomain, models.OrgVisibilityPublic)
+		}
+	}
+	if org.Visibility == models.OrgVisibilityDomain && org.Domain == "" {
+		return nil, fmt.Errorf("invalid visibility: discoverable by domain needs a domain")
+	}
 
 	if err := s.orgRepo.Update(ctx, org); err != nil {
 		return nil, err
 	}
+	if clearWages {
+		if err := s.profileRepo.ClearWages(ctx, orgID); err != nil {
+			return nil, err
+		}
+	}
 
 	// Audit Log
 	_ = s.auditLogService.Log(ctx, service.LogParams{
@@ -234,6 +476,18 @@ func (s *organizationService) UpdateOrganization(ctx context.Context, orgID uuid
 		IPAddress:      req.IPAddress,
 		UserAgent:      req.UserAgent,
 	})
+	if clearWages {
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			PersonID:       &requesterID,
+			OrganizationID: &orgID,
+			Action:         "clear_member_wages",
+			ResourceType:   "organization",
+			ResourceID:     orgID,
+			Details:        map[string]interface{}{"reason": "aggregate_only"},
+			IPAddress:      req.IPAddress,
+			UserAgent:      req.UserAgent,
+		})
+	}
 
 	return s.toOrganizationDTO(ctx, org), nil
 }
@@ -271,6 +525,11 @@ func (s *organizationService) GetMembers(ctx context.Context, orgID uuid.UUID, r
 	if err != nil {
 		return nil, fmt.Errorf("fetching profiles: %w", err)
 	}
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	wages := wageAccessFor(ctx, s.permissionRepo, org, requesterID)
 
 	// 3. Map to DTOs
 	members := make([]*service.MemberDTO, len(profiles))
@@ -282,17 +541,14 @@ func (s *organizationService) GetMembers(ctx context.Context, orgID uuid.UUID, r
 			LastName:  p.Person.LastName,
 			IsActive:  p.IsActive,
 			JoinedAt:  p.JoinedAt,
+
+			MeetingApprover: p.MeetingApprover,
 		}
 
-		// Auth check for wage visibility (admin vs self)
-		if requesterID == p.PersonID {
+		// Wages follow the organization's wage visibility
+		if wages.visible(requesterID, p.PersonID) {
 			members[i].HourlyWage = p.HourlyWage
-		} else {
-			// Check if requester is admin
-			isAdmin, _ := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "manage_members")
-			if isAdmin {
-				members[i].HourlyWage = p.HourlyWage
-			}
+			members[i].WageBandID = p.WageBandID
 		}
 	}
 
@@ -311,7 +567,7 @@ func (s *organizationService) AddMember(ctx context.Context, orgID uuid.UUID, re
 	if req.PersonID != uuid.Nil {
 		person, err = s.personRepo.GetByID(ctx, req.PersonID)
 	} else if req.Email != "" {
-		person, err = s.personRepo.GetByEmail(ctx, req.Email)
+		person, err = matchPersonByEmail(ctx, s.personRepo, s.emailRepo, req.Email)
 	} else {
 		return fmt.Errorf("either person_id or email is required")
 	}
@@ -321,40 +577,78 @@ func (s *organizationService) AddMember(ctx context.Context, orgID uuid.UUID, re
 	}
 	req.PersonID = person.ID
 
-	// 3. Check if already a member
-	existing, _ := s.profileRepo.GetByPersonAndOrg(ctx, req.PersonID, orgID)
+	reactivated, err := s.admit(ctx, orgID, req.PersonID, requesterID, req.Wage)
+	if err != nil || reactivated {
+		return err
+	}
+
+	// Audit Log
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "add_member",
+		ResourceType:   "person",
+		ResourceID:     req.PersonID,
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+	return nil
+}
+
+// admit makes the person an active member of the organization with the
+// Member role, at wage or else the organization's default wage, or
+// reactivates their membership if they had one. claimantID claims the seat
+// it takes. It reports whether it reactivated a membership.
+func (s *organizationService) admit(ctx context.Context, orgID, personID, claimantID uuid.UUID, wage *float64) (bool, error) {
+	// Check if already a member
+	existing, _ := s.profileRepo.GetByPersonAndOrg(ctx, personID, orgID)
+	if existing != nil && existing.IsActive {
+		return false, fmt.Errorf("person is already a member")
+	}
+
+	// Reactivating counts against the plan and its seats too
+	if err := s.entitlements.CheckMemberLimit(ctx, orgID); err != nil {
+		return false, err
+	}
+	if err := s.subscriptions.ClaimSeat(ctx, orgID, claimantID); err != nil {
+		return false, err
+	}
+
 	if existing != nil {
-		if existing.IsActive {
-			return fmt.Errorf("person is already a member")
-		}
 		// Reactivate
-		return s.profileRepo.Activate(ctx, req.PersonID, orgID)
+		return true, s.profileRepo.Activate(ctx, personID, orgID)
 	}
 
-	// 4. Create profile
+	// Create profile
 	org, err := s.orgRepo.GetByID(ctx, orgID)
 	if err != nil {
-		return fmt.Errorf("org not found")
+		return false, fmt.Errorf("org not found")
 	}
 
-	wage := org.DefaultWage
-	if req.Wage != nil {
-		wage = *req.Wage
+	hourlyWage := org.DefaultWage
+	if wage != nil {
+		hourlyWage = *wage
 	}
 
 	profile := &models.PersonOrganizationProfile{
-		PersonID:       req.PersonID,
+		PersonID:       personID,
 		OrganizationID: orgID,
 		IsActive:       true,
-		HourlyWage:     &wage,
+		HourlyWage:     &hourlyWage,
+	}
+	if org.AggregateOnly {
+		if wage != nil {
+			return false, errAggregateOnly
+		}
+		profile.HourlyWage = nil
 	}
 
 	err = s.profileRepo.Create(ctx, profile)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	// 5. Assign default Member role
+	// Assign default Member role
 	roles, _ := s.permissionRepo.GetRolesByOrganization(ctx, orgID)
 	var memberRoleID *uuid.UUID
 	for _, r := range roles {
@@ -367,22 +661,11 @@ func (s *organizationService) AddMember(ctx context.Context, orgID uuid.UUID, re
 	if memberRoleID != nil {
 		_ = s.permissionRepo.AssignRole(ctx, &models.RoleAssignment{
 			RoleID:         *memberRoleID,
-			PersonID:       req.PersonID,
+			PersonID:       personID,
 			OrganizationID: orgID,
 		})
 	}
-
-	// Audit Log
-	_ = s.auditLogService.Log(ctx, service.LogParams{
-		PersonID:       &requesterID,
-		OrganizationID: &orgID,
-		Action:         "add_member",
-		ResourceType:   "person",
-		ResourceID:     req.PersonID,
-		IPAddress:      req.IPAddress,
-		UserAgent:      req.UserAgent,
-	})
-	return nil
+	return false, nil
 }
 
 func (s *organizationService) RemoveMember(ctx context.Context, orgID uuid.UUID, requesterID, memberID uuid.UUID, ipAddress, userAgent string) error {
@@ -416,6 +699,14 @@ func (s *organizationService) UpdateMemberWage(ctx context.Context, orgID uuid.U
 		return fmt.Errorf("forbidden")
 	}
 
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if org.AggregateOnly {
+		return errAggregateOnly
+	}
+
 	err = s.profileRepo.UpdateWage(ctx, personID, orgID, wage)
 	if err == nil {
 		_ = s.auditLogService.Log(ctx, service.LogParams{
@@ -424,7 +715,34 @@ func (s *organizationService) UpdateMemberWage(ctx context.Context, orgID uuid.U
 			Action:         "update_member_wage",
 			ResourceType:   "person",
 			ResourceID:     personID,
-			Details:        map[string]interface{}{"wage": wage},
+			Details:        wageAuditDetails(org, wage),
+			IPAddress:      ipAddress,
+			UserAgent:      userAgent,
+		})
+	}
+	return err
+}
+
+func (s *organizationService) SetMeetingApprover(ctx context.Context, orgID uuid.UUID, personID uuid.UUID, approver bool, requesterID uuid.UUID, ipAddress, userAgent string) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "manage_members")
+	if err != nil || !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, personID, orgID)
+	if err != nil || !profile.IsActive {
+		return fmt.Errorf("member not found")
+	}
+
+	err = s.profileRepo.SetMeetingApprover(ctx, personID, orgID, approver)
+	if err == nil {
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			PersonID:       &requesterID,
+			OrganizationID: &orgID,
+			Action:         "set_meeting_approver",
+			ResourceType:   "person",
+			ResourceID:     personID,
+			Details:        map[string]interface{}{"approver": approver},
 			IPAddress:      ipAddress,
 			UserAgent:      userAgent,
 		})
@@ -464,7 +782,23 @@ func (s *organizationService) toOrganizationDTO(ctx context.Context, org *models
 		Description:    org.Description,
 		DefaultWage:    org.DefaultWage,
 		UseBlendedWage: org.UseBlendedWage,
+		SeatOverage:    org.SeatOverage,
+		WageVisibility: org.WageVisibility,
+		AggregateOnly:  org.AggregateOnly,
+		MonthlyBudget:  org.MonthlyBudget,
+		Timezone:       org.Timezone,
 		CreatedAt:      org.CreatedAt,
+
+		TargetAttendeeHourCost: org.TargetAttendeeHourCost,
+		TargetMeetingMinutes:   org.TargetMeetingMinutes,
+		MeetingSurveys:         org.MeetingSurveys,
+
+		ApprovalThreshold: org.ApprovalThreshold,
+
+		Visibility: org.Visibility,
+		Domain:     org.Domain,
+
+		WorkingHours: toWorkingHoursDTO(org),
 	}
 
 	// Fetch active member count
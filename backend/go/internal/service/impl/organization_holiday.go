@@ -0,0 +1,159 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *organizationService) ListHolidays(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, year int) ([]*service.HolidayDTO, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+
+	var from, to time.Time
+	if year != 0 {
+		if year < 1 || year > 9999 {
+			return nil, fmt.Errorf("invalid year")
+		}
+		from = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to = from.AddDate(1, 0, 0)
+	}
+	holidays, err := s.holidayRepo.ListByOrganization(ctx, orgID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*service.HolidayDTO, len(holidays))
+	for i, h := range holidays {
+		dtos[i] = toHolidayDTO(h)
+	}
+	return dtos, nil
+}
+
+func (s *organizationService) CreateHoliday(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.HolidayRequest) (*service.HolidayDTO, error) {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil || !hasPerm {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	holiday := &models.Holiday{OrganizationID: orgID}
+	if err := s.applyHoliday(ctx, holiday, req); err != nil {
+		return nil, err
+	}
+	if err := s.holidayRepo.Create(ctx, holiday); err != nil {
+		return nil, err
+	}
+
+	s.logHoliday(ctx, "create_holiday", holiday, requesterID, req.IPAddress, req.UserAgent)
+	return toHolidayDTO(holiday), nil
+}
+
+func (s *organizationService) UpdateHoliday(ctx context.Context, orgID, holidayID uuid.UUID, requesterID uuid.UUID, req service.HolidayRequest) (*service.HolidayDTO, error) {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil || !hasPerm {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	holiday, err := s.holiday(ctx, orgID, holidayID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyHoliday(ctx, holiday, req); err != nil {
+		return nil, err
+	}
+	if err := s.holidayRepo.Update(ctx, holiday); err != nil {
+		return nil, err
+	}
+
+	s.logHoliday(ctx, "update_holiday", holiday, requesterID, req.IPAddress, req.UserAgent)
+	return toHolidayDTO(holiday), nil
+}
+
+func (s *organizationService) DeleteHoliday(ctx context.Context, orgID, holidayID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil || !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+
+	holiday, err := s.holiday(ctx, orgID, holidayID)
+	if err != nil {
+		return err
+	}
+	if err := s.holidayRepo.Delete(ctx, holidayID); err != nil {
+		return err
+	}
+
+	s.logHoliday(ctx, "delete_holiday", holiday, requesterID, ipAddress, userAgent)
+	return nil
+}
+
+// holiday returns the organization's holiday holidayID.
+func (s *organizationService) holiday(ctx context.Context, orgID, holidayID uuid.UUID) (*models.Holiday, error) {
+	holiday, err := s.holidayRepo.GetByID(ctx, holidayID)
+	if err != nil || holiday.OrganizationID != orgID {
+		return nil, fmt.Errorf("holiday not found")
+	}
+	return holiday, nil
+}
+
+// applyHoliday validates req and sets it on holiday. The organization has
+// at most one holiday a day.
+func (s *organizationService) applyHoliday(ctx context.Context, holiday *models.Holiday, req service.HolidayRequest) error {
+	name := strings.TrimSpace(req.Name)
+	switch {
+	case name == "":
+		return fmt.Errorf("invalid name: must not be empty")
+	case len(name) > 100:
+		return fmt.Errorf("invalid name: must be at most 100 characters")
+	}
+	date, err := time.Parse(time.DateOnly, strings.TrimSpace(req.Date))
+	if err != nil {
+		return fmt.Errorf("invalid date: must be a date such as 2026-12-25")
+	}
+
+	existing, err := s.holidayRepo.ListByOrganization(ctx, holiday.OrganizationID, date, date.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+	for _, h := range existing {
+		if h.ID != holiday.ID {
+			return fmt.Errorf("invalid date: %s is already a holiday, %q", req.Date, h.Name)
+		}
+	}
+
+	holiday.Name = name
+	holiday.Date = date
+	return nil
+}
+
+// logHoliday records a change to the holiday calendar in the audit log.
+func (s *organizationService) logHoliday(ctx context.Context, action string, holiday *models.Holiday, requesterID uuid.UUID, ipAddress, userAgent string) {
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &holiday.OrganizationID,
+		Action:         action,
+		ResourceType:   "holiday",
+		ResourceID:     holiday.ID,
+		Details: map[string]interface{}{
+			"date": holiday.Date.Format(time.DateOnly),
+			"name": holiday.Name,
+		},
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	})
+}
+
+func toHolidayDTO(h *models.Holiday) *service.HolidayDTO {
+	return &service.HolidayDTO{
+		ID:        h.ID,
+		Date:      h.Date.Format(time.DateOnly),
+		Name:      h.Name,
+		CreatedAt: h.CreatedAt,
+	}
+}
@@ -0,0 +1,212 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+func (s *organizationService) ListWageBands(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*service.WageBandDTO, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+
+	bands, err := s.wageBandRepo.ListByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*service.WageBandDTO, len(bands))
+	for i, b := range bands {
+		dtos[i] = toWageBandDTO(b)
+	}
+	return dtos, nil
+}
+
+func (s *organizationService) CreateWageBand(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.WageBandRequest) (*service.WageBandDTO, error) {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "manage_members")
+	if err != nil || !hasPerm {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	band := &models.WageBand{OrganizationID: orgID}
+	if err := s.applyWageBand(ctx, band, req); err != nil {
+		return nil, err
+	}
+	if err := s.wageBandRepo.Create(ctx, band); err != nil {
+		return nil, err
+	}
+
+	s.logWageBand(ctx, "create_wage_band", band, requesterID, req.IPAddress, req.UserAgent)
+	return toWageBandDTO(band), nil
+}
+
+func (s *organizationService) UpdateWageBand(ctx context.Context, orgID, bandID uuid.UUID, requesterID uuid.UUID, req service.WageBandRequest) (*service.WageBandDTO, error) {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "manage_members")
+	if err != nil || !hasPerm {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	band, err := s.wageBand(ctx, orgID, bandID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyWageBand(ctx, band, req); err != nil {
+		return nil, err
+	}
+	if err := s.wageBandRepo.Update(ctx, band); err != nil {
+		return nil, err
+	}
+
+	s.logWageBand(ctx, "update_wage_band", band, requesterID, req.IPAddress, req.UserAgent)
+	return toWageBandDTO(band), nil
+}
+
+func (s *organizationService) DeleteWageBand(ctx context.Context, orgID, bandID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "manage_members")
+	if err != nil || !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+
+	band, err := s.wageBand(ctx, orgID, bandID)
+	if err != nil {
+		return err
+	}
+
+	// Deleting an assigned band would quietly drop its members back to
+	// the default wage
+	members, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return fmt.Errorf("fetching profiles: %w", err)
+	}
+	assigned := 0
+	for _, m := range members {
+		if m.WageBandID != nil && *m.WageBandID == bandID {
+			assigned++
+		}
+	}
+	if assigned > 0 {
+		return fmt.Errorf("invalid wage band: it is assigned to %d active members; reassign them first", assigned)
+	}
+
+	if err := s.wageBandRepo.Delete(ctx, bandID); err != nil {
+		return err
+	}
+
+	s.logWageBand(ctx, "delete_wage_band", band, requesterID, ipAddress, userAgent)
+	return nil
+}
+
+func (s *organizationService) SetMemberWageBand(ctx context.Context, orgID uuid.UUID, personID uuid.UUID, bandID *uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "manage_members")
+	if err != nil || !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, personID, orgID)
+	if err != nil || !profile.IsActive {
+		return fmt.Errorf("member not found")
+	}
+	if bandID != nil {
+		org, err := s.orgRepo.GetByID(ctx, orgID)
+		if err != nil {
+			return err
+		}
+		if org.AggregateOnly {
+			return errAggregateOnly
+		}
+		if _, err := s.wageBand(ctx, orgID, *bandID); err != nil {
+			return err
+		}
+	}
+
+	err = s.profileRepo.SetWageBand(ctx, personID, orgID, bandID)
+	if err == nil {
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			PersonID:       &requesterID,
+			OrganizationID: &orgID,
+			Action:         "set_member_wage_band",
+			ResourceType:   "person",
+			ResourceID:     personID,
+			Details:        map[string]interface{}{"wage_band_id": bandID},
+			IPAddress:      ipAddress,
+			UserAgent:      userAgent,
+		})
+	}
+	return err
+}
+
+// wageBand returns the organization's wage band bandID.
+func (s *organizationService) wageBand(ctx context.Context, orgID, bandID uuid.UUID) (*models.WageBand, error) {
+	band, err := s.wageBandRepo.GetByID(ctx, bandID)
+	if err != nil || band.OrganizationID != orgID {
+		return nil, fmt.Errorf("wage band not found")
+	}
+	return band, nil
+}
+
+// applyWageBand validates req and sets it on band. Names are unique within
+// the organization, ignoring case.
+func (s *organizationService) applyWageBand(ctx context.Context, band *models.WageBand, req service.WageBandRequest) error {
+	name := strings.TrimSpace(req.Name)
+	switch {
+	case name == "":
+		return fmt.Errorf("invalid name: must not be empty")
+	case len(name) > 100:
+		return fmt.Errorf("invalid name: must be at most 100 characters")
+	case req.MinWage < 0:
+		return fmt.Errorf("invalid min_wage: must not be negative")
+	case req.MaxWage <= 0:
+		return fmt.Errorf("invalid max_wage: must be greater than zero")
+	case req.MaxWage < req.MinWage:
+		return fmt.Errorf("invalid max_wage: must not be below min_wage")
+	}
+
+	bands, err := s.wageBandRepo.ListByOrganization(ctx, band.OrganizationID)
+	if err != nil {
+		return err
+	}
+	for _, b := range bands {
+		if b.ID != band.ID && strings.EqualFold(b.Name, name) {
+			return fmt.Errorf("invalid name: the organization already has a wage band named %q", b.Name)
+		}
+	}
+
+	band.Name = name
+	band.MinWage = req.MinWage
+	band.MaxWage = req.MaxWage
+	return nil
+}
+
+// logWageBand records a change to a wage band in the audit log.
+func (s *organizationService) logWageBand(ctx context.Context, action string, band *models.WageBand, requesterID uuid.UUID, ipAddress, userAgent string) {
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &band.OrganizationID,
+		Action:         action,
+		ResourceType:   "wage_band",
+		ResourceID:     band.ID,
+		Details: map[string]interface{}{
+			"name":     band.Name,
+			"min_wage": band.MinWage,
+			"max_wage": band.MaxWage,
+		},
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	})
+}
+
+func toWageBandDTO(b *models.WageBand) *service.WageBandDTO {
+	return &service.WageBandDTO{
+		ID:        b.ID,
+		Name:      b.Name,
+		MinWage:   b.MinWage,
+		MaxWage:   b.MaxWage,
+		Midpoint:  b.Midpoint(),
+		CreatedAt: b.CreatedAt,
+	}
+}
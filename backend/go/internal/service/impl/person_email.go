@@ -0,0 +1,248 @@
+package impl
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/email"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type personEmailService struct {
+	emailRepo       repository.PersonEmailRepository
+	personRepo      repository.PersonRepository
+	emailService    service.EmailService
+	auditLogService service.AuditLogService
+	publicURL       string
+	logger          logger.Logger
+}
+
+// NewPersonEmailService creates a new PersonEmailService whose verification
+// links point at publicURL.
+func NewPersonEmailService(
+	emailRepo repository.PersonEmailRepository,
+	personRepo repository.PersonRepository,
+	emailService service.EmailService,
+	auditLogService service.AuditLogService,
+	publicURL string,
+	logger logger.Logger,
+) service.PersonEmailService {
+	return &personEmailService{
+		emailRepo:       emailRepo,
+		personRepo:      personRepo,
+		emailService:    emailService,
+		auditLogService: auditLogService,
+		publicURL:       strings.TrimSuffix(publicURL, "/"),
+		logger:          logger,
+	}
+}
+
+func (s *personEmailService) ListEmails(ctx context.Context, personID uuid.UUID) ([]*service.PersonEmailDTO, error) {
+	emails, err := s.emailRepo.ListByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*service.PersonEmailDTO, len(emails))
+	for i, e := range emails {
+		dtos[i] = toPersonEmailDTO(e)
+	}
+	return dtos, nil
+}
+
+func (s *personEmailService) AddEmail(ctx context.Context, personID uuid.UUID, req service.AddPersonEmailRequest) (*service.PersonEmailDTO, error) {
+	parsed, err := mail.ParseAddress(strings.TrimSpace(req.Email))
+	if err != nil {
+		return nil, fmt.Errorf("invalid email: %w", err)
+	}
+	addr := strings.ToLower(parsed.Address)
+
+	person, err := s.personRepo.GetByID(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(person.Email, addr) {
+		return nil, fmt.Errorf("invalid email: it is already the account's sign-in email")
+	}
+	if other, err := s.personRepo.GetByEmail(ctx, addr); err == nil && other.ID != personID {
+		return nil, fmt.Errorf("invalid email: it belongs to another account")
+	}
+
+	existing, err := s.emailRepo.GetByEmail(ctx, addr)
+	switch {
+	case err != nil:
+		existing = nil
+	case existing.PersonID == personID && existing.VerifiedAt != nil:
+		return nil, fmt.Errorf("invalid email: it is already verified")
+	case existing.PersonID != personID && existing.VerifiedAt != nil:
+		return nil, fmt.Errorf("invalid email: it belongs to another account")
+	case existing.PersonID != personID:
+		// Whoever proves they own the address first gets it
+		if err := s.emailRepo.Delete(ctx, existing.ID); err != nil {
+			return nil, err
+		}
+		existing = nil
+	}
+
+	if existing == nil {
+		emails, err := s.emailRepo.ListByPerson(ctx, personID)
+		if err != nil {
+			return nil, err
+		}
+		if len(emails) >= service.MaxPersonEmails {
+			return nil, fmt.Errorf("invalid email: an account can have at most %d secondary emails", service.MaxPersonEmails)
+		}
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("generating verification token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	expiresAt := time.Now().Add(service.PersonEmailVerificationTTL)
+
+	if existing == nil {
+		existing = &models.PersonEmail{PersonID: personID, Email: addr}
+		existing.VerificationTokenHash = hashEmailToken(token)
+		existing.VerificationExpiresAt = &expiresAt
+		err = s.emailRepo.Create(ctx, existing)
+	} else {
+		existing.VerificationTokenHash = hashEmailToken(token)
+		existing.VerificationExpiresAt = &expiresAt
+		err = s.emailRepo.Update(ctx, existing)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.emailService.Send(ctx, addr, email.TemplateVerification, email.VerificationData{
+		Name: person.FirstName,
+		URL:  s.publicURL + "/api/v2/persons/emails/verify?token=" + token,
+	}); err != nil {
+		return nil, fmt.Errorf("sending verification email: %w", err)
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     &personID,
+		Action:       "add_email",
+		ResourceType: "person_email",
+		ResourceID:   existing.ID,
+		Details: map[string]interface{}{
+			"email": addr,
+		},
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+	})
+
+	return toPersonEmailDTO(existing), nil
+}
+
+func (s *personEmailService) VerifyEmail(ctx context.Context, token string) (*service.PersonEmailDTO, error) {
+	if token == "" {
+		return nil, fmt.Errorf("invalid token")
+	}
+	e, err := s.emailRepo.GetByVerificationTokenHash(ctx, hashEmailToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: add the email again for a new link")
+	}
+	if e.VerificationExpiresAt == nil || time.Now().After(*e.VerificationExpiresAt) {
+		return nil, fmt.Errorf("invalid token: add the email again for a new link")
+	}
+	// Someone may have signed up with the address since
+	if other, err := s.personRepo.GetByEmail(ctx, e.Email); err == nil && other.ID != e.PersonID {
+		return nil, fmt.Errorf("invalid email: it belongs to another account")
+	}
+
+	now := time.Now()
+	e.VerifiedAt = &now
+	e.VerificationTokenHash = ""
+	e.VerificationExpiresAt = nil
+	if err := s.emailRepo.Update(ctx, e); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     &e.PersonID,
+		Action:       "verify_email",
+		ResourceType: "person_email",
+		ResourceID:   e.ID,
+		Details: map[string]interface{}{
+			"email": e.Email,
+		},
+	})
+
+	return toPersonEmailDTO(e), nil
+}
+
+func (s *personEmailService) RemoveEmail(ctx context.Context, personID, emailID uuid.UUID, ipAddress, userAgent string) error {
+	e, err := s.emailRepo.GetByID(ctx, emailID)
+	if err != nil {
+		return err
+	}
+	if e.PersonID != personID {
+		return fmt.Errorf("email not found")
+	}
+	if err := s.emailRepo.Delete(ctx, e.ID); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:     &personID,
+		Action:       "remove_email",
+		ResourceType: "person_email",
+		ResourceID:   e.ID,
+		Details: map[string]interface{}{
+			"email": e.Email,
+		},
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	})
+	return nil
+}
+
+func (s *personEmailService) MatchPerson(ctx context.Context, email string) (uuid.UUID, error) {
+	person, err := matchPersonByEmail(ctx, s.personRepo, s.emailRepo, email)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return person.ID, nil
+}
+
+// matchPersonByEmail finds the person whose sign-in email is email, or
+// failing that, who verified it as a secondary email.
+func matchPersonByEmail(ctx context.Context, personRepo repository.PersonRepository, emailRepo repository.PersonEmailRepository, email string) (*models.Person, error) {
+	if person, err := personRepo.GetByEmail(ctx, email); err == nil {
+		return person, nil
+	}
+	e, err := emailRepo.GetByEmail(ctx, strings.TrimSpace(email))
+	if err != nil || e.VerifiedAt == nil {
+		return nil, fmt.Errorf("person not found")
+	}
+	return personRepo.GetByID(ctx, e.PersonID)
+}
+
+func toPersonEmailDTO(e *models.PersonEmail) *service.PersonEmailDTO {
+	return &service.PersonEmailDTO{
+		ID:         e.ID,
+		Email:      e.Email,
+		Verified:   e.VerifiedAt != nil,
+		VerifiedAt: e.VerifiedAt,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+// hashEmailToken returns the stored form of a verification token.
+func hashEmailToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,589 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// maxDashboardMeetings bounds how many running meetings the dashboard
+// lists.
+const maxDashboardMeetings = 100
+
+type reportService struct {
+	reportRepo     repository.ReportRepository
+	meetingRepo    repository.MeetingRepository
+	orgRepo        repository.OrganizationRepository
+	personRepo     repository.PersonRepository
+	profileRepo    repository.PersonOrganizationProfileRepository
+	permissionRepo repository.PermissionRepository
+	wageBandRepo   repository.WageBandRepository
+	holidayRepo    repository.HolidayRepository
+	entitlements   service.EntitlementService
+}
+
+// NewReportService creates a new ReportService.
+func NewReportService(
+	reportRepo repository.ReportRepository,
+	meetingRepo repository.MeetingRepository,
+	orgRepo repository.OrganizationRepository,
+	personRepo repository.PersonRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	permissionRepo repository.PermissionRepository,
+	wageBandRepo repository.WageBandRepository,
+	holidayRepo repository.HolidayRepository,
+	entitlements service.EntitlementService,
+) service.ReportService {
+	return &reportService{
+		reportRepo:     reportRepo,
+		meetingRepo:    meetingRepo,
+		orgRepo:        orgRepo,
+		personRepo:     personRepo,
+		profileRepo:    profileRepo,
+		permissionRepo: permissionRepo,
+		wageBandRepo:   wageBandRepo,
+		holidayRepo:    holidayRepo,
+		entitlements:   entitlements,
+	}
+}
+
+// reportRange checks that requester is a member of the organization and
+// returns r with its defaults filled in and its start limited to the plan's
+// report retention, along with the earliest time the plan retains (zero
+// when unlimited). The range is in the report's timezone.
+func (s *reportService) reportRange(ctx context.Context, orgID, requesterID uuid.UUID, r service.ReportRange) (service.ReportRange, time.Time, error) {
+	var earliest time.Time
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return r, earliest, fmt.Errorf("forbidden: not a member of this organization")
+	}
+	loc, err := s.Location(ctx, orgID, requesterID)
+	if err != nil {
+		return r, earliest, err
+	}
+
+	now := time.Now().In(loc)
+	if r, err = r.In(loc).Resolve(now); err != nil {
+		return r, earliest, err
+	}
+	r.From, r.To = r.From.In(loc), r.To.In(loc)
+
+	ent, err := s.entitlements.GetEntitlements(ctx, orgID)
+	if err != nil {
+		return r, earliest, err
+	}
+	if ent.ReportRetentionDays != service.Unlimited {
+		earliest = now.AddDate(0, 0, -ent.ReportRetentionDays)
+	}
+	return retain(r, earliest), earliest, nil
+}
+
+// retain starts r no earlier than earliest. A range entirely before it is
+// left empty.
+func retain(r service.ReportRange, earliest time.Time) service.ReportRange {
+	if r.From.Before(earliest) {
+		r.From = earliest
+		if r.To.Before(earliest) {
+			r.To = earliest
+		}
+	}
+	return r
+}
+
+func (s *reportService) Location(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) (*time.Location, error) {
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return s.location(ctx, org, requesterID), nil
+}
+
+// location returns the timezone of the organization's reports for
+// requester. A zone that no longer loads counts as UTC.
+func (s *reportService) location(ctx context.Context, org *models.Organization, requesterID uuid.UUID) *time.Location {
+	name := org.Timezone
+	if name == "" {
+		if person, err := s.personRepo.GetByID(ctx, requesterID); err == nil {
+			name = person.Timezone
+		}
+	}
+	loc, err := service.LoadTimezone(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (s *reportService) GetSummary(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, r service.ReportRange) (*service.CostSummaryDTO, error) {
+	r, _, err := s.reportRange(ctx, orgID, requesterID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.reportRepo.Summary(ctx, orgID, r.From, r.To)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &service.CostSummaryDTO{
+		From:         r.From,
+		To:           r.To,
+		MeetingCount: summary.MeetingCount,
+		TotalCost:    roundCents(summary.TotalCost),
+		TotalHours:   roundCents(float64(summary.TotalSeconds) / 3600),
+		AvgAttendees: roundCents(summary.AvgAttendees),
+		Benchmark:    benchmark(org, summary.TotalCost, summary.TotalSeconds, summary.AttendeeSeconds, summary.MeetingCount),
+	}
+	if summary.MeetingCount > 0 {
+		dto.AvgCostPerMeeting = roundCents(summary.TotalCost / float64(summary.MeetingCount))
+	}
+
+	late, err := s.reportRepo.LateStarts(ctx, orgID, r.From, r.To)
+	if err != nil {
+		return nil, err
+	}
+	if late.ScheduledCount > 0 {
+		dto.LateStarts = &service.LateStartDTO{
+			ScheduledMeetings: late.ScheduledCount,
+			LateMeetings:      late.LateCount,
+			MinutesLate:       roundCents(float64(late.LateSeconds) / 60),
+			Cost:              roundCents(late.LateCost),
+		}
+		if late.LateCount > 0 {
+			dto.LateStarts.AvgMinutesLate = roundCents(float64(late.LateSeconds) / 60 / float64(late.LateCount))
+		}
+	}
+
+	over, err := s.reportRepo.Overruns(ctx, orgID, r.From, r.To)
+	if err != nil {
+		return nil, err
+	}
+	if over.ScheduledCount > 0 {
+		dto.Overruns = &service.OverrunDTO{
+			ScheduledMeetings: over.ScheduledCount,
+			OverrunMeetings:   over.OverrunCount,
+			MinutesOver:       roundCents(float64(over.OverrunSeconds) / 60),
+			Cost:              roundCents(over.OverrunCost),
+		}
+		if over.OverrunCount > 0 {
+			dto.Overruns.AvgMinutesOver = roundCents(float64(over.OverrunSeconds) / 60 / float64(over.OverrunCount))
+		}
+	}
+
+	hours, err := workingHours(ctx, s.holidayRepo, org, r.From, r.To)
+	if err != nil {
+		return nil, err
+	}
+	if hours != nil {
+		off, err := s.reportRepo.OffHours(ctx, orgID, r.From, r.To, hours)
+		if err != nil {
+			return nil, err
+		}
+		dto.OffHours = &service.OffHoursDTO{
+			OffHoursMeetings: off.OffHoursCount,
+			OffHoursHours:    roundCents(float64(off.OffHoursSeconds) / 3600),
+			OffHoursCost:     roundCents(off.OffHoursCost),
+			HolidayMeetings:  off.HolidayCount,
+			HolidayHours:     roundCents(float64(off.HolidaySeconds) / 3600),
+			HolidayCost:      roundCents(off.HolidayCost),
+		}
+		if summary.TotalCost > 0 {
+			pct := roundCents((off.OffHoursCost + off.HolidayCost) / summary.TotalCost * 100)
+			dto.OffHours.PercentOfCost = &pct
+		}
+	}
+
+	if wageAccessFor(ctx, s.permissionRepo, org, requesterID).Aggregate {
+		if dto.Wages, err = s.wageSummary(ctx, orgID); err != nil {
+			return nil, err
+		}
+	}
+	return dto, nil
+}
+
+// wageSummary averages the wages of the organization's active members,
+// counting banded members at their band's midpoint, or returns nil when
+// fewer than service.MinWageGroup have one.
+func (s *reportService) wageSummary(ctx context.Context, orgID uuid.UUID) (*service.WageSummaryDTO, error) {
+	profiles, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return nil, err
+	}
+	bands, err := wageBandsByID(ctx, s.wageBandRepo, orgID)
+	if err != nil {
+		return nil, err
+	}
+	var n int
+	var total float64
+	for _, p := range profiles {
+		if wage, ok := memberWage(p, bands); ok {
+			n++
+			total += wage
+		}
+	}
+	if n < service.MinWageGroup {
+		return nil, nil
+	}
+	return &service.WageSummaryDTO{Members: n, AverageHourlyWage: roundCents(total / float64(n))}, nil
+}
+
+func (s *reportService) GetTrend(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.TrendRequest) (*service.CostTrendDTO, error) {
+	if req.Interval == "" {
+		req.Interval = repository.IntervalDay
+	}
+	if !slices.Contains(service.TrendIntervals, req.Interval) {
+		return nil, fmt.Errorf("invalid interval: must be day, week or month")
+	}
+	r, earliest, err := s.reportRange(ctx, orgID, requesterID, req.ReportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.trendSeries(ctx, orgID, r, req.Interval)
+	if err != nil {
+		return nil, err
+	}
+	dto := &service.CostTrendDTO{Interval: req.Interval, Timezone: r.From.Location().String(), Current: *current}
+
+	if req.Compare {
+		length := r.To.Sub(r.From)
+		prev := retain(service.ReportRange{From: r.From.Add(-length), To: r.From}, earliest)
+		previous, err := s.trendSeries(ctx, orgID, prev, req.Interval)
+		if err != nil {
+			return nil, err
+		}
+		dto.Previous = previous
+		if previous.TotalCost > 0 {
+			change := roundCents((current.TotalCost - previous.TotalCost) / previous.TotalCost * 100)
+			dto.CostChangePercent = &change
+		}
+	}
+	return dto, nil
+}
+
+func (s *reportService) GetTopMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.TopMeetingsRequest) (*service.TopMeetingsDTO, error) {
+	r, _, err := s.reportRange(ctx, orgID, requesterID, req.ReportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	meetings, err := s.reportRepo.TopMeetings(ctx, orgID, r.From, r.To, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	series, err := s.reportRepo.TopSeries(ctx, orgID, r.From, r.To, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	hours, err := workingHours(ctx, s.holidayRepo, org, r.From, r.To)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &service.TopMeetingsDTO{
+		From:     r.From,
+		To:       r.To,
+		Meetings: make([]service.TopMeetingDTO, len(meetings)),
+		Series:   make([]service.MeetingSeriesDTO, len(series)),
+	}
+	for i, m := range meetings {
+		dto.Meetings[i] = service.TopMeetingDTO{
+			MeetingID:       m.MeetingID,
+			Purpose:         m.Purpose,
+			StartedAt:       m.StartedAt.In(r.From.Location()),
+			DurationSeconds: m.Seconds,
+			Attendees:       m.MaxAttendees,
+			TotalCost:       roundCents(m.TotalCost),
+			Benchmark:       benchmark(org, m.TotalCost, m.Seconds, m.AttendeeSeconds, 1),
+		}
+		if hours != nil {
+			dto.Meetings[i].OutsideHours = hours.Classify(m.StartedAt)
+		}
+		// Aggregate-only organizations attribute no costs to people
+		if !org.AggregateOnly {
+			dto.Meetings[i].Organizer = &service.OrganizerDTO{
+				PersonID:  m.OrganizerID,
+				FirstName: m.OrganizerFirstName,
+				LastName:  m.OrganizerLastName,
+			}
+		}
+	}
+	for i, sc := range series {
+		dto.Series[i] = service.MeetingSeriesDTO{
+			Purpose:         sc.Purpose,
+			MeetingCount:    sc.MeetingCount,
+			TotalCost:       roundCents(sc.TotalCost),
+			DurationSeconds: sc.TotalSeconds,
+			AvgAttendees:    roundCents(sc.AvgAttendees),
+			LastStartedAt:   sc.LastStartedAt.In(r.From.Location()),
+			Benchmark:       benchmark(org, sc.TotalCost, sc.TotalSeconds, sc.AttendeeSeconds, sc.MeetingCount),
+		}
+	}
+	return dto, nil
+}
+
+func (s *reportService) GetEffectiveness(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.TopMeetingsRequest) (*service.EffectivenessDTO, error) {
+	r, _, err := s.reportRange(ctx, orgID, requesterID, req.ReportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	sum, err := s.reportRepo.RatingSummary(ctx, orgID, r.From, r.To, service.PoorRating)
+	if err != nil {
+		return nil, err
+	}
+	meetings, err := s.reportRepo.RatedMeetings(ctx, orgID, r.From, r.To, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &service.EffectivenessDTO{
+		From:                r.From,
+		To:                  r.To,
+		RatedMeetings:       sum.MeetingCount,
+		Responses:           sum.Responses,
+		RatedCost:           roundCents(sum.TotalCost),
+		PoorlyRatedMeetings: sum.PoorlyRatedCount,
+		PoorlyRatedCost:     roundCents(sum.PoorlyRatedCost),
+		Meetings:            make([]service.RatedMeetingDTO, len(meetings)),
+	}
+	if sum.Responses > 0 {
+		avg := roundCents(float64(sum.RatingTotal) / float64(sum.Responses))
+		dto.AvgRating = &avg
+	}
+	if sum.TotalCost > 0 {
+		pct := roundCents(sum.PoorlyRatedCost / sum.TotalCost * 100)
+		dto.PoorlyRatedPercent = &pct
+	}
+	for i, m := range meetings {
+		dto.Meetings[i] = service.RatedMeetingDTO{
+			MeetingID:       m.MeetingID,
+			Purpose:         m.Purpose,
+			StartedAt:       m.StartedAt.In(r.From.Location()),
+			DurationSeconds: m.Seconds,
+			Attendees:       m.MaxAttendees,
+			TotalCost:       roundCents(m.TotalCost),
+			Responses:       m.Responses,
+			AvgRating:       roundCents(m.AvgRating),
+			PoorlyRated:     m.AvgRating <= service.PoorRating,
+		}
+	}
+	return dto, nil
+}
+
+func (s *reportService) GetDashboard(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) (*service.DashboardDTO, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := s.location(ctx, org, requesterID)
+	now := time.Now().In(loc)
+	active := true
+	meetings, _, err := s.meetingRepo.List(ctx, repository.MeetingFilters{OrganizationID: &orgID, IsActive: &active}, repository.Pagination{Page: 1, PageSize: maxDashboardMeetings})
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &service.DashboardDTO{AsOf: now, Timezone: loc.String(), ActiveMeetings: make([]service.ActiveMeetingDTO, 0, len(meetings))}
+	// The open increments, which the period totals don't cost yet
+	var running []*models.Increment
+	for _, m := range meetings {
+		increments, err := s.meetingRepo.GetIncrements(ctx, m.ID)
+		if err != nil {
+			return nil, err
+		}
+		cost, elapsed := accruedCost(increments, true, now)
+		am := service.ActiveMeetingDTO{
+			MeetingID:      m.ID,
+			Purpose:        m.Purpose,
+			ElapsedSeconds: elapsed,
+			Cost:           roundCents(cost),
+		}
+		if m.StartedAt != nil {
+			am.StartedAt = m.StartedAt.In(loc)
+		}
+		if open := openIncrement(increments); open != nil {
+			am.Attendees = open.AttendeeCount
+			am.CostPerHour = roundCents(float64(open.AttendeeCount) * open.AverageWage)
+			running = append(running, open)
+		}
+		dto.ActiveMeetings = append(dto.ActiveMeetings, am)
+	}
+
+	period := func(from, to time.Time) (service.DashboardPeriodDTO, error) {
+		p := service.DashboardPeriodDTO{From: from, To: to}
+		buckets, err := s.reportRepo.Trend(ctx, orgID, from, to, repository.IntervalDay, loc)
+		if err != nil {
+			return p, err
+		}
+		var seconds int64
+		for _, b := range buckets {
+			p.Cost += b.Cost
+			seconds += b.Seconds
+		}
+		for _, inc := range running {
+			if inc.StartTime.Before(from) || !inc.StartTime.Before(to) {
+				continue
+			}
+			cost, elapsed := accruedCost([]*models.Increment{inc}, true, to)
+			p.Cost += cost
+			seconds += int64(elapsed)
+		}
+		p.Cost = roundCents(p.Cost)
+		p.Hours = roundCents(float64(seconds) / 3600)
+		return p, nil
+	}
+
+	if dto.Today, err = period(repository.TruncateInterval(now, repository.IntervalDay, loc), now); err != nil {
+		return nil, err
+	}
+	weekStart := repository.TruncateInterval(now, repository.IntervalWeek, loc)
+	if dto.ThisWeek, err = period(weekStart, now); err != nil {
+		return nil, err
+	}
+	if dto.LastWeek, err = period(weekStart.AddDate(0, 0, -7), now.AddDate(0, 0, -7)); err != nil {
+		return nil, err
+	}
+	if dto.LastWeek.Cost > 0 {
+		change := roundCents((dto.ThisWeek.Cost - dto.LastWeek.Cost) / dto.LastWeek.Cost * 100)
+		dto.WeekChangePercent = &change
+	}
+
+	if org.MonthlyBudget != nil && *org.MonthlyBudget > 0 {
+		month, err := period(repository.TruncateInterval(now, repository.IntervalMonth, loc), now)
+		if err != nil {
+			return nil, err
+		}
+		budget := *org.MonthlyBudget
+		dto.Budget = &service.DashboardBudgetDTO{
+			MonthlyBudget: budget,
+			Spent:         month.Cost,
+			Remaining:     roundCents(budget - month.Cost),
+			PercentUsed:   roundCents(month.Cost / budget * 100),
+			Status:        service.BudgetOK,
+		}
+		switch {
+		case month.Cost > budget:
+			dto.Budget.Status = service.BudgetExceeded
+		case dto.Budget.PercentUsed >= service.BudgetWarningPercent:
+			dto.Budget.Status = service.BudgetWarning
+		}
+	}
+	return dto, nil
+}
+
+func (s *reportService) RefreshRollups(ctx context.Context, now time.Time) (int64, error) {
+	return s.reportRepo.RefreshRollups(ctx, now)
+}
+
+// trendSeries returns the trend of r, in the timezone r.From is in, with
+// every bucket filled in.
+func (s *reportService) trendSeries(ctx context.Context, orgID uuid.UUID, r service.ReportRange, interval string) (*service.CostTrendSeries, error) {
+	series := &service.CostTrendSeries{From: r.From, To: r.To, Buckets: []service.CostTrendBucket{}}
+	if !r.From.Before(r.To) {
+		return series, nil
+	}
+
+	loc := r.From.Location()
+	for start := repository.TruncateInterval(r.From, interval, loc); start.Before(r.To); start = repository.NextInterval(start, interval) {
+		if len(series.Buckets) == service.MaxTrendBuckets {
+			return nil, fmt.Errorf("invalid range: more than %d %s buckets; use a longer interval", service.MaxTrendBuckets, interval)
+		}
+		series.Buckets = append(series.Buckets, service.CostTrendBucket{Start: start})
+	}
+
+	buckets, err := s.reportRepo.Trend(ctx, orgID, r.From, r.To, interval, loc)
+	if err != nil {
+		return nil, err
+	}
+	var seconds int64
+	for _, b := range buckets {
+		seconds += b.Seconds
+		series.TotalCost += b.Cost
+		i := slices.IndexFunc(series.Buckets, func(sb service.CostTrendBucket) bool { return sb.Start.Equal(b.Start) })
+		if i < 0 {
+			continue
+		}
+		series.Buckets[i].Cost = roundCents(b.Cost)
+		series.Buckets[i].Hours = roundCents(float64(b.Seconds) / 3600)
+	}
+	series.TotalCost = roundCents(series.TotalCost)
+	series.TotalHours = roundCents(float64(seconds) / 3600)
+	return series, nil
+}
+
+// benchmark compares meetings, costing cost over seconds of meeting time
+// and attendeeSeconds of their attendees' time, with the organization's
+// targets. It is nil when the organization has none.
+func benchmark(org *models.Organization, cost float64, seconds, attendeeSeconds, meetings int64) *service.BenchmarkDTO {
+	if org.TargetAttendeeHourCost == nil && org.TargetMeetingMinutes == nil {
+		return nil
+	}
+	b := &service.BenchmarkDTO{
+		TargetAttendeeHourCost: org.TargetAttendeeHourCost,
+		TargetMeetingMinutes:   org.TargetMeetingMinutes,
+	}
+
+	var scores []float64
+	if attendeeSeconds > 0 {
+		b.CostPerAttendeeHour = roundCents(cost / (float64(attendeeSeconds) / 3600))
+		if org.TargetAttendeeHourCost != nil {
+			status, score := againstTarget(b.CostPerAttendeeHour, *org.TargetAttendeeHourCost)
+			b.CostStatus = status
+			scores = append(scores, score)
+		}
+	}
+	if meetings > 0 {
+		b.AverageMinutes = roundCents(float64(seconds) / 60 / float64(meetings))
+		if org.TargetMeetingMinutes != nil {
+			status, score := againstTarget(b.AverageMinutes, float64(*org.TargetMeetingMinutes))
+			b.LengthStatus = status
+			scores = append(scores, score)
+		}
+	}
+
+	if len(scores) > 0 {
+		var total float64
+		for _, score := range scores {
+			total += score
+		}
+		health := int(math.Round(total / float64(len(scores))))
+		b.HealthScore = &health
+	}
+	return b
+}
+
+// againstTarget returns whether actual is above or below target, and its
+// score out of 100: full at or below the target, falling in proportion
+// above it.
+func againstTarget(actual, target float64) (string, float64) {
+	if actual <= target {
+		return service.BenchmarkBelow, 100
+	}
+	return service.BenchmarkAbove, 100 * target / actual
+}
+
+// roundCents rounds v to two decimal places.
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,478 @@
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/export"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type reportExportService struct {
+	exportRepo    repository.ReportExportRepository
+	orgRepo       repository.OrganizationRepository
+	profileRepo   repository.PersonOrganizationProfileRepository
+	reportService service.ReportService
+	queue         *queue.Client
+	ttl           time.Duration
+	publicURL     string
+	secret        []byte
+	logger        logger.Logger
+}
+
+// NewReportExportService creates a new ReportExportService. Download links
+// point at publicURL, are signed with secret and work for ttl.
+func NewReportExportService(
+	exportRepo repository.ReportExportRepository,
+	orgRepo repository.OrganizationRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	reportService service.ReportService,
+	queue *queue.Client,
+	ttl time.Duration,
+	publicURL string,
+	secret string,
+	logger logger.Logger,
+) service.ReportExportService {
+	return &reportExportService{
+		exportRepo:    exportRepo,
+		orgRepo:       orgRepo,
+		profileRepo:   profileRepo,
+		reportService: reportService,
+		queue:         queue,
+		ttl:           ttl,
+		publicURL:     strings.TrimSuffix(publicURL, "/"),
+		secret:        []byte(secret),
+		logger:        logger,
+	}
+}
+
+func (s *reportExportService) RequestExport(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.ReportExportRequest) (*service.ReportExportDTO, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+	if !slices.Contains(service.ExportReports, req.Report) {
+		return nil, fmt.Errorf("invalid report: must be summary, trends, top-meetings or effectiveness")
+	}
+	if !slices.Contains(export.Formats, req.Format) {
+		return nil, fmt.Errorf("invalid format: must be pdf or xlsx")
+	}
+
+	loc, err := s.reportService.Location(ctx, orgID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	r, err := req.ReportRange.In(loc).Resolve(now)
+	if err != nil {
+		return nil, err
+	}
+	exp := &models.ReportExport{
+		OrganizationID: orgID,
+		RequestedByID:  requesterID,
+		Report:         req.Report,
+		Format:         req.Format,
+		PeriodStart:    r.From,
+		PeriodEnd:      r.To,
+		Status:         models.ReportExportPending,
+		ExpiresAt:      now.Add(s.ttl),
+	}
+	switch req.Report {
+	case service.ReportTrends:
+		if req.Interval == "" {
+			req.Interval = "day"
+		}
+		if !slices.Contains(service.TrendIntervals, req.Interval) {
+			return nil, fmt.Errorf("invalid interval: must be day, week or month")
+		}
+		exp.Interval = req.Interval
+		exp.Compare = req.Compare
+	case service.ReportTopMeetings, service.ReportEffectiveness:
+		exp.Limit = req.Limit
+	}
+
+	if err := s.exportRepo.Create(ctx, exp); err != nil {
+		return nil, err
+	}
+	if _, err := s.queue.Enqueue(ctx, service.TaskGenerateReportExport, service.GenerateReportExportPayload{
+		ExportID: exp.ID,
+	}); err != nil {
+		return nil, fmt.Errorf("queueing report export: %w", err)
+	}
+	return s.toDTO(exp), nil
+}
+
+func (s *reportExportService) GetExport(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, exportID uuid.UUID) (*service.ReportExportDTO, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+	exp, err := s.exportRepo.GetByID(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if exp.OrganizationID != orgID {
+		return nil, fmt.Errorf("report export not found")
+	}
+	return s.toDTO(exp), nil
+}
+
+func (s *reportExportService) GenerateExport(ctx context.Context, exportID uuid.UUID) error {
+	exp, err := s.exportRepo.GetByID(ctx, exportID)
+	if err != nil {
+		return err
+	}
+	// Tasks are delivered at least once; don't render an export twice
+	if exp.Status == models.ReportExportReady {
+		return nil
+	}
+
+	data, genErr := s.render(ctx, exp)
+
+	exp.Attempts++
+	if genErr != nil {
+		exp.Status = models.ReportExportFailed
+		exp.Error = genErr.Error()
+		s.logger.Warn("report export failed", "export_id", exp.ID, "report", exp.Report, "format", exp.Format, "attempts", exp.Attempts, "error", genErr)
+	} else {
+		now := time.Now()
+		exp.Status = models.ReportExportReady
+		exp.Error = ""
+		exp.FileName = fmt.Sprintf("meeting-cost-%s-%s.%s", exp.Report, exp.PeriodEnd.UTC().Format(time.DateOnly), exp.Format)
+		exp.ContentType = export.ContentTypes[exp.Format]
+		exp.Data = data
+		exp.CompletedAt = &now
+		s.logger.Info("report export ready", "export_id", exp.ID, "report", exp.Report, "format", exp.Format, "bytes", len(data))
+	}
+
+	if err := s.exportRepo.Update(ctx, exp); err != nil {
+		return err
+	}
+	return genErr
+}
+
+func (s *reportExportService) Download(ctx context.Context, token string) (*service.ReportFile, error) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.sign(payload))) {
+		return nil, fmt.Errorf("report export not found")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("report export not found")
+	}
+	id, err := uuid.ParseBytes(raw)
+	if err != nil {
+		return nil, fmt.Errorf("report export not found")
+	}
+
+	exp, err := s.exportRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if exp.Status != models.ReportExportReady || !exp.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("report export not found")
+	}
+	return &service.ReportFile{
+		Name:        exp.FileName,
+		ContentType: exp.ContentType,
+		Data:        exp.Data,
+	}, nil
+}
+
+func (s *reportExportService) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+	n, err := s.exportRepo.DeleteExpired(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		s.logger.Info("purged expired report exports", "count", n)
+	}
+	return n, nil
+}
+
+// render runs the export's report as the member who requested it and
+// renders it in the export's format.
+func (s *reportExportService) render(ctx context.Context, exp *models.ReportExport) ([]byte, error) {
+	org, err := s.orgRepo.GetByID(ctx, exp.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := service.ReportRange{From: exp.PeriodStart, To: exp.PeriodEnd}
+	var doc *export.Document
+	switch exp.Report {
+	case service.ReportSummary:
+		res, err := s.reportService.GetSummary(ctx, exp.OrganizationID, exp.RequestedByID, r)
+		if err != nil {
+			return nil, err
+		}
+		doc = summaryDocument(res)
+	case service.ReportTrends:
+		res, err := s.reportService.GetTrend(ctx, exp.OrganizationID, exp.RequestedByID, service.TrendRequest{
+			ReportRange: r,
+			Interval:    exp.Interval,
+			Compare:     exp.Compare,
+		})
+		if err != nil {
+			return nil, err
+		}
+		doc = trendDocument(res)
+	case service.ReportTopMeetings:
+		res, err := s.reportService.GetTopMeetings(ctx, exp.OrganizationID, exp.RequestedByID, service.TopMeetingsRequest{
+			ReportRange: r,
+			Limit:       exp.Limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		doc = topMeetingsDocument(res)
+	case service.ReportEffectiveness:
+		res, err := s.reportService.GetEffectiveness(ctx, exp.OrganizationID, exp.RequestedByID, service.TopMeetingsRequest{
+			ReportRange: r,
+			Limit:       exp.Limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		doc = effectivenessDocument(res)
+	default:
+		return nil, fmt.Errorf("invalid report %q", exp.Report)
+	}
+
+	doc.Title = org.Name + ": " + doc.Title
+	return export.Render(doc, exp.Format)
+}
+
+func summaryDocument(res *service.CostSummaryDTO) *export.Document {
+	summary := export.Table{
+		Title:   "Summary",
+		Columns: []string{"Measure", "Value"},
+		Rows: [][]any{
+			{"Meetings", res.MeetingCount},
+			{"Total cost", res.TotalCost},
+			{"Total hours", res.TotalHours},
+			{"Average cost per meeting", res.AvgCostPerMeeting},
+			{"Average attendees", res.AvgAttendees},
+		},
+	}
+	if b := res.Benchmark; b != nil {
+		summary.Rows = append(summary.Rows,
+			[]any{"Cost per attendee-hour", b.CostPerAttendeeHour},
+			[]any{"Average meeting minutes", b.AverageMinutes},
+		)
+		if b.TargetAttendeeHourCost != nil {
+			summary.Rows = append(summary.Rows, []any{"Target cost per attendee-hour", *b.TargetAttendeeHourCost})
+		}
+		if b.TargetMeetingMinutes != nil {
+			summary.Rows = append(summary.Rows, []any{"Target meeting minutes", *b.TargetMeetingMinutes})
+		}
+		if b.HealthScore != nil {
+			summary.Rows = append(summary.Rows, []any{"Meeting health score", *b.HealthScore})
+		}
+	}
+	if l := res.LateStarts; l != nil {
+		summary.Rows = append(summary.Rows,
+			[]any{"Scheduled meetings", l.ScheduledMeetings},
+			[]any{"Late starts", l.LateMeetings},
+			[]any{"Minutes lost to late starts", l.MinutesLate},
+			[]any{"Cost of late starts", l.Cost},
+		)
+	}
+	if o := res.Overruns; o != nil {
+		summary.Rows = append(summary.Rows,
+			[]any{"Meetings with a scheduled end", o.ScheduledMeetings},
+			[]any{"Overruns", o.OverrunMeetings},
+			[]any{"Minutes over schedule", o.MinutesOver},
+			[]any{"Cost of overruns", o.Cost},
+		)
+	}
+	if w := res.Wages; w != nil {
+		summary.Rows = append(summary.Rows,
+			[]any{"Members with a wage", w.Members},
+			[]any{"Average hourly wage", w.AverageHourlyWage},
+		)
+	}
+	return &export.Document{
+		Title:    "Meeting cost summary",
+		Subtitle: period(res.From, res.To),
+		Tables:   []export.Table{summary},
+	}
+}
+
+func trendDocument(res *service.CostTrendDTO) *export.Document {
+	doc := &export.Document{
+		Title:    "Meeting cost by " + res.Interval,
+		Subtitle: period(res.Current.From, res.Current.To),
+	}
+	if res.CostChangePercent != nil {
+		doc.Subtitle += fmt.Sprintf("; cost %+.1f%% on the previous period", *res.CostChangePercent)
+	}
+
+	series := []*service.CostTrendSeries{&res.Current}
+	names := []string{"Current period"}
+	if res.Previous != nil {
+		series = append(series, res.Previous)
+		names = append(names, "Previous period")
+	}
+	totals := export.Table{Title: "Totals", Columns: []string{"Period", "From", "To", "Cost", "Hours"}}
+	for i, s := range series {
+		totals.Rows = append(totals.Rows, []any{names[i], s.From, s.To, s.TotalCost, s.TotalHours})
+	}
+	doc.Tables = append(doc.Tables, totals)
+
+	for i, s := range series {
+		t := export.Table{Title: names[i], Columns: []string{zoned("Start", res.Current.From), "Cost", "Hours"}}
+		for _, b := range s.Buckets {
+			t.Rows = append(t.Rows, []any{b.Start, b.Cost, b.Hours})
+		}
+		doc.Tables = append(doc.Tables, t)
+	}
+	return doc
+}
+
+func topMeetingsDocument(res *service.TopMeetingsDTO) *export.Document {
+	meetings := export.Table{
+		Title:   "Most expensive meetings",
+		Columns: []string{"Purpose", zoned("Started", res.From), "Hours", "Attendees", "Cost"},
+	}
+	// Organizers and benchmarks are there for every meeting or none
+	organized := slices.ContainsFunc(res.Meetings, func(m service.TopMeetingDTO) bool { return m.Organizer != nil })
+	if organized {
+		meetings.Columns = append(meetings.Columns, "Organizer")
+	}
+	benchmarked := slices.ContainsFunc(res.Meetings, func(m service.TopMeetingDTO) bool { return m.Benchmark != nil })
+	if benchmarked {
+		meetings.Columns = append(meetings.Columns, "Health score")
+	}
+	for _, m := range res.Meetings {
+		row := []any{m.Purpose, m.StartedAt, hours(m.DurationSeconds), m.Attendees, m.TotalCost}
+		if organized {
+			row = append(row, strings.TrimSpace(m.Organizer.FirstName+" "+m.Organizer.LastName))
+		}
+		if benchmarked {
+			row = append(row, healthScore(m.Benchmark))
+		}
+		meetings.Rows = append(meetings.Rows, row)
+	}
+
+	series := export.Table{
+		Title:   "Most expensive recurring meetings",
+		Columns: []string{"Purpose", "Meetings", "Hours", "Average attendees", "Cost", zoned("Last started", res.From)},
+	}
+	if benchmarked {
+		series.Columns = append(series.Columns, "Health score")
+	}
+	for _, sc := range res.Series {
+		row := []any{sc.Purpose, sc.MeetingCount, hours(sc.DurationSeconds), sc.AvgAttendees, sc.TotalCost, sc.LastStartedAt}
+		if benchmarked {
+			row = append(row, healthScore(sc.Benchmark))
+		}
+		series.Rows = append(series.Rows, row)
+	}
+
+	return &export.Document{
+		Title:    "Most expensive meetings",
+		Subtitle: period(res.From, res.To),
+		Tables:   []export.Table{meetings, series},
+	}
+}
+
+func effectivenessDocument(res *service.EffectivenessDTO) *export.Document {
+	summary := export.Table{
+		Title:   "Summary",
+		Columns: []string{"Measure", "Value"},
+		Rows: [][]any{
+			{"Rated meetings", res.RatedMeetings},
+			{"Responses", res.Responses},
+			{"Cost of rated meetings", res.RatedCost},
+			{"Poorly rated meetings", res.PoorlyRatedMeetings},
+			{"Cost of poorly rated meetings", res.PoorlyRatedCost},
+		},
+	}
+	if res.AvgRating != nil {
+		summary.Rows = append(summary.Rows, []any{"Average rating", *res.AvgRating})
+	}
+	if res.PoorlyRatedPercent != nil {
+		summary.Rows = append(summary.Rows, []any{"Share of cost poorly rated (%)", *res.PoorlyRatedPercent})
+	}
+
+	meetings := export.Table{
+		Title:   "Most expensive rated meetings",
+		Columns: []string{"Purpose", zoned("Started", res.From), "Hours", "Attendees", "Cost", "Responses", "Average rating"},
+	}
+	for _, m := range res.Meetings {
+		meetings.Rows = append(meetings.Rows, []any{m.Purpose, m.StartedAt, hours(m.DurationSeconds), m.Attendees, m.TotalCost, m.Responses, m.AvgRating})
+	}
+
+	return &export.Document{
+		Title:    "Meeting effectiveness",
+		Subtitle: period(res.From, res.To),
+		Tables:   []export.Table{summary, meetings},
+	}
+}
+
+// period describes a report's range, in the report's timezone, for a
+// document subtitle.
+func period(from, to time.Time) string {
+	const layout = "Jan 2, 2006 15:04"
+	return fmt.Sprintf("Meetings started %s to %s %s", from.Format(layout), to.In(from.Location()).Format(layout), from.Location())
+}
+
+// zoned names a column of times in the timezone t is in.
+func zoned(column string, t time.Time) string {
+	return column + " (" + t.Location().String() + ")"
+}
+
+func hours(seconds int64) float64 {
+	return roundCents(float64(seconds) / 3600)
+}
+
+// healthScore is b's health score for a table cell, empty without one.
+func healthScore(b *service.BenchmarkDTO) any {
+	if b == nil || b.HealthScore == nil {
+		return ""
+	}
+	return *b.HealthScore
+}
+
+func (s *reportExportService) toDTO(exp *models.ReportExport) *service.ReportExportDTO {
+	dto := &service.ReportExportDTO{
+		ID:          exp.ID,
+		Report:      exp.Report,
+		Format:      exp.Format,
+		From:        exp.PeriodStart,
+		To:          exp.PeriodEnd,
+		Interval:    exp.Interval,
+		Compare:     exp.Compare,
+		Limit:       exp.Limit,
+		Status:      exp.Status,
+		Error:       exp.Error,
+		FileName:    exp.FileName,
+		CreatedAt:   exp.CreatedAt,
+		CompletedAt: exp.CompletedAt,
+		ExpiresAt:   exp.ExpiresAt,
+	}
+	if exp.Status == models.ReportExportReady && exp.ExpiresAt.After(time.Now()) {
+		payload := base64.RawURLEncoding.EncodeToString([]byte(exp.ID.String()))
+		dto.DownloadURL = s.publicURL + "/api/v2/reports/exports/download?token=" + payload + "." + s.sign(payload)
+	}
+	return dto
+}
+
+// sign returns the download token signature of payload.
+func (s *reportExportService) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte("report_export:" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,912 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/billing"
+	apperrors "github.com/yourorg/meeting-cost/backend/go/internal/errors"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type subscriptionService struct {
+	subscriptionRepo    repository.SubscriptionRepository
+	orgRepo             repository.OrganizationRepository
+	profileRepo         repository.PersonOrganizationProfileRepository
+	permissionRepo      repository.PermissionRepository
+	personRepo          repository.PersonRepository
+	auditLogService     service.AuditLogService
+	notificationService service.NotificationService
+	stripe              *billing.Stripe
+	prices              map[string]string
+	meteredPrices       []string
+	seatPlans           []string
+	trial               service.TrialPolicy
+	publicURL           string
+	logger              logger.Logger
+}
+
+// NewSubscriptionService creates a new SubscriptionService. stripe may be
+// nil, in which case plans change without payment; prices maps paid plan
+// types to Stripe price IDs, and meteredPrices are added to every new
+// subscription for usage billing. seatPlans are billed per seat. New
+// organizations trial a plan as trial describes. Checkout and the portal return to publicURL unless the client
+// asks otherwise.
+func NewSubscriptionService(
+	subscriptionRepo repository.SubscriptionRepository,
+	orgRepo repository.OrganizationRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	permissionRepo repository.PermissionRepository,
+	personRepo repository.PersonRepository,
+	auditLogService service.AuditLogService,
+	notificationService service.NotificationService,
+	stripe *billing.Stripe,
+	prices map[string]string,
+	meteredPrices []string,
+	seatPlans []string,
+	trial service.TrialPolicy,
+	publicURL string,
+	logger logger.Logger,
+) service.SubscriptionService {
+	return &subscriptionService{
+		subscriptionRepo:    subscriptionRepo,
+		orgRepo:             orgRepo,
+		profileRepo:         profileRepo,
+		permissionRepo:      permissionRepo,
+		personRepo:          personRepo,
+		auditLogService:     auditLogService,
+		notificationService: notificationService,
+		stripe:              stripe,
+		prices:              prices,
+		meteredPrices:       meteredPrices,
+		seatPlans:           seatPlans,
+		trial:               trial,
+		publicURL:           publicURL,
+		logger:              logger,
+	}
+}
+
+// authorize checks that requester may manage the organization's billing.
+func (s *subscriptionService) authorize(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil {
+		return err
+	}
+	if !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+	return nil
+}
+
+func (s *subscriptionService) GetSubscription(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) (*service.SubscriptionDTO, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return &service.SubscriptionDTO{
+			OrganizationID: orgID,
+			PlanType:       models.PlanFree,
+			Status:         models.SubscriptionActive,
+			Entitlements:   service.PlanEntitlements[models.PlanFree],
+		}, nil
+	}
+	return toSubscriptionDTO(sub), nil
+}
+
+func (s *subscriptionService) ChangePlan(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.ChangePlanRequest) (*service.SubscriptionDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(service.PlanTypes, req.PlanType) {
+		return nil, fmt.Errorf("invalid plan_type: %q", req.PlanType)
+	}
+
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := models.PlanFree
+	if sub != nil {
+		from = sub.PlanType
+	}
+	if s.stripe != nil {
+		sub, err = s.changeStripePlan(ctx, orgID, sub, req.PlanType)
+	} else {
+		sub, err = s.changeLocalPlan(ctx, orgID, sub, req.PlanType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "change_plan",
+		ResourceType:   "subscription",
+		ResourceID:     sub.ID,
+		Details:        map[string]interface{}{"from": from, "to": req.PlanType},
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	return toSubscriptionDTO(sub), nil
+}
+
+// changeLocalPlan changes plan without payment, when Stripe is not
+// configured.
+func (s *subscriptionService) changeLocalPlan(ctx context.Context, orgID uuid.UUID, sub *models.Subscription, plan string) (*models.Subscription, error) {
+	seats, err := s.seatsFor(ctx, orgID, sub, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if sub == nil {
+		sub = &models.Subscription{
+			OrganizationID:     orgID,
+			PlanType:           plan,
+			Status:             models.SubscriptionActive,
+			Seats:              seats,
+			CurrentPeriodStart: now,
+			CurrentPeriodEnd:   now.AddDate(0, 1, 0),
+		}
+		if err := s.subscriptionRepo.Create(ctx, sub); err != nil {
+			return nil, err
+		}
+		return sub, nil
+	}
+
+	sub.PlanType = plan
+	sub.Seats = seats
+	// Changing plan undoes a pending cancellation; a lapsed subscription
+	// starts a new period
+	sub.Status = models.SubscriptionActive
+	if !sub.CurrentPeriodEnd.After(now) {
+		sub.CurrentPeriodStart = now
+		sub.CurrentPeriodEnd = now.AddDate(0, 1, 0)
+	}
+	if err := s.subscriptionRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// changeStripePlan moves a live Stripe subscription to another price, or
+// cancels it for the free plan, and applies the result without waiting for
+// the webhook.
+func (s *subscriptionService) changeStripePlan(ctx context.Context, orgID uuid.UUID, sub *models.Subscription, plan string) (*models.Subscription, error) {
+	if !hasStripeSubscription(sub) {
+		if plan == models.PlanFree {
+			return nil, fmt.Errorf("invalid request: no paid subscription to change")
+		}
+		return nil, fmt.Errorf("invalid request: subscribe to a paid plan through checkout")
+	}
+
+	var updated *billing.Subscription
+	var err error
+	if plan == models.PlanFree {
+		updated, err = s.stripe.CancelAtPeriodEnd(ctx, sub.StripeSubscriptionID)
+	} else {
+		price := s.prices[plan]
+		if price == "" {
+			return nil, fmt.Errorf("invalid plan_type: %q is not available", plan)
+		}
+		seats, err := s.seatsFor(ctx, orgID, sub, plan)
+		if err != nil {
+			return nil, err
+		}
+		updated, err = s.stripe.ChangePrice(ctx, sub.StripeSubscriptionID, price, max(seats, 1))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.applyStripeSubscription(ctx, orgID, sub, updated)
+}
+
+func (s *subscriptionService) CancelSubscription(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) (*service.SubscriptionDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil || sub.PlanType == models.PlanFree || sub.Status == models.SubscriptionCanceled {
+		return nil, fmt.Errorf("invalid request: no paid subscription to cancel")
+	}
+
+	if s.stripe != nil && sub.StripeSubscriptionID != "" {
+		updated, err := s.stripe.CancelAtPeriodEnd(ctx, sub.StripeSubscriptionID)
+		if err != nil {
+			return nil, err
+		}
+		if sub, err = s.applyStripeSubscription(ctx, orgID, sub, updated); err != nil {
+			return nil, err
+		}
+	} else {
+		sub.Status = models.SubscriptionCanceled
+		if err := s.subscriptionRepo.Update(ctx, sub); err != nil {
+			return nil, err
+		}
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "cancel_subscription",
+		ResourceType:   "subscription",
+		ResourceID:     sub.ID,
+		Details:        map[string]interface{}{"plan_type": sub.PlanType, "ends_at": sub.CurrentPeriodEnd},
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+	})
+
+	return toSubscriptionDTO(sub), nil
+}
+
+func (s *subscriptionService) ListInvoices(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, pagination service.Pagination) ([]*service.InvoiceDTO, int64, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, 0, err
+	}
+
+	invoices, total, err := s.subscriptionRepo.ListInvoices(ctx, orgID, repository.Pagination{
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dtos := make([]*service.InvoiceDTO, len(invoices))
+	for i, inv := range invoices {
+		dtos[i] = &service.InvoiceDTO{
+			ID:               inv.ID,
+			Number:           inv.Number,
+			Status:           inv.Status,
+			Currency:         inv.Currency,
+			AmountDue:        inv.AmountDue,
+			AmountPaid:       inv.AmountPaid,
+			IssuedAt:         inv.IssuedAt,
+			PeriodStart:      inv.PeriodStart,
+			PeriodEnd:        inv.PeriodEnd,
+			PaidAt:           inv.PaidAt,
+			HostedInvoiceURL: inv.HostedInvoiceURL,
+			InvoicePDF:       inv.InvoicePDF,
+		}
+	}
+	return dtos, total, nil
+}
+
+func (s *subscriptionService) ListPayments(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, pagination service.Pagination) ([]*service.PaymentDTO, int64, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, 0, err
+	}
+
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if sub == nil {
+		return []*service.PaymentDTO{}, 0, nil
+	}
+
+	payments, total, err := s.subscriptionRepo.ListPayments(ctx, sub.ID, repository.Pagination{
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dtos := make([]*service.PaymentDTO, len(payments))
+	for i, p := range payments {
+		dtos[i] = &service.PaymentDTO{
+			ID:         p.ID,
+			Amount:     p.Amount,
+			Currency:   p.Currency,
+			Status:     p.Status,
+			PaidAt:     p.PaidAt,
+			ReceiptURL: p.ReceiptURL,
+			CreatedAt:  p.CreatedAt,
+		}
+	}
+	return dtos, total, nil
+}
+
+func (s *subscriptionService) CreateCheckoutSession(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.CheckoutRequest) (*service.BillingSessionDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if s.stripe == nil {
+		return nil, fmt.Errorf("billing not configured")
+	}
+	price := s.prices[req.PlanType]
+	if price == "" {
+		return nil, fmt.Errorf("invalid plan_type: %q is not available", req.PlanType)
+	}
+	successURL, err := s.redirectURL("success_url", req.SuccessURL)
+	if err != nil {
+		return nil, err
+	}
+	cancelURL, err := s.redirectURL("cancel_url", req.CancelURL)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if hasStripeSubscription(sub) {
+		return nil, fmt.Errorf("invalid request: organization already has a subscription; change its plan instead")
+	}
+
+	seats, err := s.seatsFor(ctx, orgID, sub, req.PlanType)
+	if err != nil {
+		return nil, err
+	}
+
+	params := billing.CheckoutParams{
+		PriceID:           price,
+		Quantity:          seats,
+		MeteredPriceIDs:   s.meteredPrices,
+		ClientReferenceID: orgID.String(),
+		Metadata:          map[string]string{"organization_id": orgID.String()},
+		SuccessURL:        successURL,
+		CancelURL:         cancelURL,
+	}
+	// Reuse the customer of a lapsed subscription so its billing history
+	// stays in one place
+	if sub != nil && sub.StripeCustomerID != "" {
+		params.CustomerID = sub.StripeCustomerID
+	} else if person, err := s.personRepo.GetByID(ctx, requesterID); err == nil {
+		params.CustomerEmail = person.Email
+	}
+
+	session, err := s.stripe.CreateCheckoutSession(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &service.BillingSessionDTO{URL: session.URL}, nil
+}
+
+func (s *subscriptionService) CreatePortalSession(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.PortalRequest) (*service.BillingSessionDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if s.stripe == nil {
+		return nil, fmt.Errorf("billing not configured")
+	}
+	returnURL, err := s.redirectURL("return_url", req.ReturnURL)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil || sub.StripeCustomerID == "" {
+		return nil, fmt.Errorf("invalid request: organization has no billing account yet")
+	}
+
+	link, err := s.stripe.CreatePortalSession(ctx, sub.StripeCustomerID, returnURL)
+	if err != nil {
+		return nil, err
+	}
+	return &service.BillingSessionDTO{URL: link}, nil
+}
+
+// redirectURL validates a URL Stripe sends the browser back to, defaulting
+// to the public URL.
+func (s *subscriptionService) redirectURL(field, raw string) (string, error) {
+	if raw == "" {
+		return s.publicURL, nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", fmt.Errorf("invalid %s: must be an absolute http(s) URL", field)
+	}
+	return raw, nil
+}
+
+func (s *subscriptionService) HandleStripeEvent(ctx context.Context, payload []byte, signature string) error {
+	if s.stripe == nil {
+		return fmt.Errorf("billing not configured")
+	}
+	event, err := s.stripe.VerifyEvent(payload, signature, time.Now())
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case billing.EventCheckoutCompleted:
+		var session billing.CheckoutSession
+		if err := json.Unmarshal(event.Data.Object, &session); err != nil {
+			return fmt.Errorf("decoding checkout session: %w", err)
+		}
+		if session.Subscription == "" {
+			return nil
+		}
+		ss, err := s.stripe.GetSubscription(ctx, session.Subscription)
+		if err != nil {
+			return err
+		}
+		return s.syncStripeSubscription(ctx, ss, session.ClientReferenceID)
+
+	case billing.EventSubscriptionCreated, billing.EventSubscriptionUpdated, billing.EventSubscriptionDeleted:
+		var obj billing.Subscription
+		if err := json.Unmarshal(event.Data.Object, &obj); err != nil {
+			return fmt.Errorf("decoding subscription: %w", err)
+		}
+		// Events can arrive out of order, so apply the subscription as it
+		// is now rather than as the event saw it
+		ss, err := s.stripe.GetSubscription(ctx, obj.ID)
+		if err != nil {
+			return err
+		}
+		return s.syncStripeSubscription(ctx, ss, "")
+
+	case billing.EventInvoiceFinalized, billing.EventInvoicePaid, billing.EventInvoicePaymentFailed,
+		billing.EventInvoiceVoided, billing.EventInvoiceUncollectible:
+		var inv billing.Invoice
+		if err := json.Unmarshal(event.Data.Object, &inv); err != nil {
+			return fmt.Errorf("decoding invoice: %w", err)
+		}
+		sub, err := s.invoiceSubscription(ctx, &inv)
+		if err != nil || sub == nil {
+			return err
+		}
+		if err := s.saveInvoice(ctx, sub, &inv); err != nil {
+			return err
+		}
+		if event.Type == billing.EventInvoicePaid || event.Type == billing.EventInvoicePaymentFailed {
+			return s.recordPayment(ctx, sub, &inv, event.Type == billing.EventInvoicePaid)
+		}
+		return nil
+	}
+
+	s.logger.Debug("ignoring stripe event", "event_id", event.ID, "type", event.Type)
+	return nil
+}
+
+// syncStripeSubscription applies a Stripe subscription to the organization
+// it belongs to: the one already linked to it, or else the one named in its
+// metadata or by orgHint.
+func (s *subscriptionService) syncStripeSubscription(ctx context.Context, ss *billing.Subscription, orgHint string) error {
+	sub, err := s.subscriptionRepo.GetByStripeSubscriptionID(ctx, ss.ID)
+	if err != nil {
+		return err
+	}
+
+	var orgID uuid.UUID
+	if sub != nil {
+		orgID = sub.OrganizationID
+	} else {
+		ref := ss.Metadata["organization_id"]
+		if ref == "" {
+			ref = orgHint
+		}
+		if orgID, err = uuid.Parse(ref); err != nil {
+			s.logger.Warn("ignoring stripe subscription without an organization", "stripe_subscription_id", ss.ID)
+			return nil
+		}
+		// An organization keeps one row, relinked when it resubscribes
+		if sub, err = s.subscriptionRepo.GetByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	}
+
+	_, err = s.applyStripeSubscription(ctx, orgID, sub, ss)
+	return err
+}
+
+// applyStripeSubscription copies a Stripe subscription's plan, status and
+// period onto sub, creating it for the organization if nil.
+func (s *subscriptionService) applyStripeSubscription(ctx context.Context, orgID uuid.UUID, sub *models.Subscription, ss *billing.Subscription) (*models.Subscription, error) {
+	if sub == nil {
+		sub = &models.Subscription{OrganizationID: orgID, PlanType: models.PlanFree}
+	}
+
+	if plan := s.planForPrice(ss.PriceID()); plan != "" {
+		sub.PlanType = plan
+	} else {
+		s.logger.Warn("stripe subscription has an unknown price", "stripe_subscription_id", ss.ID, "price", ss.PriceID())
+	}
+	sub.Seats = 0
+	if item := ss.PlanItem(); item != nil && slices.Contains(s.seatPlans, sub.PlanType) {
+		sub.Seats = item.Quantity
+	}
+	sub.Status = stripeStatus(ss)
+	sub.CurrentPeriodStart, sub.CurrentPeriodEnd = ss.Period()
+	if ss.EndedAt > 0 {
+		sub.CurrentPeriodEnd = time.Unix(ss.EndedAt, 0).UTC()
+	}
+	sub.StripeCustomerID = ss.Customer
+	sub.StripeSubscriptionID = ss.ID
+
+	if sub.ID == uuid.Nil {
+		if err := s.subscriptionRepo.Create(ctx, sub); err != nil {
+			return nil, err
+		}
+	} else if err := s.subscriptionRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("subscription synced from stripe", "organization_id", orgID, "plan_type", sub.PlanType, "status", sub.Status)
+	return sub, nil
+}
+
+// invoiceSubscription returns the subscription an invoice bills, syncing
+// it from Stripe if its own events have not arrived yet. It is nil for
+// invoices outside a subscription.
+func (s *subscriptionService) invoiceSubscription(ctx context.Context, inv *billing.Invoice) (*models.Subscription, error) {
+	stripeSubID := inv.SubscriptionID()
+	if stripeSubID == "" {
+		return nil, nil
+	}
+	sub, err := s.subscriptionRepo.GetByStripeSubscriptionID(ctx, stripeSubID)
+	if err != nil || sub != nil {
+		return sub, err
+	}
+
+	ss, err := s.stripe.GetSubscription(ctx, stripeSubID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.syncStripeSubscription(ctx, ss, ""); err != nil {
+		return nil, err
+	}
+	return s.subscriptionRepo.GetByStripeSubscriptionID(ctx, stripeSubID)
+}
+
+// saveInvoice records the invoice as Stripe reports it now.
+func (s *subscriptionService) saveInvoice(ctx context.Context, sub *models.Subscription, inv *billing.Invoice) error {
+	var paidAt *time.Time
+	if inv.StatusTransitions.PaidAt > 0 {
+		t := time.Unix(inv.StatusTransitions.PaidAt, 0).UTC()
+		paidAt = &t
+	}
+	start, end := inv.Period()
+	return s.subscriptionRepo.SaveInvoice(ctx, &models.Invoice{
+		OrganizationID:   sub.OrganizationID,
+		SubscriptionID:   sub.ID,
+		Number:           inv.Number,
+		Status:           inv.Status,
+		Currency:         strings.ToUpper(inv.Currency),
+		AmountDue:        float64(inv.AmountDue) / 100,
+		AmountPaid:       float64(inv.AmountPaid) / 100,
+		IssuedAt:         time.Unix(inv.Created, 0).UTC(),
+		PeriodStart:      start,
+		PeriodEnd:        end,
+		PaidAt:           paidAt,
+		StripeInvoiceID:  inv.ID,
+		HostedInvoiceURL: inv.HostedInvoiceURL,
+		InvoicePDF:       inv.InvoicePDF,
+	})
+}
+
+// recordPayment saves the payment, or failed attempt, for an invoice.
+func (s *subscriptionService) recordPayment(ctx context.Context, sub *models.Subscription, inv *billing.Invoice, paid bool) error {
+	amount := inv.AmountDue
+	status := models.PaymentFailed
+	var paidAt *time.Time
+	if paid {
+		// Nothing was charged, e.g. during a trial
+		if inv.AmountPaid == 0 {
+			return nil
+		}
+		amount = inv.AmountPaid
+		status = models.PaymentSucceeded
+		t := time.Now()
+		if inv.StatusTransitions.PaidAt > 0 {
+			t = time.Unix(inv.StatusTransitions.PaidAt, 0).UTC()
+		}
+		paidAt = &t
+	}
+
+	// Newer API versions no longer put the payment intent on the invoice,
+	// so the invoice ID keys those payments instead
+	paymentID := inv.PaymentIntent
+	if paymentID == "" {
+		paymentID = inv.ID
+	}
+
+	return s.subscriptionRepo.SavePayment(ctx, &models.Payment{
+		SubscriptionID:        sub.ID,
+		Amount:                float64(amount) / 100,
+		Currency:              strings.ToUpper(inv.Currency),
+		Status:                status,
+		PaidAt:                paidAt,
+		StripePaymentIntentID: paymentID,
+		ReceiptURL:            inv.HostedInvoiceURL,
+	})
+}
+
+// planForPrice returns the plan type billed at a Stripe price, or "".
+func (s *subscriptionService) planForPrice(priceID string) string {
+	for plan, price := range s.prices {
+		if price != "" && price == priceID {
+			return plan
+		}
+	}
+	return ""
+}
+
+// hasStripeSubscription reports whether sub is a Stripe subscription that
+// has not lapsed.
+func hasStripeSubscription(sub *models.Subscription) bool {
+	if sub == nil || sub.StripeSubscriptionID == "" {
+		return false
+	}
+	return sub.Status != models.SubscriptionCanceled || sub.CurrentPeriodEnd.After(time.Now())
+}
+
+// stripeStatus maps a Stripe subscription status onto the model's. A
+// subscription set to cancel at period end counts as canceled, as it does
+// when canceled here.
+func stripeStatus(ss *billing.Subscription) string {
+	switch ss.Status {
+	case "active", "trialing":
+		if ss.CancelAtPeriodEnd {
+			return models.SubscriptionCanceled
+		}
+		if ss.Status == "trialing" {
+			return models.SubscriptionTrialing
+		}
+		return models.SubscriptionActive
+	case "canceled", "incomplete_expired":
+		return models.SubscriptionCanceled
+	}
+	// past_due, unpaid, incomplete and paused all await payment
+	return models.SubscriptionPastDue
+}
+
+// seatsFor returns how many seats the organization needs on plan: its
+// active members, or the seats it already pays for if more, and zero for a
+// plan not billed per seat.
+func (s *subscriptionService) seatsFor(ctx context.Context, orgID uuid.UUID, sub *models.Subscription, plan string) (int, error) {
+	if !slices.Contains(s.seatPlans, plan) {
+		return 0, nil
+	}
+	members, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return 0, fmt.Errorf("counting members: %w", err)
+	}
+	seats := max(len(members), 1)
+	if sub != nil && sub.PlanType == plan {
+		seats = max(seats, sub.Seats)
+	}
+	return seats, nil
+}
+
+func (s *subscriptionService) ClaimSeat(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) error {
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	// Trials and lapsed subscriptions have no seats to run out of
+	if sub == nil || sub.Seats == 0 || !slices.Contains(s.seatPlans, service.EffectivePlan(sub, time.Now())) {
+		return nil
+	}
+
+	members, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return fmt.Errorf("counting members: %w", err)
+	}
+	if len(members) < sub.Seats {
+		return nil
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if org.SeatOverage != models.SeatOverageAddSeat {
+		return apperrors.ErrSeatsExhausted(sub.Seats)
+	}
+
+	from := sub.Seats
+	seats := len(members) + 1
+	if s.stripe != nil && sub.StripeSubscriptionID != "" {
+		updated, err := s.stripe.SetQuantity(ctx, sub.StripeSubscriptionID, seats)
+		if err != nil {
+			return err
+		}
+		if sub, err = s.applyStripeSubscription(ctx, orgID, sub, updated); err != nil {
+			return err
+		}
+	} else {
+		sub.Seats = seats
+		if err := s.subscriptionRepo.Update(ctx, sub); err != nil {
+			return err
+		}
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "add_seat",
+		ResourceType:   "subscription",
+		ResourceID:     sub.ID,
+		Details:        map[string]interface{}{"from": from, "to": sub.Seats},
+	})
+	return nil
+}
+
+func (s *subscriptionService) StartTrial(ctx context.Context, orgID uuid.UUID) error {
+	if s.trial.Length <= 0 {
+		return nil
+	}
+	sub, err := s.subscriptionRepo.GetByOrganization(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if sub != nil {
+		return nil
+	}
+
+	now := time.Now()
+	sub = &models.Subscription{
+		OrganizationID:     orgID,
+		PlanType:           s.trial.PlanType,
+		Status:             models.SubscriptionTrialing,
+		CurrentPeriodStart: now,
+		CurrentPeriodEnd:   now.Add(s.trial.Length),
+	}
+	if err := s.subscriptionRepo.Create(ctx, sub); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		OrganizationID: &orgID,
+		Action:         "start_trial",
+		ResourceType:   "subscription",
+		ResourceID:     sub.ID,
+		Details:        map[string]interface{}{"plan_type": sub.PlanType, "ends_at": sub.CurrentPeriodEnd},
+	})
+	return nil
+}
+
+func (s *subscriptionService) CheckTrials(ctx context.Context, now time.Time) (int, error) {
+	// Stripe ends its own trials and reports the outcome by webhook
+	trials, err := s.subscriptionRepo.ListLocalTrials(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// One trial's failure is logged so the rest are still checked
+	changed := 0
+	for _, sub := range trials {
+		switch {
+		case !sub.CurrentPeriodEnd.After(now):
+			if err := s.endTrial(ctx, sub); err != nil {
+				s.logger.Error("failed to end trial", "organization_id", sub.OrganizationID, "error", err)
+				continue
+			}
+		case sub.TrialEndingNotifiedAt == nil && s.trial.RemindBefore > 0 && !sub.CurrentPeriodEnd.After(now.Add(s.trial.RemindBefore)):
+			if err := s.remindTrialEnding(ctx, sub, now); err != nil {
+				s.logger.Error("failed to send trial reminder", "organization_id", sub.OrganizationID, "error", err)
+				continue
+			}
+		default:
+			continue
+		}
+		changed++
+	}
+	return changed, nil
+}
+
+// remindTrialEnding tells the organization's admins, once, that its trial
+// ends soon.
+func (s *subscriptionService) remindTrialEnding(ctx context.Context, sub *models.Subscription, now time.Time) error {
+	org, err := s.orgRepo.GetByID(ctx, sub.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	// Recorded first so a failed notification is not sent again every run
+	sub.TrialEndingNotifiedAt = &now
+	if err := s.subscriptionRepo.Update(ctx, sub); err != nil {
+		return err
+	}
+
+	days := int(math.Ceil(sub.CurrentPeriodEnd.Sub(now).Hours() / 24))
+	return s.notifyAdmins(ctx, sub.OrganizationID, service.NotificationTrialEnding, service.Notification{
+		Title: fmt.Sprintf("%s's %s trial ends in %d day(s)", org.Name, sub.PlanType, days),
+		Body: fmt.Sprintf("The %s trial for %s ends on %s. Subscribe to a paid plan to keep its features; otherwise %s moves to the free plan.",
+			sub.PlanType, org.Name, sub.CurrentPeriodEnd.Format("January 2, 2006"), org.Name),
+	})
+}
+
+// endTrial moves a lapsed trial to the free plan and tells the
+// organization's admins.
+func (s *subscriptionService) endTrial(ctx context.Context, sub *models.Subscription) error {
+	org, err := s.orgRepo.GetByID(ctx, sub.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	trialPlan := sub.PlanType
+	sub.PlanType = models.PlanFree
+	sub.Status = models.SubscriptionActive
+	if err := s.subscriptionRepo.Update(ctx, sub); err != nil {
+		return err
+	}
+
+	orgID := sub.OrganizationID
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		OrganizationID: &orgID,
+		Action:         "end_trial",
+		ResourceType:   "subscription",
+		ResourceID:     sub.ID,
+		Details:        map[string]interface{}{"from": trialPlan, "to": models.PlanFree},
+	})
+	s.logger.Info("trial ended", "organization_id", orgID, "plan_type", trialPlan)
+
+	free := service.PlanEntitlements[models.PlanFree]
+	return s.notifyAdmins(ctx, orgID, service.NotificationTrialEnded, service.Notification{
+		Title: fmt.Sprintf("%s's %s trial has ended", org.Name, trialPlan),
+		Body: fmt.Sprintf("%s is now on the free plan: up to %d members and %d active meeting(s), without integrations. Subscribe to a paid plan to lift these limits.",
+			org.Name, free.MaxMembers, free.MaxActiveMeetings),
+	})
+}
+
+// notifyAdmins sends n to the organization's active members who manage its
+// billing.
+func (s *subscriptionService) notifyAdmins(ctx context.Context, orgID uuid.UUID, event string, n service.Notification) error {
+	members, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return fmt.Errorf("listing members: %w", err)
+	}
+
+	var errs []error
+	for _, m := range members {
+		admin, err := s.permissionRepo.HasPermission(ctx, m.PersonID, orgID, "organization", nil, "update")
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !admin {
+			continue
+		}
+		if err := s.notificationService.Notify(ctx, m.PersonID, event, n); err != nil {
+			errs = append(errs, fmt.Errorf("notifying %s: %w", m.PersonID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func toSubscriptionDTO(sub *models.Subscription) *service.SubscriptionDTO {
+	id := sub.ID
+	start, end := sub.CurrentPeriodStart, sub.CurrentPeriodEnd
+	return &service.SubscriptionDTO{
+		ID:                 &id,
+		OrganizationID:     sub.OrganizationID,
+		PlanType:           sub.PlanType,
+		Status:             sub.Status,
+		CurrentPeriodStart: &start,
+		CurrentPeriodEnd:   &end,
+		Seats:              sub.Seats,
+		Entitlements:       service.PlanEntitlements[service.EffectivePlan(sub, time.Now())],
+	}
+}
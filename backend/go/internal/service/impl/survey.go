@@ -0,0 +1,264 @@
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/cache"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/pubsub"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type surveyService struct {
+	ratingRepo    repository.MeetingRatingRepository
+	meetingRepo   repository.MeetingRepository
+	orgRepo       repository.OrganizationRepository
+	profileRepo   repository.PersonOrganizationProfileRepository
+	notifyService service.NotificationService
+	queue         *queue.Client
+	pubsub        pubsub.PubSub
+	publicURL     string
+	secret        []byte
+	logger        logger.Logger
+}
+
+// NewSurveyService creates a new SurveyService. Survey links point at
+// publicURL and are signed with secret.
+func NewSurveyService(
+	ratingRepo repository.MeetingRatingRepository,
+	meetingRepo repository.MeetingRepository,
+	orgRepo repository.OrganizationRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	notifyService service.NotificationService,
+	queue *queue.Client,
+	ps pubsub.PubSub,
+	publicURL string,
+	secret string,
+	logger logger.Logger,
+) service.SurveyService {
+	return &surveyService{
+		ratingRepo:    ratingRepo,
+		meetingRepo:   meetingRepo,
+		orgRepo:       orgRepo,
+		profileRepo:   profileRepo,
+		notifyService: notifyService,
+		queue:         queue,
+		pubsub:        ps,
+		publicURL:     strings.TrimSuffix(publicURL, "/"),
+		secret:        []byte(secret),
+		logger:        logger,
+	}
+}
+
+func (s *surveyService) OpenSurvey(ctx context.Context, meetingID uuid.UUID) error {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return err
+	}
+	org, err := s.orgRepo.GetByID(ctx, meeting.OrganizationID)
+	if err != nil {
+		return err
+	}
+	closesAt, open := surveyCloses(meeting)
+	if !org.MeetingSurveys || !open {
+		return nil
+	}
+
+	event := service.MeetingEvent{
+		Type:      service.EventMeetingSurvey,
+		MeetingID: meetingID,
+		Payload: service.SurveyPrompt{
+			Question:  service.SurveyQuestion,
+			MinRating: service.MinRating,
+			MaxRating: service.MaxRating,
+			ClosesAt:  closesAt,
+		},
+	}
+	if err := s.pubsub.Publish(ctx, cache.ChannelMeetingEvents(meetingID), event); err != nil {
+		s.logger.Error("failed to broadcast meeting survey", "meeting_id", meetingID, "error", err)
+	}
+
+	if _, err := s.queue.Enqueue(ctx, service.TaskSendSurveys, service.SendSurveysPayload{MeetingID: meetingID}); err != nil {
+		return fmt.Errorf("queueing meeting surveys: %w", err)
+	}
+	return nil
+}
+
+func (s *surveyService) SendSurveys(ctx context.Context, meetingID uuid.UUID) error {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return err
+	}
+	closesAt, open := surveyCloses(meeting)
+	if !open {
+		return nil
+	}
+	participants, err := s.meetingRepo.GetParticipants(ctx, meetingID)
+	if err != nil {
+		return err
+	}
+
+	recipients := []uuid.UUID{meeting.CreatedByID}
+	seen := map[uuid.UUID]bool{meeting.CreatedByID: true}
+	for _, p := range participants {
+		if !seen[p.PersonID] {
+			seen[p.PersonID] = true
+			recipients = append(recipients, p.PersonID)
+		}
+	}
+
+	title := "How was your meeting?"
+	if meeting.Purpose != "" {
+		title = "How was " + meeting.Purpose + "?"
+	}
+	var errs []error
+	for _, personID := range recipients {
+		err := s.notifyService.Notify(ctx, personID, service.NotificationMeetingSurvey, service.Notification{
+			Title: title,
+			Body: fmt.Sprintf("%s Rate it from %d to %d by %s.",
+				service.SurveyQuestion, service.MinRating, service.MaxRating, closesAt.UTC().Format("Jan 2")),
+			URL: s.surveyURL(meetingID, personID),
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *surveyService) GetSurvey(ctx context.Context, token string) (*service.SurveyDTO, error) {
+	meetingID, personID, err := s.parseToken(token)
+	if err != nil {
+		return nil, err
+	}
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, fmt.Errorf("survey not found")
+	}
+	return s.toDTO(ctx, meeting, personID), nil
+}
+
+func (s *surveyService) RespondByLink(ctx context.Context, token string, rating int) (*service.SurveyDTO, error) {
+	meetingID, personID, err := s.parseToken(token)
+	if err != nil {
+		return nil, err
+	}
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, fmt.Errorf("survey not found")
+	}
+	return s.respond(ctx, meeting, personID, rating, models.RatingSourceLink)
+}
+
+func (s *surveyService) Respond(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, rating int) (*service.SurveyDTO, error) {
+	meeting, err := s.meetingRepo.GetByID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, meeting.OrganizationID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+	return s.respond(ctx, meeting, requesterID, rating, models.RatingSourceApp)
+}
+
+// respond records the person's rating while the meeting's survey is open.
+func (s *surveyService) respond(ctx context.Context, meeting *models.Meeting, personID uuid.UUID, rating int, source string) (*service.SurveyDTO, error) {
+	if rating < service.MinRating || rating > service.MaxRating {
+		return nil, fmt.Errorf("invalid rating: must be %d to %d", service.MinRating, service.MaxRating)
+	}
+	org, err := s.orgRepo.GetByID(ctx, meeting.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !org.MeetingSurveys {
+		return nil, fmt.Errorf("invalid survey: the organization does not collect meeting ratings")
+	}
+	if _, open := surveyCloses(meeting); !open {
+		return nil, fmt.Errorf("invalid survey: the meeting's survey is not open")
+	}
+
+	if err := s.ratingRepo.Save(ctx, &models.MeetingRating{
+		MeetingID: meeting.ID,
+		PersonID:  personID,
+		Rating:    rating,
+		Source:    source,
+	}); err != nil {
+		return nil, err
+	}
+	return s.toDTO(ctx, meeting, personID), nil
+}
+
+// surveyCloses returns when the meeting's survey stops taking answers, and
+// whether it is taking them now: from when the meeting stops until
+// SurveyWindow later.
+func surveyCloses(meeting *models.Meeting) (time.Time, bool) {
+	if meeting.IsActive || meeting.StoppedAt == nil {
+		return time.Time{}, false
+	}
+	closesAt := meeting.StoppedAt.Add(service.SurveyWindow)
+	return closesAt, time.Now().Before(closesAt)
+}
+
+func (s *surveyService) toDTO(ctx context.Context, meeting *models.Meeting, personID uuid.UUID) *service.SurveyDTO {
+	dto := &service.SurveyDTO{
+		MeetingID: meeting.ID,
+		Purpose:   meeting.Purpose,
+		Question:  service.SurveyQuestion,
+		MinRating: service.MinRating,
+		MaxRating: service.MaxRating,
+	}
+	if meeting.StartedAt != nil {
+		dto.StartedAt = *meeting.StartedAt
+	}
+	dto.ClosesAt, _ = surveyCloses(meeting)
+	if rating, err := s.ratingRepo.Get(ctx, meeting.ID, personID); err == nil {
+		dto.Rating = &rating.Rating
+	}
+	return dto
+}
+
+// surveyURL returns the link that answers the meeting's survey as the
+// person while it is open.
+func (s *surveyService) surveyURL(meetingID, personID uuid.UUID) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(meetingID.String() + ":" + personID.String()))
+	return s.publicURL + "/api/v2/surveys?token=" + payload + "." + s.sign(payload)
+}
+
+// parseToken returns the meeting and person of a survey link's token.
+func (s *surveyService) parseToken(token string) (uuid.UUID, uuid.UUID, error) {
+	invalid := fmt.Errorf("survey not found")
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.sign(payload))) {
+		return uuid.Nil, uuid.Nil, invalid
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, invalid
+	}
+	meeting, person, _ := strings.Cut(string(raw), ":")
+	meetingID, err1 := uuid.Parse(meeting)
+	personID, err2 := uuid.Parse(person)
+	if err1 != nil || err2 != nil {
+		return uuid.Nil, uuid.Nil, invalid
+	}
+	return meetingID, personID, nil
+}
+
+// sign returns the survey token signature of payload.
+func (s *surveyService) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte("survey:" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
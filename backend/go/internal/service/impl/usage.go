@@ -0,0 +1,166 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/billing"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type usageService struct {
+	usageRepo        repository.UsageRepository
+	subscriptionRepo repository.SubscriptionRepository
+	profileRepo      repository.PersonOrganizationProfileRepository
+	stripe           *billing.Stripe
+	minutesMeter     string
+	membersMeter     string
+	logger           logger.Logger
+}
+
+// NewUsageService creates a new UsageService. Usage is reported to the
+// Stripe billing meters named minutesMeter and membersMeter; stripe may be
+// nil and either name empty to leave that usage unreported.
+func NewUsageService(
+	usageRepo repository.UsageRepository,
+	subscriptionRepo repository.SubscriptionRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	stripe *billing.Stripe,
+	minutesMeter string,
+	membersMeter string,
+	logger logger.Logger,
+) service.UsageService {
+	return &usageService{
+		usageRepo:        usageRepo,
+		subscriptionRepo: subscriptionRepo,
+		profileRepo:      profileRepo,
+		stripe:           stripe,
+		minutesMeter:     minutesMeter,
+		membersMeter:     membersMeter,
+		logger:           logger,
+	}
+}
+
+func (s *usageService) RecordMeetingTime(ctx context.Context, orgID uuid.UUID, seconds int, at time.Time) error {
+	members, err := s.profileRepo.GetByOrganization(ctx, orgID, true)
+	if err != nil {
+		return fmt.Errorf("counting members: %w", err)
+	}
+	return s.usageRepo.AddUsage(ctx, orgID, models.UsagePeriod(at), int64(seconds), len(members))
+}
+
+func (s *usageService) GetUsage(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, months int) ([]*service.UsageDTO, error) {
+	profile, err := s.profileRepo.GetByPersonAndOrg(ctx, requesterID, orgID)
+	if err != nil || !profile.IsActive {
+		return nil, fmt.Errorf("forbidden: not a member of this organization")
+	}
+	if months < 1 {
+		return nil, fmt.Errorf("invalid months: must be at least 1")
+	}
+
+	current := models.UsagePeriod(time.Now())
+	since := current.AddDate(0, 1-months, 0)
+	records, err := s.usageRepo.ListByOrganization(ctx, orgID, since)
+	if err != nil {
+		return nil, err
+	}
+	byPeriod := make(map[string]*models.UsageRecord, len(records))
+	for _, r := range records {
+		byPeriod[r.Period.Format("2006-01")] = r
+	}
+
+	usage := make([]*service.UsageDTO, 0, months)
+	for period := current; !period.Before(since); period = period.AddDate(0, -1, 0) {
+		dto := &service.UsageDTO{Period: period.Format("2006-01")}
+		if r, ok := byPeriod[dto.Period]; ok {
+			dto.MeetingMinutes = r.MeetingSeconds / 60
+			dto.ActiveMembers = r.ActiveMembers
+			dto.ReportedAt = r.ReportedAt
+		}
+		usage = append(usage, dto)
+	}
+	return usage, nil
+}
+
+func (s *usageService) ReportUsage(ctx context.Context) (int, error) {
+	if s.stripe == nil || (s.minutesMeter == "" && s.membersMeter == "") {
+		return 0, nil
+	}
+
+	// Last month's usage is still reported in case it changed after the
+	// previous run
+	now := time.Now()
+	current := models.UsagePeriod(now)
+	records, err := s.usageRepo.ListUnreported(ctx, current.AddDate(0, -1, 0))
+	if err != nil {
+		return 0, err
+	}
+
+	reported := 0
+	for _, r := range records {
+		sub, err := s.subscriptionRepo.GetByOrganization(ctx, r.OrganizationID)
+		if err != nil {
+			return reported, fmt.Errorf("getting subscription: %w", err)
+		}
+		if sub == nil || sub.StripeCustomerID == "" {
+			continue
+		}
+
+		// Usage lands in the month it was recorded
+		at := now
+		if r.Period.Before(current) {
+			at = current.Add(-time.Second)
+		}
+		if err := s.reportRecord(ctx, r, sub.StripeCustomerID, at); err != nil {
+			s.logger.Error("failed to report usage", "organization_id", r.OrganizationID, "period", r.Period, "error", err)
+			continue
+		}
+		reported++
+	}
+
+	if reported > 0 {
+		s.logger.Info("reported usage to stripe", "organizations", reported)
+	}
+	return reported, nil
+}
+
+// reportRecord sends one record's unreported usage. Minutes are summed by
+// the meter, so only the increase is sent; active members are a level, so
+// the meter should keep the last value. Each meter's progress is saved as
+// soon as it is sent, so a failure on the other is not reported twice.
+func (s *usageService) reportRecord(ctx context.Context, r *models.UsageRecord, customerID string, at time.Time) error {
+	// Minutes already sent stay on the meter, so a reversal below them waits
+	// for usage to catch up rather than lowering what was reported
+	minutes := max(r.MeetingSeconds/60, r.ReportedMeetingMinutes)
+	if s.minutesMeter != "" && minutes > r.ReportedMeetingMinutes {
+		if err := s.stripe.ReportMeterEvent(ctx, billing.MeterEvent{
+			EventName:  s.minutesMeter,
+			CustomerID: customerID,
+			Value:      minutes - r.ReportedMeetingMinutes,
+			Identifier: fmt.Sprintf("usage-%s-minutes-%d", r.ID, minutes),
+			Timestamp:  at,
+		}); err != nil {
+			return err
+		}
+		if err := s.usageRepo.MarkReported(ctx, r.ID, minutes, r.ReportedActiveMembers, time.Now()); err != nil {
+			return err
+		}
+	}
+	if s.membersMeter != "" && r.ActiveMembers != r.ReportedActiveMembers {
+		if err := s.stripe.ReportMeterEvent(ctx, billing.MeterEvent{
+			EventName:  s.membersMeter,
+			CustomerID: customerID,
+			Value:      int64(r.ActiveMembers),
+			Identifier: fmt.Sprintf("usage-%s-members-%d-from-%d", r.ID, r.ActiveMembers, r.ReportedActiveMembers),
+			Timestamp:  at,
+		}); err != nil {
+			return err
+		}
+	}
+	return s.usageRepo.MarkReported(ctx, r.ID, minutes, r.ActiveMembers, time.Now())
+}
@@ -0,0 +1,96 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/encryption"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+)
+
+// errAggregateOnly refuses to store a member's wage in an aggregate-only
+// organization.
+var errAggregateOnly = errors.New("invalid wage: the organization is aggregate-only and stores no individual wages; its default_wage is the blended rate")
+
+// wageAccess is what a requester may see of an organization's member
+// wages under its wage visibility.
+type wageAccess struct {
+	Others    bool // Other members' wages
+	Own       bool // The requester's own wage
+	Aggregate bool // Averages over the members
+}
+
+// wageAccessFor returns what requester may see of org's member wages.
+func wageAccessFor(ctx context.Context, permissionRepo repository.PermissionRepository, org *models.Organization, requesterID uuid.UUID) wageAccess {
+	if org.AggregateOnly {
+		// There are no individual wages to see or average
+		return wageAccess{}
+	}
+	switch org.WageVisibility {
+	case models.WageVisibilityAdmins:
+		admin, _ := permissionRepo.HasPermission(ctx, requesterID, org.ID, "organization", nil, "update")
+		return wageAccess{Others: admin, Own: admin, Aggregate: admin}
+	case models.WageVisibilityAggregate:
+		admin, _ := permissionRepo.HasPermission(ctx, requesterID, org.ID, "organization", nil, "update")
+		return wageAccess{Aggregate: admin}
+	default:
+		manager, _ := permissionRepo.HasPermission(ctx, requesterID, org.ID, "organization", nil, "manage_members")
+		return wageAccess{Others: manager, Own: true, Aggregate: manager}
+	}
+}
+
+// visible reports whether the wage of the member personID is visible to
+// requesterID.
+func (a wageAccess) visible(requesterID, personID uuid.UUID) bool {
+	if requesterID == personID {
+		return a.Own
+	}
+	return a.Others
+}
+
+// wageAuditDetails returns the audit log details recording a member's new
+// wage. Hidden wages stay out of the audit log too, and encrypted ones are
+// recorded encrypted.
+func wageAuditDetails(org *models.Organization, wage float64) map[string]interface{} {
+	if org.WageVisibility == models.WageVisibilityAggregate {
+		return nil
+	}
+	if !encryption.WagesEncrypted() {
+		return map[string]interface{}{"wage": wage}
+	}
+	stored, err := encryption.EncryptWage(org.ID, &wage)
+	if err != nil {
+		return nil
+	}
+	return map[string]interface{}{"wage": stored}
+}
+
+// wageBandsByID returns the organization's wage bands by ID.
+func wageBandsByID(ctx context.Context, wageBandRepo repository.WageBandRepository, orgID uuid.UUID) (map[uuid.UUID]*models.WageBand, error) {
+	list, err := wageBandRepo.ListByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	bands := make(map[uuid.UUID]*models.WageBand, len(list))
+	for _, b := range list {
+		bands[b.ID] = b
+	}
+	return bands, nil
+}
+
+// memberWage returns the hourly wage the member counts at in costs: their
+// wage band's midpoint when they have one, else their exact wage. It
+// reports false when the member has neither.
+func memberWage(p *models.PersonOrganizationProfile, bands map[uuid.UUID]*models.WageBand) (float64, bool) {
+	if p.WageBandID != nil {
+		if band, ok := bands[*p.WageBandID]; ok {
+			return band.Midpoint(), true
+		}
+	}
+	if p.HourlyWage != nil {
+		return *p.HourlyWage, true
+	}
+	return 0, false
+}
@@ -0,0 +1,494 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/hris"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+type wageSyncService struct {
+	integrationRepo repository.IntegrationRepository
+	orgRepo         repository.OrganizationRepository
+	profileRepo     repository.PersonOrganizationProfileRepository
+	personRepo      repository.PersonRepository
+	emailRepo       repository.PersonEmailRepository
+	wageBandRepo    repository.WageBandRepository
+	permissionRepo  repository.PermissionRepository
+	auditLogService service.AuditLogService
+	queue           *queue.Client
+	// timeout bounds each read of an HR system
+	timeout time.Duration
+	logger  logger.Logger
+}
+
+// NewWageSyncService creates a new WageSyncService implementation.
+func NewWageSyncService(
+	integrationRepo repository.IntegrationRepository,
+	orgRepo repository.OrganizationRepository,
+	profileRepo repository.PersonOrganizationProfileRepository,
+	personRepo repository.PersonRepository,
+	emailRepo repository.PersonEmailRepository,
+	wageBandRepo repository.WageBandRepository,
+	permissionRepo repository.PermissionRepository,
+	auditLogService service.AuditLogService,
+	queue *queue.Client,
+	timeout time.Duration,
+	logger logger.Logger,
+) service.WageSyncService {
+	return &wageSyncService{
+		integrationRepo: integrationRepo,
+		orgRepo:         orgRepo,
+		profileRepo:     profileRepo,
+		personRepo:      personRepo,
+		emailRepo:       emailRepo,
+		wageBandRepo:    wageBandRepo,
+		permissionRepo:  permissionRepo,
+		auditLogService: auditLogService,
+		queue:           queue,
+		timeout:         timeout,
+		logger:          logger,
+	}
+}
+
+// wageSyncSettings are an HRIS integration's settings for syncing.
+type wageSyncSettings struct {
+	scheduled bool
+	// Field names of the email, wage and band; wage or band may be empty
+	email, wage, band string
+	// hoursPerYear divides yearly wages; zero when wages are hourly
+	hoursPerYear float64
+
+	subdomain, url, username, hostKey string
+}
+
+// defaultHRISFields are the fields read when the settings don't map them.
+var defaultHRISFields = map[string]map[string]string{
+	models.IntegrationBambooHR: {"email": "workEmail", "wage": "payRate"},
+	models.IntegrationWorkday:  {"email": "email", "wage": "wage", "band": "band"},
+	models.IntegrationCSV:      {"email": "email", "wage": "wage", "band": "band"},
+}
+
+// errNotHRIS refuses syncs from integrations that aren't HR systems.
+var errNotHRIS = errors.New("invalid provider: wages sync only from bamboohr, workday or csv")
+
+// authorize checks that requester may both manage the organization's
+// integrations and set its members' wages.
+func (s *wageSyncService) authorize(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	for _, action := range []string{"update", "manage_members"} {
+		hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, action)
+		if err != nil {
+			return err
+		}
+		if !hasPerm {
+			return fmt.Errorf("forbidden")
+		}
+	}
+	return nil
+}
+
+func (s *wageSyncService) PreviewWageSync(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID) (*service.WageSyncDTO, error) {
+	org, integration, err := s.prepare(ctx, orgID, provider, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	return s.sync(ctx, org, integration, &requesterID, true, "", "")
+}
+
+func (s *wageSyncService) SyncWages(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, ipAddress, userAgent string) (*service.WageSyncDTO, error) {
+	org, integration, err := s.prepare(ctx, orgID, provider, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	return s.sync(ctx, org, integration, &requesterID, false, ipAddress, userAgent)
+}
+
+// prepare authorizes a requested sync and loads what it runs on.
+func (s *wageSyncService) prepare(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID) (*models.Organization, *models.Integration, error) {
+	if !slices.Contains(service.HRISIntegrations, provider) {
+		return nil, nil, errNotHRIS
+	}
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, nil, err
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if org.AggregateOnly {
+		return nil, nil, errAggregateOnly
+	}
+	integration, err := s.integrationRepo.GetByProvider(ctx, orgID, provider)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return nil, nil, err
+	}
+	if err != nil || integration.Status != models.IntegrationConnected {
+		return nil, nil, fmt.Errorf("invalid provider: %s is not connected", provider)
+	}
+	return org, integration, nil
+}
+
+func (s *wageSyncService) QueueWageSyncs(ctx context.Context) (int, error) {
+	var errs []error
+	queued := 0
+	for _, provider := range service.HRISIntegrations {
+		integrations, err := s.integrationRepo.ListConnected(ctx, provider)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, integration := range integrations {
+			if settings, err := hrisSettings(integration); err != nil || !settings.scheduled {
+				continue
+			}
+			if _, err := s.queue.Enqueue(ctx, service.TaskSyncWages, service.SyncWagesPayload{
+				OrganizationID: integration.OrganizationID,
+				Provider:       provider,
+			}); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			queued++
+		}
+	}
+	return queued, errors.Join(errs...)
+}
+
+func (s *wageSyncService) RunScheduledWageSync(ctx context.Context, orgID uuid.UUID, provider string) error {
+	integration, err := s.integrationRepo.GetByProvider(ctx, orgID, provider)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return err
+	}
+	if settings, err := hrisSettings(integration); err != nil || !settings.scheduled || integration.Status != models.IntegrationConnected {
+		return nil
+	}
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if org.AggregateOnly {
+		s.logger.Info("wage sync skipped: organization is aggregate-only", "organization_id", orgID, "provider", provider)
+		return nil
+	}
+
+	res, err := s.sync(ctx, org, integration, nil, false, "", "")
+	if err != nil {
+		s.logger.Error("wage sync failed", "organization_id", orgID, "provider", provider, "error", err)
+		return err
+	}
+	s.logger.Info("wages synced", "organization_id", orgID, "provider", provider,
+		"changed", len(res.Changes), "unchanged", res.Unchanged, "skipped", len(res.Skipped))
+	return nil
+}
+
+// sync reads the HR system and works out each member's change, making the
+// changes unless dryRun. requesterID is nil for scheduled syncs.
+func (s *wageSyncService) sync(ctx context.Context, org *models.Organization, integration *models.Integration, requesterID *uuid.UUID, dryRun bool, ipAddress, userAgent string) (*service.WageSyncDTO, error) {
+	settings, err := hrisSettings(integration)
+	if err != nil {
+		return nil, err
+	}
+	source, err := s.source(integration, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []string{settings.email}
+	for _, f := range []string{settings.wage, settings.band} {
+		if f != "" {
+			fields = append(fields, f)
+		}
+	}
+	records, err := source.Records(ctx, fields)
+	if err != nil {
+		return nil, fmt.Errorf("reading the HR system: %w", err)
+	}
+
+	profiles, err := s.profileRepo.GetByOrganization(ctx, org.ID, true)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[uuid.UUID]*models.PersonOrganizationProfile, len(profiles))
+	for _, p := range profiles {
+		members[p.PersonID] = p
+	}
+	bands, err := s.wageBandRepo.ListByOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	bandsByName := make(map[string]*models.WageBand, len(bands))
+	for _, b := range bands {
+		bandsByName[strings.ToLower(b.Name)] = b
+	}
+
+	res := &service.WageSyncDTO{
+		Provider: integration.Provider,
+		DryRun:   dryRun,
+		Records:  len(records),
+		Changes:  []service.WageSyncChange{},
+		Skipped:  []service.WageSyncSkip{},
+		SyncedAt: time.Now(),
+	}
+	skip := func(row int, email, reason string) {
+		res.Skipped = append(res.Skipped, service.WageSyncSkip{Row: row, Email: email, Reason: reason})
+	}
+
+	seen := make(map[uuid.UUID]bool, len(records))
+	var changes []service.WageSyncChange
+	for i, rec := range records {
+		row := i + 1
+		email := strings.TrimSpace(rec[settings.email])
+		if email == "" {
+			skip(row, "", "no email")
+			continue
+		}
+		person, err := matchPersonByEmail(ctx, s.personRepo, s.emailRepo, email)
+		if err != nil && strings.ToLower(email) != email {
+			// HR systems may capitalize addresses people signed up with
+			// in lower case
+			person, err = matchPersonByEmail(ctx, s.personRepo, s.emailRepo, strings.ToLower(email))
+		}
+		if err != nil {
+			skip(row, email, "not a member")
+			continue
+		}
+		profile, ok := members[person.ID]
+		if !ok {
+			skip(row, email, "not a member")
+			continue
+		}
+		if seen[person.ID] {
+			skip(row, email, "member already synced from an earlier record")
+			continue
+		}
+		seen[person.ID] = true
+
+		change := service.WageSyncChange{
+			PersonID:           person.ID,
+			Email:              email,
+			PreviousWage:       profile.HourlyWage,
+			PreviousWageBandID: profile.WageBandID,
+		}
+		wageValue := strings.TrimSpace(rec[settings.wage])
+		bandValue := strings.TrimSpace(rec[settings.band])
+		switch {
+		case settings.wage != "" && wageValue != "":
+			wage, ok := parseWage(wageValue)
+			if !ok {
+				skip(row, email, fmt.Sprintf("invalid wage %q", wageValue))
+				continue
+			}
+			if settings.hoursPerYear > 0 {
+				wage /= settings.hoursPerYear
+			}
+			wage = roundCents(wage)
+			if profile.WageBandID == nil && profile.HourlyWage != nil && roundCents(*profile.HourlyWage) == wage {
+				res.Unchanged++
+				continue
+			}
+			change.Wage = &wage
+		case settings.band != "" && bandValue != "":
+			band, ok := bandsByName[strings.ToLower(bandValue)]
+			if !ok {
+				skip(row, email, fmt.Sprintf("no wage band named %q", bandValue))
+				continue
+			}
+			if profile.WageBandID != nil && *profile.WageBandID == band.ID {
+				res.Unchanged++
+				continue
+			}
+			change.WageBandID = &band.ID
+		default:
+			skip(row, email, "no wage or wage band")
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	if !dryRun {
+		for _, change := range changes {
+			if err := s.apply(ctx, org, integration.Provider, change, requesterID, ipAddress, userAgent); err != nil {
+				return nil, err
+			}
+		}
+		_ = s.auditLogService.Log(ctx, service.LogParams{
+			PersonID:       requesterID,
+			OrganizationID: &org.ID,
+			Action:         "sync_wages",
+			ResourceType:   "integration",
+			ResourceID:     integration.ID,
+			Details: map[string]interface{}{
+				"provider":  integration.Provider,
+				"records":   res.Records,
+				"changed":   len(changes),
+				"unchanged": res.Unchanged,
+				"skipped":   len(res.Skipped),
+			},
+			IPAddress: ipAddress,
+			UserAgent: userAgent,
+		})
+	}
+
+	// Wages the requester couldn't see among the members stay hidden
+	var access wageAccess
+	if requesterID != nil {
+		access = wageAccessFor(ctx, s.permissionRepo, org, *requesterID)
+	}
+	for _, change := range changes {
+		if requesterID == nil || !access.visible(*requesterID, change.PersonID) {
+			change.Wage, change.PreviousWage = nil, nil
+		}
+		res.Changes = append(res.Changes, change)
+	}
+	return res, nil
+}
+
+// apply makes one member's change and records it in the audit log.
+func (s *wageSyncService) apply(ctx context.Context, org *models.Organization, provider string, change service.WageSyncChange, requesterID *uuid.UUID, ipAddress, userAgent string) error {
+	var (
+		action  string
+		details map[string]interface{}
+	)
+	if change.Wage != nil {
+		if err := s.profileRepo.UpdateWage(ctx, change.PersonID, org.ID, *change.Wage); err != nil {
+			return fmt.Errorf("syncing wage of %s: %w", change.Email, err)
+		}
+		action = "sync_member_wage"
+		details = wageAuditDetails(org, *change.Wage)
+		if details == nil {
+			details = map[string]interface{}{}
+		}
+	} else {
+		if err := s.profileRepo.SetWageBand(ctx, change.PersonID, org.ID, change.WageBandID); err != nil {
+			return fmt.Errorf("syncing wage band of %s: %w", change.Email, err)
+		}
+		action = "sync_member_wage_band"
+		details = map[string]interface{}{"wage_band_id": change.WageBandID}
+	}
+	details["provider"] = provider
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       requesterID,
+		OrganizationID: &org.ID,
+		Action:         action,
+		ResourceType:   "person",
+		ResourceID:     change.PersonID,
+		Details:        details,
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+	})
+	return nil
+}
+
+// source returns the HR system the integration reads from.
+func (s *wageSyncService) source(integration *models.Integration, settings wageSyncSettings) (hris.Source, error) {
+	switch integration.Provider {
+	case models.IntegrationBambooHR:
+		if settings.subdomain == "" {
+			return nil, fmt.Errorf("invalid settings: %s is required", service.HRISSettingSubdomain)
+		}
+		return hris.NewBambooHR(settings.subdomain, integration.AccessToken, s.timeout), nil
+	case models.IntegrationWorkday:
+		if !strings.HasPrefix(settings.url, "https://") {
+			return nil, fmt.Errorf("invalid settings: %s must be the report's https:// URL", service.HRISSettingURL)
+		}
+		if settings.username == "" {
+			return nil, fmt.Errorf("invalid settings: %s is required", service.HRISSettingUsername)
+		}
+		return hris.NewWorkday(settings.url, settings.username, integration.AccessToken, s.timeout), nil
+	case models.IntegrationCSV:
+		file, err := hris.NewFile(settings.url, integration.AccessToken, settings.hostKey, s.timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid settings: %w", err)
+		}
+		return file, nil
+	}
+	return nil, errNotHRIS
+}
+
+// hrisSettings reads an HRIS integration's settings, filling in defaults.
+func hrisSettings(integration *models.Integration) (wageSyncSettings, error) {
+	raw := map[string]interface{}{}
+	if len(integration.Settings) > 0 {
+		if err := json.Unmarshal(integration.Settings, &raw); err != nil {
+			return wageSyncSettings{}, fmt.Errorf("invalid settings: %w", err)
+		}
+	}
+	str := func(key string) string {
+		v, _ := raw[key].(string)
+		return strings.TrimSpace(v)
+	}
+
+	settings := wageSyncSettings{
+		subdomain: str(service.HRISSettingSubdomain),
+		url:       str(service.HRISSettingURL),
+		username:  str(service.HRISSettingUsername),
+		hostKey:   str(service.HRISSettingHostKey),
+	}
+	settings.scheduled, _ = raw[service.HRISSettingSchedule].(bool)
+
+	fields := defaultHRISFields[integration.Provider]
+	if mapped, ok := raw[service.HRISSettingFields].(map[string]interface{}); ok {
+		fields = map[string]string{}
+		for _, k := range []string{"email", "wage", "band"} {
+			if v, ok := mapped[k].(string); ok {
+				fields[k] = strings.TrimSpace(v)
+			}
+		}
+	}
+	settings.email, settings.wage, settings.band = fields["email"], fields["wage"], fields["band"]
+	if settings.email == "" {
+		return settings, fmt.Errorf("invalid settings: %s must map email", service.HRISSettingFields)
+	}
+	if settings.wage == "" && settings.band == "" {
+		return settings, fmt.Errorf("invalid settings: %s must map wage or band", service.HRISSettingFields)
+	}
+
+	switch unit := str(service.HRISSettingWageUnit); unit {
+	case "", "hour":
+	case "year":
+		settings.hoursPerYear = service.DefaultHoursPerYear
+		if hours, ok := raw[service.HRISSettingHoursPerYear].(float64); ok {
+			if hours <= 0 {
+				return settings, fmt.Errorf("invalid settings: %s must be positive", service.HRISSettingHoursPerYear)
+			}
+			settings.hoursPerYear = hours
+		}
+	default:
+		return settings, fmt.Errorf("invalid settings: %s must be hour or year, got %q", service.HRISSettingWageUnit, unit)
+	}
+	return settings, nil
+}
+
+// parseWage reads a positive amount such as "45.00 USD", "$85,000" or
+// "85000", taking commas as thousands separators.
+func parseWage(s string) (float64, bool) {
+	s = strings.ReplaceAll(s, ",", "")
+	isNum := func(r rune) bool { return r >= '0' && r <= '9' || r == '.' }
+	start := strings.IndexFunc(s, isNum)
+	if start < 0 {
+		return 0, false
+	}
+	end := strings.IndexFunc(s[start:], func(r rune) bool { return !isNum(r) })
+	if end < 0 {
+		end = len(s) - start
+	}
+	v, err := strconv.ParseFloat(s[start:start+end], 64)
+	return v, err == nil && v > 0
+}
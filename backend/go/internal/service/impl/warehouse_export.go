@@ -0,0 +1,582 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+	"github.com/yourorg/meeting-cost/backend/go/internal/warehouse"
+)
+
+const (
+	// warehouseBatch is how many changed meetings or memberships each
+	// push carries; the watermark advances after each
+	warehouseBatch = 1000
+	// warehouseSettle holds back changes younger than this, so that a
+	// transaction committing after a later one was exported isn't passed
+	// over by the watermark
+	warehouseSettle = time.Minute
+)
+
+// errNotWarehouse refuses exports to integrations that aren't warehouses.
+var errNotWarehouse = errors.New("invalid provider: data is exported only to bigquery, snowflake or s3")
+
+// errExportIncomplete stops a run that used up its time with changes left.
+var errExportIncomplete = errors.New("export incomplete")
+
+type warehouseExportService struct {
+	integrationRepo repository.IntegrationRepository
+	orgRepo         repository.OrganizationRepository
+	exportRepo      repository.WarehouseExportRepository
+	permissionRepo  repository.PermissionRepository
+	auditLogService service.AuditLogService
+	queue           *queue.Client
+	// timeout bounds each run
+	timeout time.Duration
+	logger  logger.Logger
+}
+
+// NewWarehouseExportService creates a new WarehouseExportService implementation.
+func NewWarehouseExportService(
+	integrationRepo repository.IntegrationRepository,
+	orgRepo repository.OrganizationRepository,
+	exportRepo repository.WarehouseExportRepository,
+	permissionRepo repository.PermissionRepository,
+	auditLogService service.AuditLogService,
+	queue *queue.Client,
+	timeout time.Duration,
+	logger logger.Logger,
+) service.WarehouseExportService {
+	return &warehouseExportService{
+		integrationRepo: integrationRepo,
+		orgRepo:         orgRepo,
+		exportRepo:      exportRepo,
+		permissionRepo:  permissionRepo,
+		auditLogService: auditLogService,
+		queue:           queue,
+		timeout:         timeout,
+		logger:          logger,
+	}
+}
+
+// authorize checks that requester may manage the organization's
+// integrations.
+func (s *warehouseExportService) authorize(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil {
+		return err
+	}
+	if !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+	return nil
+}
+
+func (s *warehouseExportService) GetWarehouseExport(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID) (*service.WarehouseExportDTO, error) {
+	if !slices.Contains(service.WarehouseIntegrations, provider) {
+		return nil, errNotWarehouse
+	}
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	export, err := s.export(ctx, orgID, provider)
+	if err != nil {
+		return nil, err
+	}
+	return s.toDTO(ctx, export), nil
+}
+
+func (s *warehouseExportService) RunWarehouseExport(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, ipAddress, userAgent string) (*service.WarehouseExportDTO, error) {
+	integration, err := s.prepare(ctx, orgID, provider, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	export, err := s.export(ctx, orgID, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.queue.Enqueue(ctx, service.TaskExportToWarehouse, service.ExportToWarehousePayload{
+		OrganizationID: orgID,
+		Provider:       provider,
+	}); err != nil {
+		return nil, err
+	}
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "run_warehouse_export",
+		ResourceType:   "integration",
+		ResourceID:     integration.ID,
+		Details:        map[string]interface{}{"provider": provider},
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+	})
+	return s.toDTO(ctx, export), nil
+}
+
+func (s *warehouseExportService) ResetWarehouseExport(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, ipAddress, userAgent string) (*service.WarehouseExportDTO, error) {
+	if !slices.Contains(service.WarehouseIntegrations, provider) {
+		return nil, errNotWarehouse
+	}
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	export, err := s.export(ctx, orgID, provider)
+	if err != nil {
+		return nil, err
+	}
+	if running(export, time.Now()) {
+		return nil, fmt.Errorf("an export is already running; reset once it finishes")
+	}
+
+	export.MeetingsAt, export.MeetingsID = nil, nil
+	export.MembershipsAt, export.MembershipsID = nil, nil
+	export.MeetingRows, export.IncrementRows, export.MembershipRows = 0, 0, 0
+	if err := s.exportRepo.Update(ctx, export); err != nil {
+		return nil, err
+	}
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "reset_warehouse_export",
+		ResourceType:   "warehouse_export",
+		ResourceID:     export.ID,
+		Details:        map[string]interface{}{"provider": provider},
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+	})
+	return s.toDTO(ctx, export), nil
+}
+
+// prepare authorizes a requested run and checks the integration can be
+// exported to.
+func (s *warehouseExportService) prepare(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID) (*models.Integration, error) {
+	if !slices.Contains(service.WarehouseIntegrations, provider) {
+		return nil, errNotWarehouse
+	}
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	integration, err := s.integrationRepo.GetByProvider(ctx, orgID, provider)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return nil, err
+	}
+	if err != nil || integration.Status != models.IntegrationConnected {
+		return nil, fmt.Errorf("invalid provider: %s is not connected", provider)
+	}
+	if _, err := s.destination(integration); err != nil {
+		return nil, err
+	}
+	return integration, nil
+}
+
+// export returns the organization's export to provider, creating it with
+// empty watermarks if it has none yet.
+func (s *warehouseExportService) export(ctx context.Context, orgID uuid.UUID, provider string) (*models.WarehouseExport, error) {
+	export, err := s.exportRepo.GetByProvider(ctx, orgID, provider)
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		return export, err
+	}
+	export = &models.WarehouseExport{OrganizationID: orgID, Provider: provider}
+	if err := s.exportRepo.Create(ctx, export); err != nil {
+		// Created meanwhile by a run
+		if existing, getErr := s.exportRepo.GetByProvider(ctx, orgID, provider); getErr == nil {
+			return existing, nil
+		}
+		return nil, err
+	}
+	return export, nil
+}
+
+func (s *warehouseExportService) QueueWarehouseExports(ctx context.Context) (int, error) {
+	var errs []error
+	queued := 0
+	for _, provider := range service.WarehouseIntegrations {
+		integrations, err := s.integrationRepo.ListConnected(ctx, provider)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, integration := range integrations {
+			if !warehouseScheduled(integration) {
+				continue
+			}
+			if _, err := s.queue.Enqueue(ctx, service.TaskExportToWarehouse, service.ExportToWarehousePayload{
+				OrganizationID: integration.OrganizationID,
+				Provider:       provider,
+			}); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			queued++
+		}
+	}
+	return queued, errors.Join(errs...)
+}
+
+func (s *warehouseExportService) ExportToWarehouse(ctx context.Context, orgID uuid.UUID, provider string) error {
+	integration, err := s.integrationRepo.GetByProvider(ctx, orgID, provider)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return err
+	}
+	if integration.Status != models.IntegrationConnected {
+		return nil
+	}
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	export, err := s.export(ctx, orgID, provider)
+	if err != nil {
+		return err
+	}
+
+	// The claim outlasts the run, so a run that dies holding it is
+	// retried on the next schedule
+	start := time.Now()
+	claimed, err := s.exportRepo.Claim(ctx, export.ID, start, start.Add(s.timeout+time.Minute))
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		s.logger.Info("warehouse export skipped: another run holds it", "organization_id", orgID, "provider", provider)
+		return nil
+	}
+	// Read again, now that a reset can no longer change it
+	if export, err = s.exportRepo.GetByProvider(ctx, orgID, provider); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	runErr := s.run(runCtx, org, integration, export, start)
+	cancel()
+	if errors.Is(runErr, context.DeadlineExceeded) && ctx.Err() == nil {
+		runErr = errExportIncomplete
+	}
+
+	export.RunningUntil = nil
+	export.LastRunAt = &start
+	switch {
+	case runErr == nil:
+		export.LastSuccessAt = &start
+		export.LastError = ""
+	case errors.Is(runErr, errExportIncomplete):
+		export.LastError = ""
+	default:
+		export.LastError = runErr.Error()
+	}
+	if err := s.exportRepo.Update(context.WithoutCancel(ctx), export); err != nil {
+		return err
+	}
+
+	switch {
+	case errors.Is(runErr, errExportIncomplete):
+		s.logger.Info("warehouse export continuing in another run", "organization_id", orgID, "provider", provider)
+		_, err := s.queue.Enqueue(context.WithoutCancel(ctx), service.TaskExportToWarehouse, service.ExportToWarehousePayload{
+			OrganizationID: orgID,
+			Provider:       provider,
+		})
+		return err
+	case runErr != nil:
+		// Failed pushes are retried from the watermark on the next
+		// schedule or run, not by the queue
+		s.logger.Error("warehouse export failed", "organization_id", orgID, "provider", provider, "error", runErr)
+		return nil
+	}
+	s.logger.Info("warehouse export finished", "organization_id", orgID, "provider", provider,
+		"meeting_rows", export.MeetingRows, "membership_rows", export.MembershipRows)
+	return nil
+}
+
+// run pushes the changes after the export's watermarks, advancing them and
+// saving the export after each push.
+func (s *warehouseExportService) run(ctx context.Context, org *models.Organization, integration *models.Integration, export *models.WarehouseExport, start time.Time) error {
+	dest, err := s.destination(integration)
+	if err != nil {
+		return err
+	}
+	until := start.Add(-warehouseSettle)
+
+	for {
+		if ctx.Err() != nil {
+			return errExportIncomplete
+		}
+		changes, err := s.exportRepo.ChangedMeetings(ctx, org.ID, watermark(export.MeetingsAt, export.MeetingsID), until, warehouseBatch)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			break
+		}
+
+		exportedAt := time.Now().UTC()
+		var meetings, increments [][]any
+		for _, c := range changes {
+			meetings = append(meetings, meetingRow(org, c.Meeting, exportedAt))
+			for _, inc := range c.Meeting.Increments {
+				increments = append(increments, incrementRow(org, &inc, exportedAt))
+			}
+		}
+		if err := dest.Write(ctx, warehouse.Meetings, meetings); err != nil {
+			return err
+		}
+		if len(increments) > 0 {
+			if err := dest.Write(ctx, warehouse.Increments, increments); err != nil {
+				return err
+			}
+		}
+
+		last := changes[len(changes)-1]
+		export.MeetingsAt, export.MeetingsID = &last.ChangedAt, &last.Meeting.ID
+		export.MeetingRows += int64(len(meetings))
+		export.IncrementRows += int64(len(increments))
+		if err := s.exportRepo.Update(ctx, export); err != nil {
+			return err
+		}
+		if len(changes) < warehouseBatch {
+			break
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return errExportIncomplete
+		}
+		changes, err := s.exportRepo.ChangedMemberships(ctx, org.ID, watermark(export.MembershipsAt, export.MembershipsID), until, warehouseBatch)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			break
+		}
+
+		exportedAt := time.Now().UTC()
+		rows := make([][]any, len(changes))
+		for i, c := range changes {
+			rows[i] = membershipRow(org, c, exportedAt)
+		}
+		if err := dest.Write(ctx, warehouse.Memberships, rows); err != nil {
+			return err
+		}
+
+		last := changes[len(changes)-1]
+		export.MembershipsAt, export.MembershipsID = &last.ChangedAt, &last.ProfileID
+		export.MembershipRows += int64(len(rows))
+		if err := s.exportRepo.Update(ctx, export); err != nil {
+			return err
+		}
+		if len(changes) < warehouseBatch {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *warehouseExportService) WarehouseSchema(ctx context.Context) []warehouse.Table {
+	return warehouse.Tables
+}
+
+// destination returns the warehouse the integration writes to.
+func (s *warehouseExportService) destination(integration *models.Integration) (warehouse.Destination, error) {
+	raw, err := warehouseSettings(integration)
+	if err != nil {
+		return nil, err
+	}
+	str := func(key string) string {
+		v, _ := raw[key].(string)
+		return strings.TrimSpace(v)
+	}
+
+	var dest warehouse.Destination
+	switch integration.Provider {
+	case models.IntegrationBigQuery:
+		dest, err = warehouse.NewBigQuery(str(service.WarehouseSettingProject), str(service.WarehouseSettingDataset),
+			integration.AccessToken, s.timeout)
+	case models.IntegrationSnowflake:
+		dest, err = warehouse.NewSnowflake(warehouse.SnowflakeConfig{
+			Account:    str(service.WarehouseSettingAccount),
+			User:       str(service.WarehouseSettingUser),
+			Database:   str(service.WarehouseSettingDatabase),
+			Schema:     str(service.WarehouseSettingSchema),
+			Warehouse:  str(service.WarehouseSettingWarehouse),
+			Role:       str(service.WarehouseSettingRole),
+			PrivateKey: integration.AccessToken,
+		}, s.timeout)
+	case models.IntegrationS3:
+		format := str(service.WarehouseSettingFormat)
+		if format == "" {
+			format = warehouse.FormatCSV
+		}
+		dest, err = warehouse.NewS3(warehouse.S3Config{
+			Bucket:          str(service.WarehouseSettingBucket),
+			Region:          str(service.WarehouseSettingRegion),
+			AccessKeyID:     str(service.WarehouseSettingAccessKeyID),
+			SecretAccessKey: integration.AccessToken,
+			Prefix:          str(service.WarehouseSettingPrefix),
+			Format:          format,
+			Endpoint:        str(service.WarehouseSettingEndpoint),
+		}, s.timeout)
+	default:
+		return nil, errNotWarehouse
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+	return dest, nil
+}
+
+// warehouseSettings reads a warehouse integration's settings.
+func warehouseSettings(integration *models.Integration) (map[string]interface{}, error) {
+	raw := map[string]interface{}{}
+	if len(integration.Settings) > 0 {
+		if err := json.Unmarshal(integration.Settings, &raw); err != nil {
+			return nil, fmt.Errorf("invalid settings: %w", err)
+		}
+	}
+	return raw, nil
+}
+
+// warehouseScheduled reports whether the integration has scheduled exports
+// on.
+func warehouseScheduled(integration *models.Integration) bool {
+	raw, err := warehouseSettings(integration)
+	if err != nil {
+		return false
+	}
+	scheduled, _ := raw[service.WarehouseSettingSchedule].(bool)
+	return scheduled
+}
+
+// watermark returns an export's stored watermark; nil reads from the start.
+func watermark(at *time.Time, id *uuid.UUID) repository.Watermark {
+	if at == nil || id == nil {
+		return repository.Watermark{}
+	}
+	return repository.Watermark{At: *at, ID: *id}
+}
+
+// running reports whether a run holds the export.
+func running(export *models.WarehouseExport, now time.Time) bool {
+	return export.RunningUntil != nil && export.RunningUntil.After(now)
+}
+
+func (s *warehouseExportService) toDTO(ctx context.Context, export *models.WarehouseExport) *service.WarehouseExportDTO {
+	dto := &service.WarehouseExportDTO{
+		Provider:           export.Provider,
+		Status:             "idle",
+		MeetingsThrough:    export.MeetingsAt,
+		MembershipsThrough: export.MembershipsAt,
+		Rows: map[string]int64{
+			warehouse.Meetings.Name:    export.MeetingRows,
+			warehouse.Increments.Name:  export.IncrementRows,
+			warehouse.Memberships.Name: export.MembershipRows,
+		},
+		LastRunAt:     export.LastRunAt,
+		LastSuccessAt: export.LastSuccessAt,
+		LastError:     export.LastError,
+	}
+	if running(export, time.Now()) {
+		dto.Status = "running"
+	}
+	if integration, err := s.integrationRepo.GetByProvider(ctx, export.OrganizationID, export.Provider); err == nil {
+		dto.Scheduled = warehouseScheduled(integration)
+	}
+	return dto
+}
+
+// meetingRow is a meeting in the columns of warehouse.Meetings. Who
+// created it is left out for aggregate-only organizations.
+func meetingRow(org *models.Organization, m *models.Meeting, exportedAt time.Time) []any {
+	var createdBy, externalType, approvalStatus, deletedAt any
+	if !org.AggregateOnly {
+		createdBy = m.CreatedByID.String()
+	}
+	if m.ExternalType != "" {
+		externalType = m.ExternalType
+	}
+	if m.ApprovalStatus != "" {
+		approvalStatus = m.ApprovalStatus
+	}
+	if m.DeletedAt.Valid {
+		deletedAt = m.DeletedAt.Time.UTC()
+	}
+	return []any{
+		m.ID.String(),
+		m.OrganizationID.String(),
+		m.Purpose,
+		createdBy,
+		externalType,
+		m.IsActive,
+		warehouse.Time(m.StartedAt),
+		warehouse.Time(m.StoppedAt),
+		warehouse.Time(m.ScheduledStart),
+		warehouse.Time(m.ScheduledEnd),
+		m.TotalCost,
+		int64(m.TotalDuration),
+		int64(m.MaxAttendees),
+		m.AttendeeSeconds,
+		int64(m.LateStartSeconds),
+		m.LateStartCost,
+		int64(m.OverrunSeconds),
+		m.OverrunCost,
+		m.ProjectedCost,
+		approvalStatus,
+		warehouse.Time(m.FinalizedAt),
+		m.CreatedAt.UTC(),
+		m.UpdatedAt.UTC(),
+		deletedAt,
+		exportedAt,
+	}
+}
+
+// incrementRow is an increment in the columns of warehouse.Increments.
+func incrementRow(org *models.Organization, inc *models.Increment, exportedAt time.Time) []any {
+	return []any{
+		inc.ID.String(),
+		inc.MeetingID.String(),
+		org.ID.String(),
+		inc.StartTime.UTC(),
+		inc.StopTime.UTC(),
+		int64(inc.AttendeeCount),
+		int64(inc.ElapsedTime),
+		inc.Cost,
+		inc.TotalCost,
+		inc.Purpose,
+		exportedAt,
+	}
+}
+
+// membershipRow is a membership in the columns of warehouse.Memberships.
+func membershipRow(org *models.Organization, m *repository.MembershipChange, exportedAt time.Time) []any {
+	return []any{
+		m.ProfileID.String(),
+		org.ID.String(),
+		m.PersonID.String(),
+		m.Email,
+		m.FirstName,
+		m.LastName,
+		m.IsActive,
+		m.MeetingApprover,
+		m.JoinedAt.UTC(),
+		warehouse.Time(m.LeftAt),
+		m.CreatedAt.UTC(),
+		warehouse.Time(m.DeletedAt),
+		exportedAt,
+	}
+}
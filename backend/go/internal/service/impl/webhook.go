@@ -0,0 +1,434 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/logger"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/queue"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+	"github.com/yourorg/meeting-cost/backend/go/internal/webhook"
+	"gorm.io/datatypes"
+)
+
+// Retry schedule for failed deliveries: exponential from one minute, capped
+// at six hours, with jitter so endpoints recovering from an outage are not
+// hit by every queued delivery at once.
+const (
+	webhookBackoffBase = time.Minute
+	webhookBackoffMax  = 6 * time.Hour
+)
+
+type webhookService struct {
+	webhookRepo     repository.WebhookRepository
+	permissionRepo  repository.PermissionRepository
+	auditLogService service.AuditLogService
+	entitlements    service.EntitlementService
+	queue           *queue.Client
+	sender          *webhook.Sender
+	maxAttempts     int
+	logger          logger.Logger
+}
+
+// NewWebhookService creates a new WebhookService. A delivery is
+// dead-lettered after maxAttempts failed attempts.
+func NewWebhookService(
+	webhookRepo repository.WebhookRepository,
+	permissionRepo repository.PermissionRepository,
+	auditLogService service.AuditLogService,
+	entitlements service.EntitlementService,
+	queue *queue.Client,
+	sender *webhook.Sender,
+	maxAttempts int,
+	logger logger.Logger,
+) service.WebhookService {
+	return &webhookService{
+		webhookRepo:     webhookRepo,
+		permissionRepo:  permissionRepo,
+		auditLogService: auditLogService,
+		entitlements:    entitlements,
+		queue:           queue,
+		sender:          sender,
+		maxAttempts:     maxAttempts,
+		logger:          logger,
+	}
+}
+
+// authorize checks that requester may manage the organization's webhooks.
+func (s *webhookService) authorize(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	hasPerm, err := s.permissionRepo.HasPermission(ctx, requesterID, orgID, "organization", nil, "update")
+	if err != nil {
+		return err
+	}
+	if !hasPerm {
+		return fmt.Errorf("forbidden")
+	}
+	return nil
+}
+
+// endpoint loads an endpoint of the organization.
+func (s *webhookService) endpoint(ctx context.Context, orgID, endpointID uuid.UUID) (*models.WebhookEndpoint, error) {
+	endpoint, err := s.webhookRepo.GetEndpoint(ctx, endpointID)
+	if err != nil || endpoint.OrganizationID != orgID {
+		return nil, fmt.Errorf("webhook endpoint not found")
+	}
+	return endpoint, nil
+}
+
+func (s *webhookService) CreateEndpoint(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req service.CreateWebhookEndpointRequest) (*service.WebhookEndpointDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := s.entitlements.CheckIntegrations(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	if err := s.sender.CheckURL(ctx, req.URL); err != nil {
+		return nil, err
+	}
+	for _, event := range req.Events {
+		if !slices.Contains(service.WebhookEvents, event) {
+			return nil, fmt.Errorf("invalid event: %s", event)
+		}
+	}
+	events, err := json.Marshal(req.Events)
+	if err != nil {
+		return nil, fmt.Errorf("encoding events: %w", err)
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := &models.WebhookEndpoint{
+		OrganizationID: orgID,
+		URL:            req.URL,
+		Description:    req.Description,
+		Secret:         secret,
+		Events:         datatypes.JSON(events),
+		Active:         true,
+	}
+	if err := s.webhookRepo.CreateEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "create",
+		ResourceType:   "webhook_endpoint",
+		ResourceID:     endpoint.ID,
+		Details:        map[string]interface{}{"url": endpoint.URL, "events": req.Events},
+		IPAddress:      req.IPAddress,
+		UserAgent:      req.UserAgent,
+	})
+
+	dto := toWebhookEndpointDTO(endpoint)
+	dto.Secret = secret
+	return dto, nil
+}
+
+func (s *webhookService) ListEndpoints(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*service.WebhookEndpointDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+
+	endpoints, err := s.webhookRepo.ListEndpoints(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*service.WebhookEndpointDTO, len(endpoints))
+	for i, e := range endpoints {
+		dtos[i] = toWebhookEndpointDTO(e)
+	}
+	return dtos, nil
+}
+
+func (s *webhookService) DeleteEndpoint(ctx context.Context, orgID, endpointID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return err
+	}
+	endpoint, err := s.endpoint(ctx, orgID, endpointID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.DeleteEndpoint(ctx, endpointID); err != nil {
+		return err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "delete",
+		ResourceType:   "webhook_endpoint",
+		ResourceID:     endpointID,
+		Details:        map[string]interface{}{"url": endpoint.URL},
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+	})
+	return nil
+}
+
+func (s *webhookService) PingEndpoint(ctx context.Context, orgID, endpointID uuid.UUID, requesterID uuid.UUID) (*service.WebhookDeliveryDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	endpoint, err := s.endpoint(ctx, orgID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, payload, err := newWebhookEvent(orgID, service.WebhookEventPing, map[string]interface{}{"endpoint_id": endpointID})
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.enqueue(ctx, endpoint.ID, event, payload)
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookDeliveryDTO(delivery), nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, orgID, endpointID uuid.UUID, requesterID uuid.UUID, pagination service.Pagination) ([]*service.WebhookDeliveryDTO, int64, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, 0, err
+	}
+	if _, err := s.endpoint(ctx, orgID, endpointID); err != nil {
+		return nil, 0, err
+	}
+
+	deliveries, total, err := s.webhookRepo.ListDeliveries(ctx, endpointID, repository.Pagination{
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dtos := make([]*service.WebhookDeliveryDTO, len(deliveries))
+	for i, d := range deliveries {
+		dtos[i] = toWebhookDeliveryDTO(d)
+	}
+	return dtos, total, nil
+}
+
+func (s *webhookService) Redeliver(ctx context.Context, orgID, endpointID, deliveryID uuid.UUID, requesterID uuid.UUID) (*service.WebhookDeliveryDTO, error) {
+	if err := s.authorize(ctx, orgID, requesterID); err != nil {
+		return nil, err
+	}
+	if _, err := s.endpoint(ctx, orgID, endpointID); err != nil {
+		return nil, err
+	}
+
+	original, err := s.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil || original.EndpointID != endpointID {
+		return nil, fmt.Errorf("webhook delivery not found")
+	}
+
+	event := service.WebhookEvent{ID: original.EventID, Type: original.EventType}
+	delivery, err := s.enqueue(ctx, endpointID, event, original.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogService.Log(ctx, service.LogParams{
+		PersonID:       &requesterID,
+		OrganizationID: &orgID,
+		Action:         "redeliver",
+		ResourceType:   "webhook_delivery",
+		ResourceID:     delivery.ID,
+		Details:        map[string]interface{}{"original_delivery_id": deliveryID, "event_id": original.EventID},
+	})
+	return toWebhookDeliveryDTO(delivery), nil
+}
+
+func (s *webhookService) Dispatch(ctx context.Context, orgID uuid.UUID, eventType string, data interface{}) error {
+	endpoints, err := s.webhookRepo.ListEndpoints(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	var subscribed []*models.WebhookEndpoint
+	for _, e := range endpoints {
+		if e.Active && subscribes(e, eventType) {
+			subscribed = append(subscribed, e)
+		}
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	event, payload, err := newWebhookEvent(orgID, eventType, data)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, e := range subscribed {
+		if _, err := s.enqueue(ctx, e.ID, event, payload); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *webhookService) Deliver(ctx context.Context, deliveryID uuid.UUID) error {
+	delivery, err := s.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	// Tasks are delivered at least once; a finished delivery stays finished
+	if delivery.Status == models.WebhookDeliverySucceeded || delivery.Status == models.WebhookDeliveryFailed {
+		return nil
+	}
+
+	endpoint := delivery.Endpoint
+	now := time.Now()
+	if endpoint.ID == uuid.Nil || endpoint.DeletedAt.Valid || !endpoint.Active {
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.Error = "endpoint deleted or disabled"
+		delivery.NextAttemptAt = nil
+		return s.webhookRepo.UpdateDelivery(ctx, delivery)
+	}
+
+	res := s.sender.Send(ctx, endpoint.URL, endpoint.Secret, webhook.Message{
+		ID:    delivery.ID.String(),
+		Event: delivery.EventType,
+		Body:  delivery.Payload,
+	})
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = res.StatusCode
+	delivery.ResponseBody = res.Body
+	delivery.DurationMs = res.Duration.Milliseconds()
+	delivery.Error = ""
+	delivery.NextAttemptAt = nil
+
+	var next time.Time
+	switch {
+	case res.Err == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+	case delivery.Attempts >= s.maxAttempts:
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.Error = res.Err.Error()
+		s.logger.Warn("webhook delivery dead-lettered", "delivery_id", delivery.ID, "endpoint_id", endpoint.ID, "attempts", delivery.Attempts, "error", res.Err)
+	default:
+		delivery.Status = models.WebhookDeliveryRetrying
+		delivery.Error = res.Err.Error()
+		next = now.Add(webhookBackoff(delivery.Attempts))
+		delivery.NextAttemptAt = &next
+	}
+
+	if err := s.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		return err
+	}
+	if next.IsZero() {
+		return nil
+	}
+	_, err = s.queue.Enqueue(ctx, service.TaskDeliverWebhook, service.DeliverWebhookPayload{DeliveryID: delivery.ID}, queue.ProcessAt(next))
+	return err
+}
+
+// enqueue records a pending delivery of event to the endpoint and queues its
+// first attempt.
+func (s *webhookService) enqueue(ctx context.Context, endpointID uuid.UUID, event service.WebhookEvent, payload []byte) (*models.WebhookDelivery, error) {
+	delivery := &models.WebhookDelivery{
+		EndpointID: endpointID,
+		EventID:    event.ID,
+		EventType:  event.Type,
+		Payload:    datatypes.JSON(payload),
+		Status:     models.WebhookDeliveryPending,
+	}
+	if err := s.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	if _, err := s.queue.Enqueue(ctx, service.TaskDeliverWebhook, service.DeliverWebhookPayload{DeliveryID: delivery.ID}); err != nil {
+		return nil, fmt.Errorf("queueing webhook delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+// newWebhookEvent wraps data in a new event and encodes it as a request body.
+func newWebhookEvent(orgID uuid.UUID, eventType string, data interface{}) (service.WebhookEvent, []byte, error) {
+	event := service.WebhookEvent{
+		ID:             uuid.Must(uuid.NewRandom()),
+		Type:           eventType,
+		OrganizationID: orgID,
+		CreatedAt:      time.Now().UTC(),
+		Data:           data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return event, nil, fmt.Errorf("encoding webhook event: %w", err)
+	}
+	return event, payload, nil
+}
+
+// subscribes reports whether the endpoint wants eventType; an endpoint with
+// no events listed wants every event.
+func subscribes(e *models.WebhookEndpoint, eventType string) bool {
+	events := endpointEvents(e)
+	return len(events) == 0 || slices.Contains(events, eventType)
+}
+
+func endpointEvents(e *models.WebhookEndpoint) []string {
+	var events []string
+	if len(e.Events) > 0 {
+		_ = json.Unmarshal(e.Events, &events)
+	}
+	return events
+}
+
+// webhookBackoff returns the delay before the attempt after the given number
+// of failed attempts: half the exponential delay plus up to as much again at
+// random.
+func webhookBackoff(attempts int) time.Duration {
+	d := webhookBackoffBase << min(attempts-1, 16)
+	if d > webhookBackoffMax {
+		d = webhookBackoffMax
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func toWebhookEndpointDTO(e *models.WebhookEndpoint) *service.WebhookEndpointDTO {
+	events := endpointEvents(e)
+	if events == nil {
+		events = []string{}
+	}
+	return &service.WebhookEndpointDTO{
+		ID:          e.ID,
+		URL:         e.URL,
+		Description: e.Description,
+		Events:      events,
+		Active:      e.Active,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func toWebhookDeliveryDTO(d *models.WebhookDelivery) *service.WebhookDeliveryDTO {
+	return &service.WebhookDeliveryDTO{
+		ID:             d.ID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastAttemptAt:  d.LastAttemptAt,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		DurationMs:     d.DurationMs,
+		CreatedAt:      d.CreatedAt,
+	}
+}
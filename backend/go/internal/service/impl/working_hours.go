@@ -0,0 +1,148 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/repository"
+	"github.com/yourorg/meeting-cost/backend/go/internal/service"
+)
+
+// applyWorkingHours validates hours and sets them on org; empty hours
+// remove them.
+func applyWorkingHours(org *models.Organization, hours *service.WorkingHours) error {
+	if len(hours.Days) == 0 && hours.Start == "" && hours.End == "" {
+		org.WorkingDays, org.WorkdayStart, org.WorkdayEnd = "", "", ""
+		return nil
+	}
+	if len(hours.Days) == 0 {
+		return fmt.Errorf("invalid working_hours: days must name at least one day")
+	}
+	worked := make([]bool, len(models.WorkingDayNames))
+	for _, d := range hours.Days {
+		i := slices.Index(models.WorkingDayNames, strings.ToLower(strings.TrimSpace(d)))
+		if i < 0 {
+			return fmt.Errorf("invalid working_hours: %q is not a day; use %s", d, strings.Join(models.WorkingDayNames, ", "))
+		}
+		worked[i] = true
+	}
+	start, err := time.Parse("15:04", strings.TrimSpace(hours.Start))
+	if err != nil {
+		return fmt.Errorf("invalid working_hours: start must be a time of day such as \"09:00\"")
+	}
+	end, err := time.Parse("15:04", strings.TrimSpace(hours.End))
+	if err != nil {
+		return fmt.Errorf("invalid working_hours: end must be a time of day such as \"17:30\"")
+	}
+	if !end.After(start) {
+		return fmt.Errorf("invalid working_hours: end must be after start")
+	}
+
+	// Days are stored in week order whatever order they were given in
+	var days []string
+	for i, w := range worked {
+		if w {
+			days = append(days, models.WorkingDayNames[i])
+		}
+	}
+	org.WorkingDays = strings.Join(days, ",")
+	org.WorkdayStart = start.Format("15:04")
+	org.WorkdayEnd = end.Format("15:04")
+	return nil
+}
+
+// toWorkingHoursDTO returns org's working hours, or nil when it has none.
+func toWorkingHoursDTO(org *models.Organization) *service.WorkingHours {
+	if org.WorkingDays == "" {
+		return nil
+	}
+	return &service.WorkingHours{
+		Days:  strings.Split(org.WorkingDays, ","),
+		Start: org.WorkdayStart,
+		End:   org.WorkdayEnd,
+	}
+}
+
+// workingHours returns org's working hours with its holidays from from up
+// to to, or nil when it has none. Hours in a timezone that no longer loads
+// count as UTC.
+func workingHours(ctx context.Context, holidayRepo repository.HolidayRepository, org *models.Organization, from, to time.Time) (*repository.WorkingHours, error) {
+	if org.WorkingDays == "" {
+		return nil, nil
+	}
+	loc, err := service.LoadTimezone(org.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	hours := &repository.WorkingHours{
+		Start:    org.WorkdayStart,
+		End:      org.WorkdayEnd,
+		Location: loc,
+	}
+	for _, d := range strings.Split(org.WorkingDays, ",") {
+		if i := slices.Index(models.WorkingDayNames, d); i >= 0 {
+			hours.Days = append(hours.Days, time.Weekday(i))
+		}
+	}
+
+	// Holidays are dated in the organization's timezone and held as
+	// midnight UTC
+	from, to = from.In(loc), to.In(loc)
+	holidays, err := holidayRepo.ListByOrganization(ctx, org.ID,
+		time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC),
+		time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range holidays {
+		hours.Holidays = append(hours.Holidays, h.Date.Format(time.DateOnly))
+	}
+	return hours, nil
+}
+
+// scheduleWarnings warns when a meeting scheduled from start to end, either
+// of which may be nil, starts or ends outside org's working hours.
+func scheduleWarnings(ctx context.Context, holidayRepo repository.HolidayRepository, org *models.Organization, start, end *time.Time) ([]string, error) {
+	if org.WorkingDays == "" || (start == nil && end == nil) {
+		return nil, nil
+	}
+	from, to := start, end
+	if from == nil {
+		from = end
+	}
+	if to == nil {
+		to = start
+	}
+	hours, err := workingHours(ctx, holidayRepo, org, *from, *to)
+	if err != nil {
+		return nil, err
+	}
+
+	within := fmt.Sprintf("%s–%s %s on %s", hours.Start, hours.End, hours.Location, strings.ReplaceAll(org.WorkingDays, ",", ", "))
+	warn := func(field string, t time.Time) string {
+		switch hours.Classify(t) {
+		case repository.Holiday:
+			return fmt.Sprintf("%s is on a holiday, %s", field, t.In(hours.Location).Format(time.DateOnly))
+		case repository.OffHours:
+			return fmt.Sprintf("%s is outside working hours (%s)", field, within)
+		}
+		return ""
+	}
+	var warnings []string
+	if start != nil {
+		if w := warn("scheduled_start", *start); w != "" {
+			warnings = append(warnings, w)
+		}
+	}
+	if end != nil {
+		// A meeting ending as the working day does ends within it
+		if w := warn("scheduled_end", end.Add(-time.Second)); w != "" {
+			warnings = append(warnings, w)
+		}
+	}
+	return warnings, nil
+}
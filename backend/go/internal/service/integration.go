@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// IntegrationService manages an organization's connections to outside
+// services. Every organization sees every provider in Integrations, whether
+// or not it has connected it.
+type IntegrationService interface {
+	ListIntegrations(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*IntegrationDTO, error)
+	GetIntegration(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID) (*IntegrationDTO, error)
+	// ConnectIntegration stores the provider's tokens for the organization,
+	// replacing any it had.
+	ConnectIntegration(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, req ConnectIntegrationRequest) (*IntegrationDTO, error)
+	// DisconnectIntegration forgets the provider's tokens and keeps its
+	// settings for a later reconnect.
+	DisconnectIntegration(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, ipAddress, userAgent string) (*IntegrationDTO, error)
+	// UpdateIntegrationSettings replaces the provider's settings, whether
+	// or not it is connected.
+	UpdateIntegrationSettings(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, req UpdateIntegrationSettingsRequest) (*IntegrationDTO, error)
+}
+
+// IntegrationProvider describes a service organizations can connect.
+type IntegrationProvider struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Purpose is what the provider is listed as a sub-processor for; empty
+	// when the organization hosts it, as with a CSV file, or it is the
+	// organization's own account, as with a data warehouse
+	Purpose string `json:"-"`
+}
+
+// Integrations lists the providers organizations can connect, in the
+// order they are listed.
+var Integrations = []IntegrationProvider{
+	{ID: models.IntegrationZoom, Name: "Zoom", Purpose: "Meeting integration"},
+	{ID: models.IntegrationGoogle, Name: "Google Calendar", Purpose: "Meeting integration"},
+	{ID: models.IntegrationSlack, Name: "Slack", Purpose: "Meeting integration"},
+	{ID: models.IntegrationTeams, Name: "Microsoft Teams", Purpose: "Meeting integration"},
+	{ID: models.IntegrationBambooHR, Name: "BambooHR", Purpose: "Wage sync"},
+	{ID: models.IntegrationWorkday, Name: "Workday", Purpose: "Wage sync"},
+	{ID: models.IntegrationCSV, Name: "CSV file (HTTPS or SFTP)"},
+	{ID: models.IntegrationBigQuery, Name: "BigQuery"},
+	{ID: models.IntegrationSnowflake, Name: "Snowflake"},
+	{ID: models.IntegrationS3, Name: "Amazon S3"},
+}
+
+type ConnectIntegrationRequest struct {
+	AccessToken  string     `json:"access_token" validate:"required"`
+	RefreshToken string     `json:"refresh_token"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	// ExternalAccount names the connected account, e.g. the Slack workspace
+	ExternalAccount string `json:"external_account"`
+	// Settings replace the current settings when given
+	Settings  map[string]interface{} `json:"settings"`
+	IPAddress string                 `json:"-"`
+	UserAgent string                 `json:"-"`
+}
+
+type UpdateIntegrationSettingsRequest struct {
+	Settings  map[string]interface{} `json:"settings"`
+	IPAddress string                 `json:"-"`
+	UserAgent string                 `json:"-"`
+}
+
+// IntegrationDTO is a provider and the organization's connection to it.
+// Tokens are never returned.
+type IntegrationDTO struct {
+	Provider string `json:"provider"`
+	Name     string `json:"name"`
+	// Status is "connected" or "disconnected"
+	Status          string                 `json:"status"`
+	ExternalAccount string                 `json:"external_account,omitempty"`
+	Settings        map[string]interface{} `json:"settings"`
+	ConnectedByID   *uuid.UUID             `json:"connected_by_id,omitempty"`
+	ConnectedAt     *time.Time             `json:"connected_at,omitempty"`
+	DisconnectedAt  *time.Time             `json:"disconnected_at,omitempty"`
+	UpdatedAt       *time.Time             `json:"updated_at,omitempty"`
+}
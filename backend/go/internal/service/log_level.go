@@ -0,0 +1,24 @@
+package service
+
+import "context"
+
+// LogLevelService changes the log level of every API and worker process
+// while they run.
+type LogLevelService interface {
+	// GetLevel returns this process's log level.
+	GetLevel() *LogLevelDTO
+	// SetLevel changes the log level here and in every other process
+	// sharing the pubsub. It lasts until they restart, when LOG_LEVEL
+	// applies again.
+	SetLevel(ctx context.Context, req SetLogLevelRequest) (*LogLevelDTO, error)
+}
+
+// LogLevelDTO is a process's log level.
+type LogLevelDTO struct {
+	Level string `json:"level"`
+}
+
+// SetLogLevelRequest names the level to log at: debug, info, warn or error.
+type SetLogLevelRequest struct {
+	Level string `json:"level"`
+}
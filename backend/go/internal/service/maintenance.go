@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// MaintenanceService runs housekeeping tasks on behalf of operators.
+type MaintenanceService interface {
+	// PurgeDeleted permanently removes rows soft-deleted longer than
+	// retention ago.
+	PurgeDeleted(ctx context.Context, retention time.Duration) (*PurgeResult, error)
+	// EnqueuePurgeDeleted hands the same purge to the worker and returns the
+	// task ID.
+	EnqueuePurgeDeleted(ctx context.Context, retention time.Duration) (string, error)
+	// CleanupSessions deletes expired sessions and returns how many were
+	// removed.
+	CleanupSessions(ctx context.Context) (int64, error)
+	// Reencrypt rewrites the values encrypted at rest that are in plaintext
+	// or under an older key with the primary key.
+	Reencrypt(ctx context.Context) (*ReencryptResult, error)
+	// MaintainPartitions creates the monthly partitions of increments
+	// through ahead months from now, and drops those of months that ended
+	// more than retention ago; 0 keeps every month.
+	MaintainPartitions(ctx context.Context, ahead int, retention time.Duration) (*PartitionResult, error)
+	// CompactIncrements merges the runs of identical increments of stopped
+	// meetings that weren't merged when they stopped, such as those
+	// stopped before compaction existed.
+	CompactIncrements(ctx context.Context) (*CompactResult, error)
+}
+
+// PurgeResult reports what a purge removed.
+type PurgeResult struct {
+	Cutoff time.Time        `json:"cutoff"`
+	Purged map[string]int64 `json:"purged"`
+}
+
+// PartitionResult reports the partitions a partition maintenance created
+// and dropped.
+type PartitionResult struct {
+	Created []string `json:"created"`
+	Dropped []string `json:"dropped"`
+}
+
+// CompactResult reports how many meetings an increment compaction went
+// through, how many increments it merged away, and how many meetings
+// failed.
+type CompactResult struct {
+	Meetings int `json:"meetings"`
+	Removed  int `json:"removed"`
+	Failed   int `json:"failed"`
+}
+
+// ReencryptResult reports how many rows a re-encryption rewrote per table.
+type ReencryptResult struct {
+	PrimaryKey string           `json:"primary_key"`
+	Rewritten  map[string]int64 `json:"rewritten"`
+}
@@ -11,9 +11,15 @@ import (
 type MeetingService interface {
 	// CRUD
 	CreateMeeting(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req CreateMeetingRequest) (*MeetingDTO, error)
-	GetMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) (*MeetingDTO, error)
+	// CreateCompletedMeeting records a meeting that was not tracked live, as
+	// a stopped meeting with one increment spanning it.
+	CreateCompletedMeeting(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req CreateCompletedMeetingRequest) (*MeetingDTO, error)
+	GetMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, expand MeetingExpand) (*MeetingDTO, error)
 	UpdateMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, req UpdateMeetingRequest) (*MeetingDTO, error)
 	DeleteMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error
+	// BulkDeleteMeetings deletes an organization's meetings by ID or by
+	// filter in one go, with a single audit entry for them all.
+	BulkDeleteMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req BulkDeleteMeetingsRequest) (*BulkDeleteMeetingsDTO, error)
 
 	// Meeting control
 	StartMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) error
@@ -22,16 +28,82 @@ type MeetingService interface {
 
 	// Increments
 	UpdateAttendeeCount(ctx context.Context, meetingID uuid.UUID, count int, requesterID uuid.UUID, ipAddress, userAgent string) error
-	UpdateAverageWage(ctx context.Context, meetingID uuid.UUID, wage float64, requesterID uuid.UUID) error
-	UpdatePurpose(ctx context.Context, meetingID uuid.UUID, purpose string, requesterID uuid.UUID) error
+	// UpdateAverageWage changes the blended hourly rate a running meeting
+	// costs attendees at from now on.
+	UpdateAverageWage(ctx context.Context, meetingID uuid.UUID, wage float64, requesterID uuid.UUID, ipAddress, userAgent string) error
+	// UpdatePurpose records a change of topic: a running meeting starts a
+	// new increment with the purpose, so costs add up per topic, and a
+	// stopped one has its purpose changed.
+	UpdatePurpose(ctx context.Context, meetingID uuid.UUID, purpose string, requesterID uuid.UUID, ipAddress, userAgent string) error
+	// UpdateIncrement applies several of the changes above at once, so a
+	// running meeting starts a single new increment for them.
+	UpdateIncrement(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, req UpdateIncrementRequest) error
+	// CorrectIncrement lets an organization admin fix a closed increment's
+	// times, attendee count, wage or purpose, recalculating its cost and the
+	// meeting's totals.
+	CorrectIncrement(ctx context.Context, meetingID, incrementID uuid.UUID, requesterID uuid.UUID, req CorrectIncrementRequest) (*IncrementDTO, error)
+	// FinalizeMeeting lets an organization admin lock a stopped meeting's
+	// increments and totals, so the numbers exported from it stay put. A
+	// finalized meeting can't be restarted, edited or deleted; only
+	// CorrectIncrement, which is audited, still changes it.
+	FinalizeMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) (*MeetingDTO, error)
+	// Approvals
+	// ApproveMeeting and RejectMeeting decide on a meeting awaiting
+	// approval, with an optional note for its organizer. Designated
+	// approvers and organization admins decide, but not on meetings they
+	// organized; a rejected meeting can't start.
+	ApproveMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, req ApprovalDecisionRequest) (*MeetingDTO, error)
+	RejectMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, req ApprovalDecisionRequest) (*MeetingDTO, error)
+	// UndoLastChange reverts a running meeting's last change of attendees,
+	// wage or purpose: the increment it opened is removed and the one
+	// before reopened, as if the change never happened.
+	UndoLastChange(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) (*UndoDTO, error)
 
 	// Participants
 	AddParticipant(ctx context.Context, meetingID uuid.UUID, personID uuid.UUID, requesterID uuid.UUID) error
 	RemoveParticipant(ctx context.Context, meetingID uuid.UUID, personID uuid.UUID, requesterID uuid.UUID) error
 
+	// Self check-in
+	// ShareMeeting returns a token that lets people outside the organization
+	// check in to the meeting until it expires.
+	ShareMeeting(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) (*MeetingShareDTO, error)
+	// CheckIn records the requester as present and, while the meeting runs,
+	// counts them in its attendee count. Members check in without a share
+	// token. Checking in again while present changes nothing.
+	CheckIn(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, shareToken string) (*CheckInDTO, error)
+	// CheckOut records the requester as gone and takes them off the
+	// attendee count. Checking out when not present changes nothing.
+	CheckOut(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, shareToken string) (*CheckInDTO, error)
+	// CheckInCode returns a QR code of a check-in link for people in the
+	// room to scan, good for CheckInCodeTTL.
+	CheckInCode(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) (*CheckInCode, error)
+
+	// Overruns
+	// CheckOverruns publishes EventMeetingOverrun for each active meeting
+	// that has run more than OverrunGrace past its scheduled end, once per
+	// meeting, and returns how many it found.
+	CheckOverruns(ctx context.Context) (int, error)
+	// BroadcastCosts publishes EventCostTick with the live cost of each
+	// active meeting, and returns how many it published to.
+	BroadcastCosts(ctx context.Context) (int, error)
+
 	// Queries
 	ListMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, filters MeetingFilters, pagination Pagination) ([]*MeetingDTO, int64, error)
+	// ListDeletedMeetings returns the organization's soft-deleted meetings
+	// that are yet to be purged, with who deleted them, for its admins.
+	ListDeletedMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, pagination Pagination) ([]*DeletedMeetingDTO, int64, error)
 	GetMeetingCost(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID) (*MeetingCostDTO, error)
+	// ExportIncrements renders the meeting's full increment history as a
+	// file in format, which must be csv or empty for csv.
+	ExportIncrements(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, format string) (*ReportFile, error)
+
+	// Offline sync
+	// Sync applies meeting operations a client recorded while offline, in
+	// the order given and at the times they were recorded. Each is applied
+	// once: syncing it again returns its first outcome. An operation the
+	// meetings' state on the server no longer allows is a conflict, left
+	// unapplied, and the rest go ahead.
+	Sync(ctx context.Context, requesterID uuid.UUID, req SyncRequest) (*SyncResultDTO, error)
 
 	// Deduplication
 	DeduplicateMeeting(ctx context.Context, meetingID uuid.UUID, externalType, externalID string) (*MeetingDTO, error)
@@ -42,14 +114,154 @@ type CreateMeetingRequest struct {
 	Purpose        string    `json:"purpose"`
 	ExternalType   string    `json:"external_type"` // "zoom", "teams", etc.
 	ExternalID     string    `json:"external_id"`
+	// ScheduledStart is when the meeting is meant to start; its first
+	// start records how late it began
+	ScheduledStart *time.Time `json:"scheduled_start"`
+	// ScheduledEnd is when the meeting is meant to end; running past it
+	// is an overrun
+	ScheduledEnd *time.Time `json:"scheduled_end"`
+	// ExpectedAttendees projects a scheduled meeting's cost; projections
+	// over the organization's approval threshold need approval
+	ExpectedAttendees int    `json:"expected_attendees" validate:"min=0"`
+	IPAddress         string `json:"-"`
+	UserAgent         string `json:"-"`
+}
+
+// CreateCompletedMeetingRequest describes a past meeting. AverageWage
+// defaults to the organization's default wage.
+type CreateCompletedMeetingRequest struct {
+	OrganizationID uuid.UUID `json:"organization_id" validate:"required"`
+	Purpose        string    `json:"purpose"`
+	StartedAt      time.Time `json:"started_at" validate:"required"`
+	StoppedAt      time.Time `json:"stopped_at" validate:"required"`
+	AttendeeCount  int       `json:"attendee_count" validate:"required"`
+	AverageWage    *float64  `json:"average_wage"`
 	IPAddress      string    `json:"-"`
 	UserAgent      string    `json:"-"`
 }
 
+// MaxCompletedMeeting is the longest meeting that can be recorded after the
+// fact.
+const MaxCompletedMeeting = 24 * time.Hour
+
 type UpdateMeetingRequest struct {
 	Purpose *string `json:"purpose"`
+	// ScheduledStart can change until the meeting first starts
+	ScheduledStart *time.Time `json:"scheduled_start"`
+	// ScheduledEnd can change at any time; moving it later lets a running
+	// meeting overrun it again
+	ScheduledEnd *time.Time `json:"scheduled_end"`
+	// ExpectedAttendees can change until the meeting first starts.
+	// Changing the schedule or attendees before then projects the cost
+	// again, which may need a new approval
+	ExpectedAttendees *int `json:"expected_attendees"`
+}
+
+// BulkDeleteMeetingsRequest selects the meetings to delete either by
+// MeetingIDs or by Filter, not both. Listed meetings must all be deletable or
+// none are deleted; a filter skips running and finalized meetings and those
+// the requester can't delete.
+type BulkDeleteMeetingsRequest struct {
+	MeetingIDs []uuid.UUID       `json:"meeting_ids,omitempty"`
+	Filter     *BulkDeleteFilter `json:"filter,omitempty"`
+	IPAddress  string            `json:"-"`
+	UserAgent  string            `json:"-"`
+}
+
+// BulkDeleteFilter matches meetings on every criterion given; at least one
+// is required.
+type BulkDeleteFilter struct {
+	// Purpose matches purposes containing it, ignoring case, such as "test"
+	Purpose       string     `json:"purpose,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+	StartedBefore *time.Time `json:"started_before,omitempty"`
+	CreatedByID   *uuid.UUID `json:"created_by_id,omitempty"`
+}
+
+// MaxBulkDeleteMeetings is the most meetings one bulk deletion can delete.
+const MaxBulkDeleteMeetings = 500
+
+// BulkDeleteMeetingsDTO reports what a bulk deletion deleted.
+type BulkDeleteMeetingsDTO struct {
+	Deleted    int         `json:"deleted"`
+	MeetingIDs []uuid.UUID `json:"meeting_ids"`
+	// Skipped counts the finalized meetings, and those the requester can't
+	// delete, that a filter matched
+	Skipped int `json:"skipped"`
 }
 
+// Offline sync operation types: create starts a new meeting, increment
+// changes a running meeting's attendees, wage or purpose, and stop stops it.
+const (
+	SyncCreate    = "create"
+	SyncIncrement = "increment"
+	SyncStop      = "stop"
+)
+
+// MaxSyncOperations is the most operations one sync can carry.
+const MaxSyncOperations = 500
+
+// SyncRequest carries the operations a client recorded offline.
+type SyncRequest struct {
+	Operations []SyncOperation `json:"operations" validate:"required"`
+	IPAddress  string          `json:"-"`
+	UserAgent  string          `json:"-"`
+}
+
+// SyncOperation is a change to a meeting made at At by the client's clock.
+// ID is the client's ID for the operation, and a create's MeetingID the ID
+// the meeting is given; both are generated by the client. Times ahead of
+// the server's clock are taken as now.
+type SyncOperation struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	Type      string    `json:"type" validate:"required,oneof=create increment stop"`
+	MeetingID uuid.UUID `json:"meeting_id" validate:"required"`
+	At        time.Time `json:"at" validate:"required"`
+	// OrganizationID is the organization a create's meeting belongs to
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	// AttendeeCount, AverageWage and Purpose set a create's first
+	// increment, the wage defaulting to the organization's, and change a
+	// running meeting's from At in an increment, which needs at least one
+	AttendeeCount *int     `json:"attendee_count,omitempty"`
+	AverageWage   *float64 `json:"average_wage,omitempty"`
+	Purpose       *string  `json:"purpose,omitempty"`
+}
+
+// SyncResultDTO reports what a sync did with each operation, in order.
+type SyncResultDTO struct {
+	Results []SyncOperationResult `json:"results"`
+	// Meetings are the meetings the operations name, as they stand on the
+	// server after the sync, for the client to replace its copies with
+	Meetings []*MeetingDTO `json:"meetings"`
+}
+
+// SyncOperationResult is the outcome of an operation: models.SyncApplied,
+// models.SyncConflict with the Reason, or models.SyncPending while another
+// sync is applying it.
+type SyncOperationResult struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+	Reason string    `json:"reason,omitempty"`
+	// Replayed is set when the operation was synced before and this is
+	// its outcome then
+	Replayed bool `json:"replayed,omitempty"`
+}
+
+// ApprovalDecisionRequest is the body of approving or rejecting a meeting.
+type ApprovalDecisionRequest struct {
+	Note      string `json:"note"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// LateStartGrace is how long after its scheduled time a meeting may start
+// and still count as on time.
+const LateStartGrace = time.Minute
+
+// OverrunGrace is how long past its scheduled end a meeting may run and
+// still count as ending on time.
+const OverrunGrace = time.Minute
+
 type MeetingDTO struct {
 	ID             uuid.UUID        `json:"id"`
 	OrganizationID uuid.UUID        `json:"organization_id"`
@@ -63,6 +275,54 @@ type MeetingDTO struct {
 	Increments     []IncrementDTO   `json:"increments,omitempty"`
 	Participants   []ParticipantDTO `json:"participants,omitempty"`
 	CreatedAt      time.Time        `json:"created_at"`
+
+	// ScheduledStart is set for scheduled meetings. Once one first starts
+	// LateStartSeconds is how late it was, 0 within LateStartGrace, and
+	// LateStartCost what the attendees checked in by then cost waiting
+	ScheduledStart   *time.Time `json:"scheduled_start,omitempty"`
+	LateStartSeconds int        `json:"late_start_seconds"`
+	LateStartCost    float64    `json:"late_start_cost"`
+
+	// ScheduledEnd is set for meetings scheduled to end. Overrun is true
+	// once one runs more than OverrunGrace past it, and OverrunSeconds is
+	// by how much, 0 within the grace: live while it runs, and as of its
+	// last stop otherwise, when OverrunCost is what that time cost
+	ScheduledEnd   *time.Time `json:"scheduled_end,omitempty"`
+	Overrun        bool       `json:"overrun"`
+	OverrunSeconds int        `json:"overrun_seconds"`
+	OverrunCost    float64    `json:"overrun_cost"`
+
+	// FinalizedAt is set once the meeting is finalized
+	FinalizedAt *time.Time `json:"finalized_at,omitempty"`
+
+	// ExpectedAttendees and ProjectedCost are set for scheduled meetings
+	// given an expected attendance. ApprovalStatus is "pending" while the
+	// projection awaits approval, then "approved" or "rejected"
+	ExpectedAttendees int        `json:"expected_attendees,omitempty"`
+	ProjectedCost     float64    `json:"projected_cost,omitempty"`
+	ApprovalStatus    string     `json:"approval_status,omitempty"`
+	ApprovalDecidedAt *time.Time `json:"approval_decided_at,omitempty"`
+	ApprovalNote      string     `json:"approval_note,omitempty"`
+
+	// Warnings are returned when a meeting is scheduled or rescheduled to
+	// start or end outside the organization's working hours or on one of
+	// its holidays. They don't stop the meeting being booked
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// DeletedMeetingDTO is a meeting in an organization's trash.
+type DeletedMeetingDTO struct {
+	MeetingDTO
+	DeletedAt time.Time `json:"deleted_at"`
+	// DeletedByID is who deleted the meeting, from the audit log; it is
+	// unset if the audit log has no record of the deletion
+	DeletedByID *uuid.UUID `json:"deleted_by_id,omitempty"`
+}
+
+// OverrunEvent is the payload of EventMeetingOverrun.
+type OverrunEvent struct {
+	ScheduledEnd time.Time `json:"scheduled_end"`
+	Cost         float64   `json:"cost"` // The meeting's cost so far
 }
 
 type IncrementDTO struct {
@@ -77,6 +337,35 @@ type IncrementDTO struct {
 	Purpose       string    `json:"purpose"`
 }
 
+// CorrectIncrementRequest changes the fields of an increment that are set.
+// The corrected times must not overlap the meeting's other increments.
+type CorrectIncrementRequest struct {
+	StartTime     *time.Time `json:"start_time"`
+	StopTime      *time.Time `json:"stop_time"`
+	AttendeeCount *int       `json:"attendee_count"`
+	AverageWage   *float64   `json:"average_wage"`
+	Purpose       *string    `json:"purpose"`
+	IPAddress     string     `json:"-"`
+	UserAgent     string     `json:"-"`
+}
+
+// UpdateIncrementRequest changes the fields of a running meeting that are
+// set; at least one must be.
+type UpdateIncrementRequest struct {
+	AttendeeCount *int     `json:"attendee_count"`
+	AverageWage   *float64 `json:"average_wage"`
+	Purpose       *string  `json:"purpose"`
+	IPAddress     string   `json:"-"`
+	UserAgent     string   `json:"-"`
+}
+
+// UndoDTO is the result of undoing a meeting's last change, and the
+// payload of EventMeetingUndo.
+type UndoDTO struct {
+	RemovedIncrementID uuid.UUID    `json:"removed_increment_id"`
+	Increment          IncrementDTO `json:"increment"` // The reopened increment
+}
+
 type ParticipantDTO struct {
 	PersonID uuid.UUID  `json:"person_id"`
 	Email    string     `json:"email"`
@@ -85,6 +374,38 @@ type ParticipantDTO struct {
 	LeftAt   *time.Time `json:"left_at"`
 }
 
+// MeetingShareTTL is how long a meeting's share token lets people check in.
+const MeetingShareTTL = 12 * time.Hour
+
+type MeetingShareDTO struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CheckInCodeTTL is how long the link in a check-in QR code works. Rooms
+// showing the code fetch a fresh one before it expires, so a photo of it
+// soon stops working.
+const CheckInCodeTTL = 15 * time.Minute
+
+// CheckInCode is a check-in link and its QR code as a PNG.
+type CheckInCode struct {
+	URL       string
+	ExpiresAt time.Time
+	PNG       []byte
+}
+
+// CheckInDTO is the requester's attendance after checking in or out.
+type CheckInDTO struct {
+	MeetingID uuid.UUID  `json:"meeting_id"`
+	PersonID  uuid.UUID  `json:"person_id"`
+	Present   bool       `json:"present"`
+	JoinedAt  *time.Time `json:"joined_at"`
+	LeftAt    *time.Time `json:"left_at"`
+	// AttendeeCount is the meeting's live attendee count, 0 while it is
+	// not running
+	AttendeeCount int `json:"attendee_count"`
+}
+
 type MeetingCostDTO struct {
 	TotalCost     float64 `json:"total_cost"`
 	TotalDuration int     `json:"total_duration"` // seconds
@@ -93,6 +414,19 @@ type MeetingCostDTO struct {
 	CostPerHour   float64 `json:"cost_per_hour"`
 }
 
+// CostTickEvent is the payload of EventCostTick: the meeting's cost as of
+// At.
+type CostTickEvent struct {
+	MeetingCostDTO
+	At time.Time `json:"at"`
+}
+
+// MeetingExpand selects optional associations to embed in a MeetingDTO.
+type MeetingExpand struct {
+	Increments   bool
+	Participants bool
+}
+
 // MeetingFilters here mirrors repository.MeetingFilters, but is kept separate
 // so the service API remains decoupled from repository concerns.
 type MeetingFilters struct {
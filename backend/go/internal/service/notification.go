@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Notification events a person can choose channels for.
+const (
+	NotificationBudgetExceeded     = "budget.exceeded"
+	NotificationCostThreshold      = "meeting.cost_threshold"
+	NotificationMeetingAutoStopped = "meeting.auto_stopped"
+	NotificationInviteAccepted     = "invite.accepted"
+	NotificationWeeklyDigest       = "digest.weekly" // Email only
+	NotificationTrialEnding        = "billing.trial_ending"
+	NotificationTrialEnded         = "billing.trial_ended"
+	NotificationMeetingSurvey      = "meeting.survey"
+	NotificationApprovalRequested  = "meeting.approval_requested"
+	NotificationApprovalDecided    = "meeting.approval_decided"
+)
+
+// NotificationEvents lists every notification event.
+var NotificationEvents = []string{
+	NotificationBudgetExceeded,
+	NotificationCostThreshold,
+	NotificationMeetingAutoStopped,
+	NotificationInviteAccepted,
+	NotificationWeeklyDigest,
+	NotificationTrialEnding,
+	NotificationTrialEnded,
+	NotificationMeetingSurvey,
+	NotificationApprovalRequested,
+	NotificationApprovalDecided,
+}
+
+// Notification channels.
+const (
+	ChannelEmail = "email"
+	ChannelSlack = "slack"
+	ChannelPush  = "push"
+)
+
+// NotificationService sends people notifications on the channels they
+// choose per event.
+type NotificationService interface {
+	// Notify queues n on every channel the person has enabled for event.
+	Notify(ctx context.Context, personID uuid.UUID, event string, n Notification) error
+	// Deliver sends one queued notification. It runs in the worker.
+	Deliver(ctx context.Context, payload SendNotificationPayload) error
+	// Enabled reports whether the person wants event on channel, for
+	// senders that don't go through Notify.
+	Enabled(ctx context.Context, personID uuid.UUID, event, channel string) (bool, error)
+
+	// Unsubscribing from email links
+	// UnsubscribeURL returns a link that turns event off on the email
+	// channel for the person without signing in.
+	UnsubscribeURL(personID uuid.UUID, event string) string
+	// Unsubscribe applies an UnsubscribeURL token and returns its event.
+	Unsubscribe(ctx context.Context, token string) (string, error)
+
+	// Preferences
+	GetPreferences(ctx context.Context, personID uuid.UUID) (*NotificationPreferencesDTO, error)
+	UpdatePreferences(ctx context.Context, personID uuid.UUID, req UpdateNotificationPreferencesRequest) (*NotificationPreferencesDTO, error)
+
+	// Web Push
+	// PushPublicKey returns the VAPID key browsers subscribe with, or ""
+	// when Web Push is not configured.
+	PushPublicKey() string
+	AddPushSubscription(ctx context.Context, personID uuid.UUID, req PushSubscriptionRequest) (*PushSubscriptionDTO, error)
+	RemovePushSubscription(ctx context.Context, personID, subscriptionID uuid.UUID) error
+}
+
+// Notification is the content of a notification, rendered by each channel.
+type Notification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	// URL links to the subject of the notification, if any
+	URL string `json:"url,omitempty"`
+}
+
+type NotificationPreferenceDTO struct {
+	Event   string `json:"event" validate:"required"`
+	Channel string `json:"channel" validate:"required"`
+	Enabled bool   `json:"enabled"`
+}
+
+// NotificationPreferencesDTO lists a person's setting for every event and
+// available channel.
+type NotificationPreferencesDTO struct {
+	// Channels are the channels this deployment has configured
+	Channels    []string                    `json:"channels"`
+	Preferences []NotificationPreferenceDTO `json:"preferences"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []NotificationPreferenceDTO `json:"preferences" validate:"required"`
+}
+
+// PushSubscriptionRequest is the JSON of a browser PushSubscription.
+type PushSubscriptionRequest struct {
+	Endpoint string `json:"endpoint" validate:"required"`
+	Keys     struct {
+		P256dh string `json:"p256dh" validate:"required"`
+		Auth   string `json:"auth" validate:"required"`
+	} `json:"keys" validate:"required"`
+	UserAgent string `json:"-"`
+}
+
+type PushSubscriptionDTO struct {
+	ID       uuid.UUID `json:"id"`
+	Endpoint string    `json:"endpoint"`
+}
@@ -16,11 +16,41 @@ type OrganizationService interface {
 	UpdateOrganization(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req UpdateOrganizationRequest) (*OrganizationDTO, error)
 	DeleteOrganization(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error
 
+	// Discovery
+	// DiscoverOrganizations searches by name the organizations the person
+	// can join without an invitation: public ones, and those discoverable
+	// by the domain of their sign-in email or a verified secondary email.
+	DiscoverOrganizations(ctx context.Context, requesterID uuid.UUID, query string) ([]*DiscoverableOrganizationDTO, error)
+	// JoinOrganization makes the person a member, with the Member role, of
+	// an organization they can discover.
+	JoinOrganization(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error
+
 	// Members
 	GetMembers(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*MemberDTO, error)
 	AddMember(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req AddMemberRequest) error
 	RemoveMember(ctx context.Context, orgID uuid.UUID, requesterID, memberID uuid.UUID, ipAddress, userAgent string) error
 	UpdateMemberWage(ctx context.Context, orgID uuid.UUID, personID uuid.UUID, wage float64, requesterID uuid.UUID, ipAddress, userAgent string) error
+	// SetMeetingApprover designates a member to decide on meetings that
+	// need approval, or withdraws them.
+	SetMeetingApprover(ctx context.Context, orgID uuid.UUID, personID uuid.UUID, approver bool, requesterID uuid.UUID, ipAddress, userAgent string) error
+	// SetMemberWageBand assigns a member a wage band in place of an exact
+	// wage, or unassigns them when bandID is nil.
+	SetMemberWageBand(ctx context.Context, orgID uuid.UUID, personID uuid.UUID, bandID *uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error
+
+	// Wage bands
+	ListWageBands(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*WageBandDTO, error)
+	CreateWageBand(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req WageBandRequest) (*WageBandDTO, error)
+	UpdateWageBand(ctx context.Context, orgID, bandID uuid.UUID, requesterID uuid.UUID, req WageBandRequest) (*WageBandDTO, error)
+	// DeleteWageBand refuses while active members are assigned the band.
+	DeleteWageBand(ctx context.Context, orgID, bandID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error
+
+	// Holidays
+	// ListHolidays returns the organization's holidays in year, or every
+	// holiday when year is 0.
+	ListHolidays(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, year int) ([]*HolidayDTO, error)
+	CreateHoliday(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req HolidayRequest) (*HolidayDTO, error)
+	UpdateHoliday(ctx context.Context, orgID, holidayID uuid.UUID, requesterID uuid.UUID, req HolidayRequest) (*HolidayDTO, error)
+	DeleteHoliday(ctx context.Context, orgID, holidayID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error
 
 	// Settings
 	UpdateSettings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, settings map[string]interface{}) error
@@ -45,8 +75,39 @@ type UpdateOrganizationRequest struct {
 	Name        *string  `json:"name,omitempty"`
 	Description *string  `json:"description,omitempty"`
 	DefaultWage *float64 `json:"default_wage,omitempty"`
-	IPAddress   string   `json:"-"`
-	UserAgent   string   `json:"-"`
+	// SeatOverage is "block" or "add_seat"
+	SeatOverage *string `json:"seat_overage,omitempty"`
+	// WageVisibility is "managers_and_self", "admins" or "aggregate"
+	WageVisibility *string `json:"wage_visibility,omitempty"`
+	// AggregateOnly turns on the mode that stores no individual wages,
+	// clearing every member's wage and wage band, or turns it off
+	AggregateOnly *bool `json:"aggregate_only,omitempty"`
+	// MonthlyBudget sets the budget for meetings per calendar month; 0
+	// removes it
+	MonthlyBudget *float64 `json:"monthly_budget,omitempty"`
+	// ApprovalThreshold sets the projected cost above which scheduled
+	// meetings need approval before they start; 0 removes it
+	ApprovalThreshold *float64 `json:"approval_threshold,omitempty"`
+	// TargetAttendeeHourCost and TargetMeetingMinutes set the benchmarks
+	// reports compare meetings with; 0 removes them
+	TargetAttendeeHourCost *float64 `json:"target_attendee_hour_cost,omitempty"`
+	TargetMeetingMinutes   *int     `json:"target_meeting_minutes,omitempty"`
+	// MeetingSurveys turns the post-meeting survey on or off
+	MeetingSurveys *bool `json:"meeting_surveys,omitempty"`
+	// Timezone is the IANA zone reports count days in, such as
+	// "Australia/Sydney"; "" removes it
+	Timezone *string `json:"timezone,omitempty"`
+	// WorkingHours sets when the organization works, in its timezone,
+	// which must be set; an empty object removes them
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+	// Visibility is "invite_only", "domain" or "public"
+	Visibility *string `json:"visibility,omitempty"`
+	// Domain is the email domain whose people can find and join the
+	// organization when it is discoverable by domain. The requester needs
+	// an email at the domain themselves; "" removes it
+	Domain    *string `json:"domain,omitempty"`
+	IPAddress string  `json:"-"`
+	UserAgent string  `json:"-"`
 }
 
 type OrganizationDTO struct {
@@ -56,8 +117,40 @@ type OrganizationDTO struct {
 	Description    string    `json:"description"`
 	DefaultWage    float64   `json:"default_wage"`
 	UseBlendedWage bool      `json:"use_blended_wage"`
+	SeatOverage    string    `json:"seat_overage"`
+	WageVisibility string    `json:"wage_visibility"`
+	AggregateOnly  bool      `json:"aggregate_only"`
+	MonthlyBudget  *float64  `json:"monthly_budget,omitempty"`
+	Timezone       string    `json:"timezone,omitempty"`
 	CreatedAt      time.Time `json:"created_at"`
 	MemberCount    int       `json:"member_count"`
+
+	// WorkingHours are set when the organization has set them
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+
+	// Benchmarks, when set
+	TargetAttendeeHourCost *float64 `json:"target_attendee_hour_cost,omitempty"`
+	TargetMeetingMinutes   *int     `json:"target_meeting_minutes,omitempty"`
+
+	MeetingSurveys bool `json:"meeting_surveys"`
+
+	// ApprovalThreshold is set when costly meetings need approval
+	ApprovalThreshold *float64 `json:"approval_threshold,omitempty"`
+
+	Visibility string `json:"visibility"`
+	Domain     string `json:"domain,omitempty"`
+}
+
+// DiscoverableOrganizationDTO is an organization as people who can join it
+// without an invitation see it.
+type DiscoverableOrganizationDTO struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	Visibility  string    `json:"visibility"`
+	// Member is true when the person is already an active member
+	Member bool `json:"member"`
 }
 
 type MemberDTO struct {
@@ -66,9 +159,15 @@ type MemberDTO struct {
 	FirstName  string    `json:"first_name"`
 	LastName   string    `json:"last_name"`
 	IsActive   bool      `json:"is_active"`
-	HourlyWage *float64  `json:"hourly_wage,omitempty"` // Only visible to authorized users
+	HourlyWage *float64  `json:"hourly_wage,omitempty"` // Only as the organization's wage visibility allows
 	JoinedAt   time.Time `json:"joined_at"`
 	Roles      []string  `json:"roles"`
+	// MeetingApprover is true for members designated to decide on
+	// meetings that need approval
+	MeetingApprover bool `json:"meeting_approver"`
+	// WageBandID is the member's wage band, when they have one in place of
+	// an exact wage; shown as the wage visibility allows
+	WageBandID *uuid.UUID `json:"wage_band_id,omitempty"`
 }
 
 type AddMemberRequest struct {
@@ -79,6 +178,54 @@ type AddMemberRequest struct {
 	UserAgent string    `json:"-"`
 }
 
+// WageBandRequest defines a wage band: a range of hourly wages whose
+// midpoint stands in for the exact wages of the members assigned it.
+type WageBandRequest struct {
+	Name      string  `json:"name" validate:"required"`
+	MinWage   float64 `json:"min_wage" validate:"min=0"`
+	MaxWage   float64 `json:"max_wage" validate:"gt=0"`
+	IPAddress string  `json:"-"`
+	UserAgent string  `json:"-"`
+}
+
+type WageBandDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	MinWage   float64   `json:"min_wage"`
+	MaxWage   float64   `json:"max_wage"`
+	Midpoint  float64   `json:"midpoint"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WorkingHours are when an organization works, in its timezone. Meetings
+// that start outside them, or on a holiday, are reported apart, and
+// scheduling one outside them warns.
+type WorkingHours struct {
+	// Days are the days worked: "mon", "tue", "wed", "thu", "fri", "sat"
+	// or "sun"
+	Days []string `json:"days"`
+	// Start and End bound the working day, as "09:00" and "17:30"
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// HolidayRequest adds a day the organization doesn't work to its holiday
+// calendar.
+type HolidayRequest struct {
+	// Date is the day, as "2026-12-25", in the organization's timezone
+	Date      string `json:"date" validate:"required"`
+	Name      string `json:"name" validate:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type HolidayDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Date      string    `json:"date"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type RoleDTO struct {
 	ID          uuid.UUID `json:"id"`
 	Name        string    `json:"name"`
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PersonEmailService manages a person's secondary email addresses, such as
+// a work alias calendars invite them by. Integrations match participants by
+// MatchPerson, which consults verified secondary addresses as well as the
+// one the person signs in with.
+type PersonEmailService interface {
+	ListEmails(ctx context.Context, personID uuid.UUID) ([]*PersonEmailDTO, error)
+	// AddEmail emails a verification link to the address. Adding an
+	// address the person has yet to verify sends a new link.
+	AddEmail(ctx context.Context, personID uuid.UUID, req AddPersonEmailRequest) (*PersonEmailDTO, error)
+	// VerifyEmail verifies the address a link's token was sent to.
+	VerifyEmail(ctx context.Context, token string) (*PersonEmailDTO, error)
+	RemoveEmail(ctx context.Context, personID, emailID uuid.UUID, ipAddress, userAgent string) error
+
+	// MatchPerson returns the person whose sign-in email or verified
+	// secondary email is email.
+	MatchPerson(ctx context.Context, email string) (uuid.UUID, error)
+}
+
+// PersonEmailVerificationTTL is how long an email verification link works.
+const PersonEmailVerificationTTL = 48 * time.Hour
+
+// MaxPersonEmails is how many secondary emails a person can have.
+const MaxPersonEmails = 10
+
+type AddPersonEmailRequest struct {
+	Email     string `json:"email" validate:"required,email"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type PersonEmailDTO struct {
+	ID         uuid.UUID  `json:"id"`
+	Email      string     `json:"email"`
+	Verified   bool       `json:"verified"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
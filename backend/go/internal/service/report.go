@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+	// Timezone names must resolve on hosts without a zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+)
+
+// DefaultReportDays is how far back a report reaches when it is given no
+// start.
+const DefaultReportDays = 30
+
+// ReportService reports on an organization's meeting costs to its members.
+// Reports reach back only as far as the organization's plan retains them.
+type ReportService interface {
+	// GetSummary totals the meetings started in the range.
+	GetSummary(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, r ReportRange) (*CostSummaryDTO, error)
+	// GetTrend buckets the cost and hours of meeting time in the range by
+	// day, week or month, for charting.
+	GetTrend(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req TrendRequest) (*CostTrendDTO, error)
+	// GetTopMeetings returns the most expensive meetings started in the
+	// range, and the most expensive recurring series: meetings sharing a
+	// purpose.
+	GetTopMeetings(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req TopMeetingsRequest) (*TopMeetingsDTO, error)
+	// GetEffectiveness weighs what the meetings started in the range cost
+	// against how their participants rated them, with the most expensive
+	// rated meetings.
+	GetEffectiveness(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req TopMeetingsRequest) (*EffectivenessDTO, error)
+	// GetDashboard returns what the home screen shows in one call: the
+	// meetings running now, what today and this week have cost so far, and
+	// how the month compares with the organization's budget.
+	GetDashboard(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) (*DashboardDTO, error)
+	// Location returns the timezone the organization's reports count
+	// days, weeks and months in for requester: the organization's, else
+	// the requester's own, else UTC.
+	Location(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) (*time.Location, error)
+	// RefreshRollups brings the daily aggregates that summaries and
+	// trends read up to date as of now, returning how many organization
+	// days changed. It is run by the worker.
+	RefreshRollups(ctx context.Context, now time.Time) (int64, error)
+}
+
+// TrendIntervals are the bucket sizes of a trend: days, ISO weeks
+// starting Monday, and calendar months, in the report's timezone.
+var TrendIntervals = []string{"day", "week", "month"}
+
+// MaxTrendBuckets bounds how many buckets one trend series may have.
+const MaxTrendBuckets = 400
+
+// ReportRange selects meetings started from From up to, but not including,
+// To. A zero To is now and a zero From is DefaultReportDays before To.
+type ReportRange struct {
+	From time.Time
+	To   time.Time
+	// FromDate and ToDate mark a From or To given as a date, held as
+	// midnight UTC until In places it in the report's timezone
+	FromDate bool
+	ToDate   bool
+}
+
+// In returns r with its dates moved to midnight in loc.
+func (r ReportRange) In(loc *time.Location) ReportRange {
+	if r.FromDate {
+		r.From = time.Date(r.From.Year(), r.From.Month(), r.From.Day(), 0, 0, 0, 0, loc)
+	}
+	if r.ToDate {
+		r.To = time.Date(r.To.Year(), r.To.Month(), r.To.Day(), 0, 0, 0, 0, loc)
+	}
+	r.FromDate, r.ToDate = false, false
+	return r
+}
+
+// LoadTimezone returns the location of an IANA timezone name; "" is UTC.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, fmt.Errorf("invalid timezone: %q is not an IANA timezone name", name)
+	}
+	return loc, nil
+}
+
+// Resolve returns r with its defaults filled in as of now.
+func (r ReportRange) Resolve(now time.Time) (ReportRange, error) {
+	if r.To.IsZero() {
+		r.To = now
+	}
+	if r.From.IsZero() {
+		r.From = r.To.AddDate(0, 0, -DefaultReportDays)
+	}
+	if !r.From.Before(r.To) {
+		return r, fmt.Errorf("invalid range: from must be before to")
+	}
+	return r, nil
+}
+
+type TrendRequest struct {
+	ReportRange
+	// Interval is one of TrendIntervals; empty is "day"
+	Interval string
+	// Compare adds the same length of time just before the range
+	Compare bool
+}
+
+type CostTrendDTO struct {
+	Interval string `json:"interval"`
+	// Timezone is the IANA zone the buckets are counted in
+	Timezone string          `json:"timezone"`
+	Current  CostTrendSeries `json:"current"`
+	// Previous is the period of the same length just before Current,
+	// when a comparison was asked for
+	Previous *CostTrendSeries `json:"previous,omitempty"`
+	// CostChangePercent compares Current's cost with Previous's; empty
+	// without a comparison or when Previous cost nothing
+	CostChangePercent *float64 `json:"cost_change_percent,omitempty"`
+}
+
+// CostTrendSeries is one period of a trend. It has a bucket for every
+// interval in the period, including those without meetings.
+type CostTrendSeries struct {
+	From       time.Time         `json:"from"`
+	To         time.Time         `json:"to"`
+	TotalCost  float64           `json:"total_cost"`
+	TotalHours float64           `json:"total_hours"`
+	Buckets    []CostTrendBucket `json:"buckets"`
+}
+
+type CostTrendBucket struct {
+	// Start of the interval, in the trend's timezone
+	Start time.Time `json:"start"`
+	Cost  float64   `json:"cost"`
+	Hours float64   `json:"hours"`
+}
+
+type TopMeetingsRequest struct {
+	ReportRange
+	// Limit is how many meetings, and how many series, to return
+	Limit int
+}
+
+type TopMeetingsDTO struct {
+	From     time.Time          `json:"from"`
+	To       time.Time          `json:"to"`
+	Meetings []TopMeetingDTO    `json:"meetings"`
+	Series   []MeetingSeriesDTO `json:"series"`
+}
+
+type TopMeetingDTO struct {
+	MeetingID       uuid.UUID     `json:"meeting_id"`
+	Purpose         string        `json:"purpose"`
+	StartedAt       time.Time     `json:"started_at"`
+	DurationSeconds int64         `json:"duration_seconds"`
+	Attendees       int           `json:"attendees"` // Peak attendance
+	TotalCost       float64       `json:"total_cost"`
+	Organizer       *OrganizerDTO `json:"organizer,omitempty"` // Unset in aggregate-only organizations
+	Benchmark       *BenchmarkDTO `json:"benchmark,omitempty"`
+	// OutsideHours is "off_hours" or "holiday" for a meeting that started
+	// outside the organization's working hours
+	OutsideHours string `json:"outside_hours,omitempty"`
+}
+
+// PoorRating is the average rating at or below which a meeting counts as
+// poorly rated.
+const PoorRating = 2.0
+
+type EffectivenessDTO struct {
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	RatedMeetings int64     `json:"rated_meetings"`
+	Responses     int64     `json:"responses"`
+	// AvgRating averages every response; it is unset without any
+	AvgRating *float64 `json:"average_rating,omitempty"`
+	RatedCost float64  `json:"rated_cost"`
+	// PoorlyRatedMeetings and PoorlyRatedCost cover the rated meetings
+	// averaging PoorRating or less, and PoorlyRatedPercent is their share
+	// of RatedCost
+	PoorlyRatedMeetings int64             `json:"poorly_rated_meetings"`
+	PoorlyRatedCost     float64           `json:"poorly_rated_cost"`
+	PoorlyRatedPercent  *float64          `json:"poorly_rated_percent,omitempty"`
+	Meetings            []RatedMeetingDTO `json:"meetings"`
+}
+
+type RatedMeetingDTO struct {
+	MeetingID       uuid.UUID `json:"meeting_id"`
+	Purpose         string    `json:"purpose"`
+	StartedAt       time.Time `json:"started_at"`
+	DurationSeconds int64     `json:"duration_seconds"`
+	Attendees       int       `json:"attendees"` // Peak attendance
+	TotalCost       float64   `json:"total_cost"`
+	Responses       int64     `json:"responses"`
+	AvgRating       float64   `json:"average_rating"`
+	PoorlyRated     bool      `json:"poorly_rated"`
+}
+
+type OrganizerDTO struct {
+	PersonID  uuid.UUID `json:"person_id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+}
+
+// MeetingSeriesDTO is a recurring meeting: two or more meetings with the
+// same purpose, ignoring case.
+type MeetingSeriesDTO struct {
+	Purpose         string        `json:"purpose"`
+	MeetingCount    int64         `json:"meeting_count"`
+	TotalCost       float64       `json:"total_cost"`
+	DurationSeconds int64         `json:"duration_seconds"`
+	AvgAttendees    float64       `json:"average_attendees"`
+	LastStartedAt   time.Time     `json:"last_started_at"`
+	Benchmark       *BenchmarkDTO `json:"benchmark,omitempty"`
+}
+
+type CostSummaryDTO struct {
+	// The range reported on; From is later than asked when the plan does
+	// not retain reports that far back
+	From              time.Time     `json:"from"`
+	To                time.Time     `json:"to"`
+	MeetingCount      int64         `json:"meeting_count"`
+	TotalCost         float64       `json:"total_cost"`
+	TotalHours        float64       `json:"total_hours"`
+	AvgCostPerMeeting float64       `json:"average_cost_per_meeting"`
+	AvgAttendees      float64       `json:"average_attendees"`
+	Benchmark         *BenchmarkDTO `json:"benchmark,omitempty"`
+	// LateStarts is set when any of the meetings were scheduled
+	LateStarts *LateStartDTO `json:"late_starts,omitempty"`
+	// Overruns is set when any of the meetings were scheduled to end
+	Overruns *OverrunDTO `json:"overruns,omitempty"`
+	// OffHours is set when the organization has set working hours
+	OffHours *OffHoursDTO `json:"off_hours,omitempty"`
+	// Wages is set for requesters the organization's wage visibility lets
+	// see wage averages, once enough members have a wage
+	Wages *WageSummaryDTO `json:"wages,omitempty"`
+}
+
+// MinWageGroup is the fewest members with a wage that wage averages are
+// reported over, so an average never gives away someone's wage.
+const MinWageGroup = 3
+
+// WageSummaryDTO averages the hourly wages of the organization's active
+// members who have one.
+type WageSummaryDTO struct {
+	Members           int     `json:"members"`
+	AverageHourlyWage float64 `json:"average_hourly_wage"`
+}
+
+// LateStartDTO is how the scheduled meetings in a report started against
+// their scheduled times, and what starting late cost.
+type LateStartDTO struct {
+	ScheduledMeetings int64   `json:"scheduled_meetings"`
+	LateMeetings      int64   `json:"late_meetings"`
+	MinutesLate       float64 `json:"minutes_late"`
+	AvgMinutesLate    float64 `json:"average_minutes_late"` // Of the late meetings
+	// Cost is what attendees who checked in before a late start cost while
+	// they waited
+	Cost float64 `json:"cost"`
+}
+
+// OverrunDTO is how the meetings in a report with a scheduled end ran
+// against it, and what running over cost.
+type OverrunDTO struct {
+	ScheduledMeetings int64   `json:"scheduled_meetings"`
+	OverrunMeetings   int64   `json:"overrun_meetings"`
+	MinutesOver       float64 `json:"minutes_over"`
+	AvgMinutesOver    float64 `json:"average_minutes_over"` // Of the meetings that ran over
+	// Cost is what the meetings cost past their scheduled ends
+	Cost float64 `json:"cost"`
+}
+
+// OffHoursDTO is what the meetings in a report that started outside the
+// organization's working hours cost: on its holidays, and otherwise
+// outside working time. Meetings count by when they started.
+type OffHoursDTO struct {
+	OffHoursMeetings int64   `json:"off_hours_meetings"`
+	OffHoursHours    float64 `json:"off_hours_hours"`
+	OffHoursCost     float64 `json:"off_hours_cost"`
+	HolidayMeetings  int64   `json:"holiday_meetings"`
+	HolidayHours     float64 `json:"holiday_hours"`
+	HolidayCost      float64 `json:"holiday_cost"`
+	// PercentOfCost is both costs' share of the report's total; empty
+	// when the meetings cost nothing
+	PercentOfCost *float64 `json:"percent_of_cost,omitempty"`
+}
+
+// Benchmark statuses: how meetings compare with a target.
+const (
+	BenchmarkBelow = "below" // At or below the target
+	BenchmarkAbove = "above"
+)
+
+// BenchmarkDTO compares a meeting, or a set of them, with the
+// organization's targets. It is left out of reports when the organization
+// has set none, and a comparison is empty when its target is not set or
+// there is nothing to compare.
+type BenchmarkDTO struct {
+	// CostPerAttendeeHour is the cost of one attendee for an hour
+	CostPerAttendeeHour    float64  `json:"cost_per_attendee_hour"`
+	TargetAttendeeHourCost *float64 `json:"target_attendee_hour_cost,omitempty"`
+	CostStatus             string   `json:"cost_status,omitempty"`
+	// AverageMinutes is how long the meetings ran on average
+	AverageMinutes       float64 `json:"average_minutes"`
+	TargetMeetingMinutes *int    `json:"target_meeting_minutes,omitempty"`
+	LengthStatus         string  `json:"length_status,omitempty"`
+	// HealthScore is 100 when every target is met, falling in proportion
+	// as meetings exceed them: twice the target scores 50
+	HealthScore *int `json:"health_score,omitempty"`
+}
+
+// Budget statuses, by the share of the monthly budget spent.
+const (
+	BudgetOK       = "ok"
+	BudgetWarning  = "warning" // BudgetWarningPercent or more spent
+	BudgetExceeded = "exceeded"
+)
+
+// BudgetWarningPercent is the share of the monthly budget, in percent, from
+// which the budget status is BudgetWarning.
+const BudgetWarningPercent = 80
+
+// DashboardDTO is the home screen. Periods are in the report timezone,
+// end now and count the meetings still running up to now.
+type DashboardDTO struct {
+	AsOf           time.Time          `json:"as_of"`
+	Timezone       string             `json:"timezone"`
+	ActiveMeetings []ActiveMeetingDTO `json:"active_meetings"`
+	Today          DashboardPeriodDTO `json:"today"`
+	ThisWeek       DashboardPeriodDTO `json:"this_week"` // Since Monday
+	LastWeek       DashboardPeriodDTO `json:"last_week"` // The same span a week earlier
+	// WeekChangePercent compares this week's cost with last week's; empty
+	// when last week cost nothing
+	WeekChangePercent *float64 `json:"week_change_percent,omitempty"`
+	// Budget is empty when the organization has no monthly budget
+	Budget *DashboardBudgetDTO `json:"budget,omitempty"`
+}
+
+// ActiveMeetingDTO is a running meeting and its cost so far.
+type ActiveMeetingDTO struct {
+	MeetingID      uuid.UUID `json:"meeting_id"`
+	Purpose        string    `json:"purpose"`
+	StartedAt      time.Time `json:"started_at"`
+	Attendees      int       `json:"attendees"`
+	ElapsedSeconds int       `json:"elapsed_seconds"`
+	Cost           float64   `json:"cost"`
+	// CostPerHour is what the meeting costs at its current attendance
+	CostPerHour float64 `json:"cost_per_hour"`
+}
+
+type DashboardPeriodDTO struct {
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+	Cost  float64   `json:"cost"`
+	Hours float64   `json:"hours"`
+}
+
+// DashboardBudgetDTO is the month to date against the monthly budget.
+type DashboardBudgetDTO struct {
+	MonthlyBudget float64 `json:"monthly_budget"`
+	Spent         float64 `json:"spent"`
+	// Remaining is negative once the budget is exceeded
+	Remaining   float64 `json:"remaining"`
+	PercentUsed float64 `json:"percent_used"`
+	Status      string  `json:"status"` // ok, warning or exceeded
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reports that can be exported.
+const (
+	ReportSummary       = "summary"
+	ReportTrends        = "trends"
+	ReportTopMeetings   = "top-meetings"
+	ReportEffectiveness = "effectiveness"
+)
+
+// ExportReports lists every report that can be exported.
+var ExportReports = []string{ReportSummary, ReportTrends, ReportTopMeetings, ReportEffectiveness}
+
+// ReportExportService renders reports to PDF or XLSX files in the worker,
+// for sharing with people who don't sign in. A finished export is
+// downloaded through a signed link that works without signing in until the
+// export expires.
+type ReportExportService interface {
+	// RequestExport checks the report's parameters and queues the export
+	// for the worker.
+	RequestExport(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req ReportExportRequest) (*ReportExportDTO, error)
+	// GetExport returns an export's status, with its download link once
+	// it is ready.
+	GetExport(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, exportID uuid.UUID) (*ReportExportDTO, error)
+	// GenerateExport renders a queued export. It is run by the worker, as
+	// the member who requested it.
+	GenerateExport(ctx context.Context, exportID uuid.UUID) error
+	// Download returns the file a download link's token refers to.
+	Download(ctx context.Context, token string) (*ReportFile, error)
+	// PurgeExpired deletes exports that expired before now.
+	PurgeExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type ReportExportRequest struct {
+	// Report is one of ExportReports
+	Report string
+	// Format is one of export.Formats
+	Format string
+	ReportRange
+	// Interval and Compare apply to trends
+	Interval string
+	Compare  bool
+	// Limit applies to top meetings and effectiveness
+	Limit int
+}
+
+type ReportExportDTO struct {
+	ID     uuid.UUID `json:"id"`
+	Report string    `json:"report"`
+	Format string    `json:"format"`
+	// From and To are the range requested; the file states the range
+	// covered after the plan's report retention
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Interval string    `json:"interval,omitempty"`
+	Compare  bool      `json:"compare,omitempty"`
+	Limit    int       `json:"limit,omitempty"`
+	Status   string    `json:"status"`
+	Error    string    `json:"error,omitempty"`
+	FileName string    `json:"file_name,omitempty"`
+	// DownloadURL is set once the export is ready. Anyone with the link
+	// can download the file until ExpiresAt.
+	DownloadURL string     `json:"download_url,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+}
+
+// ReportFile is a rendered export.
+type ReportFile struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// PlanTypes lists the plans an organization can subscribe to, cheapest
+// first.
+var PlanTypes = []string{
+	models.PlanFree,
+	models.PlanBasic,
+	models.PlanPremium,
+	models.PlanEnterprise,
+}
+
+// SubscriptionService manages an organization's plan. With Stripe
+// configured, paid plans are bought through Checkout and kept in sync by
+// Stripe's webhook events.
+type SubscriptionService interface {
+	// GetSubscription returns the organization's current subscription. An
+	// organization that never subscribed is on the free plan.
+	GetSubscription(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) (*SubscriptionDTO, error)
+	// ChangePlan moves the organization to another plan, starting a new
+	// billing period if the subscription had lapsed. With Stripe, only a
+	// Stripe subscription can change plan; moving to free cancels it.
+	ChangePlan(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req ChangePlanRequest) (*SubscriptionDTO, error)
+	// CancelSubscription cancels the subscription at the end of its current
+	// period.
+	CancelSubscription(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) (*SubscriptionDTO, error)
+
+	// Billing history, for admins
+	// ListInvoices returns the organization's invoices, most recently
+	// issued first.
+	ListInvoices(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, pagination Pagination) ([]*InvoiceDTO, int64, error)
+	// ListPayments returns the organization's payments and failed payment
+	// attempts, newest first.
+	ListPayments(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, pagination Pagination) ([]*PaymentDTO, int64, error)
+
+	// Stripe billing; these fail when Stripe is not configured
+	// CreateCheckoutSession returns a Stripe Checkout link that subscribes
+	// the organization to a paid plan.
+	CreateCheckoutSession(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req CheckoutRequest) (*BillingSessionDTO, error)
+	// CreatePortalSession returns a Stripe Customer Portal link for the
+	// organization's billing details and invoices.
+	CreatePortalSession(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req PortalRequest) (*BillingSessionDTO, error)
+	// HandleStripeEvent verifies a Stripe webhook request and syncs the
+	// subscription or payment it reports.
+	HandleStripeEvent(ctx context.Context, payload []byte, signature string) error
+
+	// ClaimSeat makes room for one more active member on a per-seat plan.
+	// When every seat is taken it adds a seat, prorated, if the
+	// organization's seat overage setting allows, and otherwise fails with
+	// a SEATS_EXHAUSTED DomainError. Other plans have no seats to claim.
+	ClaimSeat(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) error
+
+	// Trials
+	// StartTrial puts a new organization on a trial of the trial plan. It
+	// does nothing when trials are off or the organization already has a
+	// subscription.
+	StartTrial(ctx context.Context, orgID uuid.UUID) error
+	// CheckTrials reminds admins of trials ending soon and moves lapsed
+	// trials to the free plan. It returns how many trials it changed. It
+	// runs in the worker.
+	CheckTrials(ctx context.Context, now time.Time) (int, error)
+}
+
+// TrialPolicy is how new organizations try a paid plan before paying.
+type TrialPolicy struct {
+	PlanType string
+	// Length of the trial; zero turns trials off
+	Length time.Duration
+	// RemindBefore is how long before the trial ends admins are reminded
+	RemindBefore time.Duration
+}
+
+type ChangePlanRequest struct {
+	PlanType  string `json:"plan_type" validate:"required"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type SubscriptionDTO struct {
+	// ID is empty for an organization that never subscribed
+	ID                 *uuid.UUID `json:"id"`
+	OrganizationID     uuid.UUID  `json:"organization_id"`
+	PlanType           string     `json:"plan_type"`
+	Status             string     `json:"status"`
+	CurrentPeriodStart *time.Time `json:"current_period_start"`
+	CurrentPeriodEnd   *time.Time `json:"current_period_end"`
+	// Seats paid for on a per-seat plan; zero on other plans
+	Seats int `json:"seats"`
+	// What the organization may use now; a canceled subscription or a trial
+	// past its period is back on the free plan's limits
+	Entitlements Entitlements `json:"entitlements"`
+}
+
+type InvoiceDTO struct {
+	ID          uuid.UUID `json:"id"`
+	Number      string    `json:"number"`
+	Status      string    `json:"status"`
+	Currency    string    `json:"currency"`
+	AmountDue   float64   `json:"amount_due"`
+	AmountPaid  float64   `json:"amount_paid"`
+	IssuedAt    time.Time `json:"issued_at"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// PaidAt is empty until the invoice is paid
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+	// Stripe's hosted page and PDF for the invoice
+	HostedInvoiceURL string `json:"hosted_invoice_url,omitempty"`
+	InvoicePDF       string `json:"invoice_pdf,omitempty"`
+}
+
+type PaymentDTO struct {
+	ID       uuid.UUID `json:"id"`
+	Amount   float64   `json:"amount"`
+	Currency string    `json:"currency"`
+	Status   string    `json:"status"`
+	// PaidAt is empty for a failed attempt
+	PaidAt     *time.Time `json:"paid_at,omitempty"`
+	ReceiptURL string     `json:"receipt_url,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CheckoutRequest struct {
+	PlanType string `json:"plan_type" validate:"required"`
+	// Where Checkout sends the browser afterwards; default to PUBLIC_URL
+	SuccessURL string `json:"success_url"`
+	CancelURL  string `json:"cancel_url"`
+}
+
+type PortalRequest struct {
+	// ReturnURL is where the portal's back link goes; defaults to PUBLIC_URL
+	ReturnURL string `json:"return_url"`
+}
+
+// BillingSessionDTO is a hosted Stripe page to send the browser to.
+type BillingSessionDTO struct {
+	URL string `json:"url"`
+}
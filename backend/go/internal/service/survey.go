@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The meeting survey: one question, answered on a scale.
+const (
+	SurveyQuestion = "How worthwhile was this meeting?"
+	MinRating      = 1 // Not worth the time
+	MaxRating      = 5 // Time well spent
+)
+
+// SurveyWindow is how long after a meeting stops its survey takes answers.
+const SurveyWindow = 7 * 24 * time.Hour
+
+// SurveyService asks participants how worthwhile a meeting was once it
+// stops, for organizations that turn surveys on. Participants answer
+// through a signed link in the survey notification, or from a signed-in
+// client prompted over the meeting's websocket.
+type SurveyService interface {
+	// OpenSurvey starts the survey of a meeting that just stopped, if its
+	// organization collects ratings: it broadcasts EventMeetingSurvey to
+	// the meeting and queues the notifications.
+	OpenSurvey(ctx context.Context, meetingID uuid.UUID) error
+	// SendSurveys notifies the meeting's participants and organizer of
+	// its survey. It runs in the worker.
+	SendSurveys(ctx context.Context, meetingID uuid.UUID) error
+
+	// GetSurvey returns the survey a link's token was sent for.
+	GetSurvey(ctx context.Context, token string) (*SurveyDTO, error)
+	// RespondByLink records the rating of the person a link's token was
+	// sent to.
+	RespondByLink(ctx context.Context, token string, rating int) (*SurveyDTO, error)
+	// Respond records the requester's rating of a meeting of their
+	// organization.
+	Respond(ctx context.Context, meetingID uuid.UUID, requesterID uuid.UUID, rating int) (*SurveyDTO, error)
+}
+
+// SurveyDTO is a meeting's survey as one person sees it.
+type SurveyDTO struct {
+	MeetingID uuid.UUID `json:"meeting_id"`
+	Purpose   string    `json:"purpose"`
+	StartedAt time.Time `json:"started_at"`
+	Question  string    `json:"question"`
+	MinRating int       `json:"min_rating"`
+	MaxRating int       `json:"max_rating"`
+	// Rating is the person's answer, once given; answering again changes it
+	Rating   *int      `json:"rating,omitempty"`
+	ClosesAt time.Time `json:"closes_at"`
+}
+
+// SurveyPrompt is the payload of EventMeetingSurvey.
+type SurveyPrompt struct {
+	Question  string    `json:"question"`
+	MinRating int       `json:"min_rating"`
+	MaxRating int       `json:"max_rating"`
+	ClosesAt  time.Time `json:"closes_at"`
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/email"
+)
+
+// Background task types, processed by the worker (cmd/worker).
+const (
+	TaskPurgeDeleted    = "maintenance:purge_deleted"
+	TaskCleanupSessions = "maintenance:cleanup_sessions"
+	TaskPartitions      = "maintenance:partitions"
+	TaskArchiveMeetings = "maintenance:archive_meetings"
+	TaskDeliverWebhook  = "webhook:deliver"
+	TaskSendEmail       = "email:send"
+	TaskNotify          = "notification:send"
+	TaskWeeklyDigests   = "digest:weekly"
+	TaskWeeklyDigest    = "digest:weekly_organization"
+	TaskCheckCostAlerts = "alerts:check"
+	TaskReportUsage     = "usage:report"
+	TaskCheckTrials     = "billing:check_trials"
+	TaskSendSurveys     = "meetings:send_surveys"
+	TaskCheckOverruns   = "meetings:check_overruns"
+
+	TaskGenerateReportExport = "reports:generate_export"
+	TaskPurgeReportExports   = "reports:purge_exports"
+	TaskRefreshReportRollups = "reports:refresh_rollups"
+
+	TaskPurgeAPIUsage = "api_usage:purge"
+
+	TaskProcessAccountDeletions = "accounts:process_deletions"
+	TaskCompactIncrements       = "maintenance:compact_increments"
+
+	TaskQueueWageSyncs = "wages:queue_syncs"
+	TaskSyncWages      = "wages:sync"
+
+	TaskQueueWarehouseExports = "warehouse:queue_exports"
+	TaskExportToWarehouse     = "warehouse:export"
+)
+
+// PurgeDeletedPayload is the payload of TaskPurgeDeleted.
+type PurgeDeletedPayload struct {
+	Retention time.Duration `json:"retention"`
+}
+
+// PartitionsPayload is the payload of TaskPartitions.
+type PartitionsPayload struct {
+	Ahead     int           `json:"ahead"`
+	Retention time.Duration `json:"retention"`
+}
+
+// ArchiveMeetingsPayload is the payload of TaskArchiveMeetings.
+type ArchiveMeetingsPayload struct {
+	After time.Duration `json:"after"`
+}
+
+// DeliverWebhookPayload is the payload of TaskDeliverWebhook.
+type DeliverWebhookPayload struct {
+	DeliveryID uuid.UUID `json:"delivery_id"`
+}
+
+// SendEmailPayload is the payload of TaskSendEmail. It carries the rendered
+// message so its bodies stay out of the delivery log.
+type SendEmailPayload struct {
+	DeliveryID uuid.UUID     `json:"delivery_id"`
+	Message    email.Message `json:"message"`
+}
+
+// SendNotificationPayload is the payload of TaskNotify: one notification on
+// one channel.
+type SendNotificationPayload struct {
+	PersonID     uuid.UUID    `json:"person_id"`
+	Event        string       `json:"event"`
+	Channel      string       `json:"channel"`
+	Notification Notification `json:"notification"`
+}
+
+// WeeklyDigestPayload is the payload of TaskWeeklyDigest.
+type WeeklyDigestPayload struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	PeriodEnd      time.Time `json:"period_end"`
+}
+
+// GenerateReportExportPayload is the payload of TaskGenerateReportExport.
+type GenerateReportExportPayload struct {
+	ExportID uuid.UUID `json:"export_id"`
+}
+
+// SendSurveysPayload is the payload of TaskSendSurveys.
+type SendSurveysPayload struct {
+	MeetingID uuid.UUID `json:"meeting_id"`
+}
+
+// SyncWagesPayload is the payload of TaskSyncWages.
+type SyncWagesPayload struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Provider       string    `json:"provider"`
+}
+
+// ExportToWarehousePayload is the payload of TaskExportToWarehouse.
+type ExportToWarehousePayload struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Provider       string    `json:"provider"`
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UsageService meters organizations' billable usage: meeting minutes and
+// active members per calendar month.
+type UsageService interface {
+	// RecordMeetingTime adds seconds of meeting time that ended at to the
+	// organization's usage for that month, along with its current active
+	// members. The increment pipeline calls it as each increment closes;
+	// negative seconds take back time metered for an increment that was
+	// reopened.
+	RecordMeetingTime(ctx context.Context, orgID uuid.UUID, seconds int, at time.Time) error
+	// GetUsage returns the organization's usage for the last months
+	// calendar months, newest first, including months without any.
+	GetUsage(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, months int) ([]*UsageDTO, error)
+	// ReportUsage sends usage not yet reported to Stripe's billing meters
+	// and returns how many organizations' usage it reported. It does
+	// nothing unless Stripe and a meter are configured.
+	ReportUsage(ctx context.Context) (int, error)
+}
+
+type UsageDTO struct {
+	// Period is the calendar month, as YYYY-MM
+	Period         string `json:"period"`
+	MeetingMinutes int64  `json:"meeting_minutes"`
+	// ActiveMembers is the most active members seen while meetings ran
+	ActiveMembers int        `json:"active_members"`
+	ReportedAt    *time.Time `json:"reported_at,omitempty"`
+}
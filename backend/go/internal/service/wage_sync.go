@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+)
+
+// WageSyncService syncs member wages and wage bands from the HR system an
+// organization connected as one of the HRISIntegrations. Records are
+// matched to members by email, and the integration's settings say which
+// of its fields hold the email, wage and band.
+type WageSyncService interface {
+	// PreviewWageSync reads the HR system and returns the changes a sync
+	// would make, without making them.
+	PreviewWageSync(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID) (*WageSyncDTO, error)
+	// SyncWages reads the HR system and makes the changes, recording each
+	// in the audit log.
+	SyncWages(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, ipAddress, userAgent string) (*WageSyncDTO, error)
+	// QueueWageSyncs queues a sync of every connected HRIS integration
+	// with scheduled syncs on, and returns how many were queued.
+	QueueWageSyncs(ctx context.Context) (int, error)
+	// RunScheduledWageSync syncs one organization's HR system as queued by
+	// QueueWageSyncs. Integrations since disconnected or with scheduled
+	// syncs turned off are skipped.
+	RunScheduledWageSync(ctx context.Context, orgID uuid.UUID, provider string) error
+}
+
+// HRISIntegrations are the integrations wages are synced from.
+var HRISIntegrations = []string{models.IntegrationBambooHR, models.IntegrationWorkday, models.IntegrationCSV}
+
+// HRIS integration settings.
+const (
+	// HRISSettingSchedule turns on the scheduled sync when true
+	HRISSettingSchedule = "scheduled"
+	// HRISSettingFields maps "email", "wage" and "band" to the HR
+	// system's names for the fields holding them. Records need an email
+	// and a wage or a band; a record with both is synced by its wage.
+	HRISSettingFields = "fields"
+	// HRISSettingWageUnit is "hour" (the default) or "year"; yearly
+	// wages are divided by HRISSettingHoursPerYear
+	HRISSettingWageUnit = "wage_unit"
+	// HRISSettingHoursPerYear defaults to DefaultHoursPerYear
+	HRISSettingHoursPerYear = "hours_per_year"
+
+	// HRISSettingSubdomain is the BambooHR company subdomain
+	HRISSettingSubdomain = "subdomain"
+	// HRISSettingURL is the Workday report URL or the CSV file URL
+	HRISSettingURL = "url"
+	// HRISSettingUsername is the Workday integration system user
+	HRISSettingUsername = "username"
+	// HRISSettingHostKey is the SFTP server's public key, in
+	// authorized_keys format
+	HRISSettingHostKey = "host_key"
+)
+
+// DefaultHoursPerYear converts yearly wages to hourly ones: 52 weeks of 40
+// hours.
+const DefaultHoursPerYear = 2080
+
+// WageSyncDTO is the outcome of a wage sync, or for a preview, what it
+// would be.
+type WageSyncDTO struct {
+	Provider string `json:"provider"`
+	DryRun   bool   `json:"dry_run"`
+	// Records is how many records the HR system returned
+	Records   int              `json:"records"`
+	Changes   []WageSyncChange `json:"changes"`
+	Unchanged int              `json:"unchanged"`
+	Skipped   []WageSyncSkip   `json:"skipped"`
+	SyncedAt  time.Time        `json:"synced_at"`
+}
+
+// WageSyncChange is a member whose wage or wage band the sync sets. Wages
+// the requester may not see under the organization's wage visibility are
+// left out.
+type WageSyncChange struct {
+	PersonID           uuid.UUID  `json:"person_id"`
+	Email              string     `json:"email"`
+	Wage               *float64   `json:"wage,omitempty"`
+	PreviousWage       *float64   `json:"previous_wage,omitempty"`
+	WageBandID         *uuid.UUID `json:"wage_band_id,omitempty"`
+	PreviousWageBandID *uuid.UUID `json:"previous_wage_band_id,omitempty"`
+}
+
+// WageSyncSkip is a record the sync ignored and why. Row counts records
+// from 1 in the order the HR system returned them.
+type WageSyncSkip struct {
+	Row    int    `json:"row"`
+	Email  string `json:"email,omitempty"`
+	Reason string `json:"reason"`
+}
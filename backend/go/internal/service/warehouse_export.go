@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/meeting-cost/backend/go/internal/models"
+	"github.com/yourorg/meeting-cost/backend/go/internal/warehouse"
+)
+
+// WarehouseExportService pushes an organization's meetings, their
+// increments, and its memberships to the data warehouse it connected as
+// one of the WarehouseIntegrations. Each run exports what changed since
+// the last, resuming after the watermark it left.
+type WarehouseExportService interface {
+	GetWarehouseExport(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID) (*WarehouseExportDTO, error)
+	// RunWarehouseExport queues a run now, whether or not scheduled
+	// exports are on.
+	RunWarehouseExport(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, ipAddress, userAgent string) (*WarehouseExportDTO, error)
+	// ResetWarehouseExport clears the watermarks, so the next run exports
+	// everything again.
+	ResetWarehouseExport(ctx context.Context, orgID uuid.UUID, provider string, requesterID uuid.UUID, ipAddress, userAgent string) (*WarehouseExportDTO, error)
+	// QueueWarehouseExports queues a run of every connected warehouse
+	// integration with scheduled exports on, and returns how many were
+	// queued.
+	QueueWarehouseExports(ctx context.Context) (int, error)
+	// ExportToWarehouse runs an export as queued. Integrations since
+	// disconnected are skipped, as are exports another run holds.
+	ExportToWarehouse(ctx context.Context, orgID uuid.UUID, provider string) error
+	// WarehouseSchema describes the tables exported.
+	WarehouseSchema(ctx context.Context) []warehouse.Table
+}
+
+// WarehouseIntegrations are the integrations data is exported to.
+var WarehouseIntegrations = []string{models.IntegrationBigQuery, models.IntegrationSnowflake, models.IntegrationS3}
+
+// Warehouse integration settings. The integration's access token is the
+// BigQuery service account's JSON key, the Snowflake user's private key
+// in PEM, or the S3 secret access key.
+const (
+	// WarehouseSettingSchedule turns on the scheduled export when true
+	WarehouseSettingSchedule = "scheduled"
+
+	// WarehouseSettingProject and WarehouseSettingDataset are the
+	// BigQuery dataset the tables are created in
+	WarehouseSettingProject = "project"
+	WarehouseSettingDataset = "dataset"
+
+	// Snowflake account identifier, user, and where the tables are
+	// created; role is optional
+	WarehouseSettingAccount   = "account"
+	WarehouseSettingUser      = "user"
+	WarehouseSettingDatabase  = "database"
+	WarehouseSettingSchema    = "schema"
+	WarehouseSettingWarehouse = "warehouse"
+	WarehouseSettingRole      = "role"
+
+	// S3 bucket and credentials. prefix is prepended to object keys,
+	// format is "csv" (the default) or "parquet", and endpoint is the
+	// https:// URL of an S3-compatible store
+	WarehouseSettingBucket      = "bucket"
+	WarehouseSettingRegion      = "region"
+	WarehouseSettingAccessKeyID = "access_key_id"
+	WarehouseSettingPrefix      = "prefix"
+	WarehouseSettingFormat      = "format"
+	WarehouseSettingEndpoint    = "endpoint"
+)
+
+// WarehouseExportDTO is the state of an organization's export to a data
+// warehouse.
+type WarehouseExportDTO struct {
+	Provider string `json:"provider"`
+	// Status is "running" while a run holds the export, else "idle"
+	Status    string `json:"status"`
+	Scheduled bool   `json:"scheduled"`
+	// MeetingsThrough and MembershipsThrough are the watermarks: changes
+	// up to then have been exported
+	MeetingsThrough    *time.Time `json:"meetings_through,omitempty"`
+	MembershipsThrough *time.Time `json:"memberships_through,omitempty"`
+	// Rows pushed to each table since the watermarks were last reset
+	Rows          map[string]int64 `json:"rows"`
+	LastRunAt     *time.Time       `json:"last_run_at,omitempty"`
+	LastSuccessAt *time.Time       `json:"last_success_at,omitempty"`
+	LastError     string           `json:"last_error,omitempty"`
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook event types. Meeting events carry the meeting as a MeetingDTO;
+// for meeting.stopped its totals are final as of the stop.
+const (
+	WebhookEventPing             = "ping"
+	WebhookEventMeetingStarted   = "meeting.started"
+	WebhookEventMeetingStopped   = "meeting.stopped"
+	WebhookEventMeetingFinalized = "meeting.finalized"
+)
+
+// WebhookEvents lists the event types an endpoint can subscribe to.
+var WebhookEvents = []string{
+	WebhookEventMeetingStarted,
+	WebhookEventMeetingStopped,
+	WebhookEventMeetingFinalized,
+}
+
+// WebhookService manages an organization's webhook endpoints and delivers
+// events to them.
+type WebhookService interface {
+	// Endpoint management
+	CreateEndpoint(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID, req CreateWebhookEndpointRequest) (*WebhookEndpointDTO, error)
+	ListEndpoints(ctx context.Context, orgID uuid.UUID, requesterID uuid.UUID) ([]*WebhookEndpointDTO, error)
+	DeleteEndpoint(ctx context.Context, orgID, endpointID uuid.UUID, requesterID uuid.UUID, ipAddress, userAgent string) error
+	// PingEndpoint sends a ping event to one endpoint, whatever it
+	// subscribes to.
+	PingEndpoint(ctx context.Context, orgID, endpointID uuid.UUID, requesterID uuid.UUID) (*WebhookDeliveryDTO, error)
+
+	// Delivery history
+	ListDeliveries(ctx context.Context, orgID, endpointID uuid.UUID, requesterID uuid.UUID, pagination Pagination) ([]*WebhookDeliveryDTO, int64, error)
+	// Redeliver sends a past delivery's event again as a new delivery with
+	// the same event ID.
+	Redeliver(ctx context.Context, orgID, endpointID, deliveryID uuid.UUID, requesterID uuid.UUID) (*WebhookDeliveryDTO, error)
+
+	// Dispatch queues event for every active endpoint of the organization
+	// subscribed to it.
+	Dispatch(ctx context.Context, orgID uuid.UUID, event string, data interface{}) error
+	// Deliver makes one attempt at a queued delivery, scheduling the next
+	// attempt or dead-lettering it on failure. It runs in the worker.
+	Deliver(ctx context.Context, deliveryID uuid.UUID) error
+}
+
+type CreateWebhookEndpointRequest struct {
+	URL         string   `json:"url" validate:"required,url"`
+	Description string   `json:"description"`
+	Events      []string `json:"events"` // Empty subscribes to every event
+	IPAddress   string   `json:"-"`
+	UserAgent   string   `json:"-"`
+}
+
+type WebhookEndpointDTO struct {
+	ID          uuid.UUID `json:"id"`
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	Events      []string  `json:"events"`
+	Active      bool      `json:"active"`
+	// Secret is only returned when the endpoint is created
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WebhookDeliveryDTO struct {
+	ID             uuid.UUID  `json:"id"`
+	EventID        uuid.UUID  `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at"`
+	ResponseStatus int        `json:"response_status,omitempty"`
+	Error          string     `json:"error,omitempty"`
+	DurationMs     int64      `json:"duration_ms,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// WebhookEvent is the JSON body of every webhook request.
+type WebhookEvent struct {
+	ID             uuid.UUID   `json:"id"`
+	Type           string      `json:"type"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	CreatedAt      time.Time   `json:"created_at"`
+	Data           interface{} `json:"data"`
+}
@@ -0,0 +1,235 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	bigQueryAPI   = "https://bigquery.googleapis.com/bigquery/v2"
+	bigQueryScope = "https://www.googleapis.com/auth/bigquery"
+	// bigQueryBatch is how many rows each insertAll request streams
+	bigQueryBatch = 500
+)
+
+// BigQuery streams rows into tables of a dataset, authenticating as a
+// service account with the BigQuery Data Editor role on the dataset.
+type BigQuery struct {
+	project string
+	dataset string
+	key     serviceAccountKey
+	api     string
+	client  *http.Client
+
+	token        string
+	tokenExpires time.Time
+	// created are the tables known to exist
+	created map[string]bool
+}
+
+// serviceAccountKey is the fields read of a Google service account's JSON
+// key.
+type serviceAccountKey struct {
+	ClientEmail  string `json:"client_email"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+}
+
+func NewBigQuery(project, dataset, keyJSON string, timeout time.Duration) (*BigQuery, error) {
+	if project == "" || dataset == "" {
+		return nil, fmt.Errorf("project and dataset are required")
+	}
+	var key serviceAccountKey
+	if err := json.Unmarshal([]byte(keyJSON), &key); err != nil {
+		return nil, fmt.Errorf("the access token must be a service account's JSON key: %w", err)
+	}
+	if key.ClientEmail == "" || key.PrivateKey == "" || !strings.HasPrefix(key.TokenURI, "https://") {
+		return nil, fmt.Errorf("the service account key needs client_email, private_key and an https:// token_uri")
+	}
+	if _, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key.PrivateKey)); err != nil {
+		return nil, fmt.Errorf("the service account's private_key: %w", err)
+	}
+	return &BigQuery{
+		project: project,
+		dataset: dataset,
+		key:     key,
+		api:     bigQueryAPI,
+		client:  &http.Client{Timeout: timeout},
+		created: map[string]bool{},
+	}, nil
+}
+
+func (b *BigQuery) Write(ctx context.Context, table Table, rows [][]any) error {
+	if !b.created[table.Name] {
+		if err := b.createTable(ctx, table); err != nil {
+			return fmt.Errorf("bigquery: creating %s: %w", table.Name, err)
+		}
+		b.created[table.Name] = true
+	}
+
+	for start := 0; start < len(rows); start += bigQueryBatch {
+		end := min(start+bigQueryBatch, len(rows))
+		if err := b.insert(ctx, table, rows[start:end]); err != nil {
+			return fmt.Errorf("bigquery: inserting into %s: %w", table.Name, err)
+		}
+	}
+	return nil
+}
+
+// createTable creates table in the dataset unless it exists.
+func (b *BigQuery) createTable(ctx context.Context, table Table) error {
+	fields := make([]map[string]string, len(table.Columns))
+	for i, c := range table.Columns {
+		fields[i] = map[string]string{"name": c.Name, "type": c.Type, "mode": "NULLABLE", "description": c.Description}
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"tableReference": map[string]string{"projectId": b.project, "datasetId": b.dataset, "tableId": table.Name},
+		"description":    table.Description,
+		"schema":         map[string]interface{}{"fields": fields},
+	})
+	if err != nil {
+		return err
+	}
+	// 409 Conflict: the table exists
+	_, err = b.do(ctx, b.datasetURL()+"/tables", body, http.StatusConflict)
+	return err
+}
+
+// insert streams rows into table. Each row's insert ID is a hash of it, so
+// BigQuery drops rows a retry sends again shortly after.
+func (b *BigQuery) insert(ctx context.Context, table Table, rows [][]any) error {
+	type insertRow struct {
+		InsertID string                 `json:"insertId"`
+		JSON     map[string]interface{} `json:"json"`
+	}
+	req := struct {
+		Rows []insertRow `json:"rows"`
+	}{Rows: make([]insertRow, len(rows))}
+	for i, row := range rows {
+		values := make(map[string]interface{}, len(row))
+		for j, v := range row {
+			if t, ok := v.(time.Time); ok {
+				values[table.Columns[j].Name] = text(t)
+			} else {
+				values[table.Columns[j].Name] = v
+			}
+		}
+		encoded, err := json.Marshal(values)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(append([]byte(table.Name+"\n"), encoded...))
+		req.Rows[i] = insertRow{InsertID: hex.EncodeToString(sum[:16]), JSON: values}
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.do(ctx, b.datasetURL()+"/tables/"+url.PathEscape(table.Name)+"/insertAll", body)
+	if err != nil {
+		return err
+	}
+	var out struct {
+		InsertErrors []struct {
+			Index  int `json:"index"`
+			Errors []struct {
+				Reason  string `json:"reason"`
+				Message string `json:"message"`
+			} `json:"errors"`
+		} `json:"insertErrors"`
+	}
+	if err := json.Unmarshal(resp, &out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	if len(out.InsertErrors) > 0 {
+		e := out.InsertErrors[0]
+		reason := "unknown error"
+		if len(e.Errors) > 0 {
+			reason = e.Errors[0].Reason + ": " + e.Errors[0].Message
+		}
+		return fmt.Errorf("%d rows rejected, the first (row %d) with %s", len(out.InsertErrors), e.Index, reason)
+	}
+	return nil
+}
+
+func (b *BigQuery) datasetURL() string {
+	return b.api + "/projects/" + url.PathEscape(b.project) + "/datasets/" + url.PathEscape(b.dataset)
+}
+
+// do POSTs body as JSON with an access token and returns the response.
+func (b *BigQuery) do(ctx context.Context, endpoint string, body []byte, allow ...int) ([]byte, error) {
+	token, err := b.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	_, resp, err := fetch(b.client, req, allow...)
+	return resp, err
+}
+
+// accessToken exchanges a JWT signed with the service account's key for
+// an access token, reusing it until shortly before it expires.
+func (b *BigQuery) accessToken(ctx context.Context) (string, error) {
+	now := time.Now()
+	if b.token != "" && now.Before(b.tokenExpires) {
+		return b.token, nil
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(b.key.PrivateKey))
+	if err != nil {
+		return "", fmt.Errorf("reading the private key: %w", err)
+	}
+	assertion := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   b.key.ClientEmail,
+		"scope": bigQueryScope,
+		"aud":   b.key.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	assertion.Header["kid"] = b.key.PrivateKeyID
+	signed, err := assertion.SignedString(key)
+	if err != nil {
+		return "", fmt.Errorf("signing the token request: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {signed},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.key.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, resp, err := fetch(b.client, req)
+	if err != nil {
+		return "", fmt.Errorf("getting an access token: %w", err)
+	}
+	var out struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(resp, &out); err != nil || out.AccessToken == "" {
+		return "", fmt.Errorf("getting an access token: no access_token in the response")
+	}
+	b.token = out.AccessToken
+	b.tokenExpires = now.Add(time.Duration(out.ExpiresIn)*time.Second - time.Minute)
+	return b.token, nil
+}
@@ -0,0 +1,30 @@
+package warehouse
+
+import (
+	"bytes"
+	"encoding/csv"
+)
+
+// CSV encodes rows of table with a header row. NULLs are empty.
+func CSV(table Table, rows [][]any) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	record := make([]string, len(table.Columns))
+	for i, c := range table.Columns {
+		record[i] = c.Name
+	}
+	if err := w.Write(record); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for i, v := range row {
+			record[i] = text(v)
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,304 @@
+package warehouse
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Parquet physical types, converted types, encodings and field types of
+// the Thrift compact protocol the file metadata is written in.
+const (
+	parquetBoolean   = 0
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	parquetUTF8            = 0
+	parquetTimestampMicros = 10
+
+	parquetOptional = 1
+	parquetPlain    = 0
+	parquetRLE      = 3
+
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// Parquet encodes rows of table as a Parquet file: one row group, every
+// column optional, PLAIN-encoded and uncompressed. Timestamps are
+// microseconds since the epoch in UTC.
+func Parquet(table Table, rows [][]any) ([]byte, error) {
+	var file bytes.Buffer
+	file.WriteString("PAR1")
+
+	chunks := make([]columnChunk, len(table.Columns))
+	for i, c := range table.Columns {
+		values, defs, err := parquetValues(c, i, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		var page bytes.Buffer
+		levels := rleLevels(defs)
+		binary.Write(&page, binary.LittleEndian, uint32(len(levels)))
+		page.Write(levels)
+		page.Write(values)
+
+		var header thriftWriter
+		header.i32(1, 0) // DATA_PAGE
+		header.i32(2, int32(page.Len()))
+		header.i32(3, int32(page.Len()))
+		header.beginStruct(5)
+		header.i32(1, int32(len(rows)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.endStruct()
+		header.endStruct()
+
+		chunks[i] = columnChunk{offset: int64(file.Len()), size: int64(header.Len() + page.Len())}
+		file.Write(header.Bytes())
+		file.Write(page.Bytes())
+	}
+
+	var meta thriftWriter
+	meta.i32(1, 1)
+	meta.beginList(2, thriftStruct, len(table.Columns)+1)
+	meta.beginElement()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(table.Columns)))
+	meta.endStruct()
+	for _, c := range table.Columns {
+		physical, converted := parquetType(c.Type)
+		meta.beginElement()
+		meta.i32(1, physical)
+		meta.i32(3, parquetOptional)
+		meta.binary(4, c.Name)
+		if converted >= 0 {
+			meta.i32(6, converted)
+		}
+		meta.endStruct()
+	}
+	meta.i64(3, int64(len(rows)))
+
+	var total int64
+	for _, chunk := range chunks {
+		total += chunk.size
+	}
+	meta.beginList(4, thriftStruct, 1)
+	meta.beginElement()
+	meta.beginList(1, thriftStruct, len(chunks))
+	for i, c := range table.Columns {
+		physical, _ := parquetType(c.Type)
+		chunk := chunks[i]
+		meta.beginElement()
+		meta.i64(2, chunk.offset)
+		meta.beginStruct(3)
+		meta.i32(1, physical)
+		meta.beginList(2, thriftI32, 2)
+		meta.zigzag(parquetPlain)
+		meta.zigzag(parquetRLE)
+		meta.beginList(3, thriftBinary, 1)
+		meta.bytes([]byte(c.Name))
+		meta.i32(4, 0) // UNCOMPRESSED
+		meta.i64(5, int64(len(rows)))
+		meta.i64(6, chunk.size)
+		meta.i64(7, chunk.size)
+		meta.i64(9, chunk.offset)
+		meta.endStruct()
+		meta.endStruct()
+	}
+	meta.i64(2, total)
+	meta.i64(3, int64(len(rows)))
+	meta.endStruct()
+	meta.binary(6, "meeting-cost")
+	meta.endStruct()
+
+	file.Write(meta.Bytes())
+	binary.Write(&file, binary.LittleEndian, uint32(meta.Len()))
+	file.WriteString("PAR1")
+	return file.Bytes(), nil
+}
+
+type columnChunk struct {
+	offset, size int64
+}
+
+// parquetType returns the physical and converted type of a column type;
+// the converted type is -1 when there is none.
+func parquetType(t string) (int32, int32) {
+	switch t {
+	case Integer:
+		return parquetInt64, -1
+	case Float:
+		return parquetDouble, -1
+	case Boolean:
+		return parquetBoolean, -1
+	case Timestamp:
+		return parquetInt64, parquetTimestampMicros
+	}
+	return parquetByteArray, parquetUTF8
+}
+
+// parquetValues PLAIN-encodes the non-NULL values of column i and returns
+// them with each row's definition level: 1 for a value, 0 for NULL.
+func parquetValues(c Column, i int, rows [][]any) ([]byte, []byte, error) {
+	var buf bytes.Buffer
+	defs := make([]byte, len(rows))
+	var bits []bool
+	for r, row := range rows {
+		v := row[i]
+		if v == nil {
+			continue
+		}
+		defs[r] = 1
+
+		ok := true
+		switch c.Type {
+		case String:
+			var s string
+			if s, ok = v.(string); ok {
+				binary.Write(&buf, binary.LittleEndian, uint32(len(s)))
+				buf.WriteString(s)
+			}
+		case Integer:
+			var n int64
+			if n, ok = v.(int64); ok {
+				binary.Write(&buf, binary.LittleEndian, n)
+			}
+		case Float:
+			var f float64
+			if f, ok = v.(float64); ok {
+				binary.Write(&buf, binary.LittleEndian, math.Float64bits(f))
+			}
+		case Boolean:
+			var b bool
+			if b, ok = v.(bool); ok {
+				bits = append(bits, b)
+			}
+		case Timestamp:
+			var t time.Time
+			if t, ok = v.(time.Time); ok {
+				binary.Write(&buf, binary.LittleEndian, t.UnixMicro())
+			}
+		}
+		if !ok {
+			return nil, nil, fmt.Errorf("column %s: %T is not a %s", c.Name, v, c.Type)
+		}
+	}
+	// Booleans are bit-packed, least significant bit first
+	for start := 0; start < len(bits); start += 8 {
+		var b byte
+		for j := start; j < start+8 && j < len(bits); j++ {
+			if bits[j] {
+				b |= 1 << (j - start)
+			}
+		}
+		buf.WriteByte(b)
+	}
+	return buf.Bytes(), defs, nil
+}
+
+// rleLevels encodes definition levels of bit width 1 as runs of the RLE
+// and bit-packing hybrid encoding.
+func rleLevels(levels []byte) []byte {
+	var buf bytes.Buffer
+	for start := 0; start < len(levels); {
+		end := start
+		for end < len(levels) && levels[end] == levels[start] {
+			end++
+		}
+		buf.Write(binary.AppendUvarint(nil, uint64(end-start)<<1))
+		buf.WriteByte(levels[start])
+		start = end
+	}
+	return buf.Bytes()
+}
+
+// thriftWriter writes structs in the Thrift compact protocol. Field IDs
+// are written as deltas from the previous field of the same struct.
+type thriftWriter struct {
+	bytes.Buffer
+	// field is the last field written of the struct being written, and
+	// outer those of the structs it is nested in
+	field int16
+	outer []int16
+}
+
+func (w *thriftWriter) header(id int16, typ byte) {
+	if delta := id - w.field; delta > 0 && delta <= 15 {
+		w.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.WriteByte(typ)
+		w.zigzag(int64(id))
+	}
+	w.field = id
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	w.Write(binary.AppendUvarint(nil, v))
+}
+
+// zigzag writes the integer types, i16 to i64.
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64(v<<1 ^ v>>63))
+}
+
+func (w *thriftWriter) bytes(b []byte) {
+	w.varint(uint64(len(b)))
+	w.Write(b)
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.header(id, thriftI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.header(id, thriftI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) binary(id int16, s string) {
+	w.header(id, thriftBinary)
+	w.bytes([]byte(s))
+}
+
+// beginStruct starts a struct field, and beginElement a struct element of
+// a list; endStruct ends either, or the outermost struct.
+func (w *thriftWriter) beginStruct(id int16) {
+	w.header(id, thriftStruct)
+	w.beginElement()
+}
+
+func (w *thriftWriter) beginElement() {
+	w.outer = append(w.outer, w.field)
+	w.field = 0
+}
+
+func (w *thriftWriter) endStruct() {
+	w.WriteByte(0)
+	if n := len(w.outer); n > 0 {
+		w.field = w.outer[n-1]
+		w.outer = w.outer[:n-1]
+	}
+}
+
+// beginList starts a list field of n elements of type elem. Integer
+// elements are written with zigzag, binary ones with bytes, and structs
+// between beginElement and endStruct.
+func (w *thriftWriter) beginList(id int16, elem byte, n int) {
+	w.header(id, thriftList)
+	if n < 15 {
+		w.WriteByte(byte(n)<<4 | elem)
+	} else {
+		w.WriteByte(0xf0 | elem)
+		w.varint(uint64(n))
+	}
+}
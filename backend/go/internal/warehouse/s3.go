@@ -0,0 +1,178 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// S3 formats.
+const (
+	FormatCSV     = "csv"
+	FormatParquet = "parquet"
+)
+
+// S3Config is where an S3 destination writes.
+type S3Config struct {
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Prefix is prepended to every object key
+	Prefix string
+	// Format is FormatCSV or FormatParquet
+	Format string
+	// Endpoint is the https:// URL of an S3-compatible store, addressed
+	// path-style; empty for AWS
+	Endpoint string
+}
+
+// S3 writes each batch of rows as a new object under
+// <prefix>/<table>/dt=<date>/, signing requests with AWS Signature
+// Version 4.
+type S3 struct {
+	cfg    S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3(cfg S3Config, timeout time.Duration) (*S3, error) {
+	if cfg.Bucket == "" || cfg.Region == "" || cfg.AccessKeyID == "" {
+		return nil, fmt.Errorf("bucket, region and access_key_id are required")
+	}
+	if cfg.Format != FormatCSV && cfg.Format != FormatParquet {
+		return nil, fmt.Errorf("format must be %s or %s, got %q", FormatCSV, FormatParquet, cfg.Format)
+	}
+	if cfg.Endpoint != "" && !strings.HasPrefix(cfg.Endpoint, "https://") {
+		return nil, fmt.Errorf("endpoint must be an https:// URL")
+	}
+	cfg.Prefix = strings.Trim(cfg.Prefix, "/")
+	cfg.Endpoint = strings.TrimSuffix(cfg.Endpoint, "/")
+	return &S3{cfg: cfg, client: &http.Client{Timeout: timeout}, now: time.Now}, nil
+}
+
+func (s *S3) Write(ctx context.Context, table Table, rows [][]any) error {
+	var (
+		body []byte
+		err  error
+	)
+	contentType := "text/csv"
+	if s.cfg.Format == FormatParquet {
+		body, err = Parquet(table, rows)
+		contentType = "application/vnd.apache.parquet"
+	} else {
+		body, err = CSV(table, rows)
+	}
+	if err != nil {
+		return fmt.Errorf("s3: encoding %s: %w", table.Name, err)
+	}
+
+	now := s.now().UTC()
+	key := fmt.Sprintf("%s/dt=%s/%s-%s.%s", table.Name, now.Format(time.DateOnly),
+		now.Format("20060102T150405Z"), uuid.New(), s.cfg.Format)
+	if s.cfg.Prefix != "" {
+		key = s.cfg.Prefix + "/" + key
+	}
+
+	endpoint := "https://" + s.cfg.Bucket + ".s3." + s.cfg.Region + ".amazonaws.com/" + key
+	if s.cfg.Endpoint != "" {
+		endpoint = s.cfg.Endpoint + "/" + s.cfg.Bucket + "/" + key
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("s3: %w", err)
+	}
+	// S3 signs the path with every reserved character escaped, = included
+	u.RawPath = awsEscapePath(u.Path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("s3: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, body, now)
+
+	if _, _, err := fetch(s.client, req); err != nil {
+		return fmt.Errorf("s3: putting %s: %w", key, err)
+	}
+	return nil
+}
+
+// sign adds SigV4 authentication headers to req.
+func (s *S3) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := hexSHA256(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := []string{"content-type", "host", "x-amz-content-sha256", "x-amz-date"}
+	values := map[string]string{
+		"content-type":         req.Header.Get("Content-Type"),
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+	}
+	var canonicalHeaders strings.Builder
+	for _, h := range headers {
+		canonicalHeaders.WriteString(h + ":" + values[h] + "\n")
+	}
+	signedHeaders := strings.Join(headers, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hexSHA256([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	for _, v := range []string{s.cfg.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, v)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+s.cfg.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+// awsEscapePath escapes every byte of path but the unreserved characters
+// and slashes, as SigV4 expects.
+func awsEscapePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hexSHA256(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
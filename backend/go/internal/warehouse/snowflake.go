@@ -0,0 +1,214 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// snowflakeBatch is how many rows each INSERT binds
+	snowflakeBatch = 1000
+	// snowflakePoll is how often a statement still running is checked
+	snowflakePoll = time.Second
+)
+
+// snowflakeTypes are the Snowflake types of the column types.
+var snowflakeTypes = map[string]string{
+	String:    "VARCHAR",
+	Integer:   "NUMBER(38,0)",
+	Float:     "FLOAT",
+	Boolean:   "BOOLEAN",
+	Timestamp: "TIMESTAMP_TZ",
+}
+
+// snowflakeBindings are the SQL API binding types of the column types;
+// timestamps are bound as text and cast on insert.
+var snowflakeBindings = map[string]string{
+	String:    "TEXT",
+	Integer:   "FIXED",
+	Float:     "REAL",
+	Boolean:   "BOOLEAN",
+	Timestamp: "TEXT",
+}
+
+// SnowflakeConfig is where a Snowflake destination writes.
+type SnowflakeConfig struct {
+	// Account is the account identifier, e.g. myorg-myaccount
+	Account   string
+	User      string
+	Database  string
+	Schema    string
+	Warehouse string
+	// Role is optional; the user's default role is used without it
+	Role string
+	// PrivateKey is the PEM of the RSA key registered as the user's
+	// RSA_PUBLIC_KEY
+	PrivateKey string
+}
+
+// Snowflake inserts rows through the Snowflake SQL API, authenticating
+// with key-pair JWTs.
+type Snowflake struct {
+	cfg    SnowflakeConfig
+	key    *rsa.PrivateKey
+	api    string
+	client *http.Client
+	// created are the tables known to exist
+	created map[string]bool
+}
+
+func NewSnowflake(cfg SnowflakeConfig, timeout time.Duration) (*Snowflake, error) {
+	if cfg.Account == "" || cfg.User == "" || cfg.Database == "" || cfg.Schema == "" || cfg.Warehouse == "" {
+		return nil, fmt.Errorf("account, user, database, schema and warehouse are required")
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(cfg.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("the access token must be the user's RSA private key in PEM: %w", err)
+	}
+	return &Snowflake{
+		cfg:     cfg,
+		key:     key,
+		api:     "https://" + strings.ToLower(cfg.Account) + ".snowflakecomputing.com",
+		client:  &http.Client{Timeout: timeout},
+		created: map[string]bool{},
+	}, nil
+}
+
+func (s *Snowflake) Write(ctx context.Context, table Table, rows [][]any) error {
+	if !s.created[table.Name] {
+		if err := s.execute(ctx, createTableSQL(table), nil); err != nil {
+			return fmt.Errorf("snowflake: creating %s: %w", table.Name, err)
+		}
+		s.created[table.Name] = true
+	}
+
+	names := make([]string, len(table.Columns))
+	params := make([]string, len(table.Columns))
+	for i, c := range table.Columns {
+		names[i], params[i] = c.Name, "?"
+	}
+	insert := "INSERT INTO " + table.Name + " (" + strings.Join(names, ", ") + ") VALUES (" + strings.Join(params, ", ") + ")"
+
+	for start := 0; start < len(rows); start += snowflakeBatch {
+		batch := rows[start:min(start+snowflakeBatch, len(rows))]
+		// Each column binds an array of its values, a row per element
+		bindings := make(map[string]interface{}, len(table.Columns))
+		for i, c := range table.Columns {
+			values := make([]*string, len(batch))
+			for j, row := range batch {
+				if row[i] != nil {
+					v := text(row[i])
+					values[j] = &v
+				}
+			}
+			bindings[strconv.Itoa(i+1)] = map[string]interface{}{"type": snowflakeBindings[c.Type], "value": values}
+		}
+		if err := s.execute(ctx, insert, bindings); err != nil {
+			return fmt.Errorf("snowflake: inserting into %s: %w", table.Name, err)
+		}
+	}
+	return nil
+}
+
+// createTableSQL creates table unless it exists, with its descriptions as
+// comments.
+func createTableSQL(table Table) string {
+	columns := make([]string, len(table.Columns))
+	for i, c := range table.Columns {
+		columns[i] = c.Name + " " + snowflakeTypes[c.Type] + " COMMENT " + snowflakeString(c.Description)
+	}
+	return "CREATE TABLE IF NOT EXISTS " + table.Name + " (" + strings.Join(columns, ", ") + ") COMMENT = " + snowflakeString(table.Description)
+}
+
+// snowflakeString quotes s as a string literal.
+func snowflakeString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", "''").Replace(s) + "'"
+}
+
+// execute runs a statement and waits for it to finish.
+func (s *Snowflake) execute(ctx context.Context, statement string, bindings map[string]interface{}) error {
+	req := map[string]interface{}{
+		"statement": statement,
+		"timeout":   60,
+		"database":  s.cfg.Database,
+		"schema":    s.cfg.Schema,
+		"warehouse": s.cfg.Warehouse,
+	}
+	if s.cfg.Role != "" {
+		req["role"] = s.cfg.Role
+	}
+	if bindings != nil {
+		req["bindings"] = bindings
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	status, resp, err := s.do(ctx, http.MethodPost, "/api/v2/statements", body)
+	// 202 Accepted: the statement is still running
+	for err == nil && status == http.StatusAccepted {
+		var running struct {
+			StatementStatusURL string `json:"statementStatusUrl"`
+		}
+		if err := json.Unmarshal(resp, &running); err != nil || running.StatementStatusURL == "" {
+			return fmt.Errorf("no statementStatusUrl in the response")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(snowflakePoll):
+		}
+		status, resp, err = s.do(ctx, http.MethodGet, running.StatementStatusURL, nil)
+	}
+	return err
+}
+
+// do sends a request to the SQL API with a fresh JWT.
+func (s *Snowflake) do(ctx context.Context, method, path string, body []byte) (int, []byte, error) {
+	token, err := s.jwt(time.Now())
+	if err != nil {
+		return 0, nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.api+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-Snowflake-Authorization-Token-Type", "KEYPAIR_JWT")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	return fetch(s.client, req)
+}
+
+// jwt signs a key-pair token. The issuer names the public key by its
+// SHA-256 fingerprint, as Snowflake shows it in RSA_PUBLIC_KEY_FP.
+func (s *Snowflake) jwt(now time.Time) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(&s.key.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	// Account locators given with their region drop it
+	account := strings.ToUpper(strings.SplitN(s.cfg.Account, ".", 2)[0])
+	subject := account + "." + strings.ToUpper(s.cfg.User)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": subject + ".SHA256:" + base64.StdEncoding.EncodeToString(sum[:]),
+		"sub": subject,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	})
+	return token.SignedString(s.key)
+}
@@ -0,0 +1,179 @@
+// Package warehouse pushes organizations' meeting data to the data
+// warehouses they connect: BigQuery, Snowflake, or an S3 bucket of CSV or
+// Parquet files.
+package warehouse
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxResponseSize bounds what is read of a warehouse's response.
+const maxResponseSize = 1 << 20
+
+// Destination is a warehouse rows are pushed to.
+type Destination interface {
+	// Write appends rows to table, creating it first if need be. Each row
+	// holds a value per column in the table's order: a string, int64,
+	// float64, bool or time.Time, or nil for NULL.
+	Write(ctx context.Context, table Table, rows [][]any) error
+}
+
+// Column types.
+const (
+	String    = "STRING"
+	Integer   = "INTEGER"
+	Float     = "FLOAT"
+	Boolean   = "BOOLEAN"
+	Timestamp = "TIMESTAMP"
+)
+
+// Column is a column of a Table. Every column is nullable.
+type Column struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// Table is a table the export writes.
+type Table struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Columns     []Column `json:"columns"`
+}
+
+// Tables are the tables the export writes.
+var Tables = []Table{Meetings, Increments, Memberships}
+
+// Meetings holds a snapshot of each meeting each time it changes.
+var Meetings = Table{
+	Name:        "meetings",
+	Description: "A row per meeting per change, stamped with exported_at. The row of each id with the latest exported_at is the meeting as it is now; deleted meetings have deleted_at set.",
+	Columns: []Column{
+		{"id", String, "Meeting ID"},
+		{"organization_id", String, "Organization ID"},
+		{"purpose", String, "What the meeting was for"},
+		{"created_by_id", String, "Person who created the meeting; empty for aggregate-only organizations"},
+		{"external_type", String, "Integration the meeting came from, e.g. zoom; empty for meetings run in the app"},
+		{"is_active", Boolean, "Whether the meeting was running when exported"},
+		{"started_at", Timestamp, "When the meeting first started"},
+		{"stopped_at", Timestamp, "When the meeting last stopped"},
+		{"scheduled_start", Timestamp, "When the meeting was scheduled to start"},
+		{"scheduled_end", Timestamp, "When the meeting was scheduled to end"},
+		{"total_cost", Float, "Cost of the meeting, in the organization's currency"},
+		{"total_duration_seconds", Integer, "Time the meeting ran"},
+		{"max_attendees", Integer, "Most attendees at once"},
+		{"attendee_seconds", Integer, "Each attendee's time in the meeting, summed"},
+		{"late_start_seconds", Integer, "How late the meeting started"},
+		{"late_start_cost", Float, "What the attendees who waited for a late start cost"},
+		{"overrun_seconds", Integer, "Time the meeting ran past its scheduled end"},
+		{"overrun_cost", Float, "Cost of the overrun"},
+		{"projected_cost", Float, "Cost projected when the meeting was scheduled"},
+		{"approval_status", String, "pending, approved or rejected, for meetings that needed approval"},
+		{"finalized_at", Timestamp, "When an admin locked the meeting's costs"},
+		{"created_at", Timestamp, "When the meeting was created"},
+		{"updated_at", Timestamp, "When the meeting was last updated"},
+		{"deleted_at", Timestamp, "When the meeting was deleted"},
+		{"exported_at", Timestamp, "When this snapshot was exported"},
+	},
+}
+
+// Increments holds the increments of each meeting snapshot.
+var Increments = Table{
+	Name:        "increments",
+	Description: "The time slices of each meeting snapshot, with the snapshot's exported_at. A meeting's increments are those with its latest exported_at; earlier ones may since have been merged or corrected.",
+	Columns: []Column{
+		{"id", String, "Increment ID"},
+		{"meeting_id", String, "Meeting ID"},
+		{"organization_id", String, "Organization ID"},
+		{"start_time", Timestamp, "When the slice started"},
+		{"stop_time", Timestamp, "When the slice stopped"},
+		{"attendee_count", Integer, "Attendees during the slice"},
+		{"elapsed_seconds", Integer, "Length of the slice"},
+		{"cost", Float, "Cost of the slice"},
+		{"running_total_cost", Float, "Cost of the meeting up to the end of the slice"},
+		{"purpose", String, "The meeting's purpose during the slice"},
+		{"exported_at", Timestamp, "exported_at of the meeting snapshot"},
+	},
+}
+
+// Memberships holds a snapshot of each membership each time it or its
+// person changes. Wages are never exported.
+var Memberships = Table{
+	Name:        "memberships",
+	Description: "A row per member per change, stamped with exported_at. The row of each id with the latest exported_at is the membership as it is now; removed members have deleted_at set. Wages are not exported.",
+	Columns: []Column{
+		{"id", String, "Membership ID"},
+		{"organization_id", String, "Organization ID"},
+		{"person_id", String, "Person ID, as in meetings.created_by_id"},
+		{"email", String, "The member's email address"},
+		{"first_name", String, "The member's first name"},
+		{"last_name", String, "The member's last name"},
+		{"is_active", Boolean, "Whether the member is active"},
+		{"meeting_approver", Boolean, "Whether the member decides on meetings that need approval"},
+		{"joined_at", Timestamp, "When the member joined"},
+		{"left_at", Timestamp, "When the member left"},
+		{"created_at", Timestamp, "When the membership was created"},
+		{"deleted_at", Timestamp, "When the member was removed"},
+		{"exported_at", Timestamp, "When this snapshot was exported"},
+	},
+}
+
+// Time returns t as a row value: nil when t is.
+func Time(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+// text formats a row value as CSV and the warehouses' JSON APIs take it.
+// Timestamps are RFC 3339 in UTC, to the microsecond.
+func text(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.UTC().Format("2006-01-02T15:04:05.999999Z07:00")
+	}
+	return fmt.Sprint(v)
+}
+
+// fetch sends req and returns the response's status and body. Statuses
+// other than 2xx are errors unless listed in allow.
+func fetch(client *http.Client, req *http.Request, allow ...int) (int, []byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp.StatusCode, body, nil
+	}
+	for _, status := range allow {
+		if resp.StatusCode == status {
+			return resp.StatusCode, body, nil
+		}
+	}
+	if len(body) > 512 {
+		body = body[:512]
+	}
+	return resp.StatusCode, body, fmt.Errorf("responded %d: %s", resp.StatusCode, body)
+}
@@ -0,0 +1,142 @@
+// Package webhook signs and sends outbound webhook requests.
+//
+// Every request is a JSON POST carrying these headers:
+//
+//	X-Webhook-Id         the delivery ID
+//	X-Webhook-Event      the event type, e.g. meeting.stopped
+//	X-Webhook-Timestamp  Unix seconds when the request was signed
+//	X-Webhook-Signature  v1=<hex HMAC-SHA256 of "<timestamp>.<body>">
+//
+// Receivers recompute the signature with their endpoint secret, compare in
+// constant time, and reject timestamps too far from their clock to stop
+// replays.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/meeting-cost/backend/go/internal/outbound"
+)
+
+// Request headers.
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// maxResponseBody caps how much of a response is kept for delivery history.
+const maxResponseBody = 4 << 10
+
+// NewSecret generates a random endpoint secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Sign returns the X-Webhook-Signature value for body sent at ts.
+func Sign(secret string, ts time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Message is one signed request.
+type Message struct {
+	ID    string
+	Event string
+	Body  []byte
+}
+
+// Result is the outcome of one attempt. Err is set for transport failures
+// and non-2xx responses alike.
+type Result struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+	Err        error
+}
+
+// Sender posts signed messages.
+type Sender struct {
+	client *http.Client
+	// allowPrivate lets endpoints resolve to loopback and private
+	// addresses, for receivers run alongside the API in development
+	allowPrivate bool
+}
+
+// NewSender creates a Sender whose requests time out after timeout. Unless
+// allowPrivate is set, it connects only to public addresses, so endpoints
+// can't reach the API's own network.
+func NewSender(timeout time.Duration, allowPrivate bool) *Sender {
+	client := outbound.Client(timeout)
+	if allowPrivate {
+		client.Transport = http.DefaultTransport
+	}
+	return &Sender{client: client, allowPrivate: allowPrivate}
+}
+
+// CheckURL checks that rawURL is an absolute http(s) URL whose host
+// resolves only to public addresses, unless the Sender allows private
+// networks. Delivery checks each address again as it connects.
+func (s *Sender) CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Hostname() == "" {
+		return fmt.Errorf("invalid url: must be an absolute http(s) URL")
+	}
+	if s.allowPrivate {
+		return nil
+	}
+	if err := outbound.CheckHost(ctx, u.Hostname()); err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	return nil
+}
+
+// Send posts msg to url, signed with secret.
+func (s *Sender) Send(ctx context.Context, url, secret string, msg Message) Result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(msg.Body))
+	if err != nil {
+		return Result{Err: fmt.Errorf("building request: %w", err)}
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "MeetingCost-Webhooks/1.0")
+	req.Header.Set(HeaderID, msg.ID)
+	req.Header.Set(HeaderEvent, msg.Event)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(secret, now, msg.Body))
+
+	resp, err := s.client.Do(req)
+	res := Result{Duration: time.Since(now)}
+	if err != nil {
+		res.Err = fmt.Errorf("sending request: %w", err)
+		return res
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	res.StatusCode = resp.StatusCode
+	res.Body = string(body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		res.Err = fmt.Errorf("endpoint responded %d", resp.StatusCode)
+	}
+	return res
+}
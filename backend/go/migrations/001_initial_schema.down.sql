@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS cookie_consents;
+DROP TABLE IF EXISTS audit_logs;
+DROP TABLE IF EXISTS meeting_participants;
+DROP TABLE IF EXISTS increments;
+DROP TABLE IF EXISTS meetings;
+DROP TABLE IF EXISTS payments;
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS sessions;
+DROP TABLE IF EXISTS auth_methods;
+DROP TABLE IF EXISTS permissions;
+DROP TABLE IF EXISTS role_assignments;
+DROP TABLE IF EXISTS roles;
+DROP TABLE IF EXISTS person_organization_profiles;
+DROP TABLE IF EXISTS organizations;
+DROP TABLE IF EXISTS persons;
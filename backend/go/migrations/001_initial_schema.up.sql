@@ -0,0 +1,264 @@
+-- Initial schema, matching the GORM models in internal/models.
+
+CREATE TABLE persons (
+    id            uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at    timestamptz,
+    updated_at    timestamptz,
+    deleted_at    timestamptz,
+    email         text NOT NULL,
+    first_name    text NOT NULL,
+    last_name     text,
+    anonymized_at timestamptz,
+    anonymized    boolean DEFAULT false,
+    timezone      text DEFAULT 'UTC',
+    locale        text DEFAULT 'en-US'
+);
+CREATE UNIQUE INDEX idx_person_email ON persons (email);
+CREATE INDEX idx_persons_deleted_at ON persons (deleted_at);
+CREATE INDEX idx_person_anonymized ON persons (anonymized);
+
+CREATE TABLE organizations (
+    id               uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at       timestamptz,
+    updated_at       timestamptz,
+    deleted_at       timestamptz,
+    name             text NOT NULL,
+    slug             text NOT NULL,
+    description      text,
+    default_wage     numeric(10,2) DEFAULT 0,
+    use_blended_wage boolean DEFAULT false,
+    settings         jsonb
+);
+CREATE UNIQUE INDEX idx_org_slug ON organizations (slug);
+CREATE INDEX idx_organizations_deleted_at ON organizations (deleted_at);
+
+CREATE TABLE person_organization_profiles (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    deleted_at      timestamptz,
+    person_id       uuid NOT NULL REFERENCES persons (id),
+    organization_id uuid NOT NULL REFERENCES organizations (id),
+    is_active       boolean DEFAULT true,
+    joined_at       timestamptz NOT NULL,
+    left_at         timestamptz,
+    hourly_wage     numeric(10,2),
+    wage_updated_at timestamptz,
+    external_ids    jsonb
+);
+CREATE UNIQUE INDEX idx_person_org_unique ON person_organization_profiles (person_id, organization_id);
+CREATE INDEX idx_person_organization_profiles_deleted_at ON person_organization_profiles (deleted_at);
+
+CREATE TABLE roles (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    deleted_at      timestamptz,
+    name            text NOT NULL,
+    description     text,
+    organization_id uuid NOT NULL REFERENCES organizations (id)
+);
+CREATE UNIQUE INDEX idx_role_org_name ON roles (organization_id, name);
+CREATE INDEX idx_roles_deleted_at ON roles (deleted_at);
+
+CREATE TABLE role_assignments (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    deleted_at      timestamptz,
+    role_id         uuid NOT NULL REFERENCES roles (id),
+    person_id       uuid NOT NULL REFERENCES persons (id),
+    organization_id uuid NOT NULL REFERENCES organizations (id)
+);
+CREATE UNIQUE INDEX idx_role_assignment ON role_assignments (role_id, person_id, organization_id);
+CREATE INDEX idx_role_assignments_deleted_at ON role_assignments (deleted_at);
+
+CREATE TABLE permissions (
+    id                 uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at         timestamptz,
+    updated_at         timestamptz,
+    deleted_at         timestamptz,
+    resource_type      varchar(50) NOT NULL,
+    resource_id        uuid NOT NULL,
+    resource_name      text NOT NULL,
+    target_resource_id uuid,
+    activity           varchar(20) NOT NULL,
+    allowed            boolean DEFAULT true,
+    organization_id    uuid NOT NULL REFERENCES organizations (id)
+);
+CREATE INDEX idx_permission_resource ON permissions (resource_type, resource_id);
+CREATE INDEX idx_permission_org ON permissions (target_resource_id, organization_id);
+CREATE INDEX idx_permissions_deleted_at ON permissions (deleted_at);
+
+CREATE TABLE auth_methods (
+    id             uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at     timestamptz,
+    updated_at     timestamptz,
+    deleted_at     timestamptz,
+    person_id      uuid NOT NULL REFERENCES persons (id),
+    provider       varchar(50) NOT NULL,
+    provider_id    text NOT NULL,
+    email          text,
+    access_token   text,
+    refresh_token  text,
+    token_expiry   timestamptz,
+    password_hash  varchar(255),
+    email_verified boolean DEFAULT false,
+    verified_at    timestamptz
+);
+CREATE UNIQUE INDEX idx_auth_method_provider ON auth_methods (provider, provider_id);
+CREATE INDEX idx_auth_method_person ON auth_methods (person_id);
+CREATE INDEX idx_auth_method_email ON auth_methods (email);
+CREATE INDEX idx_auth_methods_deleted_at ON auth_methods (deleted_at);
+
+CREATE TABLE sessions (
+    id            uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at    timestamptz,
+    updated_at    timestamptz,
+    deleted_at    timestamptz,
+    person_id     uuid NOT NULL REFERENCES persons (id),
+    token_hash    varchar(255) NOT NULL,
+    expires_at    timestamptz NOT NULL,
+    last_activity timestamptz NOT NULL,
+    user_agent    text,
+    ip_address    text
+);
+CREATE UNIQUE INDEX idx_session_token ON sessions (token_hash);
+CREATE INDEX idx_session_person ON sessions (person_id);
+CREATE INDEX idx_session_expires ON sessions (expires_at);
+CREATE INDEX idx_sessions_deleted_at ON sessions (deleted_at);
+
+CREATE TABLE subscriptions (
+    id                     uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at             timestamptz,
+    updated_at             timestamptz,
+    deleted_at             timestamptz,
+    organization_id        uuid NOT NULL REFERENCES organizations (id),
+    plan_type              varchar(50) NOT NULL,
+    status                 varchar(50) NOT NULL,
+    current_period_start   timestamptz,
+    current_period_end     timestamptz,
+    stripe_customer_id     varchar(255),
+    stripe_subscription_id varchar(255)
+);
+CREATE INDEX idx_subscription_org ON subscriptions (organization_id);
+CREATE UNIQUE INDEX idx_subscription_stripe_customer ON subscriptions (stripe_customer_id);
+CREATE UNIQUE INDEX idx_subscription_stripe_sub ON subscriptions (stripe_subscription_id);
+CREATE INDEX idx_subscriptions_deleted_at ON subscriptions (deleted_at);
+
+CREATE TABLE payments (
+    id                       uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at               timestamptz,
+    updated_at               timestamptz,
+    deleted_at               timestamptz,
+    subscription_id          uuid NOT NULL REFERENCES subscriptions (id),
+    amount                   numeric(10,2) NOT NULL,
+    currency                 varchar(3) DEFAULT 'USD',
+    status                   varchar(50) NOT NULL,
+    paid_at                  timestamptz,
+    stripe_payment_intent_id varchar(255),
+    receipt_url              text
+);
+CREATE INDEX idx_payment_subscription ON payments (subscription_id);
+CREATE UNIQUE INDEX idx_payment_stripe ON payments (stripe_payment_intent_id);
+CREATE INDEX idx_payments_deleted_at ON payments (deleted_at);
+
+CREATE TABLE meetings (
+    id                 uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at         timestamptz,
+    updated_at         timestamptz,
+    deleted_at         timestamptz,
+    organization_id    uuid NOT NULL REFERENCES organizations (id),
+    purpose            text,
+    started_at         timestamptz,
+    stopped_at         timestamptz,
+    is_active          boolean DEFAULT false,
+    external_id        text,
+    external_type      varchar(50),
+    deduplication_hash text,
+    created_by_id      uuid NOT NULL REFERENCES persons (id),
+    total_cost         numeric(12,2) DEFAULT 0,
+    total_duration     bigint DEFAULT 0,
+    max_attendees      bigint DEFAULT 0
+);
+CREATE INDEX idx_meeting_org ON meetings (organization_id);
+CREATE INDEX idx_meeting_active ON meetings (is_active);
+CREATE INDEX idx_meeting_external ON meetings (external_id);
+CREATE INDEX idx_meeting_dedup ON meetings (deduplication_hash);
+CREATE INDEX idx_meetings_created_by_id ON meetings (created_by_id);
+CREATE INDEX idx_meetings_deleted_at ON meetings (deleted_at);
+
+CREATE TABLE increments (
+    id             uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at     timestamptz,
+    updated_at     timestamptz,
+    deleted_at     timestamptz,
+    meeting_id     uuid NOT NULL REFERENCES meetings (id),
+    start_time     timestamptz NOT NULL,
+    stop_time      timestamptz NOT NULL,
+    attendee_count bigint NOT NULL,
+    average_wage   numeric(10,2) NOT NULL,
+    elapsed_time   bigint NOT NULL,
+    cost           numeric(12,2) NOT NULL,
+    total_cost     numeric(12,2) NOT NULL,
+    purpose        text
+);
+CREATE INDEX idx_increment_meeting ON increments (meeting_id);
+CREATE INDEX idx_increment_time ON increments (start_time, stop_time);
+CREATE INDEX idx_increments_deleted_at ON increments (deleted_at);
+
+CREATE TABLE meeting_participants (
+    id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    meeting_id uuid NOT NULL REFERENCES meetings (id),
+    person_id  uuid NOT NULL REFERENCES persons (id),
+    joined_at  timestamptz,
+    left_at    timestamptz,
+    duration   bigint DEFAULT 0
+);
+CREATE UNIQUE INDEX idx_meeting_participant ON meeting_participants (meeting_id, person_id);
+CREATE INDEX idx_meeting_participants_deleted_at ON meeting_participants (deleted_at);
+
+-- Audit logs are immutable: no updated_at or deleted_at.
+CREATE TABLE audit_logs (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    person_id       uuid,
+    organization_id uuid,
+    action          varchar(100) NOT NULL,
+    resource_type   varchar(50) NOT NULL,
+    resource_id     uuid,
+    details         jsonb,
+    ip_address      text,
+    user_agent      text
+);
+CREATE INDEX idx_audit_created_at ON audit_logs (created_at);
+CREATE INDEX idx_audit_person ON audit_logs (person_id);
+CREATE INDEX idx_audit_org ON audit_logs (organization_id);
+CREATE INDEX idx_audit_resource ON audit_logs (resource_id);
+
+CREATE TABLE cookie_consents (
+    id                  uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at          timestamptz,
+    updated_at          timestamptz,
+    deleted_at          timestamptz,
+    person_id           uuid REFERENCES persons (id),
+    session_id          varchar(255) NOT NULL,
+    necessary_cookies   boolean DEFAULT true,
+    analytics_cookies   boolean DEFAULT false,
+    marketing_cookies   boolean DEFAULT false,
+    functional_cookies  boolean DEFAULT false,
+    consent_version     varchar(50) NOT NULL,
+    consent_date        timestamptz NOT NULL,
+    ip_address          text,
+    user_agent          text,
+    previous_consent_id uuid,
+    consent_source      varchar(50)
+);
+CREATE INDEX idx_cookie_consent_person ON cookie_consents (person_id);
+CREATE INDEX idx_cookie_consent_session ON cookie_consents (session_id);
+CREATE INDEX idx_cookie_consent_date ON cookie_consents (consent_date);
+CREATE INDEX idx_cookie_consent_previous ON cookie_consents (previous_consent_id);
+CREATE INDEX idx_cookie_consents_deleted_at ON cookie_consents (deleted_at);
@@ -0,0 +1,34 @@
+CREATE TABLE webhook_endpoints (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    deleted_at      timestamptz,
+    organization_id uuid NOT NULL REFERENCES organizations (id),
+    url             text NOT NULL,
+    description     text,
+    secret          text NOT NULL,
+    events          jsonb,
+    active          boolean DEFAULT true
+);
+CREATE INDEX idx_webhook_endpoints_deleted_at ON webhook_endpoints (deleted_at);
+CREATE INDEX idx_webhook_endpoint_org ON webhook_endpoints (organization_id);
+
+CREATE TABLE webhook_deliveries (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    endpoint_id     uuid NOT NULL REFERENCES webhook_endpoints (id),
+    event_id        uuid NOT NULL,
+    event_type      varchar(100) NOT NULL,
+    payload         jsonb NOT NULL,
+    status          varchar(20) NOT NULL DEFAULT 'pending',
+    attempts        bigint DEFAULT 0,
+    last_attempt_at timestamptz,
+    next_attempt_at timestamptz,
+    response_status bigint,
+    response_body   text,
+    error           text,
+    duration_ms     bigint
+);
+CREATE INDEX idx_webhook_delivery_endpoint ON webhook_deliveries (endpoint_id, created_at);
+CREATE INDEX idx_webhook_delivery_event ON webhook_deliveries (event_id);
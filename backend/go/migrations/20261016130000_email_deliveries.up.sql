@@ -0,0 +1,16 @@
+CREATE TABLE email_deliveries (
+    id                  uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at          timestamptz,
+    updated_at          timestamptz,
+    recipient           text NOT NULL,
+    template            varchar(50) NOT NULL,
+    subject             text NOT NULL,
+    driver              varchar(20) NOT NULL,
+    status              varchar(20) NOT NULL DEFAULT 'queued',
+    attempts            bigint DEFAULT 0,
+    provider_message_id text,
+    error               text,
+    sent_at             timestamptz
+);
+CREATE INDEX idx_email_deliveries_recipient ON email_deliveries (recipient);
+CREATE INDEX idx_email_deliveries_status ON email_deliveries (status);
@@ -0,0 +1,23 @@
+CREATE TABLE notification_preferences (
+    id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz,
+    updated_at timestamptz,
+    person_id  uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    event      varchar(50) NOT NULL,
+    channel    varchar(20) NOT NULL,
+    enabled    boolean NOT NULL
+);
+CREATE UNIQUE INDEX idx_notification_pref ON notification_preferences (person_id, event, channel);
+
+CREATE TABLE push_subscriptions (
+    id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz,
+    updated_at timestamptz,
+    person_id  uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    endpoint   text NOT NULL,
+    p256dh     text NOT NULL,
+    auth       text NOT NULL,
+    user_agent text
+);
+CREATE UNIQUE INDEX idx_push_subscriptions_endpoint ON push_subscriptions (endpoint);
+CREATE INDEX idx_push_subscriptions_person_id ON push_subscriptions (person_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS cost_alert_triggers;
+DROP TABLE IF EXISTS cost_alerts;
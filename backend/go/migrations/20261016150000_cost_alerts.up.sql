@@ -0,0 +1,22 @@
+CREATE TABLE cost_alerts (
+    id                uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at        timestamptz,
+    updated_at        timestamptz,
+    organization_id   uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    person_id         uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    meeting_id        uuid REFERENCES meetings (id) ON DELETE CASCADE,
+    threshold         decimal(12,2) NOT NULL,
+    last_triggered_at timestamptz
+);
+CREATE INDEX idx_cost_alert_org ON cost_alerts (organization_id);
+CREATE INDEX idx_cost_alerts_person_id ON cost_alerts (person_id);
+CREATE INDEX idx_cost_alerts_meeting_id ON cost_alerts (meeting_id);
+
+CREATE TABLE cost_alert_triggers (
+    id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz,
+    alert_id   uuid NOT NULL REFERENCES cost_alerts (id) ON DELETE CASCADE,
+    meeting_id uuid NOT NULL REFERENCES meetings (id) ON DELETE CASCADE,
+    cost       decimal(12,2) NOT NULL
+);
+CREATE UNIQUE INDEX idx_cost_alert_trigger ON cost_alert_triggers (alert_id, meeting_id);
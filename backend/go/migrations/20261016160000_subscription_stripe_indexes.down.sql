@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_subscription_stripe_customer;
+DROP INDEX IF EXISTS idx_subscription_stripe_sub;
+CREATE UNIQUE INDEX idx_subscription_stripe_customer ON subscriptions (stripe_customer_id);
+CREATE UNIQUE INDEX idx_subscription_stripe_sub ON subscriptions (stripe_subscription_id);
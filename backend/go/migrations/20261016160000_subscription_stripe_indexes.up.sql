@@ -0,0 +1,5 @@
+-- Subscriptions without Stripe store empty IDs, which must not collide
+DROP INDEX IF EXISTS idx_subscription_stripe_customer;
+DROP INDEX IF EXISTS idx_subscription_stripe_sub;
+CREATE UNIQUE INDEX idx_subscription_stripe_customer ON subscriptions (stripe_customer_id) WHERE stripe_customer_id <> '';
+CREATE UNIQUE INDEX idx_subscription_stripe_sub ON subscriptions (stripe_subscription_id) WHERE stripe_subscription_id <> '';
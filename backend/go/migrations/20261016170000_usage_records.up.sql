@@ -0,0 +1,13 @@
+CREATE TABLE usage_records (
+    id                       uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at               timestamptz,
+    updated_at               timestamptz,
+    organization_id          uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    period                   date NOT NULL,
+    meeting_seconds          bigint NOT NULL DEFAULT 0,
+    active_members           integer NOT NULL DEFAULT 0,
+    reported_meeting_minutes bigint NOT NULL DEFAULT 0,
+    reported_active_members  integer NOT NULL DEFAULT 0,
+    reported_at              timestamptz
+);
+CREATE UNIQUE INDEX idx_usage_org_period ON usage_records (organization_id, period);
@@ -0,0 +1,22 @@
+CREATE TABLE invoices (
+    id                 uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at         timestamptz,
+    updated_at         timestamptz,
+    organization_id    uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    subscription_id    uuid NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    number             varchar(100),
+    status             varchar(50) NOT NULL,
+    currency           varchar(3) DEFAULT 'USD',
+    amount_due         decimal(10,2) NOT NULL,
+    amount_paid        decimal(10,2) NOT NULL,
+    issued_at          timestamptz NOT NULL,
+    period_start       timestamptz,
+    period_end         timestamptz,
+    paid_at            timestamptz,
+    stripe_invoice_id  varchar(255),
+    hosted_invoice_url text,
+    invoice_pdf        text
+);
+CREATE INDEX idx_invoice_org ON invoices (organization_id, issued_at);
+CREATE INDEX idx_invoices_subscription_id ON invoices (subscription_id);
+CREATE UNIQUE INDEX idx_invoice_stripe ON invoices (stripe_invoice_id);
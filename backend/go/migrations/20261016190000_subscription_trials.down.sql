@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_subscription_trial_end;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS trial_ending_notified_at;
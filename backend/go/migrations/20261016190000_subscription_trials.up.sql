@@ -0,0 +1,3 @@
+ALTER TABLE subscriptions ADD COLUMN trial_ending_notified_at timestamptz;
+-- The trial check scans trials by end date
+CREATE INDEX idx_subscription_trial_end ON subscriptions (current_period_end) WHERE status = 'trialing';
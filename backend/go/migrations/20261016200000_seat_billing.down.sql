@@ -0,0 +1,2 @@
+ALTER TABLE organizations DROP COLUMN IF EXISTS seat_overage;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS seats;
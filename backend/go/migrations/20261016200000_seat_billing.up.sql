@@ -0,0 +1,2 @@
+ALTER TABLE subscriptions ADD COLUMN seats integer NOT NULL DEFAULT 0;
+ALTER TABLE organizations ADD COLUMN seat_overage varchar(20) NOT NULL DEFAULT 'block';
@@ -0,0 +1,24 @@
+CREATE TABLE report_exports (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    requested_by_id uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    report          varchar(20) NOT NULL,
+    format          varchar(10) NOT NULL,
+    period_start    timestamptz NOT NULL,
+    period_end      timestamptz NOT NULL,
+    trend_interval  varchar(10),
+    compare         boolean DEFAULT false,
+    row_limit       bigint DEFAULT 0,
+    status          varchar(20) NOT NULL DEFAULT 'pending',
+    attempts        bigint DEFAULT 0,
+    error           text,
+    file_name       text,
+    content_type    text,
+    data            bytea,
+    completed_at    timestamptz,
+    expires_at      timestamptz NOT NULL
+);
+CREATE INDEX idx_report_exports_organization_id ON report_exports (organization_id);
+CREATE INDEX idx_report_exports_expires_at ON report_exports (expires_at);
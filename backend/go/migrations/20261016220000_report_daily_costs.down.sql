@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_increments_updated_at;
+DROP INDEX IF EXISTS idx_meetings_updated_at;
+DROP TABLE IF EXISTS report_daily_costs;
@@ -0,0 +1,17 @@
+CREATE TABLE report_daily_costs (
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    day             date NOT NULL,
+    meeting_count   bigint NOT NULL DEFAULT 0,
+    meeting_cost    decimal(14,2) NOT NULL DEFAULT 0,
+    meeting_seconds bigint NOT NULL DEFAULT 0,
+    attendee_total  bigint NOT NULL DEFAULT 0,
+    cost            decimal(14,2) NOT NULL DEFAULT 0,
+    seconds         bigint NOT NULL DEFAULT 0,
+    refreshed_at    timestamptz NOT NULL,
+    PRIMARY KEY (organization_id, day)
+);
+CREATE INDEX idx_report_daily_costs_refreshed_at ON report_daily_costs (refreshed_at);
+
+-- Finding what changed since the last refresh
+CREATE INDEX idx_meetings_updated_at ON meetings (updated_at);
+CREATE INDEX idx_increments_updated_at ON increments (updated_at);
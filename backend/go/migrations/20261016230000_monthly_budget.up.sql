@@ -0,0 +1 @@
+ALTER TABLE organizations ADD COLUMN monthly_budget decimal(12,2);
@@ -0,0 +1,4 @@
+ALTER TABLE report_daily_costs DROP COLUMN IF EXISTS attendee_seconds;
+ALTER TABLE meetings DROP COLUMN IF EXISTS attendee_seconds;
+ALTER TABLE organizations DROP COLUMN IF EXISTS target_meeting_minutes;
+ALTER TABLE organizations DROP COLUMN IF EXISTS target_attendee_hour_cost;
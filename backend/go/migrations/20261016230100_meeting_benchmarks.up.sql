@@ -0,0 +1,16 @@
+ALTER TABLE organizations ADD COLUMN target_attendee_hour_cost decimal(10,2);
+ALTER TABLE organizations ADD COLUMN target_meeting_minutes integer;
+
+ALTER TABLE meetings ADD COLUMN attendee_seconds bigint NOT NULL DEFAULT 0;
+UPDATE meetings m SET attendee_seconds = t.attendee_seconds
+FROM (
+    SELECT meeting_id, SUM(elapsed_time::bigint * attendee_count) AS attendee_seconds
+    FROM increments
+    WHERE stop_time <> '0001-01-01 00:00:00+00' AND deleted_at IS NULL
+    GROUP BY meeting_id
+) t
+WHERE m.id = t.meeting_id;
+
+-- The worker's next refresh fills in every day again, with attendee time
+ALTER TABLE report_daily_costs ADD COLUMN attendee_seconds bigint NOT NULL DEFAULT 0;
+DELETE FROM report_daily_costs;
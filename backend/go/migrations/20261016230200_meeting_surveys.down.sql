@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS meeting_ratings;
+ALTER TABLE organizations DROP COLUMN IF EXISTS meeting_surveys;
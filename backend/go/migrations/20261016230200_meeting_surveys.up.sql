@@ -0,0 +1,13 @@
+ALTER TABLE organizations ADD COLUMN meeting_surveys boolean NOT NULL DEFAULT false;
+
+CREATE TABLE meeting_ratings (
+    id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz,
+    updated_at timestamptz,
+    meeting_id uuid NOT NULL REFERENCES meetings (id) ON DELETE CASCADE,
+    person_id  uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    rating     integer NOT NULL,
+    source     varchar(20) NOT NULL
+);
+CREATE UNIQUE INDEX idx_meeting_rating ON meeting_ratings (meeting_id, person_id);
+CREATE INDEX idx_meeting_ratings_person_id ON meeting_ratings (person_id);
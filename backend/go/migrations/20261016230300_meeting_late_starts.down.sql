@@ -0,0 +1,3 @@
+ALTER TABLE meetings DROP COLUMN IF EXISTS late_start_cost;
+ALTER TABLE meetings DROP COLUMN IF EXISTS late_start_seconds;
+ALTER TABLE meetings DROP COLUMN IF EXISTS scheduled_start;
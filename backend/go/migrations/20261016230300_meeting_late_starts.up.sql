@@ -0,0 +1,3 @@
+ALTER TABLE meetings ADD COLUMN scheduled_start timestamptz;
+ALTER TABLE meetings ADD COLUMN late_start_seconds integer NOT NULL DEFAULT 0;
+ALTER TABLE meetings ADD COLUMN late_start_cost decimal(12,2) NOT NULL DEFAULT 0;
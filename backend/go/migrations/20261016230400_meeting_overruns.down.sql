@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_meeting_scheduled_end;
+ALTER TABLE meetings DROP COLUMN IF EXISTS overrun_cost;
+ALTER TABLE meetings DROP COLUMN IF EXISTS overrun_seconds;
+ALTER TABLE meetings DROP COLUMN IF EXISTS overrun_at;
+ALTER TABLE meetings DROP COLUMN IF EXISTS scheduled_end;
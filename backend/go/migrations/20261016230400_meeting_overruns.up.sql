@@ -0,0 +1,6 @@
+ALTER TABLE meetings ADD COLUMN scheduled_end timestamptz;
+ALTER TABLE meetings ADD COLUMN overrun_at timestamptz;
+ALTER TABLE meetings ADD COLUMN overrun_seconds integer NOT NULL DEFAULT 0;
+ALTER TABLE meetings ADD COLUMN overrun_cost decimal(12,2) NOT NULL DEFAULT 0;
+-- The overrun check scans active meetings by scheduled end
+CREATE INDEX idx_meeting_scheduled_end ON meetings (scheduled_end) WHERE is_active AND overrun_at IS NULL;
@@ -0,0 +1 @@
+ALTER TABLE organizations ADD COLUMN timezone varchar(64) NOT NULL DEFAULT '';
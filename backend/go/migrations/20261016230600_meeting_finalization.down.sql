@@ -0,0 +1,2 @@
+ALTER TABLE meetings DROP COLUMN IF EXISTS finalized_by_id;
+ALTER TABLE meetings DROP COLUMN IF EXISTS finalized_at;
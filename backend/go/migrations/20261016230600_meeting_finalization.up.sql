@@ -0,0 +1,2 @@
+ALTER TABLE meetings ADD COLUMN finalized_at timestamptz;
+ALTER TABLE meetings ADD COLUMN finalized_by_id uuid REFERENCES persons (id);
@@ -0,0 +1,8 @@
+ALTER TABLE meetings DROP COLUMN IF EXISTS approval_note;
+ALTER TABLE meetings DROP COLUMN IF EXISTS approval_decided_by_id;
+ALTER TABLE meetings DROP COLUMN IF EXISTS approval_decided_at;
+ALTER TABLE meetings DROP COLUMN IF EXISTS approval_status;
+ALTER TABLE meetings DROP COLUMN IF EXISTS projected_cost;
+ALTER TABLE meetings DROP COLUMN IF EXISTS expected_attendees;
+ALTER TABLE person_organization_profiles DROP COLUMN IF EXISTS meeting_approver;
+ALTER TABLE organizations DROP COLUMN IF EXISTS approval_threshold;
@@ -0,0 +1,8 @@
+ALTER TABLE organizations ADD COLUMN approval_threshold decimal(12,2);
+ALTER TABLE person_organization_profiles ADD COLUMN meeting_approver boolean NOT NULL DEFAULT false;
+ALTER TABLE meetings ADD COLUMN expected_attendees integer NOT NULL DEFAULT 0;
+ALTER TABLE meetings ADD COLUMN projected_cost decimal(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE meetings ADD COLUMN approval_status varchar(20) NOT NULL DEFAULT '';
+ALTER TABLE meetings ADD COLUMN approval_decided_at timestamptz;
+ALTER TABLE meetings ADD COLUMN approval_decided_by_id uuid REFERENCES persons (id);
+ALTER TABLE meetings ADD COLUMN approval_note text NOT NULL DEFAULT '';
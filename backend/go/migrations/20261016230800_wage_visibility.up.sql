@@ -0,0 +1 @@
+ALTER TABLE organizations ADD COLUMN wage_visibility varchar(20) NOT NULL DEFAULT 'managers_and_self';
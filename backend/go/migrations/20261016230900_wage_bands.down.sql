@@ -0,0 +1,2 @@
+ALTER TABLE person_organization_profiles DROP COLUMN IF EXISTS wage_band_id;
+DROP TABLE IF EXISTS wage_bands;
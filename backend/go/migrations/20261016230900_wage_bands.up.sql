@@ -0,0 +1,12 @@
+CREATE TABLE wage_bands (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    name            varchar(100) NOT NULL,
+    min_wage        decimal(10,2) NOT NULL,
+    max_wage        decimal(10,2) NOT NULL
+);
+CREATE UNIQUE INDEX idx_wage_band_name ON wage_bands (organization_id, name);
+
+ALTER TABLE person_organization_profiles ADD COLUMN wage_band_id uuid REFERENCES wage_bands (id) ON DELETE SET NULL;
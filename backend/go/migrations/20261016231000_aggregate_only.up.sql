@@ -0,0 +1 @@
+ALTER TABLE organizations ADD COLUMN aggregate_only boolean NOT NULL DEFAULT false;
@@ -0,0 +1,13 @@
+CREATE TABLE account_deletions (
+    id               uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at       timestamptz,
+    updated_at       timestamptz,
+    person_id        uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    token_hash       varchar(64) NOT NULL,
+    token_expires_at timestamptz NOT NULL,
+    confirmed_at     timestamptz,
+    scheduled_for    timestamptz,
+    completed_at     timestamptz
+);
+CREATE UNIQUE INDEX idx_account_deletion_person ON account_deletions (person_id);
+CREATE INDEX idx_account_deletion_scheduled ON account_deletions (scheduled_for);
@@ -0,0 +1,13 @@
+CREATE TABLE person_emails (
+    id                      uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at              timestamptz,
+    updated_at              timestamptz,
+    person_id               uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    email                   varchar(255) NOT NULL,
+    verified_at             timestamptz,
+    verification_token_hash varchar(64),
+    verification_expires_at timestamptz
+);
+CREATE INDEX idx_person_email_person ON person_emails (person_id);
+CREATE UNIQUE INDEX idx_person_email_email ON person_emails (email);
+CREATE INDEX idx_person_email_token ON person_emails (verification_token_hash);
@@ -0,0 +1,2 @@
+ALTER TABLE sessions DROP COLUMN IF EXISTS active_organization_id;
+ALTER TABLE persons DROP COLUMN IF EXISTS active_organization_id;
@@ -0,0 +1,2 @@
+ALTER TABLE persons ADD COLUMN active_organization_id uuid;
+ALTER TABLE sessions ADD COLUMN active_organization_id uuid;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_org_domain;
+ALTER TABLE organizations DROP COLUMN IF EXISTS domain;
+ALTER TABLE organizations DROP COLUMN IF EXISTS visibility;
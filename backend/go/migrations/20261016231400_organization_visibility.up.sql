@@ -0,0 +1,4 @@
+ALTER TABLE organizations ADD COLUMN visibility varchar(20) NOT NULL DEFAULT 'invite_only';
+ALTER TABLE organizations ADD COLUMN domain varchar(255) NOT NULL DEFAULT '';
+-- People find organizations by the domains of their emails
+CREATE INDEX idx_org_domain ON organizations (domain);
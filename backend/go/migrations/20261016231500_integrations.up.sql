@@ -0,0 +1,17 @@
+CREATE TABLE integrations (
+    id               uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at       timestamptz,
+    updated_at       timestamptz,
+    organization_id  uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    provider         varchar(50) NOT NULL,
+    status           varchar(20) NOT NULL DEFAULT 'disconnected',
+    external_account varchar(255),
+    access_token     text,
+    refresh_token    text,
+    token_expires_at timestamptz,
+    settings         jsonb,
+    connected_by_id  uuid REFERENCES persons (id) ON DELETE SET NULL,
+    connected_at     timestamptz,
+    disconnected_at  timestamptz
+);
+CREATE UNIQUE INDEX idx_integration_provider ON integrations (organization_id, provider);
@@ -0,0 +1,4 @@
+-- Fails while any wage is encrypted; turn off ENCRYPTION_WAGES and
+-- re-encrypt to decrypt them first.
+ALTER TABLE person_organization_profiles
+    ALTER COLUMN hourly_wage TYPE numeric(10,2) USING hourly_wage::numeric(10,2);
@@ -0,0 +1,4 @@
+-- Hourly wages are stored as text so they can hold encrypted values when
+-- ENCRYPTION_WAGES is set; plaintext wages are kept as numbers in text.
+ALTER TABLE person_organization_profiles
+    ALTER COLUMN hourly_wage TYPE text USING hourly_wage::text;
@@ -0,0 +1,2 @@
+ALTER TABLE cookie_consents DROP COLUMN IF EXISTS categories;
+DROP TABLE IF EXISTS consent_categories;
@@ -0,0 +1,13 @@
+CREATE TABLE consent_categories (
+    id          uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at  timestamptz,
+    updated_at  timestamptz,
+    key         varchar(50) NOT NULL,
+    name        varchar(100) NOT NULL,
+    description text,
+    required    boolean NOT NULL DEFAULT false,
+    position    integer NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX idx_consent_category_key ON consent_categories (key);
+
+ALTER TABLE cookie_consents ADD COLUMN categories jsonb;
@@ -0,0 +1 @@
+ALTER TABLE cookie_consents DROP COLUMN IF EXISTS do_not_sell;
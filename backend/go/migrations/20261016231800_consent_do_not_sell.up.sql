@@ -0,0 +1 @@
+ALTER TABLE cookie_consents ADD COLUMN do_not_sell boolean NOT NULL DEFAULT false;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS dpa_acceptances;
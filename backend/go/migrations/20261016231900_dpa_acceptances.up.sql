@@ -0,0 +1,11 @@
+CREATE TABLE dpa_acceptances (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    version         varchar(50) NOT NULL,
+    accepted_by_id  uuid NOT NULL REFERENCES persons (id),
+    accepted_at     timestamptz NOT NULL,
+    ip_address      text,
+    user_agent      text
+);
+CREATE UNIQUE INDEX idx_dpa_acceptance_version ON dpa_acceptances (organization_id, version);
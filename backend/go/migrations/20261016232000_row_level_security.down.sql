@@ -0,0 +1,10 @@
+DROP POLICY IF EXISTS tenant_isolation ON meeting_ratings;
+ALTER TABLE meeting_ratings DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON meeting_participants;
+ALTER TABLE meeting_participants DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON increments;
+ALTER TABLE increments DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON meetings;
+ALTER TABLE meetings DISABLE ROW LEVEL SECURITY;
+DROP FUNCTION IF EXISTS tenant_organization_ids();
+DROP FUNCTION IF EXISTS tenant_scoped();
@@ -0,0 +1,44 @@
+-- Row-level security scoping meetings and their rows to organizations.
+-- Only connections that set app.tenant_scoped to 'on' are restricted, to
+-- the organizations listed in app.organization_ids; the API scopes them
+-- when DB_ROW_LEVEL_SECURITY is set, and everything else sees every row.
+-- Table owners are bound only once FORCE ROW LEVEL SECURITY is set.
+CREATE FUNCTION tenant_scoped() RETURNS boolean
+    LANGUAGE sql STABLE
+    AS $$ SELECT coalesce(current_setting('app.tenant_scoped', true), '') = 'on' $$;
+
+CREATE FUNCTION tenant_organization_ids() RETURNS uuid[]
+    LANGUAGE sql STABLE
+    AS $$ SELECT coalesce(string_to_array(nullif(current_setting('app.organization_ids', true), ''), ',')::uuid[], '{}') $$;
+
+ALTER TABLE meetings ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON meetings
+    USING (NOT tenant_scoped() OR organization_id = ANY (tenant_organization_ids()))
+    WITH CHECK (NOT tenant_scoped() OR organization_id = ANY (tenant_organization_ids()));
+
+ALTER TABLE increments ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON increments
+    USING (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = increments.meeting_id AND m.organization_id = ANY (tenant_organization_ids())))
+    WITH CHECK (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = increments.meeting_id AND m.organization_id = ANY (tenant_organization_ids())));
+
+ALTER TABLE meeting_participants ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON meeting_participants
+    USING (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = meeting_participants.meeting_id AND m.organization_id = ANY (tenant_organization_ids())))
+    WITH CHECK (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = meeting_participants.meeting_id AND m.organization_id = ANY (tenant_organization_ids())));
+
+ALTER TABLE meeting_ratings ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON meeting_ratings
+    USING (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = meeting_ratings.meeting_id AND m.organization_id = ANY (tenant_organization_ids())))
+    WITH CHECK (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = meeting_ratings.meeting_id AND m.organization_id = ANY (tenant_organization_ids())));
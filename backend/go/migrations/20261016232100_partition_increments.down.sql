@@ -0,0 +1,45 @@
+LOCK TABLE increments IN EXCLUSIVE MODE;
+
+CREATE TABLE increments_unpartitioned (
+    id             uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at     timestamptz,
+    updated_at     timestamptz,
+    deleted_at     timestamptz,
+    meeting_id     uuid NOT NULL REFERENCES meetings (id),
+    start_time     timestamptz NOT NULL,
+    stop_time      timestamptz NOT NULL,
+    attendee_count bigint NOT NULL,
+    average_wage   numeric(10,2) NOT NULL,
+    elapsed_time   bigint NOT NULL,
+    cost           numeric(12,2) NOT NULL,
+    total_cost     numeric(12,2) NOT NULL,
+    purpose        text
+);
+
+INSERT INTO increments_unpartitioned (
+    id, created_at, updated_at, deleted_at, meeting_id, start_time, stop_time,
+    attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+)
+SELECT id, created_at, updated_at, deleted_at, meeting_id, start_time, stop_time,
+       attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+FROM increments;
+
+-- Dropping the partitioned table drops its partitions
+DROP TABLE increments;
+ALTER TABLE increments_unpartitioned RENAME TO increments;
+ALTER TABLE increments RENAME CONSTRAINT increments_unpartitioned_pkey TO increments_pkey;
+ALTER TABLE increments RENAME CONSTRAINT increments_unpartitioned_meeting_id_fkey TO increments_meeting_id_fkey;
+
+CREATE INDEX idx_increment_meeting ON increments (meeting_id);
+CREATE INDEX idx_increment_time ON increments (start_time, stop_time);
+CREATE INDEX idx_increments_deleted_at ON increments (deleted_at);
+CREATE INDEX idx_increments_updated_at ON increments (updated_at);
+
+ALTER TABLE increments ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON increments
+    USING (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = increments.meeting_id AND m.organization_id = ANY (tenant_organization_ids())))
+    WITH CHECK (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = increments.meeting_id AND m.organization_id = ANY (tenant_organization_ids())));
@@ -0,0 +1,68 @@
+-- Increments are partitioned by the month they start in, so queries over a
+-- time range read only the months they cover and old months can be
+-- dropped whole. The worker keeps partitions created ahead
+-- (INCREMENT_PARTITIONS_AHEAD); increments outside every month wait in
+-- increments_default until theirs is created.
+LOCK TABLE increments IN EXCLUSIVE MODE;
+
+CREATE TABLE increments_partitioned (
+    id             uuid NOT NULL DEFAULT gen_random_uuid(),
+    created_at     timestamptz,
+    updated_at     timestamptz,
+    deleted_at     timestamptz,
+    meeting_id     uuid NOT NULL REFERENCES meetings (id),
+    start_time     timestamptz NOT NULL,
+    stop_time      timestamptz NOT NULL,
+    attendee_count bigint NOT NULL,
+    average_wage   numeric(10,2) NOT NULL,
+    elapsed_time   bigint NOT NULL,
+    cost           numeric(12,2) NOT NULL,
+    total_cost     numeric(12,2) NOT NULL,
+    purpose        text,
+    PRIMARY KEY (id, start_time)
+) PARTITION BY RANGE (start_time);
+CREATE TABLE increments_default PARTITION OF increments_partitioned DEFAULT;
+
+-- A partition for every month with increments in the last ten years,
+-- through three months ahead
+DO $$
+DECLARE
+    month timestamp := date_trunc('month', greatest(
+        coalesce((SELECT min(start_time) FROM increments), now()),
+        now() - interval '10 years') AT TIME ZONE 'UTC');
+    last  timestamp := date_trunc('month', now() AT TIME ZONE 'UTC') + interval '3 months';
+BEGIN
+    WHILE month <= last LOOP
+        EXECUTE format('CREATE TABLE %I PARTITION OF increments_partitioned FOR VALUES FROM (%L) TO (%L)',
+            'increments_' || to_char(month, 'YYYY_MM'),
+            month AT TIME ZONE 'UTC', (month + interval '1 month') AT TIME ZONE 'UTC');
+        month := month + interval '1 month';
+    END LOOP;
+END $$;
+
+INSERT INTO increments_partitioned (
+    id, created_at, updated_at, deleted_at, meeting_id, start_time, stop_time,
+    attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+)
+SELECT id, created_at, updated_at, deleted_at, meeting_id, start_time, stop_time,
+       attendee_count, average_wage, elapsed_time, cost, total_cost, purpose
+FROM increments;
+
+DROP TABLE increments;
+ALTER TABLE increments_partitioned RENAME TO increments;
+ALTER TABLE increments RENAME CONSTRAINT increments_partitioned_pkey TO increments_pkey;
+ALTER TABLE increments RENAME CONSTRAINT increments_partitioned_meeting_id_fkey TO increments_meeting_id_fkey;
+
+CREATE INDEX idx_increment_meeting ON increments (meeting_id);
+CREATE INDEX idx_increment_time ON increments (start_time, stop_time);
+CREATE INDEX idx_increments_deleted_at ON increments (deleted_at);
+CREATE INDEX idx_increments_updated_at ON increments (updated_at);
+
+ALTER TABLE increments ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON increments
+    USING (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = increments.meeting_id AND m.organization_id = ANY (tenant_organization_ids())))
+    WITH CHECK (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meetings m
+        WHERE m.id = increments.meeting_id AND m.organization_id = ANY (tenant_organization_ids())));
@@ -0,0 +1,4 @@
+-- Archived meetings are lost; restore any worth keeping first.
+DROP TABLE IF EXISTS meeting_archive_days;
+DROP TABLE IF EXISTS meeting_archives;
+ALTER TABLE meetings DROP COLUMN IF EXISTS unarchived_at;
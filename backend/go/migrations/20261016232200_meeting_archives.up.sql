@@ -0,0 +1,42 @@
+ALTER TABLE meetings ADD COLUMN unarchived_at timestamptz;
+
+CREATE TABLE meeting_archives (
+    id               uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at       timestamptz,
+    meeting_id       uuid NOT NULL,
+    organization_id  uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    purpose          text,
+    started_at       timestamptz,
+    stopped_at       timestamptz,
+    total_cost       decimal(12,2) NOT NULL DEFAULT 0,
+    total_duration   bigint NOT NULL DEFAULT 0,
+    max_attendees    bigint NOT NULL DEFAULT 0,
+    attendee_seconds bigint NOT NULL DEFAULT 0,
+    increment_count  bigint NOT NULL DEFAULT 0,
+    size             bigint NOT NULL DEFAULT 0,
+    data             bytea NOT NULL
+);
+CREATE UNIQUE INDEX idx_meeting_archives_meeting_id ON meeting_archives (meeting_id);
+CREATE INDEX idx_meeting_archive_org ON meeting_archives (organization_id, started_at);
+
+CREATE TABLE meeting_archive_days (
+    meeting_id uuid NOT NULL REFERENCES meeting_archives (meeting_id) ON DELETE CASCADE,
+    day        date NOT NULL,
+    cost       decimal(14,2) NOT NULL DEFAULT 0,
+    seconds    bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (meeting_id, day)
+);
+
+ALTER TABLE meeting_archives ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON meeting_archives
+    USING (NOT tenant_scoped() OR organization_id = ANY (tenant_organization_ids()))
+    WITH CHECK (NOT tenant_scoped() OR organization_id = ANY (tenant_organization_ids()));
+
+ALTER TABLE meeting_archive_days ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON meeting_archive_days
+    USING (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meeting_archives a
+        WHERE a.meeting_id = meeting_archive_days.meeting_id AND a.organization_id = ANY (tenant_organization_ids())))
+    WITH CHECK (NOT tenant_scoped() OR EXISTS (
+        SELECT 1 FROM meeting_archives a
+        WHERE a.meeting_id = meeting_archive_days.meeting_id AND a.organization_id = ANY (tenant_organization_ids())));
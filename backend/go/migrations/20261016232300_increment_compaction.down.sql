@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_meetings_uncompacted;
+ALTER TABLE meetings DROP COLUMN IF EXISTS increments_compacted_at;
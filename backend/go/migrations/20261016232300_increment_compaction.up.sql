@@ -0,0 +1,5 @@
+ALTER TABLE meetings ADD COLUMN increments_compacted_at timestamptz;
+
+-- Finds the stopped meetings still to compact
+CREATE INDEX idx_meetings_uncompacted ON meetings (stopped_at)
+    WHERE deleted_at IS NULL AND NOT is_active AND increments_compacted_at IS NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE cost_milestones (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    meeting_id      uuid NOT NULL REFERENCES meetings (id) ON DELETE CASCADE,
+    milestone       decimal(12,2) NOT NULL,
+    cost            decimal(12,2) NOT NULL,
+    posted_at       timestamptz
+);
+CREATE UNIQUE INDEX idx_cost_milestone ON cost_milestones (meeting_id, milestone);
+CREATE INDEX idx_cost_milestone_org ON cost_milestones (organization_id, posted_at);
@@ -0,0 +1,13 @@
+CREATE TABLE extension_tokens (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    person_id       uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    name            varchar(100),
+    token_hash      varchar(64) NOT NULL,
+    last_used_at    timestamptz,
+    revoked_at      timestamptz
+);
+CREATE INDEX idx_extension_token_person ON extension_tokens (person_id);
+CREATE UNIQUE INDEX idx_extension_token_hash ON extension_tokens (token_hash);
@@ -0,0 +1,12 @@
+CREATE TABLE sync_operations (
+    id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    person_id    uuid NOT NULL REFERENCES persons (id) ON DELETE CASCADE,
+    operation_id uuid NOT NULL,
+    meeting_id   uuid NOT NULL,
+    type         varchar(20) NOT NULL,
+    status       varchar(20) NOT NULL,
+    reason       text NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX idx_sync_operation ON sync_operations (person_id, operation_id);
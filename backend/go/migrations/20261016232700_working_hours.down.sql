@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS holidays;
+ALTER TABLE organizations DROP COLUMN IF EXISTS workday_end;
+ALTER TABLE organizations DROP COLUMN IF EXISTS workday_start;
+ALTER TABLE organizations DROP COLUMN IF EXISTS working_days;
@@ -0,0 +1,13 @@
+ALTER TABLE organizations ADD COLUMN working_days varchar(32) NOT NULL DEFAULT '';
+ALTER TABLE organizations ADD COLUMN workday_start varchar(5) NOT NULL DEFAULT '';
+ALTER TABLE organizations ADD COLUMN workday_end varchar(5) NOT NULL DEFAULT '';
+
+CREATE TABLE holidays (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    date            date NOT NULL,
+    name            varchar(100) NOT NULL
+);
+CREATE UNIQUE INDEX idx_holiday_date ON holidays (organization_id, date);
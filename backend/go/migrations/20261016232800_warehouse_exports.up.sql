@@ -0,0 +1,19 @@
+CREATE TABLE warehouse_exports (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    provider        varchar(50) NOT NULL,
+    meetings_at     timestamptz,
+    meetings_id     uuid,
+    memberships_at  timestamptz,
+    memberships_id  uuid,
+    meeting_rows    bigint NOT NULL DEFAULT 0,
+    increment_rows  bigint NOT NULL DEFAULT 0,
+    membership_rows bigint NOT NULL DEFAULT 0,
+    running_until   timestamptz,
+    last_run_at     timestamptz,
+    last_success_at timestamptz,
+    last_error      text NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX idx_warehouse_export_provider ON warehouse_exports (organization_id, provider);
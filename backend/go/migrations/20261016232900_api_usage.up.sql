@@ -0,0 +1,13 @@
+CREATE TABLE api_usage (
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      timestamptz,
+    updated_at      timestamptz,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    token_id        uuid NOT NULL,
+    hour            timestamptz NOT NULL,
+    requests        bigint NOT NULL DEFAULT 0,
+    errors          bigint NOT NULL DEFAULT 0,
+    last_request_at timestamptz NOT NULL
+);
+CREATE UNIQUE INDEX idx_api_usage_org_token_hour ON api_usage (organization_id, token_id, hour);
+CREATE INDEX idx_api_usage_hour ON api_usage (hour);